@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/schema"
+	"go.infratographer.com/metadata-api/internal/graphapi"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+
+	namespaceTypeAnnotation = "annotation"
+	namespaceTypeStatus     = "status"
+)
+
+var (
+	// errInvalidFormat is returned when the output format isn't supported.
+	errInvalidFormat = errors.New("invalid output format, must be table or json")
+
+	// errInvalidNamespaceType is returned when a namespace type or ID isn't of an annotation or status namespace.
+	errInvalidNamespaceType = errors.New("not an annotation or status namespace")
+
+	// errStatusNamespaceOnly is returned when a flag only applies to status namespaces.
+	errStatusNamespaceOnly = errors.New("only supported by status namespaces")
+
+	// errInvalidJSON is returned when a JSON flag isn't valid JSON.
+	errInvalidJSON = errors.New("invalid JSON")
+)
+
+var (
+	outputFormat       string
+	adminPublishEvents bool
+)
+
+// addAdminFlags adds the flags shared by the admin commands, which change the
+// data directly in the database without going through the api.
+func addAdminFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&outputFormat, "format", formatTable, "output format, table or json")
+	cmd.PersistentFlags().BoolVar(&adminPublishEvents, "publish-events", true, "publish change events and auth relationships of the changes like the api does, without them the permissions api and consumers of the events don't learn of the changes")
+
+	// the format is checked before any change is made
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if outputFormat != formatTable && outputFormat != formatJSON {
+			return fmt.Errorf("%w: %s", errInvalidFormat, outputFormat)
+		}
+
+		return nil
+	}
+}
+
+// adminResolver returns a resolver to run the mutations of the api with the client
+// and the context they run with. The admin commands have direct access to the
+// database, so all actions are permitted without asking the permissions api,
+// while the resolvers still validate the changes.
+func adminResolver(ctx context.Context, client *ent.Client) (context.Context, *graphapi.Resolver) {
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	return ctx, graphapi.NewResolver(client, logger.Named("resolvers"))
+}
+
+// printResult writes v to stdout as indented JSON, or as a table written by table.
+func printResult(v any, table func(w io.Writer)) error {
+	if outputFormat == formatJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(v)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:gomnd
+
+	table(tw)
+
+	return tw.Flush()
+}
+
+// namespaceType returns the type of the namespace with the ID.
+func namespaceType(id string) (string, error) {
+	pid, err := gidx.Parse(id)
+	if err != nil {
+		return "", err
+	}
+
+	switch pid.Prefix() {
+	case schema.AnnotationNamespacePrefix:
+		return namespaceTypeAnnotation, nil
+	case schema.StatusNamespacePrefix:
+		return namespaceTypeStatus, nil
+	default:
+		return "", fmt.Errorf("%w: %s", errInvalidNamespaceType, id)
+	}
+}
+
+// jsonFlag returns the JSON value of a flag, read from a file when the value is
+// @ followed by the path of the file.
+func jsonFlag(name, value string) (json.RawMessage, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	data := []byte(value)
+
+	if path, ok := strings.CutPrefix(value, "@"); ok {
+		var err error
+
+		if data, err = os.ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("--%s: %w", name, errInvalidJSON)
+	}
+
+	return data, nil
+}
+
+// orDash returns s, or - when it's empty, for the cells of tables.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/backup"
+)
+
+var (
+	// errImportConflicts is returned when records of an import conflict with the stored records.
+	errImportConflicts = errors.New("records conflict with the stored records")
+
+	// errDryRunEvents is returned when events are to be published for a dry run.
+	errDryRunEvents = errors.New("--publish-events can't be used with --dry-run")
+)
+
+var (
+	exportOutput       string
+	exportOwnerIDs     []string
+	exportNamespaceIDs []string
+	exportNodeIDPrefix string
+
+	importDryRun        bool
+	importPublishEvents bool
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export namespaces, metadata, annotations and statuses as newline-delimited JSON",
+	Long: `Export namespaces, metadata, annotations and statuses as newline-delimited JSON,
+one record per line. The export can be loaded into another environment with the import command.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return export(cmd.Context())
+	},
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import namespaces, metadata, annotations and statuses exported by the export command",
+	Long: `Import the records exported by the export command from the file, or stdin when no file
+or - is given. Records keep their IDs, so importing the same records again changes nothing.
+Records which conflict with the stored records are reported and skipped, the command then fails.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := "-"
+		if len(args) != 0 {
+			path = args[0]
+		}
+
+		return importRecords(cmd.Context(), path)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(importCmd)
+
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "-", "file to write the export to, stdout when -")
+	exportCmd.Flags().StringSliceVar(&exportOwnerIDs, "owner-id", nil, "only export the namespaces of the owners or resource providers")
+	exportCmd.Flags().StringSliceVar(&exportNamespaceIDs, "namespace-id", nil, "only export the namespaces")
+	exportCmd.Flags().StringVar(&exportNodeIDPrefix, "node-id-prefix", "", "only export the metadata of nodes with IDs starting with the prefix, e.g. loadbal-")
+
+	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "report the changes and conflicts of the import without making them")
+	importCmd.Flags().BoolVar(&importPublishEvents, "publish-events", false, "publish change events and auth relationships of the imported records like the api does")
+}
+
+func export(ctx context.Context) error {
+	filter := backup.Filter{NodeIDPrefix: exportNodeIDPrefix}
+
+	var err error
+
+	if filter.OwnerIDs, err = parseIDs(exportOwnerIDs); err != nil {
+		return fmt.Errorf("invalid owner id: %w", err)
+	}
+
+	if filter.NamespaceIDs, err = parseIDs(exportNamespaceIDs); err != nil {
+		return fmt.Errorf("invalid namespace id: %w", err)
+	}
+
+	ctx, client, closeClient, err := newDBClient(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	defer closeClient()
+
+	var w io.Writer = os.Stdout
+
+	if exportOutput != "-" {
+		f, err := os.Create(exportOutput)
+		if err != nil {
+			return err
+		}
+
+		defer f.Close()
+
+		w = f
+	}
+
+	counts, err := backup.NewExporter(client).Export(ctx, w, filter)
+	if err != nil {
+		logger.Errorw("failed to export", "error", err)
+		return err
+	}
+
+	logger.Infow("export complete", "records", counts)
+
+	return nil
+}
+
+func importRecords(ctx context.Context, path string) error {
+	if importDryRun && importPublishEvents {
+		return errDryRunEvents
+	}
+
+	ctx, client, closeClient, err := newDBClient(ctx, importPublishEvents)
+	if err != nil {
+		return err
+	}
+
+	defer closeClient()
+
+	var r io.Reader = os.Stdin
+
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		defer f.Close()
+
+		r = f
+	}
+
+	var importOpts []backup.ImporterOption
+
+	if importDryRun {
+		importOpts = append(importOpts, backup.WithDryRun())
+	}
+
+	report, err := backup.NewImporter(client, importOpts...).Import(ctx, r)
+	if err != nil {
+		logger.Errorw("failed to import", "error", err)
+		return err
+	}
+
+	for _, c := range report.Conflicts {
+		logger.Warnw("conflicting record skipped", "line", c.Line, "kind", c.Kind, "id", c.ID, "reason", c.Reason)
+	}
+
+	logger.Infow("import complete",
+		"dryRun", importDryRun,
+		"created", report.Created,
+		"updated", report.Updated,
+		"unchanged", report.Unchanged,
+		"conflicts", len(report.Conflicts),
+	)
+
+	if len(report.Conflicts) != 0 {
+		return fmt.Errorf("%d %w", len(report.Conflicts), errImportConflicts)
+	}
+
+	return nil
+}
+
+func parseIDs(ids []string) ([]gidx.PrefixedID, error) {
+	parsed := make([]gidx.PrefixedID, len(ids))
+
+	for i, id := range ids {
+		p, err := gidx.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed[i] = p
+	}
+
+	return parsed, nil
+}
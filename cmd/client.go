@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/nats-io/nats.go"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/crdbx"
+	"go.infratographer.com/x/events"
+
+	"go.infratographer.com/metadata-api/internal/config"
+	"go.infratographer.com/metadata-api/internal/ent/bulkhooks"
+	"go.infratographer.com/metadata-api/internal/ent/changehooks"
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/historyhooks"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
+	"go.infratographer.com/metadata-api/internal/pubsub"
+)
+
+// newDBClient returns a client for the database of the config, which records the
+// history of the changes made with it. With publishEvents the changes are also
+// published like the api does, to the events and to the subscriptions of the api,
+// the returned context then carries the permissions client requesting the auth
+// relationships of namespaces. The returned function closes the client.
+func newDBClient(ctx context.Context, publishEvents bool) (context.Context, *ent.Client, func(), error) {
+	var (
+		opts    []ent.Option
+		closers []func()
+		broker  *pubsub.Broker
+	)
+
+	closeAll := func() {
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i]()
+		}
+	}
+
+	if publishEvents {
+		events, err := events.NewConnection(config.AppConfig.Events, events.WithLogger(logger))
+		if err != nil {
+			logger.Errorw("failed to initialize events", "error", err)
+			return nil, nil, nil, err
+		}
+
+		closers = append(closers, func() {
+			_ = events.Shutdown(context.Background())
+		})
+
+		perms, err := permissions.New(config.AppConfig.Permissions,
+			permissions.WithLogger(logger),
+			permissions.WithEventsPublisher(events),
+		)
+		if err != nil {
+			closeAll()
+
+			logger.Errorw("failed to initialize permissions", "error", err)
+			return nil, nil, nil, err
+		}
+
+		// the event hooks request the auth relationships of namespaces through the context, like in requests to the api
+		ctx = context.WithValue(ctx, permissions.AuthRelationshipRequestHandlerCtxKey, perms)
+
+		// changes are sent to the subscriptions of the api replicas through nats
+		broker = pubsub.NewBroker(logger.Named("pubsub"))
+
+		if nc, ok := events.Source().(*nats.Conn); ok {
+			if err := broker.ConnectNATS(nc, config.AppConfig.Events.NATS.PublishPrefix); err != nil {
+				closeAll()
+
+				logger.Errorw("failed to connect to changes", "error", err)
+				return nil, nil, nil, err
+			}
+		}
+
+		opts = append(opts, ent.EventsPublisher(events))
+	}
+
+	db, err := crdbx.NewDB(config.AppConfig.CRDB, config.AppConfig.Tracing.Enabled)
+	if err != nil {
+		closeAll()
+
+		logger.Errorw("failed to connect to database", "error", err)
+		return nil, nil, nil, err
+	}
+
+	opts = append(opts, ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
+
+	if config.AppConfig.Logging.Debug {
+		opts = append(opts,
+			ent.Log(logger.Named("ent").Debugln),
+			ent.Debug(),
+		)
+	}
+
+	client := ent.NewClient(opts...)
+
+	closers = append(closers, func() {
+		_ = client.Close()
+	})
+
+	if publishEvents {
+		bulkhooks.EventHooks(client)
+		changehooks.ChangeHooks(client, broker)
+	}
+
+	historyhooks.HistoryHooks(client)
+	softdelete.Interceptors(client)
+
+	return ctx, client, closeAll, nil
+}
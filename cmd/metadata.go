@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.infratographer.com/x/gidx"
+
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
+	"go.infratographer.com/metadata-api/internal/gc"
+)
+
+var metadataCmd = &cobra.Command{
+	Use:   "metadata",
+	Short: "Inspect and remove the metadata of nodes directly in the database",
+	Long: `Inspect and remove the metadata of nodes directly in the database, without the
+api, OIDC or the permissions api.`,
+}
+
+var metadataGetCmd = &cobra.Command{
+	Use:   "get <nodeID>",
+	Short: "Show the metadata of a node with all its annotations and statuses, including expired ones",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return metadataGet(cmd.Context(), args[0])
+	},
+}
+
+var metadataPurgeCmd = &cobra.Command{
+	Use:   "purge <nodeID>",
+	Short: "Remove the metadata of a node with all its annotations and statuses",
+	Long: `Remove the metadata of a node with all its annotations and statuses, like when the
+node is deleted. The removed metadata is shown.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return metadataPurge(cmd.Context(), args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(metadataCmd)
+	addAdminFlags(metadataCmd)
+
+	metadataCmd.AddCommand(metadataGetCmd, metadataPurgeCmd)
+}
+
+// metadataView is the output of the metadata of a node.
+type metadataView struct {
+	ID          gidx.PrefixedID  `json:"id"`
+	NodeID      gidx.PrefixedID  `json:"nodeID"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	UpdatedAt   time.Time        `json:"updatedAt"`
+	Annotations []annotationView `json:"annotations"`
+	Statuses    []statusView     `json:"statuses"`
+}
+
+type annotationView struct {
+	ID          gidx.PrefixedID `json:"id"`
+	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	Data        json.RawMessage `json:"data"`
+	Version     int64           `json:"version"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+type statusView struct {
+	ID          gidx.PrefixedID `json:"id"`
+	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	Source      string          `json:"source"`
+	Data        json.RawMessage `json:"data"`
+	Version     int64           `json:"version"`
+	ExpiresAt   *time.Time      `json:"expiresAt,omitempty"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+func newMetadataView(md *ent.Metadata) metadataView {
+	view := metadataView{
+		ID:          md.ID,
+		NodeID:      md.NodeID,
+		CreatedAt:   md.CreatedAt,
+		UpdatedAt:   md.UpdatedAt,
+		Annotations: make([]annotationView, len(md.Edges.Annotations)),
+		Statuses:    make([]statusView, len(md.Edges.Statuses)),
+	}
+
+	for i, ant := range md.Edges.Annotations {
+		view.Annotations[i] = annotationView{
+			ID:          ant.ID,
+			NamespaceID: ant.AnnotationNamespaceID,
+			Data:        ant.Data,
+			Version:     ant.Version,
+			CreatedAt:   ant.CreatedAt,
+			UpdatedAt:   ant.UpdatedAt,
+		}
+	}
+
+	for i, st := range md.Edges.Statuses {
+		view.Statuses[i] = statusView{
+			ID:          st.ID,
+			NamespaceID: st.StatusNamespaceID,
+			Source:      st.Source,
+			Data:        st.Data,
+			Version:     st.Version,
+			ExpiresAt:   st.ExpiresAt,
+			CreatedAt:   st.CreatedAt,
+			UpdatedAt:   st.UpdatedAt,
+		}
+	}
+
+	return view
+}
+
+func printMetadata(view metadataView) error {
+	return printResult(view, func(w io.Writer) {
+		fmt.Fprintf(w, "METADATA\t%s\n", view.ID)
+		fmt.Fprintf(w, "NODE\t%s\n", view.NodeID)
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "KIND\tID\tNAMESPACE\tSOURCE\tVERSION\tEXPIRES\tDATA")
+
+		for _, ant := range view.Annotations {
+			fmt.Fprintf(w, "annotation\t%s\t%s\t-\t%d\t-\t%s\n", ant.ID, ant.NamespaceID, ant.Version, ant.Data)
+		}
+
+		for _, st := range view.Statuses {
+			expires := "-"
+			if st.ExpiresAt != nil {
+				expires = st.ExpiresAt.Format(time.RFC3339)
+			}
+
+			fmt.Fprintf(w, "status\t%s\t%s\t%s\t%d\t%s\t%s\n", st.ID, st.NamespaceID, orDash(st.Source), st.Version, expires, st.Data)
+		}
+	})
+}
+
+// getMetadata returns the metadata of the node with its annotations and statuses.
+func getMetadata(ctx context.Context, client *ent.Client, nodeID gidx.PrefixedID) (*ent.Metadata, error) {
+	return client.Metadata.Query().
+		Where(metadata.NodeID(nodeID)).
+		WithAnnotations(func(q *ent.AnnotationQuery) {
+			q.Order(ent.Asc(annotation.FieldAnnotationNamespaceID))
+		}).
+		WithStatuses(func(q *ent.StatusQuery) {
+			q.Order(ent.Asc(status.FieldStatusNamespaceID), ent.Asc(status.FieldSource))
+		}).
+		Only(ctx)
+}
+
+func metadataGet(ctx context.Context, id string) error {
+	nodeID, err := gidx.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	ctx, client, closeClient, err := newDBClient(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	defer closeClient()
+
+	md, err := getMetadata(ctx, client, nodeID)
+	if err != nil {
+		return err
+	}
+
+	return printMetadata(newMetadataView(md))
+}
+
+func metadataPurge(ctx context.Context, id string) error {
+	nodeID, err := gidx.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	ctx, client, closeClient, err := newDBClient(ctx, adminPublishEvents)
+	if err != nil {
+		return err
+	}
+
+	defer closeClient()
+
+	// the annotations and statuses in soft deleted namespaces are shown as they're purged as well
+	ctx = softdelete.IncludeDeleted(ctx)
+
+	md, err := getMetadata(ctx, client, nodeID)
+	if err != nil {
+		return err
+	}
+
+	// the metadata is removed the same way as the metadata of deleted nodes
+	if err := gc.New(client, nil, logger.Named("gc"), gc.Config{}).CollectNode(ctx, nodeID); err != nil {
+		return err
+	}
+
+	return printMetadata(newMetadataView(md))
+}
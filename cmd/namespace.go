@@ -0,0 +1,460 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/config"
+	"go.infratographer.com/metadata-api/internal/deleter"
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+)
+
+var (
+	namespaceTypeFlag    string
+	namespaceOwnerID     string
+	namespaceName        string
+	namespacePrivate     bool
+	namespaceJSONSchema  string
+	namespaceClearSchema bool
+	namespaceDefaultTTL  time.Duration
+	namespaceClearTTL    bool
+	namespaceForce       bool
+	namespacePurge       bool
+)
+
+var namespaceCmd = &cobra.Command{
+	Use:   "namespace",
+	Short: "Manage annotation and status namespaces directly in the database",
+	Long: `Manage annotation and status namespaces directly in the database, without the
+api, OIDC or the permissions api. Changes are validated like the api does.`,
+}
+
+var namespaceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List namespaces",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return namespaceList(cmd.Context())
+	},
+}
+
+var namespaceCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a namespace",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return namespaceCreate(cmd.Context(), cmd)
+	},
+}
+
+var namespaceUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a namespace",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return namespaceUpdate(cmd.Context(), cmd, args[0])
+	},
+}
+
+var namespaceDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a namespace",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return namespaceDelete(cmd.Context(), args[0])
+	},
+}
+
+var namespaceRestoreCmd = &cobra.Command{
+	Use:   "restore <id>",
+	Short: "Restore a deleted namespace before it's purged",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return namespaceRestore(cmd.Context(), args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(namespaceCmd)
+	addAdminFlags(namespaceCmd)
+
+	namespaceCmd.AddCommand(namespaceListCmd, namespaceCreateCmd, namespaceUpdateCmd, namespaceDeleteCmd, namespaceRestoreCmd)
+
+	namespaceListCmd.Flags().StringVar(&namespaceTypeFlag, "type", "", "only list namespaces of the type, annotation or status")
+	namespaceListCmd.Flags().StringVar(&namespaceOwnerID, "owner-id", "", "only list the namespaces of the owner or resource provider")
+
+	namespaceCreateCmd.Flags().StringVar(&namespaceTypeFlag, "type", "", "type of the namespace, annotation or status")
+	namespaceCreateCmd.Flags().StringVar(&namespaceOwnerID, "owner-id", "", "ID of the owner of an annotation namespace, or the resource provider of a status namespace")
+	namespaceCreateCmd.Flags().StringVar(&namespaceName, "name", "", "name of the namespace")
+	namespaceCreateCmd.Flags().BoolVar(&namespacePrivate, "private", false, "make the namespace private")
+	namespaceCreateCmd.Flags().StringVar(&namespaceJSONSchema, "json-schema", "", "JSON schema data in the namespace must validate against, or @ followed by the path of a file with the schema")
+	namespaceCreateCmd.Flags().DurationVar(&namespaceDefaultTTL, "default-ttl", 0, "time statuses in a status namespace are kept after they were last updated")
+
+	for _, flag := range []string{"type", "owner-id", "name"} {
+		if err := namespaceCreateCmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+
+	namespaceUpdateCmd.Flags().StringVar(&namespaceName, "name", "", "name of the namespace")
+	namespaceUpdateCmd.Flags().BoolVar(&namespacePrivate, "private", false, "whether the namespace is private")
+	namespaceUpdateCmd.Flags().StringVar(&namespaceJSONSchema, "json-schema", "", "JSON schema data in the namespace must validate against, or @ followed by the path of a file with the schema")
+	namespaceUpdateCmd.Flags().BoolVar(&namespaceClearSchema, "clear-json-schema", false, "remove the JSON schema of the namespace")
+	namespaceUpdateCmd.Flags().DurationVar(&namespaceDefaultTTL, "default-ttl", 0, "time statuses in a status namespace are kept after they were last updated")
+	namespaceUpdateCmd.Flags().BoolVar(&namespaceClearTTL, "clear-default-ttl", false, "remove the default TTL of a status namespace")
+
+	namespaceDeleteCmd.Flags().BoolVar(&namespaceForce, "force", false, "delete the annotations or statuses in the namespace along with it")
+	namespaceDeleteCmd.Flags().BoolVar(&namespacePurge, "purge", false, "purge the namespace right away, rather than once the purge delay has passed")
+}
+
+// namespaceView is the output of a namespace of either type.
+type namespaceView struct {
+	ID         gidx.PrefixedID `json:"id"`
+	Type       string          `json:"type"`
+	Name       string          `json:"name"`
+	OwnerID    gidx.PrefixedID `json:"ownerID"`
+	Private    bool            `json:"private"`
+	JSONSchema json.RawMessage `json:"jsonSchema,omitempty"`
+	DefaultTTL *int64          `json:"defaultTTL,omitempty"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	UpdatedAt  time.Time       `json:"updatedAt"`
+}
+
+func annotationNamespaceView(ns *ent.AnnotationNamespace) namespaceView {
+	return namespaceView{
+		ID:         ns.ID,
+		Type:       namespaceTypeAnnotation,
+		Name:       ns.Name,
+		OwnerID:    ns.OwnerID,
+		Private:    ns.Private,
+		JSONSchema: ns.JSONSchema,
+		CreatedAt:  ns.CreatedAt,
+		UpdatedAt:  ns.UpdatedAt,
+	}
+}
+
+func statusNamespaceView(ns *ent.StatusNamespace) namespaceView {
+	return namespaceView{
+		ID:         ns.ID,
+		Type:       namespaceTypeStatus,
+		Name:       ns.Name,
+		OwnerID:    ns.ResourceProviderID,
+		Private:    ns.Private,
+		JSONSchema: ns.JSONSchema,
+		DefaultTTL: ns.DefaultTTL,
+		CreatedAt:  ns.CreatedAt,
+		UpdatedAt:  ns.UpdatedAt,
+	}
+}
+
+func printNamespaces(namespaces []namespaceView) error {
+	return printResult(namespaces, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tTYPE\tNAME\tOWNER\tPRIVATE\tJSON SCHEMA\tDEFAULT TTL")
+
+		for _, ns := range namespaces {
+			ttl := "-"
+			if ns.DefaultTTL != nil {
+				ttl = (time.Duration(*ns.DefaultTTL) * time.Second).String()
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%t\t%s\n", ns.ID, ns.Type, ns.Name, ns.OwnerID, ns.Private, ns.JSONSchema != nil, ttl)
+		}
+	})
+}
+
+func namespaceList(ctx context.Context) error {
+	if namespaceTypeFlag != "" && namespaceTypeFlag != namespaceTypeAnnotation && namespaceTypeFlag != namespaceTypeStatus {
+		return fmt.Errorf("%w: %s", errInvalidNamespaceType, namespaceTypeFlag)
+	}
+
+	ctx, client, closeClient, err := newDBClient(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	defer closeClient()
+
+	namespaces := []namespaceView{}
+
+	if namespaceTypeFlag != namespaceTypeStatus {
+		query := client.AnnotationNamespace.Query().Order(ent.Asc(annotationnamespace.FieldName), ent.Asc(annotationnamespace.FieldID))
+
+		if namespaceOwnerID != "" {
+			query.Where(annotationnamespace.OwnerID(gidx.PrefixedID(namespaceOwnerID)))
+		}
+
+		nss, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, ns := range nss {
+			namespaces = append(namespaces, annotationNamespaceView(ns))
+		}
+	}
+
+	if namespaceTypeFlag != namespaceTypeAnnotation {
+		query := client.StatusNamespace.Query().Order(ent.Asc(statusnamespace.FieldName), ent.Asc(statusnamespace.FieldID))
+
+		if namespaceOwnerID != "" {
+			query.Where(statusnamespace.ResourceProviderID(gidx.PrefixedID(namespaceOwnerID)))
+		}
+
+		nss, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, ns := range nss {
+			namespaces = append(namespaces, statusNamespaceView(ns))
+		}
+	}
+
+	return printNamespaces(namespaces)
+}
+
+func namespaceCreate(ctx context.Context, cmd *cobra.Command) error {
+	schema, err := jsonFlag("json-schema", namespaceJSONSchema)
+	if err != nil {
+		return err
+	}
+
+	if namespaceTypeFlag != namespaceTypeAnnotation && namespaceTypeFlag != namespaceTypeStatus {
+		return fmt.Errorf("%w: %s", errInvalidNamespaceType, namespaceTypeFlag)
+	}
+
+	if namespaceTypeFlag == namespaceTypeAnnotation && cmd.Flags().Changed("default-ttl") {
+		return fmt.Errorf("--default-ttl: %w", errStatusNamespaceOnly)
+	}
+
+	ctx, client, closeClient, err := newDBClient(ctx, adminPublishEvents)
+	if err != nil {
+		return err
+	}
+
+	defer closeClient()
+
+	ctx, r := adminResolver(ctx, client)
+
+	if namespaceTypeFlag == namespaceTypeAnnotation {
+		resp, err := r.Mutation().AnnotationNamespaceCreate(ctx, ent.CreateAnnotationNamespaceInput{
+			Name:       namespaceName,
+			OwnerID:    gidx.PrefixedID(namespaceOwnerID),
+			Private:    &namespacePrivate,
+			JSONSchema: schema,
+		})
+		if err != nil {
+			return err
+		}
+
+		return printNamespaces([]namespaceView{annotationNamespaceView(resp.AnnotationNamespace)})
+	}
+
+	input := ent.CreateStatusNamespaceInput{
+		Name:               namespaceName,
+		ResourceProviderID: gidx.PrefixedID(namespaceOwnerID),
+		Private:            &namespacePrivate,
+		JSONSchema:         schema,
+	}
+
+	if cmd.Flags().Changed("default-ttl") {
+		ttl := int64(namespaceDefaultTTL.Seconds())
+		input.DefaultTTL = &ttl
+	}
+
+	resp, err := r.Mutation().StatusNamespaceCreate(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	return printNamespaces([]namespaceView{statusNamespaceView(resp.StatusNamespace)})
+}
+
+func namespaceUpdate(ctx context.Context, cmd *cobra.Command, id string) error {
+	nsType, err := namespaceType(id)
+	if err != nil {
+		return err
+	}
+
+	schema, err := jsonFlag("json-schema", namespaceJSONSchema)
+	if err != nil {
+		return err
+	}
+
+	var (
+		name    *string
+		private *bool
+	)
+
+	if cmd.Flags().Changed("name") {
+		name = &namespaceName
+	}
+
+	if cmd.Flags().Changed("private") {
+		private = &namespacePrivate
+	}
+
+	if nsType == namespaceTypeAnnotation && (cmd.Flags().Changed("default-ttl") || namespaceClearTTL) {
+		return fmt.Errorf("--default-ttl: %w", errStatusNamespaceOnly)
+	}
+
+	ctx, client, closeClient, err := newDBClient(ctx, adminPublishEvents)
+	if err != nil {
+		return err
+	}
+
+	defer closeClient()
+
+	ctx, r := adminResolver(ctx, client)
+
+	if nsType == namespaceTypeAnnotation {
+		resp, err := r.Mutation().AnnotationNamespaceUpdate(ctx, gidx.PrefixedID(id), ent.UpdateAnnotationNamespaceInput{
+			Name:            name,
+			Private:         private,
+			JSONSchema:      schema,
+			ClearJSONSchema: namespaceClearSchema,
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.InvalidAnnotationCount != 0 {
+			logger.Warnw("annotations don't validate against the json schema", "count", resp.InvalidAnnotationCount)
+		}
+
+		return printNamespaces([]namespaceView{annotationNamespaceView(resp.AnnotationNamespace)})
+	}
+
+	input := ent.UpdateStatusNamespaceInput{
+		Name:            name,
+		Private:         private,
+		JSONSchema:      schema,
+		ClearJSONSchema: namespaceClearSchema,
+		ClearDefaultTTL: namespaceClearTTL,
+	}
+
+	if cmd.Flags().Changed("default-ttl") {
+		ttl := int64(namespaceDefaultTTL.Seconds())
+		input.DefaultTTL = &ttl
+	}
+
+	resp, err := r.Mutation().StatusNamespaceUpdate(ctx, gidx.PrefixedID(id), input)
+	if err != nil {
+		return err
+	}
+
+	if resp.InvalidStatusCount != 0 {
+		logger.Warnw("statuses don't validate against the json schema", "count", resp.InvalidStatusCount)
+	}
+
+	return printNamespaces([]namespaceView{statusNamespaceView(resp.StatusNamespace)})
+}
+
+// namespaceDeleteView is the output of a namespace deletion.
+type namespaceDeleteView struct {
+	DeletedID           gidx.PrefixedID `json:"deletedID"`
+	NamespaceDeletionID gidx.PrefixedID `json:"namespaceDeletionID"`
+	State               string          `json:"state"`
+	DeletedCount        int             `json:"deletedCount"`
+}
+
+func namespaceDelete(ctx context.Context, id string) error {
+	nsType, err := namespaceType(id)
+	if err != nil {
+		return err
+	}
+
+	ctx, client, closeClient, err := newDBClient(ctx, adminPublishEvents)
+	if err != nil {
+		return err
+	}
+
+	defer closeClient()
+
+	ctx, r := adminResolver(ctx, client)
+
+	var job *ent.NamespaceDeletion
+
+	if nsType == namespaceTypeAnnotation {
+		resp, err := r.Mutation().AnnotationNamespaceDelete(ctx, gidx.PrefixedID(id), namespaceForce)
+		if err != nil {
+			return err
+		}
+
+		job = resp.NamespaceDeletion
+	} else {
+		resp, err := r.Mutation().StatusNamespaceDelete(ctx, gidx.PrefixedID(id), namespaceForce)
+		if err != nil {
+			return err
+		}
+
+		job = resp.NamespaceDeletion
+	}
+
+	// purged namespaces are deleted here rather than left to the deleter of the api
+	if namespacePurge {
+		cfg := config.AppConfig.Deleter
+		cfg.PurgeDelay = 0
+
+		if err := deleter.New(client, logger.Named("deleter"), cfg).RunDeletion(ctx, job.ID); err != nil {
+			return err
+		}
+
+		job, err = client.NamespaceDeletion.Get(ctx, job.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	deleted := namespaceDeleteView{
+		DeletedID:           gidx.PrefixedID(id),
+		NamespaceDeletionID: job.ID,
+		State:               job.State.String(),
+		DeletedCount:        job.DeletedCount,
+	}
+
+	return printResult(deleted, func(w io.Writer) {
+		fmt.Fprintln(w, "DELETED ID\tNAMESPACE DELETION ID\tSTATE\tDELETED RECORDS")
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", deleted.DeletedID, deleted.NamespaceDeletionID, deleted.State, deleted.DeletedCount)
+	})
+}
+
+func namespaceRestore(ctx context.Context, id string) error {
+	nsType, err := namespaceType(id)
+	if err != nil {
+		return err
+	}
+
+	ctx, client, closeClient, err := newDBClient(ctx, adminPublishEvents)
+	if err != nil {
+		return err
+	}
+
+	defer closeClient()
+
+	ctx, r := adminResolver(ctx, client)
+
+	if nsType == namespaceTypeAnnotation {
+		resp, err := r.Mutation().AnnotationNamespaceRestore(ctx, gidx.PrefixedID(id))
+		if err != nil {
+			return err
+		}
+
+		return printNamespaces([]namespaceView{annotationNamespaceView(resp.AnnotationNamespace)})
+	}
+
+	resp, err := r.Mutation().StatusNamespaceRestore(ctx, gidx.PrefixedID(id))
+	if err != nil {
+		return err
+	}
+
+	return printNamespaces([]namespaceView{statusNamespaceView(resp.StatusNamespace)})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	_ "github.com/mattn/go-sqlite3" // sqlite3 driver
+	"github.com/nats-io/nats.go"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"go.infratographer.com/permissions-api/pkg/permissions"
@@ -22,13 +23,21 @@ import (
 	"go.infratographer.com/x/loggingx"
 	"go.infratographer.com/x/otelx"
 	"go.infratographer.com/x/versionx"
+	"go.infratographer.com/x/viperx"
 	"go.uber.org/zap"
 
 	"go.infratographer.com/metadata-api/internal/config"
+	"go.infratographer.com/metadata-api/internal/deleter"
 	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/gc"
 	"go.infratographer.com/metadata-api/internal/graphapi"
+	"go.infratographer.com/metadata-api/internal/pubsub"
+	"go.infratographer.com/metadata-api/internal/reaper"
 
-	"go.infratographer.com/metadata-api/internal/ent/generated/eventhooks"
+	"go.infratographer.com/metadata-api/internal/ent/bulkhooks"
+	"go.infratographer.com/metadata-api/internal/ent/changehooks"
+	"go.infratographer.com/metadata-api/internal/ent/historyhooks"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
 )
 
 const (
@@ -68,6 +77,33 @@ func init() {
 	events.MustViperFlags(viper.GetViper(), serveCmd.Flags(), appName)
 	permissions.MustViperFlags(viper.GetViper(), serveCmd.Flags())
 
+	serveCmd.Flags().Duration("reaper-interval", reaper.DefaultInterval, "time between deletions of expired statuses, disabled when 0")
+	viperx.MustBindFlag(viper.GetViper(), "reaper.interval", serveCmd.Flags().Lookup("reaper-interval"))
+	serveCmd.Flags().Int("reaper-batch-size", reaper.DefaultBatchSize, "number of expired statuses looked up at once")
+	viperx.MustBindFlag(viper.GetViper(), "reaper.batchSize", serveCmd.Flags().Lookup("reaper-batch-size"))
+
+	serveCmd.Flags().Duration("namespace-deleter-interval", deleter.DefaultInterval, "time between looking for namespaces to delete with their statuses or annotations, disabled when 0")
+	viperx.MustBindFlag(viper.GetViper(), "deleter.interval", serveCmd.Flags().Lookup("namespace-deleter-interval"))
+	serveCmd.Flags().Int("namespace-deleter-batch-size", deleter.DefaultBatchSize, "number of statuses or annotations of a namespace deleted at once")
+	viperx.MustBindFlag(viper.GetViper(), "deleter.batchSize", serveCmd.Flags().Lookup("namespace-deleter-batch-size"))
+	serveCmd.Flags().Duration("namespace-purge-delay", deleter.DefaultPurgeDelay, "time deleted namespaces can be restored for before they're purged with their statuses or annotations")
+	viperx.MustBindFlag(viper.GetViper(), "deleter.purgeDelay", serveCmd.Flags().Lookup("namespace-purge-delay"))
+
+	serveCmd.Flags().StringSlice("gc-topics", []string{}, "change topics to listen for node delete events on to remove their metadata, such as delete.load-balancer, disabled when empty")
+	viperx.MustBindFlag(viper.GetViper(), "gc.topics", serveCmd.Flags().Lookup("gc-topics"))
+
+	serveCmd.Flags().Int("max-query-depth", graphapi.DefaultMaxDepth, "maximum number of nested fields in a query, disabled when 0")
+	viperx.MustBindFlag(viper.GetViper(), "limits.maxDepth", serveCmd.Flags().Lookup("max-query-depth"))
+	serveCmd.Flags().Int("max-query-complexity", graphapi.DefaultMaxComplexity, "maximum complexity of a query, where the fields of connections count once per requested record, disabled when 0")
+	viperx.MustBindFlag(viper.GetViper(), "limits.maxComplexity", serveCmd.Flags().Lookup("max-query-complexity"))
+	serveCmd.Flags().Int("max-page-size", graphapi.DefaultMaxPageSize, "maximum value of first and last on connections, disabled when 0")
+	viperx.MustBindFlag(viper.GetViper(), "limits.maxPageSize", serveCmd.Flags().Lookup("max-page-size"))
+
+	serveCmd.Flags().Int("apq-cache-size", graphapi.DefaultAPQCacheSize, "number of automatic persisted queries kept in memory, disabled when 0")
+	viperx.MustBindFlag(viper.GetViper(), "persistedQueries.cacheSize", serveCmd.Flags().Lookup("apq-cache-size"))
+	serveCmd.Flags().String("allow-list-dir", "", "directory of .graphql documents with the only operations clients may run, all operations are allowed when empty")
+	viperx.MustBindFlag(viper.GetViper(), "persistedQueries.allowListDir", serveCmd.Flags().Lookup("allow-list-dir"))
+
 	// only available as a CLI arg because it shouldn't be something that could accidentially end up in a config file or env var
 	serveCmd.Flags().BoolVar(&serveDevMode, "dev", false, "dev mode: enables playground, disables all auth checks, sets CORS to allow all, pretty logging, etc.")
 	serveCmd.Flags().BoolVar(&enablePlayground, "playground", false, "enable the graph playground")
@@ -116,7 +152,20 @@ func serve(ctx context.Context) error {
 	client := ent.NewClient(cOpts...)
 	defer client.Close()
 
-	eventhooks.EventHooks(client)
+	// changes are fanned out to the subscriptions on every replica through nats
+	broker := pubsub.NewBroker(logger.Named("pubsub"))
+
+	if nc, ok := events.Source().(*nats.Conn); ok {
+		if err := broker.ConnectNATS(nc, config.AppConfig.Events.NATS.PublishPrefix); err != nil {
+			logger.Fatalw("failed to subscribe to changes", "error", err)
+		}
+	}
+
+	bulkhooks.EventHooks(client)
+	historyhooks.HistoryHooks(client)
+	changehooks.ChangeHooks(client, broker)
+	softdelete.Interceptors(client)
+	graphapi.VisibilityInterceptors(client)
 
 	// Run the automatic migration tool to create all schema resources.
 	if err := client.Schema.Create(ctx); err != nil {
@@ -152,7 +201,24 @@ func serve(ctx context.Context) error {
 
 	middleware = append(middleware, perms.Middleware())
 
-	r := graphapi.NewResolver(client, logger.Named("resolvers"))
+	resolverOpts := []graphapi.Option{
+		graphapi.WithBroker(broker),
+		graphapi.WithLimits(config.AppConfig.Limits),
+		graphapi.WithAPQCacheSize(config.AppConfig.PersistedQueries.CacheSize),
+	}
+
+	if dir := config.AppConfig.PersistedQueries.AllowListDir; dir != "" {
+		allowList, err := graphapi.LoadAllowList(dir)
+		if err != nil {
+			logger.Fatalw("failed to load operation allow list", "error", err)
+		}
+
+		logger.Infow("only allowing the operations of the allow list", "dir", dir, "operations", allowList.Len())
+
+		resolverOpts = append(resolverOpts, graphapi.WithAllowList(allowList))
+	}
+
+	r := graphapi.NewResolver(client, logger.Named("resolvers"), resolverOpts...)
 	handler := r.Handler(enablePlayground, middleware...)
 
 	srv.AddHandler(handler)
@@ -164,6 +230,23 @@ func serve(ctx context.Context) error {
 		_ = events.Shutdown(ctx)
 	}()
 
+	// background workers are stopped once the server has shut down
+	workerCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+
+	go reaper.New(client, logger.Named("reaper"), config.AppConfig.Reaper).Run(workerCtx)
+
+	// deleting namespaces removes their auth relationships through the context, like in requests to the api
+	deleterCtx := context.WithValue(workerCtx, permissions.AuthRelationshipRequestHandlerCtxKey, perms)
+
+	go deleter.New(client, logger.Named("deleter"), config.AppConfig.Deleter).Run(deleterCtx)
+
+	go func() {
+		if err := gc.New(client, events, logger.Named("gc"), config.AppConfig.GC).Run(workerCtx); err != nil {
+			logger.Errorw("failed to run metadata gc", "error", err)
+		}
+	}()
+
 	if err := srv.RunWithContext(ctx); err != nil {
 		logger.Error("failed to run server", zap.Error(err))
 	}
@@ -0,0 +1,11 @@
+-- +goose Up
+-- modify "annotation_namespaces" table
+ALTER TABLE "annotation_namespaces" ADD COLUMN "json_schema" jsonb NULL;
+-- modify "status_namespaces" table
+ALTER TABLE "status_namespaces" ADD COLUMN "json_schema" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "status_namespaces" table
+ALTER TABLE "status_namespaces" DROP COLUMN "json_schema";
+-- reverse: modify "annotation_namespaces" table
+ALTER TABLE "annotation_namespaces" DROP COLUMN "json_schema";
@@ -0,0 +1,27 @@
+-- +goose Up
+-- create "annotation_histories" table
+CREATE TABLE "annotation_histories" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "annotation_id" character varying NOT NULL, "metadata_id" character varying NOT NULL, "annotation_namespace_id" character varying NOT NULL, "operation" character varying NOT NULL, "json_data" jsonb NULL, "actor" character varying NULL, "annotation_created_at" timestamptz NULL, "annotation_updated_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "annotationhistory_annotation_id_created_at" to table: "annotation_histories"
+CREATE INDEX "annotationhistory_annotation_id_created_at" ON "annotation_histories" ("annotation_id", "created_at");
+-- create index "annotationhistory_metadata_id_created_at" to table: "annotation_histories"
+CREATE INDEX "annotationhistory_metadata_id_created_at" ON "annotation_histories" ("metadata_id", "created_at");
+-- create "status_histories" table
+CREATE TABLE "status_histories" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "status_id" character varying NOT NULL, "metadata_id" character varying NOT NULL, "status_namespace_id" character varying NOT NULL, "source" character varying NOT NULL, "operation" character varying NOT NULL, "json_data" jsonb NULL, "actor" character varying NULL, "status_created_at" timestamptz NULL, "status_updated_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "statushistory_metadata_id_created_at" to table: "status_histories"
+CREATE INDEX "statushistory_metadata_id_created_at" ON "status_histories" ("metadata_id", "created_at");
+-- create index "statushistory_status_id_created_at" to table: "status_histories"
+CREATE INDEX "statushistory_status_id_created_at" ON "status_histories" ("status_id", "created_at");
+
+-- +goose Down
+-- reverse: create index "statushistory_status_id_created_at" to table: "status_histories"
+DROP INDEX "statushistory_status_id_created_at";
+-- reverse: create index "statushistory_metadata_id_created_at" to table: "status_histories"
+DROP INDEX "statushistory_metadata_id_created_at";
+-- reverse: create "status_histories" table
+DROP TABLE "status_histories";
+-- reverse: create index "annotationhistory_metadata_id_created_at" to table: "annotation_histories"
+DROP INDEX "annotationhistory_metadata_id_created_at";
+-- reverse: create index "annotationhistory_annotation_id_created_at" to table: "annotation_histories"
+DROP INDEX "annotationhistory_annotation_id_created_at";
+-- reverse: create "annotation_histories" table
+DROP TABLE "annotation_histories";
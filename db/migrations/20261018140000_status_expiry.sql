@@ -0,0 +1,19 @@
+-- +goose Up
+-- modify "status_namespaces" table
+ALTER TABLE "status_namespaces" ADD COLUMN "default_ttl" bigint NULL;
+-- modify "status_histories" table
+ALTER TABLE "status_histories" ADD COLUMN "status_expires_at" timestamptz NULL;
+-- modify "status" table
+ALTER TABLE "status" ADD COLUMN "expires_at" timestamptz NULL;
+-- create index "status_expires_at" to table: "status"
+CREATE INDEX "status_expires_at" ON "status" ("expires_at");
+
+-- +goose Down
+-- reverse: create index "status_expires_at" to table: "status"
+DROP INDEX "status_expires_at";
+-- reverse: modify "status" table
+ALTER TABLE "status" DROP COLUMN "expires_at";
+-- reverse: modify "status_histories" table
+ALTER TABLE "status_histories" DROP COLUMN "status_expires_at";
+-- reverse: modify "status_namespaces" table
+ALTER TABLE "status_namespaces" DROP COLUMN "default_ttl";
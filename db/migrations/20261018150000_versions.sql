@@ -0,0 +1,19 @@
+-- +goose Up
+-- modify "annotations" table
+ALTER TABLE "annotations" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- modify "annotation_histories" table
+ALTER TABLE "annotation_histories" ADD COLUMN "annotation_version" bigint NOT NULL DEFAULT 1;
+-- modify "status" table
+ALTER TABLE "status" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
+-- modify "status_histories" table
+ALTER TABLE "status_histories" ADD COLUMN "status_version" bigint NOT NULL DEFAULT 1;
+
+-- +goose Down
+-- reverse: modify "status_histories" table
+ALTER TABLE "status_histories" DROP COLUMN "status_version";
+-- reverse: modify "status" table
+ALTER TABLE "status" DROP COLUMN "version";
+-- reverse: modify "annotation_histories" table
+ALTER TABLE "annotation_histories" DROP COLUMN "annotation_version";
+-- reverse: modify "annotations" table
+ALTER TABLE "annotations" DROP COLUMN "version";
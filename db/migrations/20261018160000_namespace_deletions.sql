@@ -0,0 +1,31 @@
+-- +goose Up
+-- modify "annotation_namespaces" table
+ALTER TABLE "annotation_namespaces" ADD COLUMN "deleting" boolean NOT NULL DEFAULT false;
+-- modify "status_namespaces" table
+ALTER TABLE "status_namespaces" ADD COLUMN "deleting" boolean NOT NULL DEFAULT false;
+-- create "namespace_deletions" table
+CREATE TABLE "namespace_deletions" ("id" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "namespace_id" character varying NOT NULL, "owner_id" character varying NOT NULL, "state" character varying NOT NULL DEFAULT 'PENDING', "total_count" bigint NOT NULL, "deleted_count" bigint NOT NULL DEFAULT 0, "completed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- create index "namespacedeletion_created_at" to table: "namespace_deletions"
+CREATE INDEX "namespacedeletion_created_at" ON "namespace_deletions" ("created_at");
+-- create index "namespacedeletion_updated_at" to table: "namespace_deletions"
+CREATE INDEX "namespacedeletion_updated_at" ON "namespace_deletions" ("updated_at");
+-- create index "namespacedeletion_namespace_id" to table: "namespace_deletions"
+CREATE INDEX "namespacedeletion_namespace_id" ON "namespace_deletions" ("namespace_id");
+-- create index "namespacedeletion_state" to table: "namespace_deletions"
+CREATE INDEX "namespacedeletion_state" ON "namespace_deletions" ("state");
+
+-- +goose Down
+-- reverse: create index "namespacedeletion_state" to table: "namespace_deletions"
+DROP INDEX "namespacedeletion_state";
+-- reverse: create index "namespacedeletion_namespace_id" to table: "namespace_deletions"
+DROP INDEX "namespacedeletion_namespace_id";
+-- reverse: create index "namespacedeletion_updated_at" to table: "namespace_deletions"
+DROP INDEX "namespacedeletion_updated_at";
+-- reverse: create index "namespacedeletion_created_at" to table: "namespace_deletions"
+DROP INDEX "namespacedeletion_created_at";
+-- reverse: create "namespace_deletions" table
+DROP TABLE "namespace_deletions";
+-- reverse: modify "status_namespaces" table
+ALTER TABLE "status_namespaces" DROP COLUMN "deleting";
+-- reverse: modify "annotation_namespaces" table
+ALTER TABLE "annotation_namespaces" DROP COLUMN "deleting";
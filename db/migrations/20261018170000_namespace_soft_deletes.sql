@@ -0,0 +1,19 @@
+-- +goose Up
+-- modify "annotation_namespaces" table
+ALTER TABLE "annotation_namespaces" ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "annotationnamespace_deleted_at" to table: "annotation_namespaces"
+CREATE INDEX "annotationnamespace_deleted_at" ON "annotation_namespaces" ("deleted_at");
+-- modify "status_namespaces" table
+ALTER TABLE "status_namespaces" ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "statusnamespace_deleted_at" to table: "status_namespaces"
+CREATE INDEX "statusnamespace_deleted_at" ON "status_namespaces" ("deleted_at");
+
+-- +goose Down
+-- reverse: create index "statusnamespace_deleted_at" to table: "status_namespaces"
+DROP INDEX "statusnamespace_deleted_at";
+-- reverse: modify "status_namespaces" table
+ALTER TABLE "status_namespaces" DROP COLUMN "deleted_at";
+-- reverse: create index "annotationnamespace_deleted_at" to table: "annotation_namespaces"
+DROP INDEX "annotationnamespace_deleted_at";
+-- reverse: modify "annotation_namespaces" table
+ALTER TABLE "annotation_namespaces" DROP COLUMN "deleted_at";
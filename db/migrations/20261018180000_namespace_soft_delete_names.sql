@@ -0,0 +1,19 @@
+-- +goose Up
+-- drop index "annotationnamespace_owner_id_name" from table: "annotation_namespaces"
+DROP INDEX "annotationnamespace_owner_id_name";
+-- create index "annotationnamespace_owner_id_name" to table: "annotation_namespaces"
+CREATE UNIQUE INDEX "annotationnamespace_owner_id_name" ON "annotation_namespaces" ("owner_id", "name") WHERE (deleted_at IS NULL);
+-- drop index "statusnamespace_resource_provider_id_name" from table: "status_namespaces"
+DROP INDEX "statusnamespace_resource_provider_id_name";
+-- create index "statusnamespace_resource_provider_id_name" to table: "status_namespaces"
+CREATE UNIQUE INDEX "statusnamespace_resource_provider_id_name" ON "status_namespaces" ("resource_provider_id", "name") WHERE (deleted_at IS NULL);
+
+-- +goose Down
+-- reverse: create index "statusnamespace_resource_provider_id_name" to table: "status_namespaces"
+DROP INDEX "statusnamespace_resource_provider_id_name";
+-- reverse: drop index "statusnamespace_resource_provider_id_name" from table: "status_namespaces"
+CREATE UNIQUE INDEX "statusnamespace_resource_provider_id_name" ON "status_namespaces" ("resource_provider_id", "name");
+-- reverse: create index "annotationnamespace_owner_id_name" to table: "annotation_namespaces"
+DROP INDEX "annotationnamespace_owner_id_name";
+-- reverse: drop index "annotationnamespace_owner_id_name" from table: "annotation_namespaces"
+CREATE UNIQUE INDEX "annotationnamespace_owner_id_name" ON "annotation_namespaces" ("owner_id", "name");
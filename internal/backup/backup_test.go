@@ -0,0 +1,398 @@
+package backup_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/backup"
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
+	"go.infratographer.com/metadata-api/internal/ent/historyhooks"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
+)
+
+func newClient(t *testing.T, name string) *ent.Client {
+	t.Helper()
+
+	client, err := ent.Open(dialect.SQLite, "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { client.Close() })
+
+	require.NoError(t, client.Schema.Create(context.Background()))
+
+	historyhooks.HistoryHooks(client)
+	softdelete.Interceptors(client)
+
+	return client
+}
+
+type fixtures struct {
+	owner   gidx.PrefixedID
+	antNS   *ent.AnnotationNamespace
+	otherNS *ent.AnnotationNamespace
+	stNS    *ent.StatusNamespace
+	lbMD    *ent.Metadata
+	ipMD    *ent.Metadata
+	lbAnt   *ent.Annotation
+	ipAnt   *ent.Annotation
+	lbSt    *ent.Status
+}
+
+func newFixtures(ctx context.Context, client *ent.Client) fixtures {
+	var f fixtures
+
+	f.owner = gidx.MustNewID("tnntten")
+
+	f.antNS = client.AnnotationNamespace.Create().
+		SetName("backup-tests").
+		SetOwnerID(f.owner).
+		SetPrivate(true).
+		SetJSONSchema(json.RawMessage(`{"type":"object"}`)).
+		SaveX(ctx)
+	f.otherNS = client.AnnotationNamespace.Create().
+		SetName("backup-tests").
+		SetOwnerID(gidx.MustNewID("tnntten")).
+		SaveX(ctx)
+	f.stNS = client.StatusNamespace.Create().
+		SetName("backup-tests").
+		SetResourceProviderID(f.owner).
+		SetDefaultTTL(3600).
+		SaveX(ctx)
+
+	f.lbMD = client.Metadata.Create().SetNodeID(gidx.MustNewID("loadbal")).SaveX(ctx)
+	f.ipMD = client.Metadata.Create().SetNodeID(gidx.MustNewID("ipamipa")).SaveX(ctx)
+
+	f.lbAnt = client.Annotation.Create().
+		SetMetadata(f.lbMD).
+		SetAnnotationNamespaceID(f.antNS.ID).
+		SetData(json.RawMessage(`{"name":"lb"}`)).
+		SaveX(ctx)
+	f.ipAnt = client.Annotation.Create().
+		SetMetadata(f.ipMD).
+		SetAnnotationNamespaceID(f.otherNS.ID).
+		SetData(json.RawMessage(`{"name":"ip"}`)).
+		SaveX(ctx)
+	f.lbSt = client.Status.Create().
+		SetMetadata(f.lbMD).
+		SetStatusNamespaceID(f.stNS.ID).
+		SetSource("backup-tests").
+		SetData(json.RawMessage(`{"state":"ACTIVE"}`)).
+		SetExpiresAt(time.Now().Add(time.Hour).UTC()).
+		SaveX(ctx)
+
+	return f
+}
+
+func export(ctx context.Context, t *testing.T, client *ent.Client, filter backup.Filter) ([]backup.Record, string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	// a small page size so the records are paged
+	_, err := backup.NewExporter(client, backup.WithPageSize(1)).Export(ctx, &buf, filter)
+	require.NoError(t, err)
+
+	var records []backup.Record
+
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+
+		var rec backup.Record
+
+		require.NoError(t, json.Unmarshal([]byte(line), &rec))
+
+		records = append(records, rec)
+	}
+
+	return records, buf.String()
+}
+
+func ids(records []backup.Record) []gidx.PrefixedID {
+	ids := make([]gidx.PrefixedID, len(records))
+
+	for i, rec := range records {
+		ids[i] = rec.ID()
+	}
+
+	return ids
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+
+	src := newClient(t, "backup-src")
+	dst := newClient(t, "backup-dst")
+
+	f := newFixtures(ctx, src)
+
+	records, exported := export(ctx, t, src, backup.Filter{})
+
+	// namespaces and metadata are exported before the records referencing them
+	kinds := make([]backup.Kind, len(records))
+	for i, rec := range records {
+		kinds[i] = rec.Kind
+	}
+
+	assert.Equal(t, []backup.Kind{
+		backup.KindAnnotationNamespace,
+		backup.KindAnnotationNamespace,
+		backup.KindStatusNamespace,
+		backup.KindMetadata,
+		backup.KindMetadata,
+		backup.KindAnnotation,
+		backup.KindAnnotation,
+		backup.KindStatus,
+	}, kinds)
+
+	report, err := backup.NewImporter(dst).Import(ctx, strings.NewReader(exported))
+	require.NoError(t, err)
+
+	assert.Empty(t, report.Conflicts)
+	assert.Equal(t, backup.Counts{
+		backup.KindAnnotationNamespace: 2,
+		backup.KindStatusNamespace:     1,
+		backup.KindMetadata:            2,
+		backup.KindAnnotation:          2,
+		backup.KindStatus:              1,
+	}, report.Created)
+
+	// the records keep their IDs and values
+	_, reexported := export(ctx, t, dst, backup.Filter{})
+	assert.Equal(t, exported, reexported)
+
+	// the history hooks of the client ran for the imported records
+	history, err := dst.AnnotationHistory.Query().Where(annotationhistory.AnnotationID(f.lbAnt.ID)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, history)
+
+	// importing again doesn't change anything
+	report, err = backup.NewImporter(dst).Import(ctx, strings.NewReader(exported))
+	require.NoError(t, err)
+
+	assert.Empty(t, report.Conflicts)
+	assert.Empty(t, report.Created)
+	assert.Empty(t, report.Updated)
+	assert.Equal(t, 8, sum(report.Unchanged))
+
+	// changed records are updated
+	src.Annotation.UpdateOneID(f.lbAnt.ID).SetData(json.RawMessage(`{"name":"lb2"}`)).AddVersion(1).ExecX(ctx)
+	src.StatusNamespace.UpdateOneID(f.stNS.ID).ClearDefaultTTL().ExecX(ctx)
+
+	_, exported = export(ctx, t, src, backup.Filter{})
+
+	report, err = backup.NewImporter(dst).Import(ctx, strings.NewReader(exported))
+	require.NoError(t, err)
+
+	assert.Equal(t, backup.Counts{backup.KindAnnotation: 1, backup.KindStatusNamespace: 1}, report.Updated)
+
+	ant := dst.Annotation.GetX(ctx, f.lbAnt.ID)
+	assert.JSONEq(t, `{"name":"lb2"}`, string(ant.Data))
+	assert.Equal(t, int64(2), ant.Version)
+	assert.Nil(t, dst.StatusNamespace.GetX(ctx, f.stNS.ID).DefaultTTL)
+}
+
+func TestExportFilter(t *testing.T) {
+	ctx := context.Background()
+
+	client := newClient(t, "backup-filter")
+
+	f := newFixtures(ctx, client)
+
+	testCases := []struct {
+		TestName string
+		Filter   backup.Filter
+		IDs      []gidx.PrefixedID
+	}{
+		{
+			TestName: "Owner",
+			Filter:   backup.Filter{OwnerIDs: []gidx.PrefixedID{f.owner}},
+			IDs:      []gidx.PrefixedID{f.antNS.ID, f.stNS.ID, f.lbMD.ID, f.lbAnt.ID, f.lbSt.ID},
+		},
+		{
+			TestName: "Namespace",
+			Filter:   backup.Filter{NamespaceIDs: []gidx.PrefixedID{f.otherNS.ID}},
+			IDs:      []gidx.PrefixedID{f.otherNS.ID, f.ipMD.ID, f.ipAnt.ID},
+		},
+		{
+			TestName: "Node ID prefix",
+			Filter:   backup.Filter{NodeIDPrefix: "ipamipa-"},
+			IDs:      []gidx.PrefixedID{f.antNS.ID, f.otherNS.ID, f.stNS.ID, f.ipMD.ID, f.ipAnt.ID},
+		},
+		{
+			TestName: "Owner and node ID prefix",
+			Filter:   backup.Filter{OwnerIDs: []gidx.PrefixedID{f.owner}, NodeIDPrefix: "ipamipa-"},
+			IDs:      []gidx.PrefixedID{f.antNS.ID, f.stNS.ID},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			records, _ := export(ctx, t, client, tt.Filter)
+
+			assert.ElementsMatch(t, tt.IDs, ids(records))
+		})
+	}
+}
+
+func TestImportConflicts(t *testing.T) {
+	ctx := context.Background()
+
+	src := newClient(t, "backup-conflicts-src")
+	dst := newClient(t, "backup-conflicts-dst")
+
+	f := newFixtures(ctx, src)
+
+	_, exported := export(ctx, t, src, backup.Filter{})
+
+	// the node already has other metadata and the owner a namespace with the name
+	md := dst.Metadata.Create().SetNodeID(f.lbMD.NodeID).SaveX(ctx)
+	dst.AnnotationNamespace.Create().SetName(f.antNS.Name).SetOwnerID(f.owner).ExecX(ctx)
+
+	for _, dryRun := range []bool{true, false} {
+		var opts []backup.ImporterOption
+
+		if dryRun {
+			opts = append(opts, backup.WithDryRun())
+		}
+
+		report, err := backup.NewImporter(dst, opts...).Import(ctx, strings.NewReader(exported))
+		require.NoError(t, err)
+
+		conflicts := make(map[gidx.PrefixedID]string, len(report.Conflicts))
+		for _, c := range report.Conflicts {
+			conflicts[c.ID] = c.Reason
+		}
+
+		assert.Equal(t, map[gidx.PrefixedID]string{
+			f.antNS.ID: "owner " + f.owner.String() + " has another namespace named backup-tests",
+			f.lbMD.ID:  "node " + f.lbMD.NodeID.String() + " has metadata " + md.ID.String(),
+			f.lbAnt.ID: "references conflicting record " + f.lbMD.ID.String(),
+			f.lbSt.ID:  "references conflicting record " + f.lbMD.ID.String(),
+		}, conflicts, "dry run: %t", dryRun)
+
+		assert.Equal(t, backup.Counts{
+			backup.KindAnnotationNamespace: 1,
+			backup.KindStatusNamespace:     1,
+			backup.KindMetadata:            1,
+			backup.KindAnnotation:          1,
+		}, report.Created, "dry run: %t", dryRun)
+
+		exists, err := dst.Annotation.Query().Exist(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, !dryRun, exists, "dry run: %t", dryRun)
+	}
+}
+
+func TestImportDeletedNamespaces(t *testing.T) {
+	ctx := context.Background()
+
+	src := newClient(t, "backup-deleted-src")
+	dst := newClient(t, "backup-deleted-dst")
+
+	f := newFixtures(ctx, src)
+
+	_, exported := export(ctx, t, src, backup.Filter{})
+
+	_, err := backup.NewImporter(dst).Import(ctx, strings.NewReader(exported))
+	require.NoError(t, err)
+
+	// the namespaces are hidden once they're soft deleted, but still stored
+	dst.AnnotationNamespace.UpdateOneID(f.antNS.ID).SetDeletedAt(time.Now()).SetDeleting(true).ExecX(ctx)
+	dst.StatusNamespace.UpdateOneID(f.stNS.ID).SetDeletedAt(time.Now()).SetDeleting(true).ExecX(ctx)
+
+	for _, dryRun := range []bool{true, false} {
+		var opts []backup.ImporterOption
+
+		if dryRun {
+			opts = append(opts, backup.WithDryRun())
+		}
+
+		report, err := backup.NewImporter(dst, opts...).Import(ctx, strings.NewReader(exported))
+		require.NoError(t, err)
+
+		conflicts := make(map[gidx.PrefixedID]string, len(report.Conflicts))
+		for _, c := range report.Conflicts {
+			conflicts[c.ID] = c.Reason
+		}
+
+		assert.Equal(t, map[gidx.PrefixedID]string{
+			f.antNS.ID: "is deleted",
+			f.stNS.ID:  "is deleted",
+			f.lbAnt.ID: "references conflicting record " + f.antNS.ID.String(),
+			f.lbSt.ID:  "references conflicting record " + f.stNS.ID.String(),
+		}, conflicts, "dry run: %t", dryRun)
+
+		assert.Empty(t, report.Created, "dry run: %t", dryRun)
+	}
+
+	// the names of deleted namespaces can be used by other namespaces
+	reuse := newClient(t, "backup-deleted-reuse")
+
+	reuse.AnnotationNamespace.Create().SetName(f.antNS.Name).SetOwnerID(f.antNS.OwnerID).SetDeletedAt(time.Now()).SetDeleting(true).ExecX(ctx)
+	reuse.StatusNamespace.Create().SetName(f.stNS.Name).SetResourceProviderID(f.stNS.ResourceProviderID).SetDeletedAt(time.Now()).SetDeleting(true).ExecX(ctx)
+
+	report, err := backup.NewImporter(reuse).Import(ctx, strings.NewReader(exported))
+	require.NoError(t, err)
+	assert.Empty(t, report.Conflicts)
+}
+
+func TestImportInvalidRecord(t *testing.T) {
+	ctx := context.Background()
+
+	client := newClient(t, "backup-invalid")
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		errorMsg string
+	}{
+		{
+			TestName: "Invalid JSON",
+			Input:    "{",
+			errorMsg: "invalid record: line 1",
+		},
+		{
+			TestName: "Unknown kind",
+			Input:    `{"kind":"unknown"}`,
+			errorMsg: `invalid record: line 1: unknown kind "unknown"`,
+		},
+		{
+			TestName: "Missing record",
+			Input:    "\n" + `{"kind":"metadata"}`,
+			errorMsg: "invalid record: line 2: metadata without an id",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			_, err := backup.NewImporter(client).Import(ctx, strings.NewReader(tt.Input))
+
+			require.Error(t, err)
+			assert.ErrorIs(t, err, backup.ErrInvalidRecord)
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
+
+func sum(counts backup.Counts) int {
+	var n int
+
+	for _, c := range counts {
+		n += c
+	}
+
+	return n
+}
@@ -0,0 +1,256 @@
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"go.infratographer.com/x/gidx"
+
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+)
+
+// DefaultPageSize is the number of records loaded from the database at once.
+const DefaultPageSize = 500
+
+// Filter selects the records to export. Filters which are set must all match.
+type Filter struct {
+	// OwnerIDs selects the namespaces of the owners, annotation namespaces by their
+	// owner and status namespaces by their resource provider.
+	OwnerIDs []gidx.PrefixedID
+	// NamespaceIDs selects the namespaces with the IDs.
+	NamespaceIDs []gidx.PrefixedID
+	// NodeIDPrefix selects the metadata of the nodes with IDs starting with the prefix, e.g. "loadbal-".
+	NodeIDPrefix string
+}
+
+// namespaceFiltered reports whether the filter selects namespaces, the metadata,
+// annotations and statuses exported are then limited to the namespaces.
+func (f Filter) namespaceFiltered() bool {
+	return len(f.OwnerIDs) != 0 || len(f.NamespaceIDs) != 0
+}
+
+func (f Filter) annotationNamespaces() []predicate.AnnotationNamespace {
+	var preds []predicate.AnnotationNamespace
+
+	if len(f.OwnerIDs) != 0 {
+		preds = append(preds, annotationnamespace.OwnerIDIn(f.OwnerIDs...))
+	}
+
+	if len(f.NamespaceIDs) != 0 {
+		preds = append(preds, annotationnamespace.IDIn(f.NamespaceIDs...))
+	}
+
+	return preds
+}
+
+func (f Filter) statusNamespaces() []predicate.StatusNamespace {
+	var preds []predicate.StatusNamespace
+
+	if len(f.OwnerIDs) != 0 {
+		preds = append(preds, statusnamespace.ResourceProviderIDIn(f.OwnerIDs...))
+	}
+
+	if len(f.NamespaceIDs) != 0 {
+		preds = append(preds, statusnamespace.IDIn(f.NamespaceIDs...))
+	}
+
+	return preds
+}
+
+func (f Filter) nodes() []predicate.Metadata {
+	if f.NodeIDPrefix == "" {
+		return nil
+	}
+
+	return []predicate.Metadata{metadata.NodeIDHasPrefix(gidx.PrefixedID(f.NodeIDPrefix))}
+}
+
+func (f Filter) metadata() []predicate.Metadata {
+	preds := f.nodes()
+
+	if f.namespaceFiltered() {
+		preds = append(preds, metadata.Or(
+			metadata.HasAnnotationsWith(annotation.HasNamespaceWith(f.annotationNamespaces()...)),
+			metadata.HasStatusesWith(status.HasNamespaceWith(f.statusNamespaces()...)),
+		))
+	}
+
+	return preds
+}
+
+func (f Filter) annotations() []predicate.Annotation {
+	var preds []predicate.Annotation
+
+	if f.namespaceFiltered() {
+		preds = append(preds, annotation.HasNamespaceWith(f.annotationNamespaces()...))
+	}
+
+	if f.NodeIDPrefix != "" {
+		preds = append(preds, annotation.HasMetadataWith(f.nodes()...))
+	}
+
+	return preds
+}
+
+func (f Filter) statuses() []predicate.Status {
+	var preds []predicate.Status
+
+	if f.namespaceFiltered() {
+		preds = append(preds, status.HasNamespaceWith(f.statusNamespaces()...))
+	}
+
+	if f.NodeIDPrefix != "" {
+		preds = append(preds, status.HasMetadataWith(f.nodes()...))
+	}
+
+	return preds
+}
+
+// Counts is the number of records of each kind exported or imported.
+type Counts map[Kind]int
+
+// Exporter writes the records of a database as newline-delimited JSON.
+type Exporter struct {
+	client   *ent.Client
+	pageSize int
+}
+
+// ExporterOption configures an Exporter.
+type ExporterOption func(*Exporter)
+
+// WithPageSize sets the number of records loaded from the database at once.
+func WithPageSize(n int) ExporterOption {
+	return func(e *Exporter) {
+		if n > 0 {
+			e.pageSize = n
+		}
+	}
+}
+
+// NewExporter returns an exporter reading the records with the client.
+func NewExporter(client *ent.Client, opts ...ExporterOption) *Exporter {
+	e := &Exporter{
+		client:   client,
+		pageSize: DefaultPageSize,
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// Export writes the records matched by the filter to w, one record per line.
+// Namespaces and metadata are written before the annotations and statuses which
+// reference them, so the records can be imported in the order they are read.
+func (e *Exporter) Export(ctx context.Context, w io.Writer, filter Filter) (Counts, error) {
+	enc := json.NewEncoder(w)
+	counts := make(Counts)
+
+	write := func(rec Record) error {
+		counts[rec.Kind]++
+
+		return enc.Encode(rec)
+	}
+
+	if err := exportPages(ctx, e.pageSize, func(ctx context.Context, after gidx.PrefixedID, limit int) ([]*ent.AnnotationNamespace, error) {
+		return e.client.AnnotationNamespace.Query().
+			Where(append(filter.annotationNamespaces(), annotationnamespace.IDGT(after))...).
+			Order(ent.Asc(annotationnamespace.FieldID)).
+			Limit(limit).
+			All(ctx)
+	}, func(ns *ent.AnnotationNamespace) (gidx.PrefixedID, error) {
+		return ns.ID, write(annotationNamespaceRecord(ns))
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := exportPages(ctx, e.pageSize, func(ctx context.Context, after gidx.PrefixedID, limit int) ([]*ent.StatusNamespace, error) {
+		return e.client.StatusNamespace.Query().
+			Where(append(filter.statusNamespaces(), statusnamespace.IDGT(after))...).
+			Order(ent.Asc(statusnamespace.FieldID)).
+			Limit(limit).
+			All(ctx)
+	}, func(ns *ent.StatusNamespace) (gidx.PrefixedID, error) {
+		return ns.ID, write(statusNamespaceRecord(ns))
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := exportPages(ctx, e.pageSize, func(ctx context.Context, after gidx.PrefixedID, limit int) ([]*ent.Metadata, error) {
+		return e.client.Metadata.Query().
+			Where(append(filter.metadata(), metadata.IDGT(after))...).
+			Order(ent.Asc(metadata.FieldID)).
+			Limit(limit).
+			All(ctx)
+	}, func(md *ent.Metadata) (gidx.PrefixedID, error) {
+		return md.ID, write(metadataRecord(md))
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := exportPages(ctx, e.pageSize, func(ctx context.Context, after gidx.PrefixedID, limit int) ([]*ent.Annotation, error) {
+		return e.client.Annotation.Query().
+			Where(append(filter.annotations(), annotation.IDGT(after))...).
+			Order(ent.Asc(annotation.FieldID)).
+			Limit(limit).
+			All(ctx)
+	}, func(ant *ent.Annotation) (gidx.PrefixedID, error) {
+		return ant.ID, write(annotationRecord(ant))
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := exportPages(ctx, e.pageSize, func(ctx context.Context, after gidx.PrefixedID, limit int) ([]*ent.Status, error) {
+		return e.client.Status.Query().
+			Where(append(filter.statuses(), status.IDGT(after))...).
+			Order(ent.Asc(status.FieldID)).
+			Limit(limit).
+			All(ctx)
+	}, func(st *ent.Status) (gidx.PrefixedID, error) {
+		return st.ID, write(statusRecord(st))
+	}); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// exportPages loads the records page by page in the order of their IDs, so the
+// records aren't all held in memory, and writes each of them.
+func exportPages[T any](
+	ctx context.Context,
+	pageSize int,
+	page func(ctx context.Context, after gidx.PrefixedID, limit int) ([]T, error),
+	write func(T) (gidx.PrefixedID, error),
+) error {
+	var after gidx.PrefixedID
+
+	for {
+		records, err := page(ctx, after, pageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, rec := range records {
+			id, err := write(rec)
+			if err != nil {
+				return err
+			}
+
+			after = id
+		}
+
+		if len(records) < pageSize {
+			return nil
+		}
+	}
+}
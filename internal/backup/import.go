@@ -0,0 +1,593 @@
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"go.infratographer.com/x/gidx"
+
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
+)
+
+// ErrInvalidRecord is returned when a line of an import isn't a valid record.
+var ErrInvalidRecord = errors.New("invalid record")
+
+// Conflict is a record which isn't imported since it conflicts with the stored records.
+type Conflict struct {
+	// Line is the line of the record in the import.
+	Line int
+	// Kind is the kind of the record.
+	Kind Kind
+	// ID is the ID of the record.
+	ID gidx.PrefixedID
+	// Reason describes the conflict.
+	Reason string
+}
+
+// String implements fmt.Stringer.
+func (c Conflict) String() string {
+	return fmt.Sprintf("line %d: %s %s: %s", c.Line, c.Kind, c.ID, c.Reason)
+}
+
+// Report is the result of an import.
+type Report struct {
+	// Created is the number of records of each kind which didn't exist.
+	Created Counts
+	// Updated is the number of records of each kind which existed with other values.
+	Updated Counts
+	// Unchanged is the number of records of each kind which already existed as imported.
+	Unchanged Counts
+	// Conflicts are the records which aren't imported.
+	Conflicts []Conflict
+}
+
+type result int
+
+const (
+	resultCreated result = iota
+	resultUpdated
+	resultUnchanged
+	resultConflict
+)
+
+// Importer imports the records written by an Exporter. Records keep their IDs, so
+// importing the same records again doesn't change anything.
+type Importer struct {
+	client *ent.Client
+	dryRun bool
+}
+
+// ImporterOption configures an Importer.
+type ImporterOption func(*Importer)
+
+// WithDryRun makes the importer report the changes of an import without making them.
+func WithDryRun() ImporterOption {
+	return func(i *Importer) {
+		i.dryRun = true
+	}
+}
+
+// NewImporter returns an importer writing the records with the client. Only the
+// hooks registered on the client are run for the imported records.
+func NewImporter(client *ent.Client, opts ...ImporterOption) *Importer {
+	i := &Importer{client: client}
+
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	return i
+}
+
+// Import reads the records from r and imports them in order. Each record is
+// imported in its own transaction. A record which doesn't exist is created with
+// its timestamps, one which exists with other values is updated and gets the
+// time of the import as its update time. Records with the ID of a stored
+// record of other namespaces, nodes or owners, or with the unique fields of
+// another record, are reported as conflicts and skipped along with the records
+// referencing them. Soft deleted namespaces are conflicts as well, along with the
+// annotations and statuses in them, until they're restored or purged.
+//
+// The data of annotations and statuses isn't validated against the JSON schema
+// of their namespace. The schema may have changed since the data was stored, so
+// an export can have data which no longer validates and is restored as it was.
+//
+// In a dry run the records are imported in a single transaction which is rolled
+// back at the end, so the report matches the one of the actual import.
+func (i *Importer) Import(ctx context.Context, r io.Reader) (*Report, error) {
+	// soft deleted records are found, so they're reported rather than failing to insert
+	ctx = softdelete.IncludeDeleted(ctx)
+
+	run := &importRun{
+		report: &Report{
+			Created:   make(Counts),
+			Updated:   make(Counts),
+			Unchanged: make(Counts),
+		},
+		conflicted: make(map[gidx.PrefixedID]bool),
+	}
+
+	var dryRunTx *ent.Tx
+
+	if i.dryRun {
+		tx, err := i.client.BeginTx(ctx, &sql.TxOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		defer tx.Rollback() //nolint:errcheck
+
+		dryRunTx = tx
+	}
+
+	reader := bufio.NewReader(r)
+
+	for line := 1; ; line++ {
+		raw, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return nil, readErr
+		}
+
+		if len(bytes.TrimSpace(raw)) != 0 {
+			rec, err := decodeRecord(raw)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %s", ErrInvalidRecord, line, err)
+			}
+
+			if dryRunTx != nil {
+				err = run.importRecord(ctx, dryRunTx, line, rec)
+			} else {
+				err = i.withTx(ctx, func(tx *ent.Tx) error {
+					return run.importRecord(ctx, tx, line, rec)
+				})
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("line %d: failed to import %s %s: %w", line, rec.Kind, rec.ID(), err)
+			}
+		}
+
+		if errors.Is(readErr, io.EOF) {
+			return run.report, nil
+		}
+	}
+}
+
+func (i *Importer) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := i.client.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback() //nolint:errcheck
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// decodeRecord decodes a line of an import and checks the record of its kind is set.
+func decodeRecord(raw []byte) (Record, error) {
+	var rec Record
+
+	if err := json.Unmarshal(raw, &rec); err != nil {
+		return rec, err
+	}
+
+	switch rec.Kind {
+	case KindAnnotationNamespace, KindStatusNamespace, KindMetadata, KindAnnotation, KindStatus:
+	default:
+		return rec, fmt.Errorf("unknown kind %q", rec.Kind)
+	}
+
+	if rec.ID() == "" {
+		return rec, fmt.Errorf("%s without an id", rec.Kind)
+	}
+
+	return rec, nil
+}
+
+// importRun is the state of an import.
+type importRun struct {
+	report *Report
+	// conflicted are the IDs of the records which conflicted, records referencing
+	// them are skipped as well.
+	conflicted map[gidx.PrefixedID]bool
+}
+
+func (r *importRun) importRecord(ctx context.Context, tx *ent.Tx, line int, rec Record) error {
+	var (
+		res    result
+		reason string
+		err    error
+	)
+
+	switch rec.Kind {
+	case KindAnnotationNamespace:
+		res, reason, err = importAnnotationNamespace(ctx, tx, rec.AnnotationNamespace)
+	case KindStatusNamespace:
+		res, reason, err = importStatusNamespace(ctx, tx, rec.StatusNamespace)
+	case KindMetadata:
+		res, reason, err = importMetadata(ctx, tx, rec.Metadata)
+	case KindAnnotation:
+		if reason = r.conflictingReference(rec.Annotation.MetadataID, rec.Annotation.NamespaceID); reason != "" {
+			res = resultConflict
+		} else {
+			res, reason, err = importAnnotation(ctx, tx, rec.Annotation)
+		}
+	case KindStatus:
+		if reason = r.conflictingReference(rec.Status.MetadataID, rec.Status.NamespaceID); reason != "" {
+			res = resultConflict
+		} else {
+			res, reason, err = importStatus(ctx, tx, rec.Status)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	switch res {
+	case resultCreated:
+		r.report.Created[rec.Kind]++
+	case resultUpdated:
+		r.report.Updated[rec.Kind]++
+	case resultUnchanged:
+		r.report.Unchanged[rec.Kind]++
+	case resultConflict:
+		r.conflicted[rec.ID()] = true
+		r.report.Conflicts = append(r.report.Conflicts, Conflict{
+			Line:   line,
+			Kind:   rec.Kind,
+			ID:     rec.ID(),
+			Reason: reason,
+		})
+	}
+
+	return nil
+}
+
+// conflictingReference returns the reason a record is skipped when it references
+// a record which conflicted, or an empty string if it doesn't.
+func (r *importRun) conflictingReference(ids ...gidx.PrefixedID) string {
+	for _, id := range ids {
+		if r.conflicted[id] {
+			return fmt.Sprintf("references conflicting record %s", id)
+		}
+	}
+
+	return ""
+}
+
+func conflictf(format string, args ...any) (result, string, error) {
+	return resultConflict, fmt.Sprintf(format, args...), nil
+}
+
+func importAnnotationNamespace(ctx context.Context, tx *ent.Tx, ns *AnnotationNamespace) (result, string, error) {
+	cur, err := tx.AnnotationNamespace.Get(ctx, ns.ID)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, "", err
+	}
+
+	if cur != nil {
+		if cur.DeletedAt != nil {
+			return conflictf("is deleted")
+		}
+
+		if cur.OwnerID != ns.OwnerID {
+			return conflictf("exists with owner %s", cur.OwnerID)
+		}
+
+		if cur.Name == ns.Name && cur.Private == ns.Private && jsonEqual(cur.JSONSchema, ns.JSONSchema) {
+			return resultUnchanged, "", nil
+		}
+	}
+
+	taken, err := tx.AnnotationNamespace.Query().Where(
+		annotationnamespace.IDNEQ(ns.ID),
+		annotationnamespace.OwnerID(ns.OwnerID),
+		annotationnamespace.Name(ns.Name),
+		annotationnamespace.DeletedAtIsNil(),
+	).Exist(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if taken {
+		return conflictf("owner %s has another namespace named %s", ns.OwnerID, ns.Name)
+	}
+
+	if cur != nil {
+		upd := tx.AnnotationNamespace.UpdateOneID(ns.ID).
+			SetName(ns.Name).
+			SetPrivate(ns.Private)
+
+		if ns.JSONSchema != nil {
+			upd.SetJSONSchema(ns.JSONSchema)
+		} else {
+			upd.ClearJSONSchema()
+		}
+
+		return resultUpdated, "", upd.Exec(ctx)
+	}
+
+	create := tx.AnnotationNamespace.Create().
+		SetID(ns.ID).
+		SetName(ns.Name).
+		SetOwnerID(ns.OwnerID).
+		SetPrivate(ns.Private).
+		SetCreatedAt(ns.CreatedAt).
+		SetUpdatedAt(ns.UpdatedAt)
+
+	if ns.JSONSchema != nil {
+		create.SetJSONSchema(ns.JSONSchema)
+	}
+
+	return resultCreated, "", create.Exec(ctx)
+}
+
+func importStatusNamespace(ctx context.Context, tx *ent.Tx, ns *StatusNamespace) (result, string, error) {
+	cur, err := tx.StatusNamespace.Get(ctx, ns.ID)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, "", err
+	}
+
+	if cur != nil {
+		if cur.DeletedAt != nil {
+			return conflictf("is deleted")
+		}
+
+		if cur.ResourceProviderID != ns.ResourceProviderID {
+			return conflictf("exists with resource provider %s", cur.ResourceProviderID)
+		}
+
+		if cur.Name == ns.Name && cur.Private == ns.Private && jsonEqual(cur.JSONSchema, ns.JSONSchema) &&
+			reflect.DeepEqual(cur.DefaultTTL, ns.DefaultTTL) {
+			return resultUnchanged, "", nil
+		}
+	}
+
+	taken, err := tx.StatusNamespace.Query().Where(
+		statusnamespace.IDNEQ(ns.ID),
+		statusnamespace.ResourceProviderID(ns.ResourceProviderID),
+		statusnamespace.Name(ns.Name),
+		statusnamespace.DeletedAtIsNil(),
+	).Exist(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if taken {
+		return conflictf("resource provider %s has another namespace named %s", ns.ResourceProviderID, ns.Name)
+	}
+
+	if cur != nil {
+		upd := tx.StatusNamespace.UpdateOneID(ns.ID).
+			SetName(ns.Name).
+			SetPrivate(ns.Private)
+
+		if ns.JSONSchema != nil {
+			upd.SetJSONSchema(ns.JSONSchema)
+		} else {
+			upd.ClearJSONSchema()
+		}
+
+		if ns.DefaultTTL != nil {
+			upd.SetDefaultTTL(*ns.DefaultTTL)
+		} else {
+			upd.ClearDefaultTTL()
+		}
+
+		return resultUpdated, "", upd.Exec(ctx)
+	}
+
+	create := tx.StatusNamespace.Create().
+		SetID(ns.ID).
+		SetName(ns.Name).
+		SetResourceProviderID(ns.ResourceProviderID).
+		SetPrivate(ns.Private).
+		SetNillableDefaultTTL(ns.DefaultTTL).
+		SetCreatedAt(ns.CreatedAt).
+		SetUpdatedAt(ns.UpdatedAt)
+
+	if ns.JSONSchema != nil {
+		create.SetJSONSchema(ns.JSONSchema)
+	}
+
+	return resultCreated, "", create.Exec(ctx)
+}
+
+func importMetadata(ctx context.Context, tx *ent.Tx, md *Metadata) (result, string, error) {
+	cur, err := tx.Metadata.Query().Where(
+		metadata.Or(metadata.ID(md.ID), metadata.NodeID(md.NodeID)),
+	).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, "", err
+	}
+
+	switch {
+	case cur == nil:
+		return resultCreated, "", tx.Metadata.Create().
+			SetID(md.ID).
+			SetNodeID(md.NodeID).
+			SetCreatedAt(md.CreatedAt).
+			SetUpdatedAt(md.UpdatedAt).
+			Exec(ctx)
+	case cur.ID != md.ID:
+		return conflictf("node %s has metadata %s", md.NodeID, cur.ID)
+	case cur.NodeID != md.NodeID:
+		return conflictf("exists for node %s", cur.NodeID)
+	default:
+		return resultUnchanged, "", nil
+	}
+}
+
+func importAnnotation(ctx context.Context, tx *ent.Tx, ant *Annotation) (result, string, error) {
+	ns, err := tx.AnnotationNamespace.Get(ctx, ant.NamespaceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return conflictf("annotation namespace %s doesn't exist", ant.NamespaceID)
+		}
+
+		return 0, "", err
+	}
+
+	if ns.DeletedAt != nil {
+		return conflictf("annotation namespace %s is deleted", ant.NamespaceID)
+	}
+
+	if res, reason, err := requireMetadata(ctx, tx, ant.MetadataID); err != nil || res == resultConflict {
+		return res, reason, err
+	}
+
+	cur, err := tx.Annotation.Query().Where(
+		annotation.Or(
+			annotation.ID(ant.ID),
+			annotation.And(annotation.MetadataID(ant.MetadataID), annotation.AnnotationNamespaceID(ant.NamespaceID)),
+		),
+	).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, "", err
+	}
+
+	switch {
+	case cur == nil:
+		return resultCreated, "", tx.Annotation.Create().
+			SetID(ant.ID).
+			SetMetadataID(ant.MetadataID).
+			SetAnnotationNamespaceID(ant.NamespaceID).
+			SetData(ant.Data).
+			SetVersion(ant.Version).
+			SetCreatedAt(ant.CreatedAt).
+			SetUpdatedAt(ant.UpdatedAt).
+			Exec(ctx)
+	case cur.ID != ant.ID:
+		return conflictf("metadata %s has annotation %s in namespace %s", ant.MetadataID, cur.ID, ant.NamespaceID)
+	case cur.MetadataID != ant.MetadataID || cur.AnnotationNamespaceID != ant.NamespaceID:
+		return conflictf("exists for metadata %s in namespace %s", cur.MetadataID, cur.AnnotationNamespaceID)
+	case cur.Version == ant.Version && jsonEqual(cur.Data, ant.Data):
+		return resultUnchanged, "", nil
+	default:
+		return resultUpdated, "", tx.Annotation.UpdateOneID(ant.ID).
+			SetData(ant.Data).
+			SetVersion(ant.Version).
+			Exec(ctx)
+	}
+}
+
+func importStatus(ctx context.Context, tx *ent.Tx, st *Status) (result, string, error) {
+	ns, err := tx.StatusNamespace.Get(ctx, st.NamespaceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return conflictf("status namespace %s doesn't exist", st.NamespaceID)
+		}
+
+		return 0, "", err
+	}
+
+	if ns.DeletedAt != nil {
+		return conflictf("status namespace %s is deleted", st.NamespaceID)
+	}
+
+	if res, reason, err := requireMetadata(ctx, tx, st.MetadataID); err != nil || res == resultConflict {
+		return res, reason, err
+	}
+
+	cur, err := tx.Status.Query().Where(
+		status.Or(
+			status.ID(st.ID),
+			status.And(status.MetadataID(st.MetadataID), status.StatusNamespaceID(st.NamespaceID), status.Source(st.Source)),
+		),
+	).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, "", err
+	}
+
+	switch {
+	case cur == nil:
+		return resultCreated, "", tx.Status.Create().
+			SetID(st.ID).
+			SetMetadataID(st.MetadataID).
+			SetStatusNamespaceID(st.NamespaceID).
+			SetSource(st.Source).
+			SetData(st.Data).
+			SetVersion(st.Version).
+			SetNillableExpiresAt(st.ExpiresAt).
+			SetCreatedAt(st.CreatedAt).
+			SetUpdatedAt(st.UpdatedAt).
+			Exec(ctx)
+	case cur.ID != st.ID:
+		return conflictf("metadata %s has status %s from source %s in namespace %s", st.MetadataID, cur.ID, st.Source, st.NamespaceID)
+	case cur.MetadataID != st.MetadataID || cur.StatusNamespaceID != st.NamespaceID || cur.Source != st.Source:
+		return conflictf("exists for metadata %s from source %s in namespace %s", cur.MetadataID, cur.Source, cur.StatusNamespaceID)
+	case cur.Version == st.Version && jsonEqual(cur.Data, st.Data) && timeEqual(cur.ExpiresAt, st.ExpiresAt):
+		return resultUnchanged, "", nil
+	default:
+		upd := tx.Status.UpdateOneID(st.ID).
+			SetData(st.Data).
+			SetVersion(st.Version)
+
+		if st.ExpiresAt != nil {
+			upd.SetExpiresAt(*st.ExpiresAt)
+		} else {
+			upd.ClearExpiresAt()
+		}
+
+		return resultUpdated, "", upd.Exec(ctx)
+	}
+}
+
+// requireMetadata checks the metadata referenced by an annotation or status
+// exists, either stored before or imported earlier.
+func requireMetadata(ctx context.Context, tx *ent.Tx, id gidx.PrefixedID) (result, string, error) {
+	exists, err := tx.Metadata.Query().Where(metadata.ID(id)).Exist(ctx)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if !exists {
+		return conflictf("metadata %s doesn't exist", id)
+	}
+
+	return resultCreated, "", nil
+}
+
+// jsonEqual reports whether a and b are the same JSON values, the formatting of
+// stored JSON can differ from the imported one.
+func jsonEqual(a, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+
+	var va, vb any
+
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return bytes.Equal(a, b)
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
@@ -0,0 +1,183 @@
+// Package backup exports namespaces, metadata, annotations and statuses as
+// newline-delimited JSON and imports them again, to back up the metadata of an
+// environment or to clone it into another one.
+package backup
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.infratographer.com/x/gidx"
+
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+)
+
+// Kind is the kind of the record on a line of an export.
+type Kind string
+
+// The kinds of records in an export, in the order they are exported.
+const (
+	KindAnnotationNamespace Kind = "annotationNamespace"
+	KindStatusNamespace     Kind = "statusNamespace"
+	KindMetadata            Kind = "metadata"
+	KindAnnotation          Kind = "annotation"
+	KindStatus              Kind = "status"
+)
+
+// Record is a line of an export. The field matching its kind is set.
+type Record struct {
+	Kind                Kind                 `json:"kind"`
+	AnnotationNamespace *AnnotationNamespace `json:"annotationNamespace,omitempty"`
+	StatusNamespace     *StatusNamespace     `json:"statusNamespace,omitempty"`
+	Metadata            *Metadata            `json:"metadata,omitempty"`
+	Annotation          *Annotation          `json:"annotation,omitempty"`
+	Status              *Status              `json:"status,omitempty"`
+}
+
+// ID returns the ID of the record, or an empty ID when the field of its kind isn't set.
+func (r Record) ID() gidx.PrefixedID {
+	switch {
+	case r.Kind == KindAnnotationNamespace && r.AnnotationNamespace != nil:
+		return r.AnnotationNamespace.ID
+	case r.Kind == KindStatusNamespace && r.StatusNamespace != nil:
+		return r.StatusNamespace.ID
+	case r.Kind == KindMetadata && r.Metadata != nil:
+		return r.Metadata.ID
+	case r.Kind == KindAnnotation && r.Annotation != nil:
+		return r.Annotation.ID
+	case r.Kind == KindStatus && r.Status != nil:
+		return r.Status.ID
+	default:
+		return ""
+	}
+}
+
+// AnnotationNamespace is an exported annotation namespace.
+type AnnotationNamespace struct {
+	ID         gidx.PrefixedID `json:"id"`
+	Name       string          `json:"name"`
+	OwnerID    gidx.PrefixedID `json:"ownerID"`
+	Private    bool            `json:"private"`
+	JSONSchema json.RawMessage `json:"jsonSchema,omitempty"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	UpdatedAt  time.Time       `json:"updatedAt"`
+}
+
+// StatusNamespace is an exported status namespace.
+type StatusNamespace struct {
+	ID                 gidx.PrefixedID `json:"id"`
+	Name               string          `json:"name"`
+	ResourceProviderID gidx.PrefixedID `json:"resourceProviderID"`
+	Private            bool            `json:"private"`
+	JSONSchema         json.RawMessage `json:"jsonSchema,omitempty"`
+	DefaultTTL         *int64          `json:"defaultTTL,omitempty"`
+	CreatedAt          time.Time       `json:"createdAt"`
+	UpdatedAt          time.Time       `json:"updatedAt"`
+}
+
+// Metadata is the exported metadata of a node.
+type Metadata struct {
+	ID        gidx.PrefixedID `json:"id"`
+	NodeID    gidx.PrefixedID `json:"nodeID"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+}
+
+// Annotation is an exported annotation.
+type Annotation struct {
+	ID          gidx.PrefixedID `json:"id"`
+	MetadataID  gidx.PrefixedID `json:"metadataID"`
+	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	Data        json.RawMessage `json:"data"`
+	Version     int64           `json:"version"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+// Status is an exported status.
+type Status struct {
+	ID          gidx.PrefixedID `json:"id"`
+	MetadataID  gidx.PrefixedID `json:"metadataID"`
+	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	Source      string          `json:"source"`
+	Data        json.RawMessage `json:"data"`
+	Version     int64           `json:"version"`
+	ExpiresAt   *time.Time      `json:"expiresAt,omitempty"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+}
+
+func annotationNamespaceRecord(ns *ent.AnnotationNamespace) Record {
+	return Record{
+		Kind: KindAnnotationNamespace,
+		AnnotationNamespace: &AnnotationNamespace{
+			ID:         ns.ID,
+			Name:       ns.Name,
+			OwnerID:    ns.OwnerID,
+			Private:    ns.Private,
+			JSONSchema: ns.JSONSchema,
+			CreatedAt:  ns.CreatedAt,
+			UpdatedAt:  ns.UpdatedAt,
+		},
+	}
+}
+
+func statusNamespaceRecord(ns *ent.StatusNamespace) Record {
+	return Record{
+		Kind: KindStatusNamespace,
+		StatusNamespace: &StatusNamespace{
+			ID:                 ns.ID,
+			Name:               ns.Name,
+			ResourceProviderID: ns.ResourceProviderID,
+			Private:            ns.Private,
+			JSONSchema:         ns.JSONSchema,
+			DefaultTTL:         ns.DefaultTTL,
+			CreatedAt:          ns.CreatedAt,
+			UpdatedAt:          ns.UpdatedAt,
+		},
+	}
+}
+
+func metadataRecord(md *ent.Metadata) Record {
+	return Record{
+		Kind: KindMetadata,
+		Metadata: &Metadata{
+			ID:        md.ID,
+			NodeID:    md.NodeID,
+			CreatedAt: md.CreatedAt,
+			UpdatedAt: md.UpdatedAt,
+		},
+	}
+}
+
+func annotationRecord(ant *ent.Annotation) Record {
+	return Record{
+		Kind: KindAnnotation,
+		Annotation: &Annotation{
+			ID:          ant.ID,
+			MetadataID:  ant.MetadataID,
+			NamespaceID: ant.AnnotationNamespaceID,
+			Data:        ant.Data,
+			Version:     ant.Version,
+			CreatedAt:   ant.CreatedAt,
+			UpdatedAt:   ant.UpdatedAt,
+		},
+	}
+}
+
+func statusRecord(st *ent.Status) Record {
+	return Record{
+		Kind: KindStatus,
+		Status: &Status{
+			ID:          st.ID,
+			MetadataID:  st.MetadataID,
+			NamespaceID: st.StatusNamespaceID,
+			Source:      st.Source,
+			Data:        st.Data,
+			Version:     st.Version,
+			ExpiresAt:   st.ExpiresAt,
+			CreatedAt:   st.CreatedAt,
+			UpdatedAt:   st.UpdatedAt,
+		},
+	}
+}
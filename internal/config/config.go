@@ -9,6 +9,11 @@ import (
 	"go.infratographer.com/x/events"
 	"go.infratographer.com/x/loggingx"
 	"go.infratographer.com/x/otelx"
+
+	"go.infratographer.com/metadata-api/internal/deleter"
+	"go.infratographer.com/metadata-api/internal/gc"
+	"go.infratographer.com/metadata-api/internal/graphapi"
+	"go.infratographer.com/metadata-api/internal/reaper"
 )
 
 // AppConfig stores all the config values for our application
@@ -20,4 +25,10 @@ var AppConfig struct {
 	Logging     loggingx.Config
 	Server      echox.Config
 	Tracing     otelx.Config
+	Reaper      reaper.Config
+	Deleter     deleter.Config
+	GC          gc.Config
+	Limits      graphapi.Limits
+
+	PersistedQueries graphapi.PersistedQueries
 }
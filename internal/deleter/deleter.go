@@ -0,0 +1,272 @@
+// Package deleter purges soft deleted namespaces with their statuses or annotations in the background.
+package deleter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/metadata-api/internal/ent/bulkhooks"
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/schema"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
+)
+
+const (
+	// DefaultInterval is the default time between looking for deletions to run.
+	DefaultInterval = 5 * time.Second
+
+	// DefaultBatchSize is the default number of statuses or annotations deleted at once.
+	DefaultBatchSize = 500
+
+	// DefaultPurgeDelay is the default time deleted namespaces can be restored for before they're purged.
+	DefaultPurgeDelay = 24 * time.Hour
+
+	// staleAfter is how long a running deletion can go without progress before it's
+	// picked up again, such as when the replica running it was stopped.
+	staleAfter = 5 * time.Minute
+)
+
+// Config defines the deleter configuration structure
+type Config struct {
+	// Interval is the time between looking for deletions to run. The deleter is disabled when it isn't positive.
+	Interval time.Duration
+
+	// BatchSize is the number of statuses or annotations deleted at once.
+	BatchSize int
+
+	// PurgeDelay is the time deleted namespaces can be restored for before they're purged.
+	PurgeDelay time.Duration
+}
+
+// Deleter runs the namespace deletions. Statuses and annotations are deleted in
+// batches, each in its own transaction, so no locks are held for long.
+type Deleter struct {
+	client     *ent.Client
+	logger     *zap.SugaredLogger
+	interval   time.Duration
+	batchSize  int
+	purgeDelay time.Duration
+}
+
+// New returns a deleter which runs the namespace deletions with the client.
+func New(client *ent.Client, logger *zap.SugaredLogger, cfg Config) *Deleter {
+	d := &Deleter{
+		client:     client,
+		logger:     logger,
+		interval:   cfg.Interval,
+		batchSize:  cfg.BatchSize,
+		purgeDelay: cfg.PurgeDelay,
+	}
+
+	if d.batchSize <= 0 {
+		d.batchSize = DefaultBatchSize
+	}
+
+	return d
+}
+
+// Run runs the pending namespace deletions every interval until the context is canceled.
+func (d *Deleter) Run(ctx context.Context) {
+	if d.interval <= 0 {
+		d.logger.Info("namespace deleter disabled")
+
+		return
+	}
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := d.RunPending(ctx)
+			if err != nil {
+				d.logger.Errorw("failed to look up namespace deletions", "error", err)
+
+				continue
+			}
+
+			if count > 0 {
+				d.logger.Infow("ran namespace deletions", "count", count)
+			}
+		}
+	}
+}
+
+// RunPending runs the deletions which are past the purge delay, or have stopped
+// making progress, and returns the number run. Deletions which fail are picked up
+// again once they're stale.
+func (d *Deleter) RunPending(ctx context.Context) (int, error) {
+	ids, err := d.client.NamespaceDeletion.Query().
+		Where(d.runnable(time.Now())).
+		Order(ent.Asc(namespacedeletion.FieldCreatedAt)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+
+	for _, id := range ids {
+		if err := d.RunDeletion(ctx, id); err != nil {
+			d.logger.Errorw("failed to run namespace deletion", "namespaceDeletionID", id, "error", err)
+
+			continue
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+// RunDeletion runs the deletion until its namespace is purged. Nothing is done
+// if the deletion isn't past the purge delay, is completed or canceled, or is
+// being run by another replica.
+func (d *Deleter) RunDeletion(ctx context.Context, id gidx.PrefixedID) error {
+	// the namespace and its statuses or annotations are soft deleted
+	ctx = softdelete.IncludeDeleted(ctx)
+
+	// a single event is published for each batch of deleted statuses or annotations
+	ctx = bulkhooks.WithBulkDelete(ctx, d.batchSize)
+
+	// claim the deletion so other replicas don't run it, and it can't be canceled
+	claimed, err := d.client.NamespaceDeletion.Update().
+		Where(namespacedeletion.ID(id), d.runnable(time.Now())).
+		SetState(namespacedeletion.StateRUNNING).
+		Save(ctx)
+	if err != nil || claimed == 0 {
+		return err
+	}
+
+	job, err := d.client.NamespaceDeletion.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	logger := d.logger.With("namespaceDeletionID", job.ID, "namespaceID", job.NamespaceID)
+
+	for {
+		done, err := d.deleteBatch(ctx, job)
+		if err != nil {
+			return fmt.Errorf("failed to delete namespace %s: %w", job.NamespaceID, err)
+		}
+
+		if done {
+			break
+		}
+	}
+
+	logger.Infow("deleted namespace")
+
+	return nil
+}
+
+// deleteBatch deletes a batch of the statuses or annotations of the namespace
+// being deleted. Once there are none left, the namespace is deleted and done is
+// returned.
+func (d *Deleter) deleteBatch(ctx context.Context, job *ent.NamespaceDeletion) (bool, error) {
+	tx, err := d.client.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	defer tx.Rollback()
+
+	var deleted int
+
+	switch job.NamespaceID.Prefix() {
+	case schema.StatusNamespacePrefix:
+		deleted, err = d.deleteStatuses(ctx, tx, job.NamespaceID)
+	case schema.AnnotationNamespacePrefix:
+		deleted, err = d.deleteAnnotations(ctx, tx, job.NamespaceID)
+	default:
+		err = fmt.Errorf("unknown namespace type %s", job.NamespaceID.Prefix())
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	update := tx.NamespaceDeletion.UpdateOneID(job.ID).AddDeletedCount(deleted)
+
+	done := deleted == 0
+
+	if done {
+		if err := d.deleteNamespace(ctx, tx, job.NamespaceID); err != nil {
+			return false, err
+		}
+
+		update.SetState(namespacedeletion.StateCOMPLETED).SetCompletedAt(time.Now())
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return false, err
+	}
+
+	return done, tx.Commit()
+}
+
+// deleteStatuses deletes a batch of the statuses in the namespace in bulk, so a
+// single event is published for the batch.
+func (d *Deleter) deleteStatuses(ctx context.Context, tx *ent.Tx, nsID gidx.PrefixedID) (int, error) {
+	ids, err := tx.Status.Query().Where(status.StatusNamespaceID(nsID)).Limit(d.batchSize).IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	return tx.Status.Delete().Where(status.IDIn(ids...)).Exec(ctx)
+}
+
+// deleteAnnotations deletes a batch of the annotations in the namespace. See deleteStatuses.
+func (d *Deleter) deleteAnnotations(ctx context.Context, tx *ent.Tx, nsID gidx.PrefixedID) (int, error) {
+	ids, err := tx.Annotation.Query().Where(annotation.AnnotationNamespaceID(nsID)).Limit(d.batchSize).IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	return tx.Annotation.Delete().Where(annotation.IDIn(ids...)).Exec(ctx)
+}
+
+func (d *Deleter) deleteNamespace(ctx context.Context, tx *ent.Tx, nsID gidx.PrefixedID) error {
+	var err error
+
+	if nsID.Prefix() == schema.StatusNamespacePrefix {
+		err = tx.StatusNamespace.DeleteOneID(nsID).Exec(ctx)
+	} else {
+		err = tx.AnnotationNamespace.DeleteOneID(nsID).Exec(ctx)
+	}
+
+	// the namespace was already deleted when a previous run stopped before completing
+	if ent.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// runnable matches the deletions which are past the purge delay, or have stopped
+// making progress at t.
+func (d *Deleter) runnable(t time.Time) predicate.NamespaceDeletion {
+	return namespacedeletion.Or(
+		namespacedeletion.And(
+			namespacedeletion.StateEQ(namespacedeletion.StatePENDING),
+			namespacedeletion.CreatedAtLTE(t.Add(-d.purgeDelay)),
+		),
+		namespacedeletion.And(
+			namespacedeletion.StateEQ(namespacedeletion.StateRUNNING),
+			namespacedeletion.UpdatedAtLT(t.Add(-staleAfter)),
+		),
+	)
+}
@@ -0,0 +1,235 @@
+package deleter_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"go.infratographer.com/x/testing/eventtools"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/metadata-api/internal/deleter"
+	"go.infratographer.com/metadata-api/internal/ent/bulkhooks"
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
+)
+
+func TestDeleterDeletesNamespacesInBatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	nats, err := eventtools.NewNatsServer()
+	require.NoError(t, err)
+
+	defer nats.Close()
+
+	conn, err := events.NewConnection(nats.Config)
+	require.NoError(t, err)
+
+	client, err := ent.Open(dialect.SQLite, "file:deleter?mode=memory&cache=shared&_fk=1", ent.EventsPublisher(conn))
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	require.NoError(t, client.Schema.Create(ctx))
+
+	bulkhooks.EventHooks(client)
+	softdelete.Interceptors(client)
+
+	stNS := client.StatusNamespace.Create().SetName("deleter-tests").SetResourceProviderID(gidx.MustNewID("rcrspro")).SetDeleting(true).SetDeletedAt(time.Now()).SaveX(ctx)
+	antNS := client.AnnotationNamespace.Create().SetName("deleter-tests").SetOwnerID(gidx.MustNewID("tnntten")).SetDeleting(true).SetDeletedAt(time.Now()).SaveX(ctx)
+	otherNS := client.StatusNamespace.Create().SetName("deleter-tests-other").SetResourceProviderID(gidx.MustNewID("rcrspro")).SaveX(ctx)
+
+	for i := 0; i < 5; i++ {
+		md := client.Metadata.Create().SetNodeID(gidx.MustNewID("loadbal")).SaveX(ctx)
+		client.Status.Create().SetMetadata(md).SetNamespace(stNS).SetSource("deleter-tests").SetData(json.RawMessage(`{"a":1}`)).SaveX(ctx)
+		client.Annotation.Create().SetMetadata(md).SetNamespace(antNS).SetData(json.RawMessage(`{"b":2}`)).SaveX(ctx)
+		client.Status.Create().SetMetadata(md).SetNamespace(otherNS).SetSource("deleter-tests").SetData(json.RawMessage(`{"c":3}`)).SaveX(ctx)
+	}
+
+	stJob := client.NamespaceDeletion.Create().SetNamespaceID(stNS.ID).SetOwnerID(stNS.ResourceProviderID).SetTotalCount(5).SaveX(ctx)
+	antJob := client.NamespaceDeletion.Create().SetNamespaceID(antNS.ID).SetOwnerID(antNS.OwnerID).SetTotalCount(5).SaveX(ctx)
+
+	bulkDeletes, err := conn.SubscribeChanges(ctx, bulkhooks.EventTypeBulkDelete+".status-namespace")
+	require.NoError(t, err)
+
+	count, err := deleter.New(client, zap.NewNop().Sugar(), deleter.Config{BatchSize: 2}).RunPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	for _, id := range []gidx.PrefixedID{stJob.ID, antJob.ID} {
+		job := client.NamespaceDeletion.GetX(ctx, id)
+		assert.Equal(t, namespacedeletion.StateCOMPLETED, job.State)
+		assert.Equal(t, 5, job.DeletedCount)
+		assert.NotNil(t, job.CompletedAt)
+	}
+
+	// the soft deleted records are gone, not just hidden
+	deletedCtx := softdelete.IncludeDeleted(ctx)
+
+	assert.Equal(t, 0, client.Status.Query().Where(status.StatusNamespaceID(stNS.ID)).CountX(deletedCtx))
+	assert.Equal(t, 0, client.Annotation.Query().Where(annotation.AnnotationNamespaceID(antNS.ID)).CountX(deletedCtx))
+	assert.Equal(t, 5, client.Status.Query().Where(status.StatusNamespaceID(otherNS.ID)).CountX(deletedCtx))
+
+	_, err = client.StatusNamespace.Get(deletedCtx, stNS.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	_, err = client.AnnotationNamespace.Get(deletedCtx, antNS.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	// a single event is published for each batch of deleted statuses
+	deleted := []gidx.PrefixedID{}
+
+	for _, size := range []int{2, 2, 1} {
+		select {
+		case msg := <-bulkDeletes:
+			require.NoError(t, msg.Error())
+			assert.Equal(t, stNS.ID, msg.Message().SubjectID)
+			assert.Len(t, msg.Message().AdditionalSubjectIDs, size)
+
+			deleted = append(deleted, msg.Message().AdditionalSubjectIDs...)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the bulk delete event")
+		}
+	}
+
+	assert.Len(t, deleted, 5)
+
+	// bulk deletes made elsewhere don't drop the events of the deleted records
+	_, err = client.Status.Delete().Where(status.StatusNamespaceID(otherNS.ID)).Exec(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, 5, client.Status.Query().Where(status.StatusNamespaceID(otherNS.ID)).CountX(ctx))
+
+	// the deleted records of a bulk delete are split into events of the chunk size
+	_, err = client.Status.Delete().Where(status.StatusNamespaceID(otherNS.ID)).Exec(bulkhooks.WithBulkDelete(ctx, 2))
+	require.NoError(t, err)
+
+	deleted = []gidx.PrefixedID{}
+
+	for _, size := range []int{2, 2, 1} {
+		select {
+		case msg := <-bulkDeletes:
+			require.NoError(t, msg.Error())
+			assert.Equal(t, otherNS.ID, msg.Message().SubjectID)
+			assert.Len(t, msg.Message().AdditionalSubjectIDs, size)
+
+			deleted = append(deleted, msg.Message().AdditionalSubjectIDs...)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the bulk delete event")
+		}
+	}
+
+	assert.Len(t, deleted, 5)
+
+	// completed deletions aren't run again
+	count, err = deleter.New(client, zap.NewNop().Sugar(), deleter.Config{}).RunPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestDeleterSkipsRunningDeletions(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := ent.Open(dialect.SQLite, "file:deleter-running?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	require.NoError(t, client.Schema.Create(ctx))
+
+	running := client.StatusNamespace.Create().SetName("deleter-running").SetResourceProviderID(gidx.MustNewID("rcrspro")).SetDeleting(true).SaveX(ctx)
+	stopped := client.StatusNamespace.Create().SetName("deleter-stopped").SetResourceProviderID(gidx.MustNewID("rcrspro")).SetDeleting(true).SaveX(ctx)
+
+	// the deletion is being run by another replica
+	runningJob := client.NamespaceDeletion.Create().
+		SetNamespaceID(running.ID).
+		SetOwnerID(running.ResourceProviderID).
+		SetTotalCount(0).
+		SetState(namespacedeletion.StateRUNNING).
+		SaveX(ctx)
+
+	// the replica running the deletion stopped, so it's picked up once it's stale
+	stoppedJob := client.NamespaceDeletion.Create().
+		SetNamespaceID(stopped.ID).
+		SetOwnerID(stopped.ResourceProviderID).
+		SetTotalCount(0).
+		SetState(namespacedeletion.StateRUNNING).
+		SetUpdatedAt(time.Now().Add(-time.Hour)).
+		SaveX(ctx)
+
+	count, err := deleter.New(client, zap.NewNop().Sugar(), deleter.Config{}).RunPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	assert.Equal(t, namespacedeletion.StateRUNNING, client.NamespaceDeletion.GetX(ctx, runningJob.ID).State)
+	assert.True(t, client.StatusNamespace.Query().Where(statusnamespace.ID(running.ID)).ExistX(ctx))
+
+	assert.Equal(t, namespacedeletion.StateCOMPLETED, client.NamespaceDeletion.GetX(ctx, stoppedJob.ID).State)
+	assert.False(t, client.StatusNamespace.Query().Where(statusnamespace.ID(stopped.ID)).ExistX(ctx))
+}
+
+func TestDeleterWaitsForPurgeDelay(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := ent.Open(dialect.SQLite, "file:deleter-delay?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	require.NoError(t, client.Schema.Create(ctx))
+
+	softdelete.Interceptors(client)
+
+	recent := client.StatusNamespace.Create().SetName("deleter-recent").SetResourceProviderID(gidx.MustNewID("rcrspro")).SetDeleting(true).SetDeletedAt(time.Now()).SaveX(ctx)
+	expired := client.StatusNamespace.Create().SetName("deleter-expired").SetResourceProviderID(gidx.MustNewID("rcrspro")).SetDeleting(true).SetDeletedAt(time.Now().Add(-2 * time.Hour)).SaveX(ctx)
+
+	recentJob := client.NamespaceDeletion.Create().
+		SetNamespaceID(recent.ID).
+		SetOwnerID(recent.ResourceProviderID).
+		SetTotalCount(0).
+		SaveX(ctx)
+
+	expiredJob := client.NamespaceDeletion.Create().
+		SetNamespaceID(expired.ID).
+		SetOwnerID(expired.ResourceProviderID).
+		SetTotalCount(0).
+		SetCreatedAt(time.Now().Add(-2 * time.Hour)).
+		SaveX(ctx)
+
+	d := deleter.New(client, zap.NewNop().Sugar(), deleter.Config{PurgeDelay: time.Hour})
+
+	count, err := d.RunPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	deletedCtx := softdelete.IncludeDeleted(ctx)
+
+	assert.Equal(t, namespacedeletion.StatePENDING, client.NamespaceDeletion.GetX(ctx, recentJob.ID).State)
+	assert.True(t, client.StatusNamespace.Query().Where(statusnamespace.ID(recent.ID)).ExistX(deletedCtx))
+
+	assert.Equal(t, namespacedeletion.StateCOMPLETED, client.NamespaceDeletion.GetX(ctx, expiredJob.ID).State)
+	assert.False(t, client.StatusNamespace.Query().Where(statusnamespace.ID(expired.ID)).ExistX(deletedCtx))
+
+	// deletions within the purge delay can't be run directly either
+	require.NoError(t, d.RunDeletion(ctx, recentJob.ID))
+	assert.Equal(t, namespacedeletion.StatePENDING, client.NamespaceDeletion.GetX(ctx, recentJob.ID).State)
+}
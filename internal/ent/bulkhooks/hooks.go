@@ -0,0 +1,215 @@
+// Package bulkhooks provides ent hooks which publish a single event for the
+// statuses and annotations of a namespace that are deleted in bulk.
+package bulkhooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/eventhooks"
+	"go.infratographer.com/metadata-api/internal/ent/generated/hook"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+)
+
+// EventTypeBulkDelete is the event type of the events published for the statuses
+// or annotations of a namespace that are deleted in bulk. The subject of the
+// event is the namespace, and the deleted records are its additional subjects.
+const EventTypeBulkDelete = "bulk-delete"
+
+// DefaultChunkSize is the default number of deleted records in each bulk delete event.
+const DefaultChunkSize = 500
+
+type bulkDeleteCtxKey struct{}
+
+// WithBulkDelete returns a copy of the context in which statuses and annotations
+// deleted in bulk publish bulk delete events for their namespaces, instead of an
+// event for each record. Each event has at most chunkSize deleted records, more
+// events are published for larger deletes.
+func WithBulkDelete(ctx context.Context, chunkSize int) context.Context {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+
+	return context.WithValue(ctx, bulkDeleteCtxKey{}, chunkSize)
+}
+
+// bulkDeleteChunkSize returns the chunk size of the bulk deletes made with the
+// context, or false if it isn't from WithBulkDelete.
+func bulkDeleteChunkSize(ctx context.Context) (int, bool) {
+	size, ok := ctx.Value(bulkDeleteCtxKey{}).(int)
+
+	return size, ok
+}
+
+// EventHooks registers the generated event hooks on the client, along with the
+// bulk delete hooks. The generated delete hooks of statuses and annotations need
+// the ID of the single record deleted, so they're skipped for the bulk deletes
+// made with a context from WithBulkDelete. Other bulk deletes of statuses and
+// annotations fail, rather than being deleted without their events.
+//
+// The generated update hooks of namespaces can't report a deleted_at which wasn't
+// set before, so they're skipped when a namespace is soft deleted. Its delete
+// event is published once it's purged instead.
+func EventHooks(c *generated.Client) {
+	c.Annotation.Use(skipBulkDeletes(eventhooks.AnnotationHooks())...)
+	c.Annotation.Use(AnnotationHooks()...)
+
+	c.AnnotationNamespace.Use(skipSoftDeletes(eventhooks.AnnotationNamespaceHooks())...)
+
+	c.Metadata.Use(eventhooks.MetadataHooks()...)
+
+	c.Status.Use(skipBulkDeletes(eventhooks.StatusHooks())...)
+	c.Status.Use(StatusHooks()...)
+
+	c.StatusNamespace.Use(skipSoftDeletes(eventhooks.StatusNamespaceHooks())...)
+}
+
+// StatusHooks returns the hooks which publish bulk delete events for each status
+// namespace that statuses are deleted from in bulk. See WithBulkDelete.
+func StatusHooks() []ent.Hook {
+	return []ent.Hook{
+		hook.If(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.StatusFunc(func(ctx context.Context, m *generated.StatusMutation) (ent.Value, error) {
+					ids, err := m.IDs(ctx)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get mutated ids: %w", err)
+					}
+
+					// deleted statuses must be loaded before they are gone
+					deleted, err := m.Client().Status.Query().
+						Where(status.IDIn(ids...)).
+						Select(status.FieldID, status.FieldStatusNamespaceID).
+						All(ctx)
+					if err != nil {
+						return nil, err
+					}
+
+					retValue, err := next.Mutate(ctx, m)
+					if err != nil {
+						return retValue, err
+					}
+
+					namespaces := make(map[gidx.PrefixedID][]gidx.PrefixedID)
+
+					for _, st := range deleted {
+						namespaces[st.StatusNamespaceID] = append(namespaces[st.StatusNamespaceID], st.ID)
+					}
+
+					if err := publishBulkDeletes(ctx, m.EventsPublisher, "status-namespace", namespaces); err != nil {
+						return nil, err
+					}
+
+					return retValue, nil
+				})
+			},
+			isBulkDelete,
+		),
+	}
+}
+
+// AnnotationHooks returns the hooks which publish bulk delete events for each
+// annotation namespace that annotations are deleted from in bulk. See WithBulkDelete.
+func AnnotationHooks() []ent.Hook {
+	return []ent.Hook{
+		hook.If(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.AnnotationFunc(func(ctx context.Context, m *generated.AnnotationMutation) (ent.Value, error) {
+					ids, err := m.IDs(ctx)
+					if err != nil {
+						return nil, fmt.Errorf("failed to get mutated ids: %w", err)
+					}
+
+					// deleted annotations must be loaded before they are gone
+					deleted, err := m.Client().Annotation.Query().
+						Where(annotation.IDIn(ids...)).
+						Select(annotation.FieldID, annotation.FieldAnnotationNamespaceID).
+						All(ctx)
+					if err != nil {
+						return nil, err
+					}
+
+					retValue, err := next.Mutate(ctx, m)
+					if err != nil {
+						return retValue, err
+					}
+
+					namespaces := make(map[gidx.PrefixedID][]gidx.PrefixedID)
+
+					for _, ant := range deleted {
+						namespaces[ant.AnnotationNamespaceID] = append(namespaces[ant.AnnotationNamespaceID], ant.ID)
+					}
+
+					if err := publishBulkDeletes(ctx, m.EventsPublisher, "annotation-namespace", namespaces); err != nil {
+						return nil, err
+					}
+
+					return retValue, nil
+				})
+			},
+			isBulkDelete,
+		),
+	}
+}
+
+// isBulkDelete matches the bulk deletes made with a context from WithBulkDelete.
+func isBulkDelete(ctx context.Context, m ent.Mutation) bool {
+	_, ok := bulkDeleteChunkSize(ctx)
+
+	return ok && m.Op().Is(ent.OpDelete)
+}
+
+// skipBulkDeletes wraps the hooks so they don't run for the bulk deletes made
+// with a context from WithBulkDelete.
+func skipBulkDeletes(hooks []ent.Hook) []ent.Hook {
+	wrapped := make([]ent.Hook, len(hooks))
+
+	for i, h := range hooks {
+		wrapped[i] = hook.If(h, hook.Not(isBulkDelete))
+	}
+
+	return wrapped
+}
+
+// skipSoftDeletes wraps the hooks so they don't run for soft deletes.
+func skipSoftDeletes(hooks []ent.Hook) []ent.Hook {
+	wrapped := make([]ent.Hook, len(hooks))
+
+	for i, h := range hooks {
+		wrapped[i] = hook.If(h, hook.Not(hook.HasFields("deleted_at")))
+	}
+
+	return wrapped
+}
+
+// publishBulkDeletes publishes the bulk delete events of the namespaces, with the
+// records deleted from each split into chunks.
+func publishBulkDeletes(ctx context.Context, publisher events.Connection, subjectType string, namespaces map[gidx.PrefixedID][]gidx.PrefixedID) error {
+	chunkSize, _ := bulkDeleteChunkSize(ctx)
+
+	for nsID, ids := range namespaces {
+		for start := 0; start < len(ids); start += chunkSize {
+			end := min(start+chunkSize, len(ids))
+
+			msg := events.ChangeMessage{
+				EventType:            EventTypeBulkDelete,
+				SubjectID:            nsID,
+				AdditionalSubjectIDs: ids[start:end],
+				Timestamp:            time.Now().UTC(),
+			}
+
+			if _, err := publisher.PublishChange(ctx, subjectType, msg); err != nil {
+				return fmt.Errorf("failed to publish change: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+// Package changehooks provides ent hooks which publish the changes made to
+// statuses and annotations to the subscribers of the graph.
+package changehooks
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/hook"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/pubsub"
+)
+
+// ChangeHooks registers the change hooks on the client.
+func ChangeHooks(c *generated.Client, b *pubsub.Broker) {
+	c.Annotation.Use(AnnotationHooks(b)...)
+
+	c.Status.Use(StatusHooks(b)...)
+}
+
+// StatusHooks returns the hooks which publish a change for each status that is
+// created, updated or deleted.
+func StatusHooks(b *pubsub.Broker) []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return hook.StatusFunc(func(ctx context.Context, m *generated.StatusMutation) (ent.Value, error) {
+				ids, err := mutatedIDs(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+
+				load := func() ([]*generated.Status, error) {
+					return m.Client().Status.Query().Where(status.IDIn(ids...)).WithMetadata().All(ctx)
+				}
+
+				// deleted statuses must be loaded before they are gone
+				var deleted []*generated.Status
+
+				if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					if deleted, err = load(); err != nil {
+						return nil, err
+					}
+				}
+
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				changed := deleted
+
+				if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					if st, ok := retValue.(*generated.Status); ok {
+						ids = []gidx.PrefixedID{st.ID}
+					}
+
+					if changed, err = load(); err != nil {
+						return nil, err
+					}
+				}
+
+				changes := make([]pubsub.Change, 0, len(changed))
+
+				for _, st := range changed {
+					changes = append(changes, pubsub.Change{
+						Operation:   operation(m.Op()),
+						ID:          st.ID,
+						NodeID:      st.Edges.Metadata.NodeID,
+						NamespaceID: st.StatusNamespaceID,
+					})
+				}
+
+				publishOnCommit(m, b, pubsub.TopicStatus, changes)
+
+				return retValue, nil
+			})
+		},
+	}
+}
+
+// AnnotationHooks returns the hooks which publish a change for each annotation
+// that is created, updated or deleted.
+func AnnotationHooks(b *pubsub.Broker) []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return hook.AnnotationFunc(func(ctx context.Context, m *generated.AnnotationMutation) (ent.Value, error) {
+				ids, err := mutatedIDs(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+
+				load := func() ([]*generated.Annotation, error) {
+					return m.Client().Annotation.Query().Where(annotation.IDIn(ids...)).WithMetadata().All(ctx)
+				}
+
+				// deleted annotations must be loaded before they are gone
+				var deleted []*generated.Annotation
+
+				if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					if deleted, err = load(); err != nil {
+						return nil, err
+					}
+				}
+
+				retValue, err := next.Mutate(ctx, m)
+				if err != nil {
+					return retValue, err
+				}
+
+				changed := deleted
+
+				if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					if ant, ok := retValue.(*generated.Annotation); ok {
+						ids = []gidx.PrefixedID{ant.ID}
+					}
+
+					if changed, err = load(); err != nil {
+						return nil, err
+					}
+				}
+
+				changes := make([]pubsub.Change, 0, len(changed))
+
+				for _, ant := range changed {
+					changes = append(changes, pubsub.Change{
+						Operation:   operation(m.Op()),
+						ID:          ant.ID,
+						NodeID:      ant.Edges.Metadata.NodeID,
+						NamespaceID: ant.AnnotationNamespaceID,
+					})
+				}
+
+				publishOnCommit(m, b, pubsub.TopicAnnotation, changes)
+
+				return retValue, nil
+			})
+		},
+	}
+}
+
+type mutation interface {
+	Op() ent.Op
+	IDs(ctx context.Context) ([]gidx.PrefixedID, error)
+	Tx() (*generated.Tx, error)
+}
+
+// mutatedIDs returns the ids of the records changed by an update or delete. The
+// ids of created records are only known once they are saved.
+func mutatedIDs(ctx context.Context, m mutation) ([]gidx.PrefixedID, error) {
+	if m.Op().Is(ent.OpCreate) {
+		return nil, nil
+	}
+
+	ids, err := m.IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mutated ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// publishOnCommit publishes the changes once the transaction of the mutation is
+// committed, so subscribers never see changes that were rolled back. Changes made
+// outside of a transaction are published right away.
+func publishOnCommit(m mutation, b *pubsub.Broker, topic string, changes []pubsub.Change) {
+	publish := func() {
+		for _, change := range changes {
+			b.Publish(topic, change)
+		}
+	}
+
+	tx, err := m.Tx()
+	if err != nil {
+		publish()
+
+		return
+	}
+
+	tx.OnCommit(func(next generated.Committer) generated.Committer {
+		return generated.CommitFunc(func(ctx context.Context, tx *generated.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+
+			publish()
+
+			return nil
+		})
+	})
+}
+
+func operation(op ent.Op) pubsub.Operation {
+	switch {
+	case op.Is(ent.OpCreate):
+		return pubsub.OperationCreate
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		return pubsub.OperationDelete
+	default:
+		return pubsub.OperationUpdate
+	}
+}
@@ -17,12 +17,14 @@
 package main
 
 import (
+	"fmt"
 	"log"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema/field"
+	"github.com/vektah/gqlparser/v2/ast"
 	"go.infratographer.com/x/entx"
 )
 
@@ -43,7 +45,7 @@ func main() {
 		entgql.WithSchemaPath("schema/ent.graphql"),
 		entgql.WithConfigPath("gqlgen.yml"),
 		entgql.WithWhereInputs(true),
-		entgql.WithSchemaHook(xExt.GQLSchemaHooks()...),
+		entgql.WithSchemaHook(append(xExt.GQLSchemaHooks(), asOfSchemaHook)...),
 	)
 	if err != nil {
 		log.Fatalf("creating entgql extension: %v", err)
@@ -74,3 +76,35 @@ func main() {
 		log.Fatalf("running ent codegen: %v", err)
 	}
 }
+
+// asOfSchemaHook adds an asOf argument to the annotations and statuses connections
+// of Metadata so past state can be read. The connections are resolved by a custom
+// resolver since the generated edge resolvers don't know about the argument.
+func asOfSchemaHook(_ *gen.Graph, s *ast.Schema) error {
+	md, ok := s.Types["Metadata"]
+	if !ok {
+		return fmt.Errorf("asOf schema hook: Metadata type not found")
+	}
+
+	for _, name := range []string{"annotations", "statuses"} {
+		f := md.Fields.ForName(name)
+		if f == nil {
+			return fmt.Errorf("asOf schema hook: Metadata.%s field not found", name)
+		}
+
+		f.Arguments = append(f.Arguments, &ast.ArgumentDefinition{
+			Name:        "asOf",
+			Description: "Returns the " + name + " as they were at the given time.",
+			Type:        ast.NamedType("Time", nil),
+		})
+
+		f.Directives = append(f.Directives, &ast.Directive{
+			Name: "goField",
+			Arguments: ast.ArgumentList{
+				{Name: "forceResolver", Value: &ast.Value{Raw: "true", Kind: ast.BooleanValue}},
+			},
+		})
+	}
+
+	return nil
+}
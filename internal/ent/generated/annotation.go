@@ -46,6 +46,8 @@ type Annotation struct {
 	AnnotationNamespaceID gidx.PrefixedID `json:"annotation_namespace_id,omitempty"`
 	// JSON formatted data of this annotation.
 	Data json.RawMessage `json:"data,omitempty"`
+	// Version of the annotation, incremented each time its data is set.
+	Version int64 `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AnnotationQuery when eager-loading is set.
 	Edges        AnnotationEdges `json:"edges"`
@@ -100,6 +102,8 @@ func (*Annotation) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case annotation.FieldID, annotation.FieldMetadataID, annotation.FieldAnnotationNamespaceID:
 			values[i] = new(gidx.PrefixedID)
+		case annotation.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case annotation.FieldCreatedAt, annotation.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
@@ -155,6 +159,12 @@ func (a *Annotation) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field data: %w", err)
 				}
 			}
+		case annotation.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				a.Version = value.Int64
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -215,6 +225,9 @@ func (a *Annotation) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("data=")
 	builder.WriteString(fmt.Sprintf("%v", a.Data))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", a.Version))
 	builder.WriteByte(')')
 	return builder.String()
 }
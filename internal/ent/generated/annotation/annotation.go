@@ -39,6 +39,8 @@ const (
 	FieldAnnotationNamespaceID = "annotation_namespace_id"
 	// FieldData holds the string denoting the data field in the database.
 	FieldData = "json_data"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// EdgeNamespace holds the string denoting the namespace edge name in mutations.
 	EdgeNamespace = "namespace"
 	// EdgeMetadata holds the string denoting the metadata edge name in mutations.
@@ -69,6 +71,7 @@ var Columns = []string{
 	FieldMetadataID,
 	FieldAnnotationNamespaceID,
 	FieldData,
+	FieldVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -90,6 +93,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// AnnotationNamespaceIDValidator is a validator for the "annotation_namespace_id" field. It is called by the builders before save.
 	AnnotationNamespaceIDValidator func(string) error
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int64
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() gidx.PrefixedID
 )
@@ -122,6 +127,11 @@ func ByAnnotationNamespaceID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAnnotationNamespaceID, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
 // ByNamespaceField orders the results by namespace field.
 func ByNamespaceField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
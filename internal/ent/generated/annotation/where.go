@@ -90,6 +90,11 @@ func AnnotationNamespaceID(v gidx.PrefixedID) predicate.Annotation {
 	return predicate.Annotation(sql.FieldEQ(FieldAnnotationNamespaceID, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int64) predicate.Annotation {
+	return predicate.Annotation(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Annotation {
 	return predicate.Annotation(sql.FieldEQ(FieldCreatedAt, v))
@@ -310,6 +315,46 @@ func AnnotationNamespaceIDContainsFold(v gidx.PrefixedID) predicate.Annotation {
 	return predicate.Annotation(sql.FieldContainsFold(FieldAnnotationNamespaceID, vc))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int64) predicate.Annotation {
+	return predicate.Annotation(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int64) predicate.Annotation {
+	return predicate.Annotation(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int64) predicate.Annotation {
+	return predicate.Annotation(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int64) predicate.Annotation {
+	return predicate.Annotation(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int64) predicate.Annotation {
+	return predicate.Annotation(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int64) predicate.Annotation {
+	return predicate.Annotation(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int64) predicate.Annotation {
+	return predicate.Annotation(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int64) predicate.Annotation {
+	return predicate.Annotation(sql.FieldLTE(FieldVersion, v))
+}
+
 // HasNamespace applies the HasEdge predicate on the "namespace" edge.
 func HasNamespace() predicate.Annotation {
 	return predicate.Annotation(func(s *sql.Selector) {
@@ -84,6 +84,20 @@ func (ac *AnnotationCreate) SetData(jm json.RawMessage) *AnnotationCreate {
 	return ac
 }
 
+// SetVersion sets the "version" field.
+func (ac *AnnotationCreate) SetVersion(i int64) *AnnotationCreate {
+	ac.mutation.SetVersion(i)
+	return ac
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (ac *AnnotationCreate) SetNillableVersion(i *int64) *AnnotationCreate {
+	if i != nil {
+		ac.SetVersion(*i)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *AnnotationCreate) SetID(gi gidx.PrefixedID) *AnnotationCreate {
 	ac.mutation.SetID(gi)
@@ -157,6 +171,10 @@ func (ac *AnnotationCreate) defaults() {
 		v := annotation.DefaultUpdatedAt()
 		ac.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := ac.mutation.Version(); !ok {
+		v := annotation.DefaultVersion
+		ac.mutation.SetVersion(v)
+	}
 	if _, ok := ac.mutation.ID(); !ok {
 		v := annotation.DefaultID()
 		ac.mutation.SetID(v)
@@ -185,6 +203,9 @@ func (ac *AnnotationCreate) check() error {
 	if _, ok := ac.mutation.Data(); !ok {
 		return &ValidationError{Name: "data", err: errors.New(`generated: missing required field "Annotation.data"`)}
 	}
+	if _, ok := ac.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "Annotation.version"`)}
+	}
 	if _, ok := ac.mutation.NamespaceID(); !ok {
 		return &ValidationError{Name: "namespace", err: errors.New(`generated: missing required edge "Annotation.namespace"`)}
 	}
@@ -238,6 +259,10 @@ func (ac *AnnotationCreate) createSpec() (*Annotation, *sqlgraph.CreateSpec) {
 		_spec.SetField(annotation.FieldData, field.TypeJSON, value)
 		_node.Data = value
 	}
+	if value, ok := ac.mutation.Version(); ok {
+		_spec.SetField(annotation.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
 	if nodes := ac.mutation.NamespaceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -55,6 +55,27 @@ func (au *AnnotationUpdate) AppendData(jm json.RawMessage) *AnnotationUpdate {
 	return au
 }
 
+// SetVersion sets the "version" field.
+func (au *AnnotationUpdate) SetVersion(i int64) *AnnotationUpdate {
+	au.mutation.ResetVersion()
+	au.mutation.SetVersion(i)
+	return au
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (au *AnnotationUpdate) SetNillableVersion(i *int64) *AnnotationUpdate {
+	if i != nil {
+		au.SetVersion(*i)
+	}
+	return au
+}
+
+// AddVersion adds i to the "version" field.
+func (au *AnnotationUpdate) AddVersion(i int64) *AnnotationUpdate {
+	au.mutation.AddVersion(i)
+	return au
+}
+
 // Mutation returns the AnnotationMutation object of the builder.
 func (au *AnnotationUpdate) Mutation() *AnnotationMutation {
 	return au.mutation
@@ -130,6 +151,12 @@ func (au *AnnotationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, annotation.FieldData, value)
 		})
 	}
+	if value, ok := au.mutation.Version(); ok {
+		_spec.SetField(annotation.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.AddedVersion(); ok {
+		_spec.AddField(annotation.FieldVersion, field.TypeInt64, value)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{annotation.Label}
@@ -162,6 +189,27 @@ func (auo *AnnotationUpdateOne) AppendData(jm json.RawMessage) *AnnotationUpdate
 	return auo
 }
 
+// SetVersion sets the "version" field.
+func (auo *AnnotationUpdateOne) SetVersion(i int64) *AnnotationUpdateOne {
+	auo.mutation.ResetVersion()
+	auo.mutation.SetVersion(i)
+	return auo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (auo *AnnotationUpdateOne) SetNillableVersion(i *int64) *AnnotationUpdateOne {
+	if i != nil {
+		auo.SetVersion(*i)
+	}
+	return auo
+}
+
+// AddVersion adds i to the "version" field.
+func (auo *AnnotationUpdateOne) AddVersion(i int64) *AnnotationUpdateOne {
+	auo.mutation.AddVersion(i)
+	return auo
+}
+
 // Mutation returns the AnnotationMutation object of the builder.
 func (auo *AnnotationUpdateOne) Mutation() *AnnotationMutation {
 	return auo.mutation
@@ -267,6 +315,12 @@ func (auo *AnnotationUpdateOne) sqlSave(ctx context.Context) (_node *Annotation,
 			sqljson.Append(u, annotation.FieldData, value)
 		})
 	}
+	if value, ok := auo.mutation.Version(); ok {
+		_spec.SetField(annotation.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.AddedVersion(); ok {
+		_spec.AddField(annotation.FieldVersion, field.TypeInt64, value)
+	}
 	_node = &Annotation{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,236 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
+	"go.infratographer.com/x/gidx"
+)
+
+// AnnotationHistory is the model entity for the AnnotationHistory schema.
+type AnnotationHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID gidx.PrefixedID `json:"id,omitempty"`
+	// Time the change to the annotation was made.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ID of the annotation that was changed.
+	AnnotationID gidx.PrefixedID `json:"annotation_id,omitempty"`
+	// ID of the metadata of the annotation.
+	MetadataID gidx.PrefixedID `json:"metadata_id,omitempty"`
+	// ID of the annotation namespace of the annotation.
+	AnnotationNamespaceID gidx.PrefixedID `json:"annotation_namespace_id,omitempty"`
+	// The kind of change made to the annotation.
+	Operation annotationhistory.Operation `json:"operation,omitempty"`
+	// JSON formatted data of the annotation before the change. Empty when the annotation was created.
+	Data json.RawMessage `json:"data,omitempty"`
+	// The subject that made the change, if known.
+	Actor string `json:"actor,omitempty"`
+	// Time the annotation was created. Empty when the annotation was created by this change.
+	AnnotationCreatedAt *time.Time `json:"annotation_created_at,omitempty"`
+	// Time the annotation was last updated before this change. Empty when the annotation was created by this change.
+	AnnotationUpdatedAt *time.Time `json:"annotation_updated_at,omitempty"`
+	// Version of the annotation before this change.
+	AnnotationVersion int64 `json:"annotation_version,omitempty"`
+	selectValues      sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AnnotationHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case annotationhistory.FieldData:
+			values[i] = new([]byte)
+		case annotationhistory.FieldID, annotationhistory.FieldAnnotationID, annotationhistory.FieldMetadataID, annotationhistory.FieldAnnotationNamespaceID:
+			values[i] = new(gidx.PrefixedID)
+		case annotationhistory.FieldAnnotationVersion:
+			values[i] = new(sql.NullInt64)
+		case annotationhistory.FieldOperation, annotationhistory.FieldActor:
+			values[i] = new(sql.NullString)
+		case annotationhistory.FieldCreatedAt, annotationhistory.FieldAnnotationCreatedAt, annotationhistory.FieldAnnotationUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AnnotationHistory fields.
+func (ah *AnnotationHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case annotationhistory.FieldID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ah.ID = *value
+			}
+		case annotationhistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ah.CreatedAt = value.Time
+			}
+		case annotationhistory.FieldAnnotationID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field annotation_id", values[i])
+			} else if value != nil {
+				ah.AnnotationID = *value
+			}
+		case annotationhistory.FieldMetadataID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata_id", values[i])
+			} else if value != nil {
+				ah.MetadataID = *value
+			}
+		case annotationhistory.FieldAnnotationNamespaceID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field annotation_namespace_id", values[i])
+			} else if value != nil {
+				ah.AnnotationNamespaceID = *value
+			}
+		case annotationhistory.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				ah.Operation = annotationhistory.Operation(value.String)
+			}
+		case annotationhistory.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ah.Data); err != nil {
+					return fmt.Errorf("unmarshal field data: %w", err)
+				}
+			}
+		case annotationhistory.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				ah.Actor = value.String
+			}
+		case annotationhistory.FieldAnnotationCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field annotation_created_at", values[i])
+			} else if value.Valid {
+				ah.AnnotationCreatedAt = new(time.Time)
+				*ah.AnnotationCreatedAt = value.Time
+			}
+		case annotationhistory.FieldAnnotationUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field annotation_updated_at", values[i])
+			} else if value.Valid {
+				ah.AnnotationUpdatedAt = new(time.Time)
+				*ah.AnnotationUpdatedAt = value.Time
+			}
+		case annotationhistory.FieldAnnotationVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field annotation_version", values[i])
+			} else if value.Valid {
+				ah.AnnotationVersion = value.Int64
+			}
+		default:
+			ah.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AnnotationHistory.
+// This includes values selected through modifiers, order, etc.
+func (ah *AnnotationHistory) Value(name string) (ent.Value, error) {
+	return ah.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AnnotationHistory.
+// Note that you need to call AnnotationHistory.Unwrap() before calling this method if this AnnotationHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ah *AnnotationHistory) Update() *AnnotationHistoryUpdateOne {
+	return NewAnnotationHistoryClient(ah.config).UpdateOne(ah)
+}
+
+// Unwrap unwraps the AnnotationHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ah *AnnotationHistory) Unwrap() *AnnotationHistory {
+	_tx, ok := ah.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: AnnotationHistory is not a transactional entity")
+	}
+	ah.config.driver = _tx.drv
+	return ah
+}
+
+// String implements the fmt.Stringer.
+func (ah *AnnotationHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("AnnotationHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ah.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ah.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("annotation_id=")
+	builder.WriteString(fmt.Sprintf("%v", ah.AnnotationID))
+	builder.WriteString(", ")
+	builder.WriteString("metadata_id=")
+	builder.WriteString(fmt.Sprintf("%v", ah.MetadataID))
+	builder.WriteString(", ")
+	builder.WriteString("annotation_namespace_id=")
+	builder.WriteString(fmt.Sprintf("%v", ah.AnnotationNamespaceID))
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", ah.Operation))
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", ah.Data))
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(ah.Actor)
+	builder.WriteString(", ")
+	if v := ah.AnnotationCreatedAt; v != nil {
+		builder.WriteString("annotation_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ah.AnnotationUpdatedAt; v != nil {
+		builder.WriteString("annotation_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("annotation_version=")
+	builder.WriteString(fmt.Sprintf("%v", ah.AnnotationVersion))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IsEntity implement fedruntime.Entity
+func (ah AnnotationHistory) IsEntity() {}
+
+// AnnotationHistories is a parsable slice of AnnotationHistory.
+type AnnotationHistories []*AnnotationHistory
@@ -0,0 +1,191 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package annotationhistory
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/x/gidx"
+)
+
+const (
+	// Label holds the string label denoting the annotationhistory type in the database.
+	Label = "annotation_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldAnnotationID holds the string denoting the annotation_id field in the database.
+	FieldAnnotationID = "annotation_id"
+	// FieldMetadataID holds the string denoting the metadata_id field in the database.
+	FieldMetadataID = "metadata_id"
+	// FieldAnnotationNamespaceID holds the string denoting the annotation_namespace_id field in the database.
+	FieldAnnotationNamespaceID = "annotation_namespace_id"
+	// FieldOperation holds the string denoting the operation field in the database.
+	FieldOperation = "operation"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "json_data"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldAnnotationCreatedAt holds the string denoting the annotation_created_at field in the database.
+	FieldAnnotationCreatedAt = "annotation_created_at"
+	// FieldAnnotationUpdatedAt holds the string denoting the annotation_updated_at field in the database.
+	FieldAnnotationUpdatedAt = "annotation_updated_at"
+	// FieldAnnotationVersion holds the string denoting the annotation_version field in the database.
+	FieldAnnotationVersion = "annotation_version"
+	// Table holds the table name of the annotationhistory in the database.
+	Table = "annotation_histories"
+)
+
+// Columns holds all SQL columns for annotationhistory fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldAnnotationID,
+	FieldMetadataID,
+	FieldAnnotationNamespaceID,
+	FieldOperation,
+	FieldData,
+	FieldActor,
+	FieldAnnotationCreatedAt,
+	FieldAnnotationUpdatedAt,
+	FieldAnnotationVersion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// AnnotationIDValidator is a validator for the "annotation_id" field. It is called by the builders before save.
+	AnnotationIDValidator func(string) error
+	// MetadataIDValidator is a validator for the "metadata_id" field. It is called by the builders before save.
+	MetadataIDValidator func(string) error
+	// AnnotationNamespaceIDValidator is a validator for the "annotation_namespace_id" field. It is called by the builders before save.
+	AnnotationNamespaceIDValidator func(string) error
+	// DefaultAnnotationVersion holds the default value on creation for the "annotation_version" field.
+	DefaultAnnotationVersion int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() gidx.PrefixedID
+)
+
+// Operation defines the type for the "operation" enum field.
+type Operation string
+
+// Operation values.
+const (
+	OperationCREATE Operation = "CREATE"
+	OperationUPDATE Operation = "UPDATE"
+	OperationDELETE Operation = "DELETE"
+)
+
+func (o Operation) String() string {
+	return string(o)
+}
+
+// OperationValidator is a validator for the "operation" field enum values. It is called by the builders before save.
+func OperationValidator(o Operation) error {
+	switch o {
+	case OperationCREATE, OperationUPDATE, OperationDELETE:
+		return nil
+	default:
+		return fmt.Errorf("annotationhistory: invalid enum value for operation field: %q", o)
+	}
+}
+
+// OrderOption defines the ordering options for the AnnotationHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAnnotationID orders the results by the annotation_id field.
+func ByAnnotationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnotationID, opts...).ToFunc()
+}
+
+// ByMetadataID orders the results by the metadata_id field.
+func ByMetadataID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetadataID, opts...).ToFunc()
+}
+
+// ByAnnotationNamespaceID orders the results by the annotation_namespace_id field.
+func ByAnnotationNamespaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnotationNamespaceID, opts...).ToFunc()
+}
+
+// ByOperation orders the results by the operation field.
+func ByOperation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperation, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// ByAnnotationCreatedAt orders the results by the annotation_created_at field.
+func ByAnnotationCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnotationCreatedAt, opts...).ToFunc()
+}
+
+// ByAnnotationUpdatedAt orders the results by the annotation_updated_at field.
+func ByAnnotationUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnotationUpdatedAt, opts...).ToFunc()
+}
+
+// ByAnnotationVersion orders the results by the annotation_version field.
+func ByAnnotationVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnnotationVersion, opts...).ToFunc()
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Operation) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Operation) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Operation(str)
+	if err := OperationValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Operation", str)
+	}
+	return nil
+}
@@ -0,0 +1,620 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package annotationhistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/x/gidx"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AnnotationID applies equality check predicate on the "annotation_id" field. It's identical to AnnotationIDEQ.
+func AnnotationID(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldAnnotationID, v))
+}
+
+// MetadataID applies equality check predicate on the "metadata_id" field. It's identical to MetadataIDEQ.
+func MetadataID(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldMetadataID, v))
+}
+
+// AnnotationNamespaceID applies equality check predicate on the "annotation_namespace_id" field. It's identical to AnnotationNamespaceIDEQ.
+func AnnotationNamespaceID(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldAnnotationNamespaceID, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// AnnotationCreatedAt applies equality check predicate on the "annotation_created_at" field. It's identical to AnnotationCreatedAtEQ.
+func AnnotationCreatedAt(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldAnnotationCreatedAt, v))
+}
+
+// AnnotationUpdatedAt applies equality check predicate on the "annotation_updated_at" field. It's identical to AnnotationUpdatedAtEQ.
+func AnnotationUpdatedAt(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldAnnotationUpdatedAt, v))
+}
+
+// AnnotationVersion applies equality check predicate on the "annotation_version" field. It's identical to AnnotationVersionEQ.
+func AnnotationVersion(v int64) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldAnnotationVersion, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// AnnotationIDEQ applies the EQ predicate on the "annotation_id" field.
+func AnnotationIDEQ(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldAnnotationID, v))
+}
+
+// AnnotationIDNEQ applies the NEQ predicate on the "annotation_id" field.
+func AnnotationIDNEQ(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNEQ(FieldAnnotationID, v))
+}
+
+// AnnotationIDIn applies the In predicate on the "annotation_id" field.
+func AnnotationIDIn(vs ...gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIn(FieldAnnotationID, vs...))
+}
+
+// AnnotationIDNotIn applies the NotIn predicate on the "annotation_id" field.
+func AnnotationIDNotIn(vs ...gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotIn(FieldAnnotationID, vs...))
+}
+
+// AnnotationIDGT applies the GT predicate on the "annotation_id" field.
+func AnnotationIDGT(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGT(FieldAnnotationID, v))
+}
+
+// AnnotationIDGTE applies the GTE predicate on the "annotation_id" field.
+func AnnotationIDGTE(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGTE(FieldAnnotationID, v))
+}
+
+// AnnotationIDLT applies the LT predicate on the "annotation_id" field.
+func AnnotationIDLT(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLT(FieldAnnotationID, v))
+}
+
+// AnnotationIDLTE applies the LTE predicate on the "annotation_id" field.
+func AnnotationIDLTE(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLTE(FieldAnnotationID, v))
+}
+
+// AnnotationIDContains applies the Contains predicate on the "annotation_id" field.
+func AnnotationIDContains(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldContains(FieldAnnotationID, vc))
+}
+
+// AnnotationIDHasPrefix applies the HasPrefix predicate on the "annotation_id" field.
+func AnnotationIDHasPrefix(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldHasPrefix(FieldAnnotationID, vc))
+}
+
+// AnnotationIDHasSuffix applies the HasSuffix predicate on the "annotation_id" field.
+func AnnotationIDHasSuffix(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldHasSuffix(FieldAnnotationID, vc))
+}
+
+// AnnotationIDEqualFold applies the EqualFold predicate on the "annotation_id" field.
+func AnnotationIDEqualFold(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldEqualFold(FieldAnnotationID, vc))
+}
+
+// AnnotationIDContainsFold applies the ContainsFold predicate on the "annotation_id" field.
+func AnnotationIDContainsFold(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldContainsFold(FieldAnnotationID, vc))
+}
+
+// MetadataIDEQ applies the EQ predicate on the "metadata_id" field.
+func MetadataIDEQ(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldMetadataID, v))
+}
+
+// MetadataIDNEQ applies the NEQ predicate on the "metadata_id" field.
+func MetadataIDNEQ(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNEQ(FieldMetadataID, v))
+}
+
+// MetadataIDIn applies the In predicate on the "metadata_id" field.
+func MetadataIDIn(vs ...gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIn(FieldMetadataID, vs...))
+}
+
+// MetadataIDNotIn applies the NotIn predicate on the "metadata_id" field.
+func MetadataIDNotIn(vs ...gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotIn(FieldMetadataID, vs...))
+}
+
+// MetadataIDGT applies the GT predicate on the "metadata_id" field.
+func MetadataIDGT(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGT(FieldMetadataID, v))
+}
+
+// MetadataIDGTE applies the GTE predicate on the "metadata_id" field.
+func MetadataIDGTE(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGTE(FieldMetadataID, v))
+}
+
+// MetadataIDLT applies the LT predicate on the "metadata_id" field.
+func MetadataIDLT(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLT(FieldMetadataID, v))
+}
+
+// MetadataIDLTE applies the LTE predicate on the "metadata_id" field.
+func MetadataIDLTE(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLTE(FieldMetadataID, v))
+}
+
+// MetadataIDContains applies the Contains predicate on the "metadata_id" field.
+func MetadataIDContains(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldContains(FieldMetadataID, vc))
+}
+
+// MetadataIDHasPrefix applies the HasPrefix predicate on the "metadata_id" field.
+func MetadataIDHasPrefix(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldHasPrefix(FieldMetadataID, vc))
+}
+
+// MetadataIDHasSuffix applies the HasSuffix predicate on the "metadata_id" field.
+func MetadataIDHasSuffix(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldHasSuffix(FieldMetadataID, vc))
+}
+
+// MetadataIDEqualFold applies the EqualFold predicate on the "metadata_id" field.
+func MetadataIDEqualFold(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldEqualFold(FieldMetadataID, vc))
+}
+
+// MetadataIDContainsFold applies the ContainsFold predicate on the "metadata_id" field.
+func MetadataIDContainsFold(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldContainsFold(FieldMetadataID, vc))
+}
+
+// AnnotationNamespaceIDEQ applies the EQ predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDEQ(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldAnnotationNamespaceID, v))
+}
+
+// AnnotationNamespaceIDNEQ applies the NEQ predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDNEQ(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNEQ(FieldAnnotationNamespaceID, v))
+}
+
+// AnnotationNamespaceIDIn applies the In predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDIn(vs ...gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIn(FieldAnnotationNamespaceID, vs...))
+}
+
+// AnnotationNamespaceIDNotIn applies the NotIn predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDNotIn(vs ...gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotIn(FieldAnnotationNamespaceID, vs...))
+}
+
+// AnnotationNamespaceIDGT applies the GT predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDGT(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGT(FieldAnnotationNamespaceID, v))
+}
+
+// AnnotationNamespaceIDGTE applies the GTE predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDGTE(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGTE(FieldAnnotationNamespaceID, v))
+}
+
+// AnnotationNamespaceIDLT applies the LT predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDLT(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLT(FieldAnnotationNamespaceID, v))
+}
+
+// AnnotationNamespaceIDLTE applies the LTE predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDLTE(v gidx.PrefixedID) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLTE(FieldAnnotationNamespaceID, v))
+}
+
+// AnnotationNamespaceIDContains applies the Contains predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDContains(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldContains(FieldAnnotationNamespaceID, vc))
+}
+
+// AnnotationNamespaceIDHasPrefix applies the HasPrefix predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDHasPrefix(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldHasPrefix(FieldAnnotationNamespaceID, vc))
+}
+
+// AnnotationNamespaceIDHasSuffix applies the HasSuffix predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDHasSuffix(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldHasSuffix(FieldAnnotationNamespaceID, vc))
+}
+
+// AnnotationNamespaceIDEqualFold applies the EqualFold predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDEqualFold(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldEqualFold(FieldAnnotationNamespaceID, vc))
+}
+
+// AnnotationNamespaceIDContainsFold applies the ContainsFold predicate on the "annotation_namespace_id" field.
+func AnnotationNamespaceIDContainsFold(v gidx.PrefixedID) predicate.AnnotationHistory {
+	vc := string(v)
+	return predicate.AnnotationHistory(sql.FieldContainsFold(FieldAnnotationNamespaceID, vc))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v Operation) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v Operation) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...Operation) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...Operation) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// DataIsNil applies the IsNil predicate on the "data" field.
+func DataIsNil() predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIsNull(FieldData))
+}
+
+// DataNotNil applies the NotNil predicate on the "data" field.
+func DataNotNil() predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotNull(FieldData))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorIsNil applies the IsNil predicate on the "actor" field.
+func ActorIsNil() predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIsNull(FieldActor))
+}
+
+// ActorNotNil applies the NotNil predicate on the "actor" field.
+func ActorNotNil() predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotNull(FieldActor))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldContainsFold(FieldActor, v))
+}
+
+// AnnotationCreatedAtEQ applies the EQ predicate on the "annotation_created_at" field.
+func AnnotationCreatedAtEQ(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldAnnotationCreatedAt, v))
+}
+
+// AnnotationCreatedAtNEQ applies the NEQ predicate on the "annotation_created_at" field.
+func AnnotationCreatedAtNEQ(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNEQ(FieldAnnotationCreatedAt, v))
+}
+
+// AnnotationCreatedAtIn applies the In predicate on the "annotation_created_at" field.
+func AnnotationCreatedAtIn(vs ...time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIn(FieldAnnotationCreatedAt, vs...))
+}
+
+// AnnotationCreatedAtNotIn applies the NotIn predicate on the "annotation_created_at" field.
+func AnnotationCreatedAtNotIn(vs ...time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotIn(FieldAnnotationCreatedAt, vs...))
+}
+
+// AnnotationCreatedAtGT applies the GT predicate on the "annotation_created_at" field.
+func AnnotationCreatedAtGT(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGT(FieldAnnotationCreatedAt, v))
+}
+
+// AnnotationCreatedAtGTE applies the GTE predicate on the "annotation_created_at" field.
+func AnnotationCreatedAtGTE(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGTE(FieldAnnotationCreatedAt, v))
+}
+
+// AnnotationCreatedAtLT applies the LT predicate on the "annotation_created_at" field.
+func AnnotationCreatedAtLT(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLT(FieldAnnotationCreatedAt, v))
+}
+
+// AnnotationCreatedAtLTE applies the LTE predicate on the "annotation_created_at" field.
+func AnnotationCreatedAtLTE(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLTE(FieldAnnotationCreatedAt, v))
+}
+
+// AnnotationCreatedAtIsNil applies the IsNil predicate on the "annotation_created_at" field.
+func AnnotationCreatedAtIsNil() predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIsNull(FieldAnnotationCreatedAt))
+}
+
+// AnnotationCreatedAtNotNil applies the NotNil predicate on the "annotation_created_at" field.
+func AnnotationCreatedAtNotNil() predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotNull(FieldAnnotationCreatedAt))
+}
+
+// AnnotationUpdatedAtEQ applies the EQ predicate on the "annotation_updated_at" field.
+func AnnotationUpdatedAtEQ(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldAnnotationUpdatedAt, v))
+}
+
+// AnnotationUpdatedAtNEQ applies the NEQ predicate on the "annotation_updated_at" field.
+func AnnotationUpdatedAtNEQ(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNEQ(FieldAnnotationUpdatedAt, v))
+}
+
+// AnnotationUpdatedAtIn applies the In predicate on the "annotation_updated_at" field.
+func AnnotationUpdatedAtIn(vs ...time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIn(FieldAnnotationUpdatedAt, vs...))
+}
+
+// AnnotationUpdatedAtNotIn applies the NotIn predicate on the "annotation_updated_at" field.
+func AnnotationUpdatedAtNotIn(vs ...time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotIn(FieldAnnotationUpdatedAt, vs...))
+}
+
+// AnnotationUpdatedAtGT applies the GT predicate on the "annotation_updated_at" field.
+func AnnotationUpdatedAtGT(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGT(FieldAnnotationUpdatedAt, v))
+}
+
+// AnnotationUpdatedAtGTE applies the GTE predicate on the "annotation_updated_at" field.
+func AnnotationUpdatedAtGTE(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGTE(FieldAnnotationUpdatedAt, v))
+}
+
+// AnnotationUpdatedAtLT applies the LT predicate on the "annotation_updated_at" field.
+func AnnotationUpdatedAtLT(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLT(FieldAnnotationUpdatedAt, v))
+}
+
+// AnnotationUpdatedAtLTE applies the LTE predicate on the "annotation_updated_at" field.
+func AnnotationUpdatedAtLTE(v time.Time) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLTE(FieldAnnotationUpdatedAt, v))
+}
+
+// AnnotationUpdatedAtIsNil applies the IsNil predicate on the "annotation_updated_at" field.
+func AnnotationUpdatedAtIsNil() predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIsNull(FieldAnnotationUpdatedAt))
+}
+
+// AnnotationUpdatedAtNotNil applies the NotNil predicate on the "annotation_updated_at" field.
+func AnnotationUpdatedAtNotNil() predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotNull(FieldAnnotationUpdatedAt))
+}
+
+// AnnotationVersionEQ applies the EQ predicate on the "annotation_version" field.
+func AnnotationVersionEQ(v int64) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldEQ(FieldAnnotationVersion, v))
+}
+
+// AnnotationVersionNEQ applies the NEQ predicate on the "annotation_version" field.
+func AnnotationVersionNEQ(v int64) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNEQ(FieldAnnotationVersion, v))
+}
+
+// AnnotationVersionIn applies the In predicate on the "annotation_version" field.
+func AnnotationVersionIn(vs ...int64) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldIn(FieldAnnotationVersion, vs...))
+}
+
+// AnnotationVersionNotIn applies the NotIn predicate on the "annotation_version" field.
+func AnnotationVersionNotIn(vs ...int64) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldNotIn(FieldAnnotationVersion, vs...))
+}
+
+// AnnotationVersionGT applies the GT predicate on the "annotation_version" field.
+func AnnotationVersionGT(v int64) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGT(FieldAnnotationVersion, v))
+}
+
+// AnnotationVersionGTE applies the GTE predicate on the "annotation_version" field.
+func AnnotationVersionGTE(v int64) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldGTE(FieldAnnotationVersion, v))
+}
+
+// AnnotationVersionLT applies the LT predicate on the "annotation_version" field.
+func AnnotationVersionLT(v int64) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLT(FieldAnnotationVersion, v))
+}
+
+// AnnotationVersionLTE applies the LTE predicate on the "annotation_version" field.
+func AnnotationVersionLTE(v int64) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.FieldLTE(FieldAnnotationVersion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AnnotationHistory) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AnnotationHistory) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AnnotationHistory) predicate.AnnotationHistory {
+	return predicate.AnnotationHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,402 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
+	"go.infratographer.com/x/gidx"
+)
+
+// AnnotationHistoryCreate is the builder for creating a AnnotationHistory entity.
+type AnnotationHistoryCreate struct {
+	config
+	mutation *AnnotationHistoryMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ahc *AnnotationHistoryCreate) SetCreatedAt(t time.Time) *AnnotationHistoryCreate {
+	ahc.mutation.SetCreatedAt(t)
+	return ahc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ahc *AnnotationHistoryCreate) SetNillableCreatedAt(t *time.Time) *AnnotationHistoryCreate {
+	if t != nil {
+		ahc.SetCreatedAt(*t)
+	}
+	return ahc
+}
+
+// SetAnnotationID sets the "annotation_id" field.
+func (ahc *AnnotationHistoryCreate) SetAnnotationID(gi gidx.PrefixedID) *AnnotationHistoryCreate {
+	ahc.mutation.SetAnnotationID(gi)
+	return ahc
+}
+
+// SetMetadataID sets the "metadata_id" field.
+func (ahc *AnnotationHistoryCreate) SetMetadataID(gi gidx.PrefixedID) *AnnotationHistoryCreate {
+	ahc.mutation.SetMetadataID(gi)
+	return ahc
+}
+
+// SetAnnotationNamespaceID sets the "annotation_namespace_id" field.
+func (ahc *AnnotationHistoryCreate) SetAnnotationNamespaceID(gi gidx.PrefixedID) *AnnotationHistoryCreate {
+	ahc.mutation.SetAnnotationNamespaceID(gi)
+	return ahc
+}
+
+// SetOperation sets the "operation" field.
+func (ahc *AnnotationHistoryCreate) SetOperation(a annotationhistory.Operation) *AnnotationHistoryCreate {
+	ahc.mutation.SetOperation(a)
+	return ahc
+}
+
+// SetData sets the "data" field.
+func (ahc *AnnotationHistoryCreate) SetData(jm json.RawMessage) *AnnotationHistoryCreate {
+	ahc.mutation.SetData(jm)
+	return ahc
+}
+
+// SetActor sets the "actor" field.
+func (ahc *AnnotationHistoryCreate) SetActor(s string) *AnnotationHistoryCreate {
+	ahc.mutation.SetActor(s)
+	return ahc
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (ahc *AnnotationHistoryCreate) SetNillableActor(s *string) *AnnotationHistoryCreate {
+	if s != nil {
+		ahc.SetActor(*s)
+	}
+	return ahc
+}
+
+// SetAnnotationCreatedAt sets the "annotation_created_at" field.
+func (ahc *AnnotationHistoryCreate) SetAnnotationCreatedAt(t time.Time) *AnnotationHistoryCreate {
+	ahc.mutation.SetAnnotationCreatedAt(t)
+	return ahc
+}
+
+// SetNillableAnnotationCreatedAt sets the "annotation_created_at" field if the given value is not nil.
+func (ahc *AnnotationHistoryCreate) SetNillableAnnotationCreatedAt(t *time.Time) *AnnotationHistoryCreate {
+	if t != nil {
+		ahc.SetAnnotationCreatedAt(*t)
+	}
+	return ahc
+}
+
+// SetAnnotationUpdatedAt sets the "annotation_updated_at" field.
+func (ahc *AnnotationHistoryCreate) SetAnnotationUpdatedAt(t time.Time) *AnnotationHistoryCreate {
+	ahc.mutation.SetAnnotationUpdatedAt(t)
+	return ahc
+}
+
+// SetNillableAnnotationUpdatedAt sets the "annotation_updated_at" field if the given value is not nil.
+func (ahc *AnnotationHistoryCreate) SetNillableAnnotationUpdatedAt(t *time.Time) *AnnotationHistoryCreate {
+	if t != nil {
+		ahc.SetAnnotationUpdatedAt(*t)
+	}
+	return ahc
+}
+
+// SetAnnotationVersion sets the "annotation_version" field.
+func (ahc *AnnotationHistoryCreate) SetAnnotationVersion(i int64) *AnnotationHistoryCreate {
+	ahc.mutation.SetAnnotationVersion(i)
+	return ahc
+}
+
+// SetNillableAnnotationVersion sets the "annotation_version" field if the given value is not nil.
+func (ahc *AnnotationHistoryCreate) SetNillableAnnotationVersion(i *int64) *AnnotationHistoryCreate {
+	if i != nil {
+		ahc.SetAnnotationVersion(*i)
+	}
+	return ahc
+}
+
+// SetID sets the "id" field.
+func (ahc *AnnotationHistoryCreate) SetID(gi gidx.PrefixedID) *AnnotationHistoryCreate {
+	ahc.mutation.SetID(gi)
+	return ahc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ahc *AnnotationHistoryCreate) SetNillableID(gi *gidx.PrefixedID) *AnnotationHistoryCreate {
+	if gi != nil {
+		ahc.SetID(*gi)
+	}
+	return ahc
+}
+
+// Mutation returns the AnnotationHistoryMutation object of the builder.
+func (ahc *AnnotationHistoryCreate) Mutation() *AnnotationHistoryMutation {
+	return ahc.mutation
+}
+
+// Save creates the AnnotationHistory in the database.
+func (ahc *AnnotationHistoryCreate) Save(ctx context.Context) (*AnnotationHistory, error) {
+	ahc.defaults()
+	return withHooks(ctx, ahc.sqlSave, ahc.mutation, ahc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ahc *AnnotationHistoryCreate) SaveX(ctx context.Context) *AnnotationHistory {
+	v, err := ahc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ahc *AnnotationHistoryCreate) Exec(ctx context.Context) error {
+	_, err := ahc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ahc *AnnotationHistoryCreate) ExecX(ctx context.Context) {
+	if err := ahc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ahc *AnnotationHistoryCreate) defaults() {
+	if _, ok := ahc.mutation.CreatedAt(); !ok {
+		v := annotationhistory.DefaultCreatedAt()
+		ahc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ahc.mutation.AnnotationVersion(); !ok {
+		v := annotationhistory.DefaultAnnotationVersion
+		ahc.mutation.SetAnnotationVersion(v)
+	}
+	if _, ok := ahc.mutation.ID(); !ok {
+		v := annotationhistory.DefaultID()
+		ahc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ahc *AnnotationHistoryCreate) check() error {
+	if _, ok := ahc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "AnnotationHistory.created_at"`)}
+	}
+	if _, ok := ahc.mutation.AnnotationID(); !ok {
+		return &ValidationError{Name: "annotation_id", err: errors.New(`generated: missing required field "AnnotationHistory.annotation_id"`)}
+	}
+	if v, ok := ahc.mutation.AnnotationID(); ok {
+		if err := annotationhistory.AnnotationIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "annotation_id", err: fmt.Errorf(`generated: validator failed for field "AnnotationHistory.annotation_id": %w`, err)}
+		}
+	}
+	if _, ok := ahc.mutation.MetadataID(); !ok {
+		return &ValidationError{Name: "metadata_id", err: errors.New(`generated: missing required field "AnnotationHistory.metadata_id"`)}
+	}
+	if v, ok := ahc.mutation.MetadataID(); ok {
+		if err := annotationhistory.MetadataIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "metadata_id", err: fmt.Errorf(`generated: validator failed for field "AnnotationHistory.metadata_id": %w`, err)}
+		}
+	}
+	if _, ok := ahc.mutation.AnnotationNamespaceID(); !ok {
+		return &ValidationError{Name: "annotation_namespace_id", err: errors.New(`generated: missing required field "AnnotationHistory.annotation_namespace_id"`)}
+	}
+	if v, ok := ahc.mutation.AnnotationNamespaceID(); ok {
+		if err := annotationhistory.AnnotationNamespaceIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "annotation_namespace_id", err: fmt.Errorf(`generated: validator failed for field "AnnotationHistory.annotation_namespace_id": %w`, err)}
+		}
+	}
+	if _, ok := ahc.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`generated: missing required field "AnnotationHistory.operation"`)}
+	}
+	if v, ok := ahc.mutation.Operation(); ok {
+		if err := annotationhistory.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`generated: validator failed for field "AnnotationHistory.operation": %w`, err)}
+		}
+	}
+	if _, ok := ahc.mutation.AnnotationVersion(); !ok {
+		return &ValidationError{Name: "annotation_version", err: errors.New(`generated: missing required field "AnnotationHistory.annotation_version"`)}
+	}
+	return nil
+}
+
+func (ahc *AnnotationHistoryCreate) sqlSave(ctx context.Context) (*AnnotationHistory, error) {
+	if err := ahc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ahc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ahc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*gidx.PrefixedID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ahc.mutation.id = &_node.ID
+	ahc.mutation.done = true
+	return _node, nil
+}
+
+func (ahc *AnnotationHistoryCreate) createSpec() (*AnnotationHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AnnotationHistory{config: ahc.config}
+		_spec = sqlgraph.NewCreateSpec(annotationhistory.Table, sqlgraph.NewFieldSpec(annotationhistory.FieldID, field.TypeString))
+	)
+	if id, ok := ahc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ahc.mutation.CreatedAt(); ok {
+		_spec.SetField(annotationhistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ahc.mutation.AnnotationID(); ok {
+		_spec.SetField(annotationhistory.FieldAnnotationID, field.TypeString, value)
+		_node.AnnotationID = value
+	}
+	if value, ok := ahc.mutation.MetadataID(); ok {
+		_spec.SetField(annotationhistory.FieldMetadataID, field.TypeString, value)
+		_node.MetadataID = value
+	}
+	if value, ok := ahc.mutation.AnnotationNamespaceID(); ok {
+		_spec.SetField(annotationhistory.FieldAnnotationNamespaceID, field.TypeString, value)
+		_node.AnnotationNamespaceID = value
+	}
+	if value, ok := ahc.mutation.Operation(); ok {
+		_spec.SetField(annotationhistory.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := ahc.mutation.Data(); ok {
+		_spec.SetField(annotationhistory.FieldData, field.TypeJSON, value)
+		_node.Data = value
+	}
+	if value, ok := ahc.mutation.Actor(); ok {
+		_spec.SetField(annotationhistory.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := ahc.mutation.AnnotationCreatedAt(); ok {
+		_spec.SetField(annotationhistory.FieldAnnotationCreatedAt, field.TypeTime, value)
+		_node.AnnotationCreatedAt = &value
+	}
+	if value, ok := ahc.mutation.AnnotationUpdatedAt(); ok {
+		_spec.SetField(annotationhistory.FieldAnnotationUpdatedAt, field.TypeTime, value)
+		_node.AnnotationUpdatedAt = &value
+	}
+	if value, ok := ahc.mutation.AnnotationVersion(); ok {
+		_spec.SetField(annotationhistory.FieldAnnotationVersion, field.TypeInt64, value)
+		_node.AnnotationVersion = value
+	}
+	return _node, _spec
+}
+
+// AnnotationHistoryCreateBulk is the builder for creating many AnnotationHistory entities in bulk.
+type AnnotationHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*AnnotationHistoryCreate
+}
+
+// Save creates the AnnotationHistory entities in the database.
+func (ahcb *AnnotationHistoryCreateBulk) Save(ctx context.Context) ([]*AnnotationHistory, error) {
+	if ahcb.err != nil {
+		return nil, ahcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ahcb.builders))
+	nodes := make([]*AnnotationHistory, len(ahcb.builders))
+	mutators := make([]Mutator, len(ahcb.builders))
+	for i := range ahcb.builders {
+		func(i int, root context.Context) {
+			builder := ahcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AnnotationHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ahcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ahcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ahcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ahcb *AnnotationHistoryCreateBulk) SaveX(ctx context.Context) []*AnnotationHistory {
+	v, err := ahcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ahcb *AnnotationHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := ahcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ahcb *AnnotationHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := ahcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
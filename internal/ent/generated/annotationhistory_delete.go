@@ -0,0 +1,102 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+)
+
+// AnnotationHistoryDelete is the builder for deleting a AnnotationHistory entity.
+type AnnotationHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *AnnotationHistoryMutation
+}
+
+// Where appends a list predicates to the AnnotationHistoryDelete builder.
+func (ahd *AnnotationHistoryDelete) Where(ps ...predicate.AnnotationHistory) *AnnotationHistoryDelete {
+	ahd.mutation.Where(ps...)
+	return ahd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ahd *AnnotationHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ahd.sqlExec, ahd.mutation, ahd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ahd *AnnotationHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := ahd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ahd *AnnotationHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(annotationhistory.Table, sqlgraph.NewFieldSpec(annotationhistory.FieldID, field.TypeString))
+	if ps := ahd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ahd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ahd.mutation.done = true
+	return affected, err
+}
+
+// AnnotationHistoryDeleteOne is the builder for deleting a single AnnotationHistory entity.
+type AnnotationHistoryDeleteOne struct {
+	ahd *AnnotationHistoryDelete
+}
+
+// Where appends a list predicates to the AnnotationHistoryDelete builder.
+func (ahdo *AnnotationHistoryDeleteOne) Where(ps ...predicate.AnnotationHistory) *AnnotationHistoryDeleteOne {
+	ahdo.ahd.mutation.Where(ps...)
+	return ahdo
+}
+
+// Exec executes the deletion query.
+func (ahdo *AnnotationHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := ahdo.ahd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{annotationhistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ahdo *AnnotationHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := ahdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,554 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/x/gidx"
+)
+
+// AnnotationHistoryQuery is the builder for querying AnnotationHistory entities.
+type AnnotationHistoryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []annotationhistory.OrderOption
+	inters     []Interceptor
+	predicates []predicate.AnnotationHistory
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*AnnotationHistory) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AnnotationHistoryQuery builder.
+func (ahq *AnnotationHistoryQuery) Where(ps ...predicate.AnnotationHistory) *AnnotationHistoryQuery {
+	ahq.predicates = append(ahq.predicates, ps...)
+	return ahq
+}
+
+// Limit the number of records to be returned by this query.
+func (ahq *AnnotationHistoryQuery) Limit(limit int) *AnnotationHistoryQuery {
+	ahq.ctx.Limit = &limit
+	return ahq
+}
+
+// Offset to start from.
+func (ahq *AnnotationHistoryQuery) Offset(offset int) *AnnotationHistoryQuery {
+	ahq.ctx.Offset = &offset
+	return ahq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ahq *AnnotationHistoryQuery) Unique(unique bool) *AnnotationHistoryQuery {
+	ahq.ctx.Unique = &unique
+	return ahq
+}
+
+// Order specifies how the records should be ordered.
+func (ahq *AnnotationHistoryQuery) Order(o ...annotationhistory.OrderOption) *AnnotationHistoryQuery {
+	ahq.order = append(ahq.order, o...)
+	return ahq
+}
+
+// First returns the first AnnotationHistory entity from the query.
+// Returns a *NotFoundError when no AnnotationHistory was found.
+func (ahq *AnnotationHistoryQuery) First(ctx context.Context) (*AnnotationHistory, error) {
+	nodes, err := ahq.Limit(1).All(setContextOp(ctx, ahq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{annotationhistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ahq *AnnotationHistoryQuery) FirstX(ctx context.Context) *AnnotationHistory {
+	node, err := ahq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AnnotationHistory ID from the query.
+// Returns a *NotFoundError when no AnnotationHistory ID was found.
+func (ahq *AnnotationHistoryQuery) FirstID(ctx context.Context) (id gidx.PrefixedID, err error) {
+	var ids []gidx.PrefixedID
+	if ids, err = ahq.Limit(1).IDs(setContextOp(ctx, ahq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{annotationhistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ahq *AnnotationHistoryQuery) FirstIDX(ctx context.Context) gidx.PrefixedID {
+	id, err := ahq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AnnotationHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AnnotationHistory entity is found.
+// Returns a *NotFoundError when no AnnotationHistory entities are found.
+func (ahq *AnnotationHistoryQuery) Only(ctx context.Context) (*AnnotationHistory, error) {
+	nodes, err := ahq.Limit(2).All(setContextOp(ctx, ahq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{annotationhistory.Label}
+	default:
+		return nil, &NotSingularError{annotationhistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ahq *AnnotationHistoryQuery) OnlyX(ctx context.Context) *AnnotationHistory {
+	node, err := ahq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AnnotationHistory ID in the query.
+// Returns a *NotSingularError when more than one AnnotationHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ahq *AnnotationHistoryQuery) OnlyID(ctx context.Context) (id gidx.PrefixedID, err error) {
+	var ids []gidx.PrefixedID
+	if ids, err = ahq.Limit(2).IDs(setContextOp(ctx, ahq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{annotationhistory.Label}
+	default:
+		err = &NotSingularError{annotationhistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ahq *AnnotationHistoryQuery) OnlyIDX(ctx context.Context) gidx.PrefixedID {
+	id, err := ahq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AnnotationHistories.
+func (ahq *AnnotationHistoryQuery) All(ctx context.Context) ([]*AnnotationHistory, error) {
+	ctx = setContextOp(ctx, ahq.ctx, "All")
+	if err := ahq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AnnotationHistory, *AnnotationHistoryQuery]()
+	return withInterceptors[[]*AnnotationHistory](ctx, ahq, qr, ahq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ahq *AnnotationHistoryQuery) AllX(ctx context.Context) []*AnnotationHistory {
+	nodes, err := ahq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AnnotationHistory IDs.
+func (ahq *AnnotationHistoryQuery) IDs(ctx context.Context) (ids []gidx.PrefixedID, err error) {
+	if ahq.ctx.Unique == nil && ahq.path != nil {
+		ahq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ahq.ctx, "IDs")
+	if err = ahq.Select(annotationhistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ahq *AnnotationHistoryQuery) IDsX(ctx context.Context) []gidx.PrefixedID {
+	ids, err := ahq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ahq *AnnotationHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ahq.ctx, "Count")
+	if err := ahq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ahq, querierCount[*AnnotationHistoryQuery](), ahq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ahq *AnnotationHistoryQuery) CountX(ctx context.Context) int {
+	count, err := ahq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ahq *AnnotationHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ahq.ctx, "Exist")
+	switch _, err := ahq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ahq *AnnotationHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := ahq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AnnotationHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ahq *AnnotationHistoryQuery) Clone() *AnnotationHistoryQuery {
+	if ahq == nil {
+		return nil
+	}
+	return &AnnotationHistoryQuery{
+		config:     ahq.config,
+		ctx:        ahq.ctx.Clone(),
+		order:      append([]annotationhistory.OrderOption{}, ahq.order...),
+		inters:     append([]Interceptor{}, ahq.inters...),
+		predicates: append([]predicate.AnnotationHistory{}, ahq.predicates...),
+		// clone intermediate query.
+		sql:  ahq.sql.Clone(),
+		path: ahq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AnnotationHistory.Query().
+//		GroupBy(annotationhistory.FieldCreatedAt).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (ahq *AnnotationHistoryQuery) GroupBy(field string, fields ...string) *AnnotationHistoryGroupBy {
+	ahq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AnnotationHistoryGroupBy{build: ahq}
+	grbuild.flds = &ahq.ctx.Fields
+	grbuild.label = annotationhistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.AnnotationHistory.Query().
+//		Select(annotationhistory.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ahq *AnnotationHistoryQuery) Select(fields ...string) *AnnotationHistorySelect {
+	ahq.ctx.Fields = append(ahq.ctx.Fields, fields...)
+	sbuild := &AnnotationHistorySelect{AnnotationHistoryQuery: ahq}
+	sbuild.label = annotationhistory.Label
+	sbuild.flds, sbuild.scan = &ahq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AnnotationHistorySelect configured with the given aggregations.
+func (ahq *AnnotationHistoryQuery) Aggregate(fns ...AggregateFunc) *AnnotationHistorySelect {
+	return ahq.Select().Aggregate(fns...)
+}
+
+func (ahq *AnnotationHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ahq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ahq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ahq.ctx.Fields {
+		if !annotationhistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if ahq.path != nil {
+		prev, err := ahq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ahq.sql = prev
+	}
+	return nil
+}
+
+func (ahq *AnnotationHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AnnotationHistory, error) {
+	var (
+		nodes = []*AnnotationHistory{}
+		_spec = ahq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AnnotationHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AnnotationHistory{config: ahq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ahq.modifiers) > 0 {
+		_spec.Modifiers = ahq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ahq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range ahq.loadTotal {
+		if err := ahq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ahq *AnnotationHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ahq.querySpec()
+	if len(ahq.modifiers) > 0 {
+		_spec.Modifiers = ahq.modifiers
+	}
+	_spec.Node.Columns = ahq.ctx.Fields
+	if len(ahq.ctx.Fields) > 0 {
+		_spec.Unique = ahq.ctx.Unique != nil && *ahq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ahq.driver, _spec)
+}
+
+func (ahq *AnnotationHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(annotationhistory.Table, annotationhistory.Columns, sqlgraph.NewFieldSpec(annotationhistory.FieldID, field.TypeString))
+	_spec.From = ahq.sql
+	if unique := ahq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ahq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ahq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, annotationhistory.FieldID)
+		for i := range fields {
+			if fields[i] != annotationhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ahq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ahq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ahq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ahq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ahq *AnnotationHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ahq.driver.Dialect())
+	t1 := builder.Table(annotationhistory.Table)
+	columns := ahq.ctx.Fields
+	if len(columns) == 0 {
+		columns = annotationhistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ahq.sql != nil {
+		selector = ahq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ahq.ctx.Unique != nil && *ahq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ahq.predicates {
+		p(selector)
+	}
+	for _, p := range ahq.order {
+		p(selector)
+	}
+	if offset := ahq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ahq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AnnotationHistoryGroupBy is the group-by builder for AnnotationHistory entities.
+type AnnotationHistoryGroupBy struct {
+	selector
+	build *AnnotationHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ahgb *AnnotationHistoryGroupBy) Aggregate(fns ...AggregateFunc) *AnnotationHistoryGroupBy {
+	ahgb.fns = append(ahgb.fns, fns...)
+	return ahgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ahgb *AnnotationHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ahgb.build.ctx, "GroupBy")
+	if err := ahgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AnnotationHistoryQuery, *AnnotationHistoryGroupBy](ctx, ahgb.build, ahgb, ahgb.build.inters, v)
+}
+
+func (ahgb *AnnotationHistoryGroupBy) sqlScan(ctx context.Context, root *AnnotationHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ahgb.fns))
+	for _, fn := range ahgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ahgb.flds)+len(ahgb.fns))
+		for _, f := range *ahgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ahgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ahgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AnnotationHistorySelect is the builder for selecting fields of AnnotationHistory entities.
+type AnnotationHistorySelect struct {
+	*AnnotationHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ahs *AnnotationHistorySelect) Aggregate(fns ...AggregateFunc) *AnnotationHistorySelect {
+	ahs.fns = append(ahs.fns, fns...)
+	return ahs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ahs *AnnotationHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ahs.ctx, "Select")
+	if err := ahs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AnnotationHistoryQuery, *AnnotationHistorySelect](ctx, ahs.AnnotationHistoryQuery, ahs, ahs.inters, v)
+}
+
+func (ahs *AnnotationHistorySelect) sqlScan(ctx context.Context, root *AnnotationHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ahs.fns))
+	for _, fn := range ahs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ahs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ahs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,213 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+)
+
+// AnnotationHistoryUpdate is the builder for updating AnnotationHistory entities.
+type AnnotationHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AnnotationHistoryMutation
+}
+
+// Where appends a list predicates to the AnnotationHistoryUpdate builder.
+func (ahu *AnnotationHistoryUpdate) Where(ps ...predicate.AnnotationHistory) *AnnotationHistoryUpdate {
+	ahu.mutation.Where(ps...)
+	return ahu
+}
+
+// Mutation returns the AnnotationHistoryMutation object of the builder.
+func (ahu *AnnotationHistoryUpdate) Mutation() *AnnotationHistoryMutation {
+	return ahu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ahu *AnnotationHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ahu.sqlSave, ahu.mutation, ahu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ahu *AnnotationHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ahu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ahu *AnnotationHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := ahu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ahu *AnnotationHistoryUpdate) ExecX(ctx context.Context) {
+	if err := ahu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ahu *AnnotationHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(annotationhistory.Table, annotationhistory.Columns, sqlgraph.NewFieldSpec(annotationhistory.FieldID, field.TypeString))
+	if ps := ahu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ahu.mutation.DataCleared() {
+		_spec.ClearField(annotationhistory.FieldData, field.TypeJSON)
+	}
+	if ahu.mutation.ActorCleared() {
+		_spec.ClearField(annotationhistory.FieldActor, field.TypeString)
+	}
+	if ahu.mutation.AnnotationCreatedAtCleared() {
+		_spec.ClearField(annotationhistory.FieldAnnotationCreatedAt, field.TypeTime)
+	}
+	if ahu.mutation.AnnotationUpdatedAtCleared() {
+		_spec.ClearField(annotationhistory.FieldAnnotationUpdatedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ahu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{annotationhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ahu.mutation.done = true
+	return n, nil
+}
+
+// AnnotationHistoryUpdateOne is the builder for updating a single AnnotationHistory entity.
+type AnnotationHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AnnotationHistoryMutation
+}
+
+// Mutation returns the AnnotationHistoryMutation object of the builder.
+func (ahuo *AnnotationHistoryUpdateOne) Mutation() *AnnotationHistoryMutation {
+	return ahuo.mutation
+}
+
+// Where appends a list predicates to the AnnotationHistoryUpdate builder.
+func (ahuo *AnnotationHistoryUpdateOne) Where(ps ...predicate.AnnotationHistory) *AnnotationHistoryUpdateOne {
+	ahuo.mutation.Where(ps...)
+	return ahuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ahuo *AnnotationHistoryUpdateOne) Select(field string, fields ...string) *AnnotationHistoryUpdateOne {
+	ahuo.fields = append([]string{field}, fields...)
+	return ahuo
+}
+
+// Save executes the query and returns the updated AnnotationHistory entity.
+func (ahuo *AnnotationHistoryUpdateOne) Save(ctx context.Context) (*AnnotationHistory, error) {
+	return withHooks(ctx, ahuo.sqlSave, ahuo.mutation, ahuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ahuo *AnnotationHistoryUpdateOne) SaveX(ctx context.Context) *AnnotationHistory {
+	node, err := ahuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ahuo *AnnotationHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := ahuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ahuo *AnnotationHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := ahuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ahuo *AnnotationHistoryUpdateOne) sqlSave(ctx context.Context) (_node *AnnotationHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(annotationhistory.Table, annotationhistory.Columns, sqlgraph.NewFieldSpec(annotationhistory.FieldID, field.TypeString))
+	id, ok := ahuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "AnnotationHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ahuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, annotationhistory.FieldID)
+		for _, f := range fields {
+			if !annotationhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != annotationhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ahuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if ahuo.mutation.DataCleared() {
+		_spec.ClearField(annotationhistory.FieldData, field.TypeJSON)
+	}
+	if ahuo.mutation.ActorCleared() {
+		_spec.ClearField(annotationhistory.FieldActor, field.TypeString)
+	}
+	if ahuo.mutation.AnnotationCreatedAtCleared() {
+		_spec.ClearField(annotationhistory.FieldAnnotationCreatedAt, field.TypeTime)
+	}
+	if ahuo.mutation.AnnotationUpdatedAtCleared() {
+		_spec.ClearField(annotationhistory.FieldAnnotationUpdatedAt, field.TypeTime)
+	}
+	_node = &AnnotationHistory{config: ahuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ahuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{annotationhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ahuo.mutation.done = true
+	return _node, nil
+}
@@ -17,6 +17,7 @@
 package generated
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -37,12 +38,18 @@ type AnnotationNamespace struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Time the record was deleted, it's purged once the purge delay has passed.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// The name of the annotation namespace.
 	Name string `json:"name,omitempty"`
 	// The ID for the owner for this annotation namespace.
 	OwnerID gidx.PrefixedID `json:"owner_id,omitempty"`
 	// Flag for if this namespace is private.
 	Private bool `json:"private,omitempty"`
+	// Flag for if this namespace is being deleted with its annotations. No annotations can be written to it.
+	Deleting bool `json:"deleting,omitempty"`
+	// JSON Schema that annotation data in this namespace must validate against.
+	JSONSchema json.RawMessage `json:"json_schema,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AnnotationNamespaceQuery when eager-loading is set.
 	Edges        AnnotationNamespaceEdges `json:"edges"`
@@ -76,13 +83,15 @@ func (*AnnotationNamespace) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case annotationnamespace.FieldJSONSchema:
+			values[i] = new([]byte)
 		case annotationnamespace.FieldID, annotationnamespace.FieldOwnerID:
 			values[i] = new(gidx.PrefixedID)
-		case annotationnamespace.FieldPrivate:
+		case annotationnamespace.FieldPrivate, annotationnamespace.FieldDeleting:
 			values[i] = new(sql.NullBool)
 		case annotationnamespace.FieldName:
 			values[i] = new(sql.NullString)
-		case annotationnamespace.FieldCreatedAt, annotationnamespace.FieldUpdatedAt:
+		case annotationnamespace.FieldCreatedAt, annotationnamespace.FieldUpdatedAt, annotationnamespace.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -117,6 +126,13 @@ func (an *AnnotationNamespace) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				an.UpdatedAt = value.Time
 			}
+		case annotationnamespace.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				an.DeletedAt = new(time.Time)
+				*an.DeletedAt = value.Time
+			}
 		case annotationnamespace.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -135,6 +151,20 @@ func (an *AnnotationNamespace) assignValues(columns []string, values []any) erro
 			} else if value.Valid {
 				an.Private = value.Bool
 			}
+		case annotationnamespace.FieldDeleting:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field deleting", values[i])
+			} else if value.Valid {
+				an.Deleting = value.Bool
+			}
+		case annotationnamespace.FieldJSONSchema:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field json_schema", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &an.JSONSchema); err != nil {
+					return fmt.Errorf("unmarshal field json_schema: %w", err)
+				}
+			}
 		default:
 			an.selectValues.Set(columns[i], values[i])
 		}
@@ -182,6 +212,11 @@ func (an *AnnotationNamespace) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(an.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := an.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(an.Name)
 	builder.WriteString(", ")
@@ -190,6 +225,12 @@ func (an *AnnotationNamespace) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("private=")
 	builder.WriteString(fmt.Sprintf("%v", an.Private))
+	builder.WriteString(", ")
+	builder.WriteString("deleting=")
+	builder.WriteString(fmt.Sprintf("%v", an.Deleting))
+	builder.WriteString(", ")
+	builder.WriteString("json_schema=")
+	builder.WriteString(fmt.Sprintf("%v", an.JSONSchema))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -33,12 +33,18 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldOwnerID holds the string denoting the owner_id field in the database.
 	FieldOwnerID = "owner_id"
 	// FieldPrivate holds the string denoting the private field in the database.
 	FieldPrivate = "private"
+	// FieldDeleting holds the string denoting the deleting field in the database.
+	FieldDeleting = "deleting"
+	// FieldJSONSchema holds the string denoting the json_schema field in the database.
+	FieldJSONSchema = "json_schema"
 	// EdgeAnnotations holds the string denoting the annotations edge name in mutations.
 	EdgeAnnotations = "annotations"
 	// Table holds the table name of the annotationnamespace in the database.
@@ -57,9 +63,12 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 	FieldName,
 	FieldOwnerID,
 	FieldPrivate,
+	FieldDeleting,
+	FieldJSONSchema,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -85,6 +94,8 @@ var (
 	OwnerIDValidator func(string) error
 	// DefaultPrivate holds the default value on creation for the "private" field.
 	DefaultPrivate bool
+	// DefaultDeleting holds the default value on creation for the "deleting" field.
+	DefaultDeleting bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() gidx.PrefixedID
 )
@@ -107,6 +118,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -122,6 +138,11 @@ func ByPrivate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPrivate, opts...).ToFunc()
 }
 
+// ByDeleting orders the results by the deleting field.
+func ByDeleting(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleting, opts...).ToFunc()
+}
+
 // ByAnnotationsCount orders the results by annotations count.
 func ByAnnotationsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
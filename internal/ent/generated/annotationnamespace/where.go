@@ -80,6 +80,11 @@ func UpdatedAt(v time.Time) predicate.AnnotationNamespace {
 	return predicate.AnnotationNamespace(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.AnnotationNamespace {
 	return predicate.AnnotationNamespace(sql.FieldEQ(FieldName, v))
@@ -95,6 +100,11 @@ func Private(v bool) predicate.AnnotationNamespace {
 	return predicate.AnnotationNamespace(sql.FieldEQ(FieldPrivate, v))
 }
 
+// Deleting applies equality check predicate on the "deleting" field. It's identical to DeletingEQ.
+func Deleting(v bool) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldEQ(FieldDeleting, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.AnnotationNamespace {
 	return predicate.AnnotationNamespace(sql.FieldEQ(FieldCreatedAt, v))
@@ -175,6 +185,56 @@ func UpdatedAtLTE(v time.Time) predicate.AnnotationNamespace {
 	return predicate.AnnotationNamespace(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.AnnotationNamespace {
 	return predicate.AnnotationNamespace(sql.FieldEQ(FieldName, v))
@@ -320,6 +380,26 @@ func PrivateNEQ(v bool) predicate.AnnotationNamespace {
 	return predicate.AnnotationNamespace(sql.FieldNEQ(FieldPrivate, v))
 }
 
+// DeletingEQ applies the EQ predicate on the "deleting" field.
+func DeletingEQ(v bool) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldEQ(FieldDeleting, v))
+}
+
+// DeletingNEQ applies the NEQ predicate on the "deleting" field.
+func DeletingNEQ(v bool) predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldNEQ(FieldDeleting, v))
+}
+
+// JSONSchemaIsNil applies the IsNil predicate on the "json_schema" field.
+func JSONSchemaIsNil() predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldIsNull(FieldJSONSchema))
+}
+
+// JSONSchemaNotNil applies the NotNil predicate on the "json_schema" field.
+func JSONSchemaNotNil() predicate.AnnotationNamespace {
+	return predicate.AnnotationNamespace(sql.FieldNotNull(FieldJSONSchema))
+}
+
 // HasAnnotations applies the HasEdge predicate on the "annotations" edge.
 func HasAnnotations() predicate.AnnotationNamespace {
 	return predicate.AnnotationNamespace(func(s *sql.Selector) {
@@ -18,6 +18,7 @@ package generated
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -64,6 +65,20 @@ func (anc *AnnotationNamespaceCreate) SetNillableUpdatedAt(t *time.Time) *Annota
 	return anc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (anc *AnnotationNamespaceCreate) SetDeletedAt(t time.Time) *AnnotationNamespaceCreate {
+	anc.mutation.SetDeletedAt(t)
+	return anc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (anc *AnnotationNamespaceCreate) SetNillableDeletedAt(t *time.Time) *AnnotationNamespaceCreate {
+	if t != nil {
+		anc.SetDeletedAt(*t)
+	}
+	return anc
+}
+
 // SetName sets the "name" field.
 func (anc *AnnotationNamespaceCreate) SetName(s string) *AnnotationNamespaceCreate {
 	anc.mutation.SetName(s)
@@ -90,6 +105,26 @@ func (anc *AnnotationNamespaceCreate) SetNillablePrivate(b *bool) *AnnotationNam
 	return anc
 }
 
+// SetDeleting sets the "deleting" field.
+func (anc *AnnotationNamespaceCreate) SetDeleting(b bool) *AnnotationNamespaceCreate {
+	anc.mutation.SetDeleting(b)
+	return anc
+}
+
+// SetNillableDeleting sets the "deleting" field if the given value is not nil.
+func (anc *AnnotationNamespaceCreate) SetNillableDeleting(b *bool) *AnnotationNamespaceCreate {
+	if b != nil {
+		anc.SetDeleting(*b)
+	}
+	return anc
+}
+
+// SetJSONSchema sets the "json_schema" field.
+func (anc *AnnotationNamespaceCreate) SetJSONSchema(jm json.RawMessage) *AnnotationNamespaceCreate {
+	anc.mutation.SetJSONSchema(jm)
+	return anc
+}
+
 // SetID sets the "id" field.
 func (anc *AnnotationNamespaceCreate) SetID(gi gidx.PrefixedID) *AnnotationNamespaceCreate {
 	anc.mutation.SetID(gi)
@@ -166,6 +201,10 @@ func (anc *AnnotationNamespaceCreate) defaults() {
 		v := annotationnamespace.DefaultPrivate
 		anc.mutation.SetPrivate(v)
 	}
+	if _, ok := anc.mutation.Deleting(); !ok {
+		v := annotationnamespace.DefaultDeleting
+		anc.mutation.SetDeleting(v)
+	}
 	if _, ok := anc.mutation.ID(); !ok {
 		v := annotationnamespace.DefaultID()
 		anc.mutation.SetID(v)
@@ -199,6 +238,9 @@ func (anc *AnnotationNamespaceCreate) check() error {
 	if _, ok := anc.mutation.Private(); !ok {
 		return &ValidationError{Name: "private", err: errors.New(`generated: missing required field "AnnotationNamespace.private"`)}
 	}
+	if _, ok := anc.mutation.Deleting(); !ok {
+		return &ValidationError{Name: "deleting", err: errors.New(`generated: missing required field "AnnotationNamespace.deleting"`)}
+	}
 	return nil
 }
 
@@ -242,6 +284,10 @@ func (anc *AnnotationNamespaceCreate) createSpec() (*AnnotationNamespace, *sqlgr
 		_spec.SetField(annotationnamespace.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := anc.mutation.DeletedAt(); ok {
+		_spec.SetField(annotationnamespace.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := anc.mutation.Name(); ok {
 		_spec.SetField(annotationnamespace.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -254,6 +300,14 @@ func (anc *AnnotationNamespaceCreate) createSpec() (*AnnotationNamespace, *sqlgr
 		_spec.SetField(annotationnamespace.FieldPrivate, field.TypeBool, value)
 		_node.Private = value
 	}
+	if value, ok := anc.mutation.Deleting(); ok {
+		_spec.SetField(annotationnamespace.FieldDeleting, field.TypeBool, value)
+		_node.Deleting = value
+	}
+	if value, ok := anc.mutation.JSONSchema(); ok {
+		_spec.SetField(annotationnamespace.FieldJSONSchema, field.TypeJSON, value)
+		_node.JSONSchema = value
+	}
 	if nodes := anc.mutation.AnnotationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -18,11 +18,14 @@ package generated
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
@@ -43,6 +46,26 @@ func (anu *AnnotationNamespaceUpdate) Where(ps ...predicate.AnnotationNamespace)
 	return anu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (anu *AnnotationNamespaceUpdate) SetDeletedAt(t time.Time) *AnnotationNamespaceUpdate {
+	anu.mutation.SetDeletedAt(t)
+	return anu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (anu *AnnotationNamespaceUpdate) SetNillableDeletedAt(t *time.Time) *AnnotationNamespaceUpdate {
+	if t != nil {
+		anu.SetDeletedAt(*t)
+	}
+	return anu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (anu *AnnotationNamespaceUpdate) ClearDeletedAt() *AnnotationNamespaceUpdate {
+	anu.mutation.ClearDeletedAt()
+	return anu
+}
+
 // SetName sets the "name" field.
 func (anu *AnnotationNamespaceUpdate) SetName(s string) *AnnotationNamespaceUpdate {
 	anu.mutation.SetName(s)
@@ -63,6 +86,38 @@ func (anu *AnnotationNamespaceUpdate) SetNillablePrivate(b *bool) *AnnotationNam
 	return anu
 }
 
+// SetDeleting sets the "deleting" field.
+func (anu *AnnotationNamespaceUpdate) SetDeleting(b bool) *AnnotationNamespaceUpdate {
+	anu.mutation.SetDeleting(b)
+	return anu
+}
+
+// SetNillableDeleting sets the "deleting" field if the given value is not nil.
+func (anu *AnnotationNamespaceUpdate) SetNillableDeleting(b *bool) *AnnotationNamespaceUpdate {
+	if b != nil {
+		anu.SetDeleting(*b)
+	}
+	return anu
+}
+
+// SetJSONSchema sets the "json_schema" field.
+func (anu *AnnotationNamespaceUpdate) SetJSONSchema(jm json.RawMessage) *AnnotationNamespaceUpdate {
+	anu.mutation.SetJSONSchema(jm)
+	return anu
+}
+
+// AppendJSONSchema appends jm to the "json_schema" field.
+func (anu *AnnotationNamespaceUpdate) AppendJSONSchema(jm json.RawMessage) *AnnotationNamespaceUpdate {
+	anu.mutation.AppendJSONSchema(jm)
+	return anu
+}
+
+// ClearJSONSchema clears the value of the "json_schema" field.
+func (anu *AnnotationNamespaceUpdate) ClearJSONSchema() *AnnotationNamespaceUpdate {
+	anu.mutation.ClearJSONSchema()
+	return anu
+}
+
 // AddAnnotationIDs adds the "annotations" edge to the Annotation entity by IDs.
 func (anu *AnnotationNamespaceUpdate) AddAnnotationIDs(ids ...gidx.PrefixedID) *AnnotationNamespaceUpdate {
 	anu.mutation.AddAnnotationIDs(ids...)
@@ -165,12 +220,32 @@ func (anu *AnnotationNamespaceUpdate) sqlSave(ctx context.Context) (n int, err e
 	if value, ok := anu.mutation.UpdatedAt(); ok {
 		_spec.SetField(annotationnamespace.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := anu.mutation.DeletedAt(); ok {
+		_spec.SetField(annotationnamespace.FieldDeletedAt, field.TypeTime, value)
+	}
+	if anu.mutation.DeletedAtCleared() {
+		_spec.ClearField(annotationnamespace.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := anu.mutation.Name(); ok {
 		_spec.SetField(annotationnamespace.FieldName, field.TypeString, value)
 	}
 	if value, ok := anu.mutation.Private(); ok {
 		_spec.SetField(annotationnamespace.FieldPrivate, field.TypeBool, value)
 	}
+	if value, ok := anu.mutation.Deleting(); ok {
+		_spec.SetField(annotationnamespace.FieldDeleting, field.TypeBool, value)
+	}
+	if value, ok := anu.mutation.JSONSchema(); ok {
+		_spec.SetField(annotationnamespace.FieldJSONSchema, field.TypeJSON, value)
+	}
+	if value, ok := anu.mutation.AppendedJSONSchema(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, annotationnamespace.FieldJSONSchema, value)
+		})
+	}
+	if anu.mutation.JSONSchemaCleared() {
+		_spec.ClearField(annotationnamespace.FieldJSONSchema, field.TypeJSON)
+	}
 	if anu.mutation.AnnotationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -236,6 +311,26 @@ type AnnotationNamespaceUpdateOne struct {
 	mutation *AnnotationNamespaceMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (anuo *AnnotationNamespaceUpdateOne) SetDeletedAt(t time.Time) *AnnotationNamespaceUpdateOne {
+	anuo.mutation.SetDeletedAt(t)
+	return anuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (anuo *AnnotationNamespaceUpdateOne) SetNillableDeletedAt(t *time.Time) *AnnotationNamespaceUpdateOne {
+	if t != nil {
+		anuo.SetDeletedAt(*t)
+	}
+	return anuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (anuo *AnnotationNamespaceUpdateOne) ClearDeletedAt() *AnnotationNamespaceUpdateOne {
+	anuo.mutation.ClearDeletedAt()
+	return anuo
+}
+
 // SetName sets the "name" field.
 func (anuo *AnnotationNamespaceUpdateOne) SetName(s string) *AnnotationNamespaceUpdateOne {
 	anuo.mutation.SetName(s)
@@ -256,6 +351,38 @@ func (anuo *AnnotationNamespaceUpdateOne) SetNillablePrivate(b *bool) *Annotatio
 	return anuo
 }
 
+// SetDeleting sets the "deleting" field.
+func (anuo *AnnotationNamespaceUpdateOne) SetDeleting(b bool) *AnnotationNamespaceUpdateOne {
+	anuo.mutation.SetDeleting(b)
+	return anuo
+}
+
+// SetNillableDeleting sets the "deleting" field if the given value is not nil.
+func (anuo *AnnotationNamespaceUpdateOne) SetNillableDeleting(b *bool) *AnnotationNamespaceUpdateOne {
+	if b != nil {
+		anuo.SetDeleting(*b)
+	}
+	return anuo
+}
+
+// SetJSONSchema sets the "json_schema" field.
+func (anuo *AnnotationNamespaceUpdateOne) SetJSONSchema(jm json.RawMessage) *AnnotationNamespaceUpdateOne {
+	anuo.mutation.SetJSONSchema(jm)
+	return anuo
+}
+
+// AppendJSONSchema appends jm to the "json_schema" field.
+func (anuo *AnnotationNamespaceUpdateOne) AppendJSONSchema(jm json.RawMessage) *AnnotationNamespaceUpdateOne {
+	anuo.mutation.AppendJSONSchema(jm)
+	return anuo
+}
+
+// ClearJSONSchema clears the value of the "json_schema" field.
+func (anuo *AnnotationNamespaceUpdateOne) ClearJSONSchema() *AnnotationNamespaceUpdateOne {
+	anuo.mutation.ClearJSONSchema()
+	return anuo
+}
+
 // AddAnnotationIDs adds the "annotations" edge to the Annotation entity by IDs.
 func (anuo *AnnotationNamespaceUpdateOne) AddAnnotationIDs(ids ...gidx.PrefixedID) *AnnotationNamespaceUpdateOne {
 	anuo.mutation.AddAnnotationIDs(ids...)
@@ -388,12 +515,32 @@ func (anuo *AnnotationNamespaceUpdateOne) sqlSave(ctx context.Context) (_node *A
 	if value, ok := anuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(annotationnamespace.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := anuo.mutation.DeletedAt(); ok {
+		_spec.SetField(annotationnamespace.FieldDeletedAt, field.TypeTime, value)
+	}
+	if anuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(annotationnamespace.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := anuo.mutation.Name(); ok {
 		_spec.SetField(annotationnamespace.FieldName, field.TypeString, value)
 	}
 	if value, ok := anuo.mutation.Private(); ok {
 		_spec.SetField(annotationnamespace.FieldPrivate, field.TypeBool, value)
 	}
+	if value, ok := anuo.mutation.Deleting(); ok {
+		_spec.SetField(annotationnamespace.FieldDeleting, field.TypeBool, value)
+	}
+	if value, ok := anuo.mutation.JSONSchema(); ok {
+		_spec.SetField(annotationnamespace.FieldJSONSchema, field.TypeJSON, value)
+	}
+	if value, ok := anuo.mutation.AppendedJSONSchema(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, annotationnamespace.FieldJSONSchema, value)
+		})
+	}
+	if anuo.mutation.JSONSchemaCleared() {
+		_spec.ClearField(annotationnamespace.FieldJSONSchema, field.TypeJSON)
+	}
 	if anuo.mutation.AnnotationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -30,9 +30,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
 	"go.infratographer.com/x/events"
 	"go.infratographer.com/x/gidx"
@@ -45,12 +48,18 @@ type Client struct {
 	Schema *migrate.Schema
 	// Annotation is the client for interacting with the Annotation builders.
 	Annotation *AnnotationClient
+	// AnnotationHistory is the client for interacting with the AnnotationHistory builders.
+	AnnotationHistory *AnnotationHistoryClient
 	// AnnotationNamespace is the client for interacting with the AnnotationNamespace builders.
 	AnnotationNamespace *AnnotationNamespaceClient
 	// Metadata is the client for interacting with the Metadata builders.
 	Metadata *MetadataClient
+	// NamespaceDeletion is the client for interacting with the NamespaceDeletion builders.
+	NamespaceDeletion *NamespaceDeletionClient
 	// Status is the client for interacting with the Status builders.
 	Status *StatusClient
+	// StatusHistory is the client for interacting with the StatusHistory builders.
+	StatusHistory *StatusHistoryClient
 	// StatusNamespace is the client for interacting with the StatusNamespace builders.
 	StatusNamespace *StatusNamespaceClient
 }
@@ -67,9 +76,12 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Annotation = NewAnnotationClient(c.config)
+	c.AnnotationHistory = NewAnnotationHistoryClient(c.config)
 	c.AnnotationNamespace = NewAnnotationNamespaceClient(c.config)
 	c.Metadata = NewMetadataClient(c.config)
+	c.NamespaceDeletion = NewNamespaceDeletionClient(c.config)
 	c.Status = NewStatusClient(c.config)
+	c.StatusHistory = NewStatusHistoryClient(c.config)
 	c.StatusNamespace = NewStatusNamespaceClient(c.config)
 }
 
@@ -165,9 +177,12 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                 ctx,
 		config:              cfg,
 		Annotation:          NewAnnotationClient(cfg),
+		AnnotationHistory:   NewAnnotationHistoryClient(cfg),
 		AnnotationNamespace: NewAnnotationNamespaceClient(cfg),
 		Metadata:            NewMetadataClient(cfg),
+		NamespaceDeletion:   NewNamespaceDeletionClient(cfg),
 		Status:              NewStatusClient(cfg),
+		StatusHistory:       NewStatusHistoryClient(cfg),
 		StatusNamespace:     NewStatusNamespaceClient(cfg),
 	}, nil
 }
@@ -189,9 +204,12 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                 ctx,
 		config:              cfg,
 		Annotation:          NewAnnotationClient(cfg),
+		AnnotationHistory:   NewAnnotationHistoryClient(cfg),
 		AnnotationNamespace: NewAnnotationNamespaceClient(cfg),
 		Metadata:            NewMetadataClient(cfg),
+		NamespaceDeletion:   NewNamespaceDeletionClient(cfg),
 		Status:              NewStatusClient(cfg),
+		StatusHistory:       NewStatusHistoryClient(cfg),
 		StatusNamespace:     NewStatusNamespaceClient(cfg),
 	}, nil
 }
@@ -221,21 +239,23 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Annotation.Use(hooks...)
-	c.AnnotationNamespace.Use(hooks...)
-	c.Metadata.Use(hooks...)
-	c.Status.Use(hooks...)
-	c.StatusNamespace.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Annotation, c.AnnotationHistory, c.AnnotationNamespace, c.Metadata,
+		c.NamespaceDeletion, c.Status, c.StatusHistory, c.StatusNamespace,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Annotation.Intercept(interceptors...)
-	c.AnnotationNamespace.Intercept(interceptors...)
-	c.Metadata.Intercept(interceptors...)
-	c.Status.Intercept(interceptors...)
-	c.StatusNamespace.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Annotation, c.AnnotationHistory, c.AnnotationNamespace, c.Metadata,
+		c.NamespaceDeletion, c.Status, c.StatusHistory, c.StatusNamespace,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -243,12 +263,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AnnotationMutation:
 		return c.Annotation.mutate(ctx, m)
+	case *AnnotationHistoryMutation:
+		return c.AnnotationHistory.mutate(ctx, m)
 	case *AnnotationNamespaceMutation:
 		return c.AnnotationNamespace.mutate(ctx, m)
 	case *MetadataMutation:
 		return c.Metadata.mutate(ctx, m)
+	case *NamespaceDeletionMutation:
+		return c.NamespaceDeletion.mutate(ctx, m)
 	case *StatusMutation:
 		return c.Status.mutate(ctx, m)
+	case *StatusHistoryMutation:
+		return c.StatusHistory.mutate(ctx, m)
 	case *StatusNamespaceMutation:
 		return c.StatusNamespace.mutate(ctx, m)
 	default:
@@ -421,6 +447,139 @@ func (c *AnnotationClient) mutate(ctx context.Context, m *AnnotationMutation) (V
 	}
 }
 
+// AnnotationHistoryClient is a client for the AnnotationHistory schema.
+type AnnotationHistoryClient struct {
+	config
+}
+
+// NewAnnotationHistoryClient returns a client for the AnnotationHistory from the given config.
+func NewAnnotationHistoryClient(c config) *AnnotationHistoryClient {
+	return &AnnotationHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `annotationhistory.Hooks(f(g(h())))`.
+func (c *AnnotationHistoryClient) Use(hooks ...Hook) {
+	c.hooks.AnnotationHistory = append(c.hooks.AnnotationHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `annotationhistory.Intercept(f(g(h())))`.
+func (c *AnnotationHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AnnotationHistory = append(c.inters.AnnotationHistory, interceptors...)
+}
+
+// Create returns a builder for creating a AnnotationHistory entity.
+func (c *AnnotationHistoryClient) Create() *AnnotationHistoryCreate {
+	mutation := newAnnotationHistoryMutation(c.config, OpCreate)
+	return &AnnotationHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AnnotationHistory entities.
+func (c *AnnotationHistoryClient) CreateBulk(builders ...*AnnotationHistoryCreate) *AnnotationHistoryCreateBulk {
+	return &AnnotationHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AnnotationHistoryClient) MapCreateBulk(slice any, setFunc func(*AnnotationHistoryCreate, int)) *AnnotationHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AnnotationHistoryCreateBulk{err: fmt.Errorf("calling to AnnotationHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AnnotationHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AnnotationHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AnnotationHistory.
+func (c *AnnotationHistoryClient) Update() *AnnotationHistoryUpdate {
+	mutation := newAnnotationHistoryMutation(c.config, OpUpdate)
+	return &AnnotationHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AnnotationHistoryClient) UpdateOne(ah *AnnotationHistory) *AnnotationHistoryUpdateOne {
+	mutation := newAnnotationHistoryMutation(c.config, OpUpdateOne, withAnnotationHistory(ah))
+	return &AnnotationHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AnnotationHistoryClient) UpdateOneID(id gidx.PrefixedID) *AnnotationHistoryUpdateOne {
+	mutation := newAnnotationHistoryMutation(c.config, OpUpdateOne, withAnnotationHistoryID(id))
+	return &AnnotationHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AnnotationHistory.
+func (c *AnnotationHistoryClient) Delete() *AnnotationHistoryDelete {
+	mutation := newAnnotationHistoryMutation(c.config, OpDelete)
+	return &AnnotationHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AnnotationHistoryClient) DeleteOne(ah *AnnotationHistory) *AnnotationHistoryDeleteOne {
+	return c.DeleteOneID(ah.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AnnotationHistoryClient) DeleteOneID(id gidx.PrefixedID) *AnnotationHistoryDeleteOne {
+	builder := c.Delete().Where(annotationhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AnnotationHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for AnnotationHistory.
+func (c *AnnotationHistoryClient) Query() *AnnotationHistoryQuery {
+	return &AnnotationHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAnnotationHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AnnotationHistory entity by its id.
+func (c *AnnotationHistoryClient) Get(ctx context.Context, id gidx.PrefixedID) (*AnnotationHistory, error) {
+	return c.Query().Where(annotationhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AnnotationHistoryClient) GetX(ctx context.Context, id gidx.PrefixedID) *AnnotationHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AnnotationHistoryClient) Hooks() []Hook {
+	return c.hooks.AnnotationHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *AnnotationHistoryClient) Interceptors() []Interceptor {
+	return c.inters.AnnotationHistory
+}
+
+func (c *AnnotationHistoryClient) mutate(ctx context.Context, m *AnnotationHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AnnotationHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AnnotationHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AnnotationHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AnnotationHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown AnnotationHistory mutation op: %q", m.Op())
+	}
+}
+
 // AnnotationNamespaceClient is a client for the AnnotationNamespace schema.
 type AnnotationNamespaceClient struct {
 	config
@@ -735,6 +894,139 @@ func (c *MetadataClient) mutate(ctx context.Context, m *MetadataMutation) (Value
 	}
 }
 
+// NamespaceDeletionClient is a client for the NamespaceDeletion schema.
+type NamespaceDeletionClient struct {
+	config
+}
+
+// NewNamespaceDeletionClient returns a client for the NamespaceDeletion from the given config.
+func NewNamespaceDeletionClient(c config) *NamespaceDeletionClient {
+	return &NamespaceDeletionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `namespacedeletion.Hooks(f(g(h())))`.
+func (c *NamespaceDeletionClient) Use(hooks ...Hook) {
+	c.hooks.NamespaceDeletion = append(c.hooks.NamespaceDeletion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `namespacedeletion.Intercept(f(g(h())))`.
+func (c *NamespaceDeletionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NamespaceDeletion = append(c.inters.NamespaceDeletion, interceptors...)
+}
+
+// Create returns a builder for creating a NamespaceDeletion entity.
+func (c *NamespaceDeletionClient) Create() *NamespaceDeletionCreate {
+	mutation := newNamespaceDeletionMutation(c.config, OpCreate)
+	return &NamespaceDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NamespaceDeletion entities.
+func (c *NamespaceDeletionClient) CreateBulk(builders ...*NamespaceDeletionCreate) *NamespaceDeletionCreateBulk {
+	return &NamespaceDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NamespaceDeletionClient) MapCreateBulk(slice any, setFunc func(*NamespaceDeletionCreate, int)) *NamespaceDeletionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NamespaceDeletionCreateBulk{err: fmt.Errorf("calling to NamespaceDeletionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NamespaceDeletionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NamespaceDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NamespaceDeletion.
+func (c *NamespaceDeletionClient) Update() *NamespaceDeletionUpdate {
+	mutation := newNamespaceDeletionMutation(c.config, OpUpdate)
+	return &NamespaceDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NamespaceDeletionClient) UpdateOne(nd *NamespaceDeletion) *NamespaceDeletionUpdateOne {
+	mutation := newNamespaceDeletionMutation(c.config, OpUpdateOne, withNamespaceDeletion(nd))
+	return &NamespaceDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NamespaceDeletionClient) UpdateOneID(id gidx.PrefixedID) *NamespaceDeletionUpdateOne {
+	mutation := newNamespaceDeletionMutation(c.config, OpUpdateOne, withNamespaceDeletionID(id))
+	return &NamespaceDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NamespaceDeletion.
+func (c *NamespaceDeletionClient) Delete() *NamespaceDeletionDelete {
+	mutation := newNamespaceDeletionMutation(c.config, OpDelete)
+	return &NamespaceDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NamespaceDeletionClient) DeleteOne(nd *NamespaceDeletion) *NamespaceDeletionDeleteOne {
+	return c.DeleteOneID(nd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NamespaceDeletionClient) DeleteOneID(id gidx.PrefixedID) *NamespaceDeletionDeleteOne {
+	builder := c.Delete().Where(namespacedeletion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NamespaceDeletionDeleteOne{builder}
+}
+
+// Query returns a query builder for NamespaceDeletion.
+func (c *NamespaceDeletionClient) Query() *NamespaceDeletionQuery {
+	return &NamespaceDeletionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNamespaceDeletion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NamespaceDeletion entity by its id.
+func (c *NamespaceDeletionClient) Get(ctx context.Context, id gidx.PrefixedID) (*NamespaceDeletion, error) {
+	return c.Query().Where(namespacedeletion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NamespaceDeletionClient) GetX(ctx context.Context, id gidx.PrefixedID) *NamespaceDeletion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NamespaceDeletionClient) Hooks() []Hook {
+	return c.hooks.NamespaceDeletion
+}
+
+// Interceptors returns the client interceptors.
+func (c *NamespaceDeletionClient) Interceptors() []Interceptor {
+	return c.inters.NamespaceDeletion
+}
+
+func (c *NamespaceDeletionClient) mutate(ctx context.Context, m *NamespaceDeletionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NamespaceDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NamespaceDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NamespaceDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NamespaceDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown NamespaceDeletion mutation op: %q", m.Op())
+	}
+}
+
 // StatusClient is a client for the Status schema.
 type StatusClient struct {
 	config
@@ -900,6 +1192,139 @@ func (c *StatusClient) mutate(ctx context.Context, m *StatusMutation) (Value, er
 	}
 }
 
+// StatusHistoryClient is a client for the StatusHistory schema.
+type StatusHistoryClient struct {
+	config
+}
+
+// NewStatusHistoryClient returns a client for the StatusHistory from the given config.
+func NewStatusHistoryClient(c config) *StatusHistoryClient {
+	return &StatusHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `statushistory.Hooks(f(g(h())))`.
+func (c *StatusHistoryClient) Use(hooks ...Hook) {
+	c.hooks.StatusHistory = append(c.hooks.StatusHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `statushistory.Intercept(f(g(h())))`.
+func (c *StatusHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StatusHistory = append(c.inters.StatusHistory, interceptors...)
+}
+
+// Create returns a builder for creating a StatusHistory entity.
+func (c *StatusHistoryClient) Create() *StatusHistoryCreate {
+	mutation := newStatusHistoryMutation(c.config, OpCreate)
+	return &StatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StatusHistory entities.
+func (c *StatusHistoryClient) CreateBulk(builders ...*StatusHistoryCreate) *StatusHistoryCreateBulk {
+	return &StatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StatusHistoryClient) MapCreateBulk(slice any, setFunc func(*StatusHistoryCreate, int)) *StatusHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StatusHistoryCreateBulk{err: fmt.Errorf("calling to StatusHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StatusHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StatusHistory.
+func (c *StatusHistoryClient) Update() *StatusHistoryUpdate {
+	mutation := newStatusHistoryMutation(c.config, OpUpdate)
+	return &StatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StatusHistoryClient) UpdateOne(sh *StatusHistory) *StatusHistoryUpdateOne {
+	mutation := newStatusHistoryMutation(c.config, OpUpdateOne, withStatusHistory(sh))
+	return &StatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StatusHistoryClient) UpdateOneID(id gidx.PrefixedID) *StatusHistoryUpdateOne {
+	mutation := newStatusHistoryMutation(c.config, OpUpdateOne, withStatusHistoryID(id))
+	return &StatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StatusHistory.
+func (c *StatusHistoryClient) Delete() *StatusHistoryDelete {
+	mutation := newStatusHistoryMutation(c.config, OpDelete)
+	return &StatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StatusHistoryClient) DeleteOne(sh *StatusHistory) *StatusHistoryDeleteOne {
+	return c.DeleteOneID(sh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StatusHistoryClient) DeleteOneID(id gidx.PrefixedID) *StatusHistoryDeleteOne {
+	builder := c.Delete().Where(statushistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StatusHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for StatusHistory.
+func (c *StatusHistoryClient) Query() *StatusHistoryQuery {
+	return &StatusHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStatusHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StatusHistory entity by its id.
+func (c *StatusHistoryClient) Get(ctx context.Context, id gidx.PrefixedID) (*StatusHistory, error) {
+	return c.Query().Where(statushistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StatusHistoryClient) GetX(ctx context.Context, id gidx.PrefixedID) *StatusHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StatusHistoryClient) Hooks() []Hook {
+	return c.hooks.StatusHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *StatusHistoryClient) Interceptors() []Interceptor {
+	return c.inters.StatusHistory
+}
+
+func (c *StatusHistoryClient) mutate(ctx context.Context, m *StatusHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown StatusHistory mutation op: %q", m.Op())
+	}
+}
+
 // StatusNamespaceClient is a client for the StatusNamespace schema.
 type StatusNamespaceClient struct {
 	config
@@ -1052,10 +1477,11 @@ func (c *StatusNamespaceClient) mutate(ctx context.Context, m *StatusNamespaceMu
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Annotation, AnnotationNamespace, Metadata, Status, StatusNamespace []ent.Hook
+		Annotation, AnnotationHistory, AnnotationNamespace, Metadata, NamespaceDeletion,
+		Status, StatusHistory, StatusNamespace []ent.Hook
 	}
 	inters struct {
-		Annotation, AnnotationNamespace, Metadata, Status,
-		StatusNamespace []ent.Interceptor
+		Annotation, AnnotationHistory, AnnotationNamespace, Metadata, NamespaceDeletion,
+		Status, StatusHistory, StatusNamespace []ent.Interceptor
 	}
 )
@@ -27,9 +27,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
 )
 
@@ -92,9 +95,12 @@ func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			annotation.Table:          annotation.ValidColumn,
+			annotationhistory.Table:   annotationhistory.ValidColumn,
 			annotationnamespace.Table: annotationnamespace.ValidColumn,
 			metadata.Table:            metadata.ValidColumn,
+			namespacedeletion.Table:   namespacedeletion.ValidColumn,
 			status.Table:              status.ValidColumn,
+			statushistory.Table:       statushistory.ValidColumn,
 			statusnamespace.Table:     statusnamespace.ValidColumn,
 		})
 	})
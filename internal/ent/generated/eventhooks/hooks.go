@@ -154,6 +154,28 @@ func AnnotationHooks() []ent.Hook {
 						})
 					}
 
+					cv_version := ""
+					version, ok := m.Version()
+
+					if ok {
+						cv_version = fmt.Sprintf("%s", fmt.Sprint(version))
+						pv_version := ""
+						if !m.Op().Is(ent.OpCreate) {
+							ov, err := m.OldVersion(ctx)
+							if err != nil {
+								pv_version = "<unknown>"
+							} else {
+								pv_version = fmt.Sprintf("%s", fmt.Sprint(ov))
+							}
+						}
+
+						changeset = append(changeset, events.FieldChange{
+							Field:         "version",
+							PreviousValue: pv_version,
+							CurrentValue:  cv_version,
+						})
+					}
+
 					msg := events.ChangeMessage{
 						EventType:            eventType(m.Op()),
 						SubjectID:            objID,
@@ -290,6 +312,28 @@ func AnnotationNamespaceHooks() []ent.Hook {
 						})
 					}
 
+					cv_deleted_at := ""
+					deleted_at, ok := m.DeletedAt()
+
+					if ok {
+						cv_deleted_at = deleted_at.Format(time.RFC3339)
+						pv_deleted_at := ""
+						if !m.Op().Is(ent.OpCreate) {
+							ov, err := m.OldDeletedAt(ctx)
+							if err != nil {
+								pv_deleted_at = "<unknown>"
+							} else {
+								pv_deleted_at = ov.Format(time.RFC3339)
+							}
+						}
+
+						changeset = append(changeset, events.FieldChange{
+							Field:         "deleted_at",
+							PreviousValue: pv_deleted_at,
+							CurrentValue:  cv_deleted_at,
+						})
+					}
+
 					cv_name := ""
 					name, ok := m.Name()
 
@@ -369,6 +413,50 @@ func AnnotationNamespaceHooks() []ent.Hook {
 						})
 					}
 
+					cv_deleting := ""
+					deleting, ok := m.Deleting()
+
+					if ok {
+						cv_deleting = fmt.Sprintf("%s", fmt.Sprint(deleting))
+						pv_deleting := ""
+						if !m.Op().Is(ent.OpCreate) {
+							ov, err := m.OldDeleting(ctx)
+							if err != nil {
+								pv_deleting = "<unknown>"
+							} else {
+								pv_deleting = fmt.Sprintf("%s", fmt.Sprint(ov))
+							}
+						}
+
+						changeset = append(changeset, events.FieldChange{
+							Field:         "deleting",
+							PreviousValue: pv_deleting,
+							CurrentValue:  cv_deleting,
+						})
+					}
+
+					cv_json_schema := ""
+					json_schema, ok := m.JSONSchema()
+
+					if ok {
+						cv_json_schema = fmt.Sprintf("%s", fmt.Sprint(json_schema))
+						pv_json_schema := ""
+						if !m.Op().Is(ent.OpCreate) {
+							ov, err := m.OldJSONSchema(ctx)
+							if err != nil {
+								pv_json_schema = "<unknown>"
+							} else {
+								pv_json_schema = fmt.Sprintf("%s", fmt.Sprint(ov))
+							}
+						}
+
+						changeset = append(changeset, events.FieldChange{
+							Field:         "json_schema",
+							PreviousValue: pv_json_schema,
+							CurrentValue:  cv_json_schema,
+						})
+					}
+
 					msg := events.ChangeMessage{
 						EventType:            eventType(m.Op()),
 						SubjectID:            objID,
@@ -758,6 +846,50 @@ func StatusHooks() []ent.Hook {
 						})
 					}
 
+					cv_version := ""
+					version, ok := m.Version()
+
+					if ok {
+						cv_version = fmt.Sprintf("%s", fmt.Sprint(version))
+						pv_version := ""
+						if !m.Op().Is(ent.OpCreate) {
+							ov, err := m.OldVersion(ctx)
+							if err != nil {
+								pv_version = "<unknown>"
+							} else {
+								pv_version = fmt.Sprintf("%s", fmt.Sprint(ov))
+							}
+						}
+
+						changeset = append(changeset, events.FieldChange{
+							Field:         "version",
+							PreviousValue: pv_version,
+							CurrentValue:  cv_version,
+						})
+					}
+
+					cv_expires_at := ""
+					expires_at, ok := m.ExpiresAt()
+
+					if ok {
+						cv_expires_at = expires_at.Format(time.RFC3339)
+						pv_expires_at := ""
+						if !m.Op().Is(ent.OpCreate) {
+							ov, err := m.OldExpiresAt(ctx)
+							if err != nil {
+								pv_expires_at = "<unknown>"
+							} else {
+								pv_expires_at = ov.Format(time.RFC3339)
+							}
+						}
+
+						changeset = append(changeset, events.FieldChange{
+							Field:         "expires_at",
+							PreviousValue: pv_expires_at,
+							CurrentValue:  cv_expires_at,
+						})
+					}
+
 					msg := events.ChangeMessage{
 						EventType:            eventType(m.Op()),
 						SubjectID:            objID,
@@ -894,6 +1026,28 @@ func StatusNamespaceHooks() []ent.Hook {
 						})
 					}
 
+					cv_deleted_at := ""
+					deleted_at, ok := m.DeletedAt()
+
+					if ok {
+						cv_deleted_at = deleted_at.Format(time.RFC3339)
+						pv_deleted_at := ""
+						if !m.Op().Is(ent.OpCreate) {
+							ov, err := m.OldDeletedAt(ctx)
+							if err != nil {
+								pv_deleted_at = "<unknown>"
+							} else {
+								pv_deleted_at = ov.Format(time.RFC3339)
+							}
+						}
+
+						changeset = append(changeset, events.FieldChange{
+							Field:         "deleted_at",
+							PreviousValue: pv_deleted_at,
+							CurrentValue:  cv_deleted_at,
+						})
+					}
+
 					cv_name := ""
 					name, ok := m.Name()
 
@@ -973,6 +1127,72 @@ func StatusNamespaceHooks() []ent.Hook {
 						})
 					}
 
+					cv_deleting := ""
+					deleting, ok := m.Deleting()
+
+					if ok {
+						cv_deleting = fmt.Sprintf("%s", fmt.Sprint(deleting))
+						pv_deleting := ""
+						if !m.Op().Is(ent.OpCreate) {
+							ov, err := m.OldDeleting(ctx)
+							if err != nil {
+								pv_deleting = "<unknown>"
+							} else {
+								pv_deleting = fmt.Sprintf("%s", fmt.Sprint(ov))
+							}
+						}
+
+						changeset = append(changeset, events.FieldChange{
+							Field:         "deleting",
+							PreviousValue: pv_deleting,
+							CurrentValue:  cv_deleting,
+						})
+					}
+
+					cv_json_schema := ""
+					json_schema, ok := m.JSONSchema()
+
+					if ok {
+						cv_json_schema = fmt.Sprintf("%s", fmt.Sprint(json_schema))
+						pv_json_schema := ""
+						if !m.Op().Is(ent.OpCreate) {
+							ov, err := m.OldJSONSchema(ctx)
+							if err != nil {
+								pv_json_schema = "<unknown>"
+							} else {
+								pv_json_schema = fmt.Sprintf("%s", fmt.Sprint(ov))
+							}
+						}
+
+						changeset = append(changeset, events.FieldChange{
+							Field:         "json_schema",
+							PreviousValue: pv_json_schema,
+							CurrentValue:  cv_json_schema,
+						})
+					}
+
+					cv_default_ttl := ""
+					default_ttl, ok := m.DefaultTTL()
+
+					if ok {
+						cv_default_ttl = fmt.Sprintf("%s", fmt.Sprint(default_ttl))
+						pv_default_ttl := ""
+						if !m.Op().Is(ent.OpCreate) {
+							ov, err := m.OldDefaultTTL(ctx)
+							if err != nil {
+								pv_default_ttl = "<unknown>"
+							} else {
+								pv_default_ttl = fmt.Sprintf("%s", fmt.Sprint(ov))
+							}
+						}
+
+						changeset = append(changeset, events.FieldChange{
+							Field:         "default_ttl",
+							PreviousValue: pv_default_ttl,
+							CurrentValue:  cv_default_ttl,
+						})
+					}
+
 					msg := events.ChangeMessage{
 						EventType:            eventType(m.Op()),
 						SubjectID:            objID,
@@ -25,9 +25,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
 	"go.infratographer.com/x/gidx"
 )
@@ -101,6 +104,11 @@ func (a *AnnotationQuery) collectField(ctx context.Context, opCtx *graphql.Opera
 				selectedFields = append(selectedFields, annotation.FieldData)
 				fieldSeen[annotation.FieldData] = struct{}{}
 			}
+		case "version":
+			if _, ok := fieldSeen[annotation.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, annotation.FieldVersion)
+				fieldSeen[annotation.FieldVersion] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -164,6 +172,125 @@ func newAnnotationPaginateArgs(rv map[string]any) *annotationPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (ah *AnnotationHistoryQuery) CollectFields(ctx context.Context, satisfies ...string) (*AnnotationHistoryQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return ah, nil
+	}
+	if err := ah.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return ah, nil
+}
+
+func (ah *AnnotationHistoryQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(annotationhistory.Columns))
+		selectedFields = []string{annotationhistory.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "createdAt":
+			if _, ok := fieldSeen[annotationhistory.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, annotationhistory.FieldCreatedAt)
+				fieldSeen[annotationhistory.FieldCreatedAt] = struct{}{}
+			}
+		case "annotationID":
+			if _, ok := fieldSeen[annotationhistory.FieldAnnotationID]; !ok {
+				selectedFields = append(selectedFields, annotationhistory.FieldAnnotationID)
+				fieldSeen[annotationhistory.FieldAnnotationID] = struct{}{}
+			}
+		case "metadataID":
+			if _, ok := fieldSeen[annotationhistory.FieldMetadataID]; !ok {
+				selectedFields = append(selectedFields, annotationhistory.FieldMetadataID)
+				fieldSeen[annotationhistory.FieldMetadataID] = struct{}{}
+			}
+		case "annotationNamespaceID":
+			if _, ok := fieldSeen[annotationhistory.FieldAnnotationNamespaceID]; !ok {
+				selectedFields = append(selectedFields, annotationhistory.FieldAnnotationNamespaceID)
+				fieldSeen[annotationhistory.FieldAnnotationNamespaceID] = struct{}{}
+			}
+		case "operation":
+			if _, ok := fieldSeen[annotationhistory.FieldOperation]; !ok {
+				selectedFields = append(selectedFields, annotationhistory.FieldOperation)
+				fieldSeen[annotationhistory.FieldOperation] = struct{}{}
+			}
+		case "data":
+			if _, ok := fieldSeen[annotationhistory.FieldData]; !ok {
+				selectedFields = append(selectedFields, annotationhistory.FieldData)
+				fieldSeen[annotationhistory.FieldData] = struct{}{}
+			}
+		case "actor":
+			if _, ok := fieldSeen[annotationhistory.FieldActor]; !ok {
+				selectedFields = append(selectedFields, annotationhistory.FieldActor)
+				fieldSeen[annotationhistory.FieldActor] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		ah.Select(selectedFields...)
+	}
+	return nil
+}
+
+type annotationhistoryPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AnnotationHistoryPaginateOption
+}
+
+func newAnnotationHistoryPaginateArgs(rv map[string]any) *annotationhistoryPaginateArgs {
+	args := &annotationhistoryPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &AnnotationHistoryOrder{Field: &AnnotationHistoryOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithAnnotationHistoryOrder(order))
+			}
+		case *AnnotationHistoryOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithAnnotationHistoryOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*AnnotationHistoryWhereInput); ok {
+		args.opts = append(args.opts, WithAnnotationHistoryFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (an *AnnotationNamespaceQuery) CollectFields(ctx context.Context, satisfies ...string) (*AnnotationNamespaceQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -191,9 +318,81 @@ func (an *AnnotationNamespaceQuery) collectField(ctx context.Context, opCtx *gra
 				path  = append(path, alias)
 				query = (&AnnotationClient{config: an.config}).Query()
 			)
-			if err := query.collectField(ctx, opCtx, field, path, satisfies...); err != nil {
+			args := newAnnotationPaginateArgs(fieldArgs(ctx, new(AnnotationWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newAnnotationPager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					an.loadTotal = append(an.loadTotal, func(ctx context.Context, nodes []*AnnotationNamespace) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID gidx.PrefixedID `sql:"annotation_namespace_id"`
+							Count  int             `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(annotationnamespace.AnnotationsColumn), ids...))
+						})
+						if err := query.GroupBy(annotationnamespace.AnnotationsColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[gidx.PrefixedID]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				} else {
+					an.loadTotal = append(an.loadTotal, func(_ context.Context, nodes []*AnnotationNamespace) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.Annotations)
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
 				return err
 			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, opCtx, *field, path, mayAddCondition(satisfies, "Annotation")...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				modify := limitRows(annotationnamespace.AnnotationsColumn, limit, pager.orderExpr(query))
+				query.modifiers = append(query.modifiers, modify)
+			} else {
+				query = pager.applyOrder(query)
+			}
 			an.WithNamedAnnotations(alias, func(wq *AnnotationQuery) {
 				*wq = *query
 			})
@@ -207,6 +406,11 @@ func (an *AnnotationNamespaceQuery) collectField(ctx context.Context, opCtx *gra
 				selectedFields = append(selectedFields, annotationnamespace.FieldUpdatedAt)
 				fieldSeen[annotationnamespace.FieldUpdatedAt] = struct{}{}
 			}
+		case "deletedAt":
+			if _, ok := fieldSeen[annotationnamespace.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, annotationnamespace.FieldDeletedAt)
+				fieldSeen[annotationnamespace.FieldDeletedAt] = struct{}{}
+			}
 		case "name":
 			if _, ok := fieldSeen[annotationnamespace.FieldName]; !ok {
 				selectedFields = append(selectedFields, annotationnamespace.FieldName)
@@ -217,6 +421,16 @@ func (an *AnnotationNamespaceQuery) collectField(ctx context.Context, opCtx *gra
 				selectedFields = append(selectedFields, annotationnamespace.FieldPrivate)
 				fieldSeen[annotationnamespace.FieldPrivate] = struct{}{}
 			}
+		case "deleting":
+			if _, ok := fieldSeen[annotationnamespace.FieldDeleting]; !ok {
+				selectedFields = append(selectedFields, annotationnamespace.FieldDeleting)
+				fieldSeen[annotationnamespace.FieldDeleting] = struct{}{}
+			}
+		case "jsonSchema":
+			if _, ok := fieldSeen[annotationnamespace.FieldJSONSchema]; !ok {
+				selectedFields = append(selectedFields, annotationnamespace.FieldJSONSchema)
+				fieldSeen[annotationnamespace.FieldJSONSchema] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -547,6 +761,130 @@ func newMetadataPaginateArgs(rv map[string]any) *metadataPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (nd *NamespaceDeletionQuery) CollectFields(ctx context.Context, satisfies ...string) (*NamespaceDeletionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return nd, nil
+	}
+	if err := nd.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return nd, nil
+}
+
+func (nd *NamespaceDeletionQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(namespacedeletion.Columns))
+		selectedFields = []string{namespacedeletion.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "createdAt":
+			if _, ok := fieldSeen[namespacedeletion.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, namespacedeletion.FieldCreatedAt)
+				fieldSeen[namespacedeletion.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[namespacedeletion.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, namespacedeletion.FieldUpdatedAt)
+				fieldSeen[namespacedeletion.FieldUpdatedAt] = struct{}{}
+			}
+		case "namespaceID":
+			if _, ok := fieldSeen[namespacedeletion.FieldNamespaceID]; !ok {
+				selectedFields = append(selectedFields, namespacedeletion.FieldNamespaceID)
+				fieldSeen[namespacedeletion.FieldNamespaceID] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[namespacedeletion.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, namespacedeletion.FieldOwnerID)
+				fieldSeen[namespacedeletion.FieldOwnerID] = struct{}{}
+			}
+		case "state":
+			if _, ok := fieldSeen[namespacedeletion.FieldState]; !ok {
+				selectedFields = append(selectedFields, namespacedeletion.FieldState)
+				fieldSeen[namespacedeletion.FieldState] = struct{}{}
+			}
+		case "totalCount":
+			if _, ok := fieldSeen[namespacedeletion.FieldTotalCount]; !ok {
+				selectedFields = append(selectedFields, namespacedeletion.FieldTotalCount)
+				fieldSeen[namespacedeletion.FieldTotalCount] = struct{}{}
+			}
+		case "deletedCount":
+			if _, ok := fieldSeen[namespacedeletion.FieldDeletedCount]; !ok {
+				selectedFields = append(selectedFields, namespacedeletion.FieldDeletedCount)
+				fieldSeen[namespacedeletion.FieldDeletedCount] = struct{}{}
+			}
+		case "completedAt":
+			if _, ok := fieldSeen[namespacedeletion.FieldCompletedAt]; !ok {
+				selectedFields = append(selectedFields, namespacedeletion.FieldCompletedAt)
+				fieldSeen[namespacedeletion.FieldCompletedAt] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		nd.Select(selectedFields...)
+	}
+	return nil
+}
+
+type namespacedeletionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []NamespaceDeletionPaginateOption
+}
+
+func newNamespaceDeletionPaginateArgs(rv map[string]any) *namespacedeletionPaginateArgs {
+	args := &namespacedeletionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &NamespaceDeletionOrder{Field: &NamespaceDeletionOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithNamespaceDeletionOrder(order))
+			}
+		case *NamespaceDeletionOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithNamespaceDeletionOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*NamespaceDeletionWhereInput); ok {
+		args.opts = append(args.opts, WithNamespaceDeletionFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (s *StatusQuery) CollectFields(ctx context.Context, satisfies ...string) (*StatusQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -626,6 +964,16 @@ func (s *StatusQuery) collectField(ctx context.Context, opCtx *graphql.Operation
 				selectedFields = append(selectedFields, status.FieldData)
 				fieldSeen[status.FieldData] = struct{}{}
 			}
+		case "version":
+			if _, ok := fieldSeen[status.FieldVersion]; !ok {
+				selectedFields = append(selectedFields, status.FieldVersion)
+				fieldSeen[status.FieldVersion] = struct{}{}
+			}
+		case "expiresAt":
+			if _, ok := fieldSeen[status.FieldExpiresAt]; !ok {
+				selectedFields = append(selectedFields, status.FieldExpiresAt)
+				fieldSeen[status.FieldExpiresAt] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -689,6 +1037,130 @@ func newStatusPaginateArgs(rv map[string]any) *statusPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (sh *StatusHistoryQuery) CollectFields(ctx context.Context, satisfies ...string) (*StatusHistoryQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return sh, nil
+	}
+	if err := sh.collectField(ctx, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return sh, nil
+}
+
+func (sh *StatusHistoryQuery) collectField(ctx context.Context, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(statushistory.Columns))
+		selectedFields = []string{statushistory.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "createdAt":
+			if _, ok := fieldSeen[statushistory.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, statushistory.FieldCreatedAt)
+				fieldSeen[statushistory.FieldCreatedAt] = struct{}{}
+			}
+		case "statusID":
+			if _, ok := fieldSeen[statushistory.FieldStatusID]; !ok {
+				selectedFields = append(selectedFields, statushistory.FieldStatusID)
+				fieldSeen[statushistory.FieldStatusID] = struct{}{}
+			}
+		case "metadataID":
+			if _, ok := fieldSeen[statushistory.FieldMetadataID]; !ok {
+				selectedFields = append(selectedFields, statushistory.FieldMetadataID)
+				fieldSeen[statushistory.FieldMetadataID] = struct{}{}
+			}
+		case "statusNamespaceID":
+			if _, ok := fieldSeen[statushistory.FieldStatusNamespaceID]; !ok {
+				selectedFields = append(selectedFields, statushistory.FieldStatusNamespaceID)
+				fieldSeen[statushistory.FieldStatusNamespaceID] = struct{}{}
+			}
+		case "source":
+			if _, ok := fieldSeen[statushistory.FieldSource]; !ok {
+				selectedFields = append(selectedFields, statushistory.FieldSource)
+				fieldSeen[statushistory.FieldSource] = struct{}{}
+			}
+		case "operation":
+			if _, ok := fieldSeen[statushistory.FieldOperation]; !ok {
+				selectedFields = append(selectedFields, statushistory.FieldOperation)
+				fieldSeen[statushistory.FieldOperation] = struct{}{}
+			}
+		case "data":
+			if _, ok := fieldSeen[statushistory.FieldData]; !ok {
+				selectedFields = append(selectedFields, statushistory.FieldData)
+				fieldSeen[statushistory.FieldData] = struct{}{}
+			}
+		case "actor":
+			if _, ok := fieldSeen[statushistory.FieldActor]; !ok {
+				selectedFields = append(selectedFields, statushistory.FieldActor)
+				fieldSeen[statushistory.FieldActor] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		sh.Select(selectedFields...)
+	}
+	return nil
+}
+
+type statushistoryPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []StatusHistoryPaginateOption
+}
+
+func newStatusHistoryPaginateArgs(rv map[string]any) *statushistoryPaginateArgs {
+	args := &statushistoryPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &StatusHistoryOrder{Field: &StatusHistoryOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithStatusHistoryOrder(order))
+			}
+		case *StatusHistoryOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithStatusHistoryOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*StatusHistoryWhereInput); ok {
+		args.opts = append(args.opts, WithStatusHistoryFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (sn *StatusNamespaceQuery) CollectFields(ctx context.Context, satisfies ...string) (*StatusNamespaceQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -710,6 +1182,90 @@ func (sn *StatusNamespaceQuery) collectField(ctx context.Context, opCtx *graphql
 	)
 	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
 		switch field.Name {
+		case "statuses":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&StatusClient{config: sn.config}).Query()
+			)
+			args := newStatusPaginateArgs(fieldArgs(ctx, new(StatusWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newStatusPager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					sn.loadTotal = append(sn.loadTotal, func(ctx context.Context, nodes []*StatusNamespace) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID gidx.PrefixedID `sql:"status_namespace_id"`
+							Count  int             `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(statusnamespace.StatusesColumn), ids...))
+						})
+						if err := query.GroupBy(statusnamespace.StatusesColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[gidx.PrefixedID]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				} else {
+					sn.loadTotal = append(sn.loadTotal, func(_ context.Context, nodes []*StatusNamespace) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.Statuses)
+							if nodes[i].Edges.totalCount[0] == nil {
+								nodes[i].Edges.totalCount[0] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[0][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, opCtx, *field, path, mayAddCondition(satisfies, "Status")...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				modify := limitRows(statusnamespace.StatusesColumn, limit, pager.orderExpr(query))
+				query.modifiers = append(query.modifiers, modify)
+			} else {
+				query = pager.applyOrder(query)
+			}
+			sn.WithNamedStatuses(alias, func(wq *StatusQuery) {
+				*wq = *query
+			})
 		case "createdAt":
 			if _, ok := fieldSeen[statusnamespace.FieldCreatedAt]; !ok {
 				selectedFields = append(selectedFields, statusnamespace.FieldCreatedAt)
@@ -720,6 +1276,11 @@ func (sn *StatusNamespaceQuery) collectField(ctx context.Context, opCtx *graphql
 				selectedFields = append(selectedFields, statusnamespace.FieldUpdatedAt)
 				fieldSeen[statusnamespace.FieldUpdatedAt] = struct{}{}
 			}
+		case "deletedAt":
+			if _, ok := fieldSeen[statusnamespace.FieldDeletedAt]; !ok {
+				selectedFields = append(selectedFields, statusnamespace.FieldDeletedAt)
+				fieldSeen[statusnamespace.FieldDeletedAt] = struct{}{}
+			}
 		case "name":
 			if _, ok := fieldSeen[statusnamespace.FieldName]; !ok {
 				selectedFields = append(selectedFields, statusnamespace.FieldName)
@@ -730,6 +1291,21 @@ func (sn *StatusNamespaceQuery) collectField(ctx context.Context, opCtx *graphql
 				selectedFields = append(selectedFields, statusnamespace.FieldPrivate)
 				fieldSeen[statusnamespace.FieldPrivate] = struct{}{}
 			}
+		case "deleting":
+			if _, ok := fieldSeen[statusnamespace.FieldDeleting]; !ok {
+				selectedFields = append(selectedFields, statusnamespace.FieldDeleting)
+				fieldSeen[statusnamespace.FieldDeleting] = struct{}{}
+			}
+		case "jsonSchema":
+			if _, ok := fieldSeen[statusnamespace.FieldJSONSchema]; !ok {
+				selectedFields = append(selectedFields, statusnamespace.FieldJSONSchema)
+				fieldSeen[statusnamespace.FieldJSONSchema] = struct{}{}
+			}
+		case "defaultTTL":
+			if _, ok := fieldSeen[statusnamespace.FieldDefaultTTL]; !ok {
+				selectedFields = append(selectedFields, statusnamespace.FieldDefaultTTL)
+				fieldSeen[statusnamespace.FieldDefaultTTL] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -38,16 +38,25 @@ func (a *Annotation) Metadata(ctx context.Context) (*Metadata, error) {
 	return result, err
 }
 
-func (an *AnnotationNamespace) Annotations(ctx context.Context) (result []*Annotation, err error) {
-	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
-		result, err = an.NamedAnnotations(graphql.GetFieldContext(ctx).Field.Alias)
-	} else {
-		result, err = an.Edges.AnnotationsOrErr()
+func (an *AnnotationNamespace) Annotations(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *AnnotationOrder, where *AnnotationWhereInput,
+) (*AnnotationConnection, error) {
+	opts := []AnnotationPaginateOption{
+		WithAnnotationOrder(orderBy),
+		WithAnnotationFilter(where.Filter),
 	}
-	if IsNotLoaded(err) {
-		result, err = an.QueryAnnotations().All(ctx)
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := an.Edges.totalCount[0][alias]
+	if nodes, err := an.NamedAnnotations(alias); err == nil || hasTotalCount {
+		pager, err := newAnnotationPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &AnnotationConnection{Edges: []*AnnotationEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
 	}
-	return result, err
+	return an.QueryAnnotations().Paginate(ctx, after, first, before, last, opts...)
 }
 
 func (m *Metadata) Annotations(
@@ -107,3 +116,24 @@ func (s *Status) Metadata(ctx context.Context) (*Metadata, error) {
 	}
 	return result, err
 }
+
+func (sn *StatusNamespace) Statuses(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *StatusOrder, where *StatusWhereInput,
+) (*StatusConnection, error) {
+	opts := []StatusPaginateOption{
+		WithStatusOrder(orderBy),
+		WithStatusFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := sn.Edges.totalCount[0][alias]
+	if nodes, err := sn.NamedStatuses(alias); err == nil || hasTotalCount {
+		pager, err := newStatusPager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &StatusConnection{Edges: []*StatusEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return sn.QueryStatuses().Paginate(ctx, after, first, before, last, opts...)
+}
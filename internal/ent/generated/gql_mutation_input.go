@@ -24,9 +24,10 @@ import (
 
 // CreateAnnotationNamespaceInput represents a mutation input for creating annotationnamespaces.
 type CreateAnnotationNamespaceInput struct {
-	Name    string
-	OwnerID gidx.PrefixedID
-	Private *bool
+	Name       string
+	OwnerID    gidx.PrefixedID
+	Private    *bool
+	JSONSchema json.RawMessage
 }
 
 // Mutate applies the CreateAnnotationNamespaceInput on the AnnotationNamespaceMutation builder.
@@ -36,6 +37,9 @@ func (i *CreateAnnotationNamespaceInput) Mutate(m *AnnotationNamespaceMutation)
 	if v := i.Private; v != nil {
 		m.SetPrivate(*v)
 	}
+	if v := i.JSONSchema; v != nil {
+		m.SetJSONSchema(v)
+	}
 }
 
 // SetInput applies the change-set in the CreateAnnotationNamespaceInput on the AnnotationNamespaceCreate builder.
@@ -46,8 +50,11 @@ func (c *AnnotationNamespaceCreate) SetInput(i CreateAnnotationNamespaceInput) *
 
 // UpdateAnnotationNamespaceInput represents a mutation input for updating annotationnamespaces.
 type UpdateAnnotationNamespaceInput struct {
-	Name    *string
-	Private *bool
+	Name             *string
+	Private          *bool
+	ClearJSONSchema  bool
+	JSONSchema       json.RawMessage
+	AppendJSONSchema json.RawMessage
 }
 
 // Mutate applies the UpdateAnnotationNamespaceInput on the AnnotationNamespaceMutation builder.
@@ -58,6 +65,15 @@ func (i *UpdateAnnotationNamespaceInput) Mutate(m *AnnotationNamespaceMutation)
 	if v := i.Private; v != nil {
 		m.SetPrivate(*v)
 	}
+	if i.ClearJSONSchema {
+		m.ClearJSONSchema()
+	}
+	if v := i.JSONSchema; v != nil {
+		m.SetJSONSchema(v)
+	}
+	if i.AppendJSONSchema != nil {
+		m.AppendJSONSchema(i.JSONSchema)
+	}
 }
 
 // SetInput applies the change-set in the UpdateAnnotationNamespaceInput on the AnnotationNamespaceUpdate builder.
@@ -129,6 +145,8 @@ type CreateStatusNamespaceInput struct {
 	Name               string
 	ResourceProviderID gidx.PrefixedID
 	Private            *bool
+	JSONSchema         json.RawMessage
+	DefaultTTL         *int64
 }
 
 // Mutate applies the CreateStatusNamespaceInput on the StatusNamespaceMutation builder.
@@ -138,6 +156,12 @@ func (i *CreateStatusNamespaceInput) Mutate(m *StatusNamespaceMutation) {
 	if v := i.Private; v != nil {
 		m.SetPrivate(*v)
 	}
+	if v := i.JSONSchema; v != nil {
+		m.SetJSONSchema(v)
+	}
+	if v := i.DefaultTTL; v != nil {
+		m.SetDefaultTTL(*v)
+	}
 }
 
 // SetInput applies the change-set in the CreateStatusNamespaceInput on the StatusNamespaceCreate builder.
@@ -148,8 +172,13 @@ func (c *StatusNamespaceCreate) SetInput(i CreateStatusNamespaceInput) *StatusNa
 
 // UpdateStatusNamespaceInput represents a mutation input for updating statusnamespaces.
 type UpdateStatusNamespaceInput struct {
-	Name    *string
-	Private *bool
+	Name             *string
+	Private          *bool
+	ClearJSONSchema  bool
+	JSONSchema       json.RawMessage
+	AppendJSONSchema json.RawMessage
+	ClearDefaultTTL  bool
+	DefaultTTL       *int64
 }
 
 // Mutate applies the UpdateStatusNamespaceInput on the StatusNamespaceMutation builder.
@@ -160,6 +189,21 @@ func (i *UpdateStatusNamespaceInput) Mutate(m *StatusNamespaceMutation) {
 	if v := i.Private; v != nil {
 		m.SetPrivate(*v)
 	}
+	if i.ClearJSONSchema {
+		m.ClearJSONSchema()
+	}
+	if v := i.JSONSchema; v != nil {
+		m.SetJSONSchema(v)
+	}
+	if i.AppendJSONSchema != nil {
+		m.AppendJSONSchema(i.JSONSchema)
+	}
+	if i.ClearDefaultTTL {
+		m.ClearDefaultTTL()
+	}
+	if v := i.DefaultTTL; v != nil {
+		m.SetDefaultTTL(*v)
+	}
 }
 
 // SetInput applies the change-set in the UpdateStatusNamespaceInput on the StatusNamespaceUpdate builder.
@@ -24,9 +24,12 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/hashicorp/go-multierror"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
 	"go.infratographer.com/x/gidx"
 )
@@ -39,15 +42,24 @@ type Noder interface {
 // IsNode implements the Node interface check for GQLGen.
 func (n *Annotation) IsNode() {}
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *AnnotationHistory) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *AnnotationNamespace) IsNode() {}
 
 // IsNode implements the Node interface check for GQLGen.
 func (n *Metadata) IsNode() {}
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *NamespaceDeletion) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *Status) IsNode() {}
 
+// IsNode implements the Node interface check for GQLGen.
+func (n *StatusHistory) IsNode() {}
+
 // IsNode implements the Node interface check for GQLGen.
 func (n *StatusNamespace) IsNode() {}
 
@@ -125,6 +137,22 @@ func (c *Client) noder(ctx context.Context, table string, id gidx.PrefixedID) (N
 			return nil, err
 		}
 		return n, nil
+	case annotationhistory.Table:
+		var uid gidx.PrefixedID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.AnnotationHistory.Query().
+			Where(annotationhistory.ID(uid))
+		query, err := query.CollectFields(ctx, "AnnotationHistory")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case annotationnamespace.Table:
 		var uid gidx.PrefixedID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -157,6 +185,22 @@ func (c *Client) noder(ctx context.Context, table string, id gidx.PrefixedID) (N
 			return nil, err
 		}
 		return n, nil
+	case namespacedeletion.Table:
+		var uid gidx.PrefixedID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.NamespaceDeletion.Query().
+			Where(namespacedeletion.ID(uid))
+		query, err := query.CollectFields(ctx, "NamespaceDeletion")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case status.Table:
 		var uid gidx.PrefixedID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -173,6 +217,22 @@ func (c *Client) noder(ctx context.Context, table string, id gidx.PrefixedID) (N
 			return nil, err
 		}
 		return n, nil
+	case statushistory.Table:
+		var uid gidx.PrefixedID
+		if err := uid.UnmarshalGQL(id); err != nil {
+			return nil, err
+		}
+		query := c.StatusHistory.Query().
+			Where(statushistory.ID(uid))
+		query, err := query.CollectFields(ctx, "StatusHistory")
+		if err != nil {
+			return nil, err
+		}
+		n, err := query.Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
 	case statusnamespace.Table:
 		var uid gidx.PrefixedID
 		if err := uid.UnmarshalGQL(id); err != nil {
@@ -278,6 +338,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []gidx.PrefixedID
 				*noder = node
 			}
 		}
+	case annotationhistory.Table:
+		query := c.AnnotationHistory.Query().
+			Where(annotationhistory.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "AnnotationHistory")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case annotationnamespace.Table:
 		query := c.AnnotationNamespace.Query().
 			Where(annotationnamespace.IDIn(ids...))
@@ -310,6 +386,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []gidx.PrefixedID
 				*noder = node
 			}
 		}
+	case namespacedeletion.Table:
+		query := c.NamespaceDeletion.Query().
+			Where(namespacedeletion.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "NamespaceDeletion")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case status.Table:
 		query := c.Status.Query().
 			Where(status.IDIn(ids...))
@@ -326,6 +418,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []gidx.PrefixedID
 				*noder = node
 			}
 		}
+	case statushistory.Table:
+		query := c.StatusHistory.Query().
+			Where(statushistory.IDIn(ids...))
+		query, err := query.CollectFields(ctx, "StatusHistory")
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case statusnamespace.Table:
 		query := c.StatusNamespace.Query().
 			Where(statusnamespace.IDIn(ids...))
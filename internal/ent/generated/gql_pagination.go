@@ -30,9 +30,12 @@ import (
 	"github.com/99designs/gqlgen/graphql/errcode"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
 	"go.infratographer.com/x/gidx"
 )
@@ -428,6 +431,299 @@ func (a *Annotation) ToEdge(order *AnnotationOrder) *AnnotationEdge {
 	}
 }
 
+// AnnotationHistoryEdge is the edge representation of AnnotationHistory.
+type AnnotationHistoryEdge struct {
+	Node   *AnnotationHistory `json:"node"`
+	Cursor Cursor             `json:"cursor"`
+}
+
+// AnnotationHistoryConnection is the connection containing edges to AnnotationHistory.
+type AnnotationHistoryConnection struct {
+	Edges      []*AnnotationHistoryEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
+}
+
+func (c *AnnotationHistoryConnection) build(nodes []*AnnotationHistory, pager *annotationhistoryPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *AnnotationHistory
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *AnnotationHistory {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *AnnotationHistory {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*AnnotationHistoryEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &AnnotationHistoryEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// AnnotationHistoryPaginateOption enables pagination customization.
+type AnnotationHistoryPaginateOption func(*annotationhistoryPager) error
+
+// WithAnnotationHistoryOrder configures pagination ordering.
+func WithAnnotationHistoryOrder(order *AnnotationHistoryOrder) AnnotationHistoryPaginateOption {
+	if order == nil {
+		order = DefaultAnnotationHistoryOrder
+	}
+	o := *order
+	return func(pager *annotationhistoryPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultAnnotationHistoryOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithAnnotationHistoryFilter configures pagination filter.
+func WithAnnotationHistoryFilter(filter func(*AnnotationHistoryQuery) (*AnnotationHistoryQuery, error)) AnnotationHistoryPaginateOption {
+	return func(pager *annotationhistoryPager) error {
+		if filter == nil {
+			return errors.New("AnnotationHistoryQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type annotationhistoryPager struct {
+	reverse bool
+	order   *AnnotationHistoryOrder
+	filter  func(*AnnotationHistoryQuery) (*AnnotationHistoryQuery, error)
+}
+
+func newAnnotationHistoryPager(opts []AnnotationHistoryPaginateOption, reverse bool) (*annotationhistoryPager, error) {
+	pager := &annotationhistoryPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultAnnotationHistoryOrder
+	}
+	return pager, nil
+}
+
+func (p *annotationhistoryPager) applyFilter(query *AnnotationHistoryQuery) (*AnnotationHistoryQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *annotationhistoryPager) toCursor(ah *AnnotationHistory) Cursor {
+	return p.order.Field.toCursor(ah)
+}
+
+func (p *annotationhistoryPager) applyCursors(query *AnnotationHistoryQuery, after, before *Cursor) (*AnnotationHistoryQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultAnnotationHistoryOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *annotationhistoryPager) applyOrder(query *AnnotationHistoryQuery) *AnnotationHistoryQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultAnnotationHistoryOrder.Field {
+		query = query.Order(DefaultAnnotationHistoryOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *annotationhistoryPager) orderExpr(query *AnnotationHistoryQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultAnnotationHistoryOrder.Field {
+			b.Comma().Ident(DefaultAnnotationHistoryOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to AnnotationHistory.
+func (ah *AnnotationHistoryQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...AnnotationHistoryPaginateOption,
+) (*AnnotationHistoryConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newAnnotationHistoryPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if ah, err = pager.applyFilter(ah); err != nil {
+		return nil, err
+	}
+	conn := &AnnotationHistoryConnection{Edges: []*AnnotationHistoryEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = ah.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if ah, err = pager.applyCursors(ah, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		ah.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := ah.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	ah = pager.applyOrder(ah)
+	nodes, err := ah.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// AnnotationHistoryOrderFieldCreatedAt orders AnnotationHistory by created_at.
+	AnnotationHistoryOrderFieldCreatedAt = &AnnotationHistoryOrderField{
+		Value: func(ah *AnnotationHistory) (ent.Value, error) {
+			return ah.CreatedAt, nil
+		},
+		column: annotationhistory.FieldCreatedAt,
+		toTerm: annotationhistory.ByCreatedAt,
+		toCursor: func(ah *AnnotationHistory) Cursor {
+			return Cursor{
+				ID:    ah.ID,
+				Value: ah.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f AnnotationHistoryOrderField) String() string {
+	var str string
+	switch f.column {
+	case AnnotationHistoryOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f AnnotationHistoryOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *AnnotationHistoryOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("AnnotationHistoryOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *AnnotationHistoryOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid AnnotationHistoryOrderField", str)
+	}
+	return nil
+}
+
+// AnnotationHistoryOrderField defines the ordering field of AnnotationHistory.
+type AnnotationHistoryOrderField struct {
+	// Value extracts the ordering value from the given AnnotationHistory.
+	Value    func(*AnnotationHistory) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) annotationhistory.OrderOption
+	toCursor func(*AnnotationHistory) Cursor
+}
+
+// AnnotationHistoryOrder defines the ordering of AnnotationHistory.
+type AnnotationHistoryOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *AnnotationHistoryOrderField `json:"field"`
+}
+
+// DefaultAnnotationHistoryOrder is the default ordering of AnnotationHistory.
+var DefaultAnnotationHistoryOrder = &AnnotationHistoryOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &AnnotationHistoryOrderField{
+		Value: func(ah *AnnotationHistory) (ent.Value, error) {
+			return ah.ID, nil
+		},
+		column: annotationhistory.FieldID,
+		toTerm: annotationhistory.ByID,
+		toCursor: func(ah *AnnotationHistory) Cursor {
+			return Cursor{ID: ah.ID}
+		},
+	},
+}
+
+// ToEdge converts AnnotationHistory into AnnotationHistoryEdge.
+func (ah *AnnotationHistory) ToEdge(order *AnnotationHistoryOrder) *AnnotationHistoryEdge {
+	if order == nil {
+		order = DefaultAnnotationHistoryOrder
+	}
+	return &AnnotationHistoryEdge{
+		Node:   ah,
+		Cursor: order.Field.toCursor(ah),
+	}
+}
+
 // AnnotationNamespaceEdge is the edge representation of AnnotationNamespace.
 type AnnotationNamespaceEdge struct {
 	Node   *AnnotationNamespace `json:"node"`
@@ -1122,20 +1418,20 @@ func (m *Metadata) ToEdge(order *MetadataOrder) *MetadataEdge {
 	}
 }
 
-// StatusEdge is the edge representation of Status.
-type StatusEdge struct {
-	Node   *Status `json:"node"`
-	Cursor Cursor  `json:"cursor"`
+// NamespaceDeletionEdge is the edge representation of NamespaceDeletion.
+type NamespaceDeletionEdge struct {
+	Node   *NamespaceDeletion `json:"node"`
+	Cursor Cursor             `json:"cursor"`
 }
 
-// StatusConnection is the connection containing edges to Status.
-type StatusConnection struct {
-	Edges      []*StatusEdge `json:"edges"`
-	PageInfo   PageInfo      `json:"pageInfo"`
-	TotalCount int           `json:"totalCount"`
+// NamespaceDeletionConnection is the connection containing edges to NamespaceDeletion.
+type NamespaceDeletionConnection struct {
+	Edges      []*NamespaceDeletionEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
 }
 
-func (c *StatusConnection) build(nodes []*Status, pager *statusPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *NamespaceDeletionConnection) build(nodes []*NamespaceDeletion, pager *namespacedeletionPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && *first+1 == len(nodes) {
@@ -1145,21 +1441,21 @@ func (c *StatusConnection) build(nodes []*Status, pager *statusPager, after *Cur
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-	var nodeAt func(int) *Status
+	var nodeAt func(int) *NamespaceDeletion
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Status {
+		nodeAt = func(i int) *NamespaceDeletion {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Status {
+		nodeAt = func(i int) *NamespaceDeletion {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*StatusEdge, len(nodes))
+	c.Edges = make([]*NamespaceDeletionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &StatusEdge{
+		c.Edges[i] = &NamespaceDeletionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -1173,87 +1469,87 @@ func (c *StatusConnection) build(nodes []*Status, pager *statusPager, after *Cur
 	}
 }
 
-// StatusPaginateOption enables pagination customization.
-type StatusPaginateOption func(*statusPager) error
+// NamespaceDeletionPaginateOption enables pagination customization.
+type NamespaceDeletionPaginateOption func(*namespacedeletionPager) error
 
-// WithStatusOrder configures pagination ordering.
-func WithStatusOrder(order *StatusOrder) StatusPaginateOption {
+// WithNamespaceDeletionOrder configures pagination ordering.
+func WithNamespaceDeletionOrder(order *NamespaceDeletionOrder) NamespaceDeletionPaginateOption {
 	if order == nil {
-		order = DefaultStatusOrder
+		order = DefaultNamespaceDeletionOrder
 	}
 	o := *order
-	return func(pager *statusPager) error {
+	return func(pager *namespacedeletionPager) error {
 		if err := o.Direction.Validate(); err != nil {
 			return err
 		}
 		if o.Field == nil {
-			o.Field = DefaultStatusOrder.Field
+			o.Field = DefaultNamespaceDeletionOrder.Field
 		}
 		pager.order = &o
 		return nil
 	}
 }
 
-// WithStatusFilter configures pagination filter.
-func WithStatusFilter(filter func(*StatusQuery) (*StatusQuery, error)) StatusPaginateOption {
-	return func(pager *statusPager) error {
+// WithNamespaceDeletionFilter configures pagination filter.
+func WithNamespaceDeletionFilter(filter func(*NamespaceDeletionQuery) (*NamespaceDeletionQuery, error)) NamespaceDeletionPaginateOption {
+	return func(pager *namespacedeletionPager) error {
 		if filter == nil {
-			return errors.New("StatusQuery filter cannot be nil")
+			return errors.New("NamespaceDeletionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type statusPager struct {
+type namespacedeletionPager struct {
 	reverse bool
-	order   *StatusOrder
-	filter  func(*StatusQuery) (*StatusQuery, error)
+	order   *NamespaceDeletionOrder
+	filter  func(*NamespaceDeletionQuery) (*NamespaceDeletionQuery, error)
 }
 
-func newStatusPager(opts []StatusPaginateOption, reverse bool) (*statusPager, error) {
-	pager := &statusPager{reverse: reverse}
+func newNamespaceDeletionPager(opts []NamespaceDeletionPaginateOption, reverse bool) (*namespacedeletionPager, error) {
+	pager := &namespacedeletionPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultStatusOrder
+		pager.order = DefaultNamespaceDeletionOrder
 	}
 	return pager, nil
 }
 
-func (p *statusPager) applyFilter(query *StatusQuery) (*StatusQuery, error) {
+func (p *namespacedeletionPager) applyFilter(query *NamespaceDeletionQuery) (*NamespaceDeletionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *statusPager) toCursor(s *Status) Cursor {
-	return p.order.Field.toCursor(s)
+func (p *namespacedeletionPager) toCursor(nd *NamespaceDeletion) Cursor {
+	return p.order.Field.toCursor(nd)
 }
 
-func (p *statusPager) applyCursors(query *StatusQuery, after, before *Cursor) (*StatusQuery, error) {
+func (p *namespacedeletionPager) applyCursors(query *NamespaceDeletionQuery, after, before *Cursor) (*NamespaceDeletionQuery, error) {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultStatusOrder.Field.column, p.order.Field.column, direction) {
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultNamespaceDeletionOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *statusPager) applyOrder(query *StatusQuery) *StatusQuery {
+func (p *namespacedeletionPager) applyOrder(query *NamespaceDeletionQuery) *NamespaceDeletionQuery {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
 	}
 	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultStatusOrder.Field {
-		query = query.Order(DefaultStatusOrder.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultNamespaceDeletionOrder.Field {
+		query = query.Order(DefaultNamespaceDeletionOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	if len(query.ctx.Fields) > 0 {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
@@ -1261,7 +1557,7 @@ func (p *statusPager) applyOrder(query *StatusQuery) *StatusQuery {
 	return query
 }
 
-func (p *statusPager) orderExpr(query *StatusQuery) sql.Querier {
+func (p *namespacedeletionPager) orderExpr(query *NamespaceDeletionQuery) sql.Querier {
 	direction := p.order.Direction
 	if p.reverse {
 		direction = direction.Reverse()
@@ -1271,33 +1567,33 @@ func (p *statusPager) orderExpr(query *StatusQuery) sql.Querier {
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
 		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultStatusOrder.Field {
-			b.Comma().Ident(DefaultStatusOrder.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultNamespaceDeletionOrder.Field {
+			b.Comma().Ident(DefaultNamespaceDeletionOrder.Field.column).Pad().WriteString(string(direction))
 		}
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Status.
-func (s *StatusQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to NamespaceDeletion.
+func (nd *NamespaceDeletionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...StatusPaginateOption,
-) (*StatusConnection, error) {
+	before *Cursor, last *int, opts ...NamespaceDeletionPaginateOption,
+) (*NamespaceDeletionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newStatusPager(opts, last != nil)
+	pager, err := newNamespaceDeletionPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	if s, err = pager.applyFilter(s); err != nil {
+	if nd, err = pager.applyFilter(nd); err != nil {
 		return nil, err
 	}
-	conn := &StatusConnection{Edges: []*StatusEdge{}}
+	conn := &NamespaceDeletionConnection{Edges: []*NamespaceDeletionEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
 		if hasPagination || ignoredEdges {
-			if conn.TotalCount, err = s.Clone().Count(ctx); err != nil {
+			if conn.TotalCount, err = nd.Clone().Count(ctx); err != nil {
 				return nil, err
 			}
 			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
@@ -1307,19 +1603,19 @@ func (s *StatusQuery) Paginate(
 	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
 		return conn, nil
 	}
-	if s, err = pager.applyCursors(s, after, before); err != nil {
+	if nd, err = pager.applyCursors(nd, after, before); err != nil {
 		return nil, err
 	}
 	if limit := paginateLimit(first, last); limit != 0 {
-		s.Limit(limit)
+		nd.Limit(limit)
 	}
 	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := s.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+		if err := nd.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
 			return nil, err
 		}
 	}
-	s = pager.applyOrder(s)
-	nodes, err := s.All(ctx)
+	nd = pager.applyOrder(nd)
+	nodes, err := nd.All(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1328,10 +1624,321 @@ func (s *StatusQuery) Paginate(
 }
 
 var (
-	// StatusOrderFieldCreatedAt orders Status by created_at.
-	StatusOrderFieldCreatedAt = &StatusOrderField{
-		Value: func(s *Status) (ent.Value, error) {
-			return s.CreatedAt, nil
+	// NamespaceDeletionOrderFieldCreatedAt orders NamespaceDeletion by created_at.
+	NamespaceDeletionOrderFieldCreatedAt = &NamespaceDeletionOrderField{
+		Value: func(nd *NamespaceDeletion) (ent.Value, error) {
+			return nd.CreatedAt, nil
+		},
+		column: namespacedeletion.FieldCreatedAt,
+		toTerm: namespacedeletion.ByCreatedAt,
+		toCursor: func(nd *NamespaceDeletion) Cursor {
+			return Cursor{
+				ID:    nd.ID,
+				Value: nd.CreatedAt,
+			}
+		},
+	}
+	// NamespaceDeletionOrderFieldUpdatedAt orders NamespaceDeletion by updated_at.
+	NamespaceDeletionOrderFieldUpdatedAt = &NamespaceDeletionOrderField{
+		Value: func(nd *NamespaceDeletion) (ent.Value, error) {
+			return nd.UpdatedAt, nil
+		},
+		column: namespacedeletion.FieldUpdatedAt,
+		toTerm: namespacedeletion.ByUpdatedAt,
+		toCursor: func(nd *NamespaceDeletion) Cursor {
+			return Cursor{
+				ID:    nd.ID,
+				Value: nd.UpdatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f NamespaceDeletionOrderField) String() string {
+	var str string
+	switch f.column {
+	case NamespaceDeletionOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	case NamespaceDeletionOrderFieldUpdatedAt.column:
+		str = "UPDATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f NamespaceDeletionOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *NamespaceDeletionOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("NamespaceDeletionOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *NamespaceDeletionOrderFieldCreatedAt
+	case "UPDATED_AT":
+		*f = *NamespaceDeletionOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid NamespaceDeletionOrderField", str)
+	}
+	return nil
+}
+
+// NamespaceDeletionOrderField defines the ordering field of NamespaceDeletion.
+type NamespaceDeletionOrderField struct {
+	// Value extracts the ordering value from the given NamespaceDeletion.
+	Value    func(*NamespaceDeletion) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) namespacedeletion.OrderOption
+	toCursor func(*NamespaceDeletion) Cursor
+}
+
+// NamespaceDeletionOrder defines the ordering of NamespaceDeletion.
+type NamespaceDeletionOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *NamespaceDeletionOrderField `json:"field"`
+}
+
+// DefaultNamespaceDeletionOrder is the default ordering of NamespaceDeletion.
+var DefaultNamespaceDeletionOrder = &NamespaceDeletionOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &NamespaceDeletionOrderField{
+		Value: func(nd *NamespaceDeletion) (ent.Value, error) {
+			return nd.ID, nil
+		},
+		column: namespacedeletion.FieldID,
+		toTerm: namespacedeletion.ByID,
+		toCursor: func(nd *NamespaceDeletion) Cursor {
+			return Cursor{ID: nd.ID}
+		},
+	},
+}
+
+// ToEdge converts NamespaceDeletion into NamespaceDeletionEdge.
+func (nd *NamespaceDeletion) ToEdge(order *NamespaceDeletionOrder) *NamespaceDeletionEdge {
+	if order == nil {
+		order = DefaultNamespaceDeletionOrder
+	}
+	return &NamespaceDeletionEdge{
+		Node:   nd,
+		Cursor: order.Field.toCursor(nd),
+	}
+}
+
+// StatusEdge is the edge representation of Status.
+type StatusEdge struct {
+	Node   *Status `json:"node"`
+	Cursor Cursor  `json:"cursor"`
+}
+
+// StatusConnection is the connection containing edges to Status.
+type StatusConnection struct {
+	Edges      []*StatusEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+func (c *StatusConnection) build(nodes []*Status, pager *statusPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *Status
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Status {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Status {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*StatusEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &StatusEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// StatusPaginateOption enables pagination customization.
+type StatusPaginateOption func(*statusPager) error
+
+// WithStatusOrder configures pagination ordering.
+func WithStatusOrder(order *StatusOrder) StatusPaginateOption {
+	if order == nil {
+		order = DefaultStatusOrder
+	}
+	o := *order
+	return func(pager *statusPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultStatusOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithStatusFilter configures pagination filter.
+func WithStatusFilter(filter func(*StatusQuery) (*StatusQuery, error)) StatusPaginateOption {
+	return func(pager *statusPager) error {
+		if filter == nil {
+			return errors.New("StatusQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type statusPager struct {
+	reverse bool
+	order   *StatusOrder
+	filter  func(*StatusQuery) (*StatusQuery, error)
+}
+
+func newStatusPager(opts []StatusPaginateOption, reverse bool) (*statusPager, error) {
+	pager := &statusPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultStatusOrder
+	}
+	return pager, nil
+}
+
+func (p *statusPager) applyFilter(query *StatusQuery) (*StatusQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *statusPager) toCursor(s *Status) Cursor {
+	return p.order.Field.toCursor(s)
+}
+
+func (p *statusPager) applyCursors(query *StatusQuery, after, before *Cursor) (*StatusQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultStatusOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *statusPager) applyOrder(query *StatusQuery) *StatusQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultStatusOrder.Field {
+		query = query.Order(DefaultStatusOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *statusPager) orderExpr(query *StatusQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultStatusOrder.Field {
+			b.Comma().Ident(DefaultStatusOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Status.
+func (s *StatusQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...StatusPaginateOption,
+) (*StatusConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newStatusPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if s, err = pager.applyFilter(s); err != nil {
+		return nil, err
+	}
+	conn := &StatusConnection{Edges: []*StatusEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = s.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if s, err = pager.applyCursors(s, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		s.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := s.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	s = pager.applyOrder(s)
+	nodes, err := s.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// StatusOrderFieldCreatedAt orders Status by created_at.
+	StatusOrderFieldCreatedAt = &StatusOrderField{
+		Value: func(s *Status) (ent.Value, error) {
+			return s.CreatedAt, nil
 		},
 		column: status.FieldCreatedAt,
 		toTerm: status.ByCreatedAt,
@@ -1356,6 +1963,20 @@ var (
 			}
 		},
 	}
+	// StatusOrderFieldExpiresAt orders Status by expires_at.
+	StatusOrderFieldExpiresAt = &StatusOrderField{
+		Value: func(s *Status) (ent.Value, error) {
+			return s.ExpiresAt, nil
+		},
+		column: status.FieldExpiresAt,
+		toTerm: status.ByExpiresAt,
+		toCursor: func(s *Status) Cursor {
+			return Cursor{
+				ID:    s.ID,
+				Value: s.ExpiresAt,
+			}
+		},
+	}
 )
 
 // String implement fmt.Stringer interface.
@@ -1366,6 +1987,8 @@ func (f StatusOrderField) String() string {
 		str = "CREATED_AT"
 	case StatusOrderFieldUpdatedAt.column:
 		str = "UPDATED_AT"
+	case StatusOrderFieldExpiresAt.column:
+		str = "EXPIRES_AT"
 	}
 	return str
 }
@@ -1386,6 +2009,8 @@ func (f *StatusOrderField) UnmarshalGQL(v interface{}) error {
 		*f = *StatusOrderFieldCreatedAt
 	case "UPDATED_AT":
 		*f = *StatusOrderFieldUpdatedAt
+	case "EXPIRES_AT":
+		*f = *StatusOrderFieldExpiresAt
 	default:
 		return fmt.Errorf("%s is not a valid StatusOrderField", str)
 	}
@@ -1433,6 +2058,299 @@ func (s *Status) ToEdge(order *StatusOrder) *StatusEdge {
 	}
 }
 
+// StatusHistoryEdge is the edge representation of StatusHistory.
+type StatusHistoryEdge struct {
+	Node   *StatusHistory `json:"node"`
+	Cursor Cursor         `json:"cursor"`
+}
+
+// StatusHistoryConnection is the connection containing edges to StatusHistory.
+type StatusHistoryConnection struct {
+	Edges      []*StatusHistoryEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+func (c *StatusHistoryConnection) build(nodes []*StatusHistory, pager *statushistoryPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *StatusHistory
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *StatusHistory {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *StatusHistory {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*StatusHistoryEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &StatusHistoryEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// StatusHistoryPaginateOption enables pagination customization.
+type StatusHistoryPaginateOption func(*statushistoryPager) error
+
+// WithStatusHistoryOrder configures pagination ordering.
+func WithStatusHistoryOrder(order *StatusHistoryOrder) StatusHistoryPaginateOption {
+	if order == nil {
+		order = DefaultStatusHistoryOrder
+	}
+	o := *order
+	return func(pager *statushistoryPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultStatusHistoryOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithStatusHistoryFilter configures pagination filter.
+func WithStatusHistoryFilter(filter func(*StatusHistoryQuery) (*StatusHistoryQuery, error)) StatusHistoryPaginateOption {
+	return func(pager *statushistoryPager) error {
+		if filter == nil {
+			return errors.New("StatusHistoryQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type statushistoryPager struct {
+	reverse bool
+	order   *StatusHistoryOrder
+	filter  func(*StatusHistoryQuery) (*StatusHistoryQuery, error)
+}
+
+func newStatusHistoryPager(opts []StatusHistoryPaginateOption, reverse bool) (*statushistoryPager, error) {
+	pager := &statushistoryPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultStatusHistoryOrder
+	}
+	return pager, nil
+}
+
+func (p *statushistoryPager) applyFilter(query *StatusHistoryQuery) (*StatusHistoryQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *statushistoryPager) toCursor(sh *StatusHistory) Cursor {
+	return p.order.Field.toCursor(sh)
+}
+
+func (p *statushistoryPager) applyCursors(query *StatusHistoryQuery, after, before *Cursor) (*StatusHistoryQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultStatusHistoryOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *statushistoryPager) applyOrder(query *StatusHistoryQuery) *StatusHistoryQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultStatusHistoryOrder.Field {
+		query = query.Order(DefaultStatusHistoryOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *statushistoryPager) orderExpr(query *StatusHistoryQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultStatusHistoryOrder.Field {
+			b.Comma().Ident(DefaultStatusHistoryOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to StatusHistory.
+func (sh *StatusHistoryQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...StatusHistoryPaginateOption,
+) (*StatusHistoryConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newStatusHistoryPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if sh, err = pager.applyFilter(sh); err != nil {
+		return nil, err
+	}
+	conn := &StatusHistoryConnection{Edges: []*StatusHistoryEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			if conn.TotalCount, err = sh.Clone().Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if sh, err = pager.applyCursors(sh, after, before); err != nil {
+		return nil, err
+	}
+	if limit := paginateLimit(first, last); limit != 0 {
+		sh.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := sh.collectField(ctx, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	sh = pager.applyOrder(sh)
+	nodes, err := sh.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// StatusHistoryOrderFieldCreatedAt orders StatusHistory by created_at.
+	StatusHistoryOrderFieldCreatedAt = &StatusHistoryOrderField{
+		Value: func(sh *StatusHistory) (ent.Value, error) {
+			return sh.CreatedAt, nil
+		},
+		column: statushistory.FieldCreatedAt,
+		toTerm: statushistory.ByCreatedAt,
+		toCursor: func(sh *StatusHistory) Cursor {
+			return Cursor{
+				ID:    sh.ID,
+				Value: sh.CreatedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f StatusHistoryOrderField) String() string {
+	var str string
+	switch f.column {
+	case StatusHistoryOrderFieldCreatedAt.column:
+		str = "CREATED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f StatusHistoryOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *StatusHistoryOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("StatusHistoryOrderField %T must be a string", v)
+	}
+	switch str {
+	case "CREATED_AT":
+		*f = *StatusHistoryOrderFieldCreatedAt
+	default:
+		return fmt.Errorf("%s is not a valid StatusHistoryOrderField", str)
+	}
+	return nil
+}
+
+// StatusHistoryOrderField defines the ordering field of StatusHistory.
+type StatusHistoryOrderField struct {
+	// Value extracts the ordering value from the given StatusHistory.
+	Value    func(*StatusHistory) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) statushistory.OrderOption
+	toCursor func(*StatusHistory) Cursor
+}
+
+// StatusHistoryOrder defines the ordering of StatusHistory.
+type StatusHistoryOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *StatusHistoryOrderField `json:"field"`
+}
+
+// DefaultStatusHistoryOrder is the default ordering of StatusHistory.
+var DefaultStatusHistoryOrder = &StatusHistoryOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &StatusHistoryOrderField{
+		Value: func(sh *StatusHistory) (ent.Value, error) {
+			return sh.ID, nil
+		},
+		column: statushistory.FieldID,
+		toTerm: statushistory.ByID,
+		toCursor: func(sh *StatusHistory) Cursor {
+			return Cursor{ID: sh.ID}
+		},
+	},
+}
+
+// ToEdge converts StatusHistory into StatusHistoryEdge.
+func (sh *StatusHistory) ToEdge(order *StatusHistoryOrder) *StatusHistoryEdge {
+	if order == nil {
+		order = DefaultStatusHistoryOrder
+	}
+	return &StatusHistoryEdge{
+		Node:   sh,
+		Cursor: order.Field.toCursor(sh),
+	}
+}
+
 // StatusNamespaceEdge is the edge representation of StatusNamespace.
 type StatusNamespaceEdge struct {
 	Node   *StatusNamespace `json:"node"`
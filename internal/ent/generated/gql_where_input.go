@@ -22,10 +22,13 @@ import (
 	"time"
 
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
 	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
 	"go.infratographer.com/x/gidx"
 )
@@ -266,6 +269,244 @@ func (i *AnnotationWhereInput) P() (predicate.Annotation, error) {
 	}
 }
 
+// AnnotationHistoryWhereInput represents a where input for filtering AnnotationHistory queries.
+type AnnotationHistoryWhereInput struct {
+	Predicates []predicate.AnnotationHistory  `json:"-"`
+	Not        *AnnotationHistoryWhereInput   `json:"not,omitempty"`
+	Or         []*AnnotationHistoryWhereInput `json:"or,omitempty"`
+	And        []*AnnotationHistoryWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *gidx.PrefixedID  `json:"id,omitempty"`
+	IDNEQ   *gidx.PrefixedID  `json:"idNEQ,omitempty"`
+	IDIn    []gidx.PrefixedID `json:"idIn,omitempty"`
+	IDNotIn []gidx.PrefixedID `json:"idNotIn,omitempty"`
+	IDGT    *gidx.PrefixedID  `json:"idGT,omitempty"`
+	IDGTE   *gidx.PrefixedID  `json:"idGTE,omitempty"`
+	IDLT    *gidx.PrefixedID  `json:"idLT,omitempty"`
+	IDLTE   *gidx.PrefixedID  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "operation" field predicates.
+	Operation      *annotationhistory.Operation  `json:"operation,omitempty"`
+	OperationNEQ   *annotationhistory.Operation  `json:"operationNEQ,omitempty"`
+	OperationIn    []annotationhistory.Operation `json:"operationIn,omitempty"`
+	OperationNotIn []annotationhistory.Operation `json:"operationNotIn,omitempty"`
+
+	// "actor" field predicates.
+	Actor             *string  `json:"actor,omitempty"`
+	ActorNEQ          *string  `json:"actorNEQ,omitempty"`
+	ActorIn           []string `json:"actorIn,omitempty"`
+	ActorNotIn        []string `json:"actorNotIn,omitempty"`
+	ActorGT           *string  `json:"actorGT,omitempty"`
+	ActorGTE          *string  `json:"actorGTE,omitempty"`
+	ActorLT           *string  `json:"actorLT,omitempty"`
+	ActorLTE          *string  `json:"actorLTE,omitempty"`
+	ActorContains     *string  `json:"actorContains,omitempty"`
+	ActorHasPrefix    *string  `json:"actorHasPrefix,omitempty"`
+	ActorHasSuffix    *string  `json:"actorHasSuffix,omitempty"`
+	ActorIsNil        bool     `json:"actorIsNil,omitempty"`
+	ActorNotNil       bool     `json:"actorNotNil,omitempty"`
+	ActorEqualFold    *string  `json:"actorEqualFold,omitempty"`
+	ActorContainsFold *string  `json:"actorContainsFold,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *AnnotationHistoryWhereInput) AddPredicates(predicates ...predicate.AnnotationHistory) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the AnnotationHistoryWhereInput filter on the AnnotationHistoryQuery builder.
+func (i *AnnotationHistoryWhereInput) Filter(q *AnnotationHistoryQuery) (*AnnotationHistoryQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyAnnotationHistoryWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyAnnotationHistoryWhereInput is returned in case the AnnotationHistoryWhereInput is empty.
+var ErrEmptyAnnotationHistoryWhereInput = errors.New("generated: empty predicate AnnotationHistoryWhereInput")
+
+// P returns a predicate for filtering annotationhistories.
+// An error is returned if the input is empty or invalid.
+func (i *AnnotationHistoryWhereInput) P() (predicate.AnnotationHistory, error) {
+	var predicates []predicate.AnnotationHistory
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, annotationhistory.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.AnnotationHistory, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, annotationhistory.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.AnnotationHistory, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, annotationhistory.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, annotationhistory.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, annotationhistory.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, annotationhistory.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, annotationhistory.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, annotationhistory.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, annotationhistory.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, annotationhistory.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, annotationhistory.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, annotationhistory.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, annotationhistory.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, annotationhistory.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, annotationhistory.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, annotationhistory.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, annotationhistory.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, annotationhistory.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, annotationhistory.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.Operation != nil {
+		predicates = append(predicates, annotationhistory.OperationEQ(*i.Operation))
+	}
+	if i.OperationNEQ != nil {
+		predicates = append(predicates, annotationhistory.OperationNEQ(*i.OperationNEQ))
+	}
+	if len(i.OperationIn) > 0 {
+		predicates = append(predicates, annotationhistory.OperationIn(i.OperationIn...))
+	}
+	if len(i.OperationNotIn) > 0 {
+		predicates = append(predicates, annotationhistory.OperationNotIn(i.OperationNotIn...))
+	}
+	if i.Actor != nil {
+		predicates = append(predicates, annotationhistory.ActorEQ(*i.Actor))
+	}
+	if i.ActorNEQ != nil {
+		predicates = append(predicates, annotationhistory.ActorNEQ(*i.ActorNEQ))
+	}
+	if len(i.ActorIn) > 0 {
+		predicates = append(predicates, annotationhistory.ActorIn(i.ActorIn...))
+	}
+	if len(i.ActorNotIn) > 0 {
+		predicates = append(predicates, annotationhistory.ActorNotIn(i.ActorNotIn...))
+	}
+	if i.ActorGT != nil {
+		predicates = append(predicates, annotationhistory.ActorGT(*i.ActorGT))
+	}
+	if i.ActorGTE != nil {
+		predicates = append(predicates, annotationhistory.ActorGTE(*i.ActorGTE))
+	}
+	if i.ActorLT != nil {
+		predicates = append(predicates, annotationhistory.ActorLT(*i.ActorLT))
+	}
+	if i.ActorLTE != nil {
+		predicates = append(predicates, annotationhistory.ActorLTE(*i.ActorLTE))
+	}
+	if i.ActorContains != nil {
+		predicates = append(predicates, annotationhistory.ActorContains(*i.ActorContains))
+	}
+	if i.ActorHasPrefix != nil {
+		predicates = append(predicates, annotationhistory.ActorHasPrefix(*i.ActorHasPrefix))
+	}
+	if i.ActorHasSuffix != nil {
+		predicates = append(predicates, annotationhistory.ActorHasSuffix(*i.ActorHasSuffix))
+	}
+	if i.ActorIsNil {
+		predicates = append(predicates, annotationhistory.ActorIsNil())
+	}
+	if i.ActorNotNil {
+		predicates = append(predicates, annotationhistory.ActorNotNil())
+	}
+	if i.ActorEqualFold != nil {
+		predicates = append(predicates, annotationhistory.ActorEqualFold(*i.ActorEqualFold))
+	}
+	if i.ActorContainsFold != nil {
+		predicates = append(predicates, annotationhistory.ActorContainsFold(*i.ActorContainsFold))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyAnnotationHistoryWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return annotationhistory.And(predicates...), nil
+	}
+}
+
 // AnnotationNamespaceWhereInput represents a where input for filtering AnnotationNamespace queries.
 type AnnotationNamespaceWhereInput struct {
 	Predicates []predicate.AnnotationNamespace  `json:"-"`
@@ -770,12 +1011,12 @@ func (i *MetadataWhereInput) P() (predicate.Metadata, error) {
 	}
 }
 
-// StatusWhereInput represents a where input for filtering Status queries.
-type StatusWhereInput struct {
-	Predicates []predicate.Status  `json:"-"`
-	Not        *StatusWhereInput   `json:"not,omitempty"`
-	Or         []*StatusWhereInput `json:"or,omitempty"`
-	And        []*StatusWhereInput `json:"and,omitempty"`
+// NamespaceDeletionWhereInput represents a where input for filtering NamespaceDeletion queries.
+type NamespaceDeletionWhereInput struct {
+	Predicates []predicate.NamespaceDeletion  `json:"-"`
+	Not        *NamespaceDeletionWhereInput   `json:"not,omitempty"`
+	Or         []*NamespaceDeletionWhereInput `json:"or,omitempty"`
+	And        []*NamespaceDeletionWhereInput `json:"and,omitempty"`
 
 	// "id" field predicates.
 	ID      *gidx.PrefixedID  `json:"id,omitempty"`
@@ -807,43 +1048,88 @@ type StatusWhereInput struct {
 	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
 	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
 
-	// "source" field predicates.
-	Source             *string  `json:"source,omitempty"`
-	SourceNEQ          *string  `json:"sourceNEQ,omitempty"`
-	SourceIn           []string `json:"sourceIn,omitempty"`
-	SourceNotIn        []string `json:"sourceNotIn,omitempty"`
-	SourceGT           *string  `json:"sourceGT,omitempty"`
-	SourceGTE          *string  `json:"sourceGTE,omitempty"`
-	SourceLT           *string  `json:"sourceLT,omitempty"`
-	SourceLTE          *string  `json:"sourceLTE,omitempty"`
-	SourceContains     *string  `json:"sourceContains,omitempty"`
-	SourceHasPrefix    *string  `json:"sourceHasPrefix,omitempty"`
-	SourceHasSuffix    *string  `json:"sourceHasSuffix,omitempty"`
-	SourceEqualFold    *string  `json:"sourceEqualFold,omitempty"`
-	SourceContainsFold *string  `json:"sourceContainsFold,omitempty"`
+	// "namespace_id" field predicates.
+	NamespaceID             *gidx.PrefixedID  `json:"namespaceID,omitempty"`
+	NamespaceIDNEQ          *gidx.PrefixedID  `json:"namespaceIDNEQ,omitempty"`
+	NamespaceIDIn           []gidx.PrefixedID `json:"namespaceIDIn,omitempty"`
+	NamespaceIDNotIn        []gidx.PrefixedID `json:"namespaceIDNotIn,omitempty"`
+	NamespaceIDGT           *gidx.PrefixedID  `json:"namespaceIDGT,omitempty"`
+	NamespaceIDGTE          *gidx.PrefixedID  `json:"namespaceIDGTE,omitempty"`
+	NamespaceIDLT           *gidx.PrefixedID  `json:"namespaceIDLT,omitempty"`
+	NamespaceIDLTE          *gidx.PrefixedID  `json:"namespaceIDLTE,omitempty"`
+	NamespaceIDContains     *gidx.PrefixedID  `json:"namespaceIDContains,omitempty"`
+	NamespaceIDHasPrefix    *gidx.PrefixedID  `json:"namespaceIDHasPrefix,omitempty"`
+	NamespaceIDHasSuffix    *gidx.PrefixedID  `json:"namespaceIDHasSuffix,omitempty"`
+	NamespaceIDEqualFold    *gidx.PrefixedID  `json:"namespaceIDEqualFold,omitempty"`
+	NamespaceIDContainsFold *gidx.PrefixedID  `json:"namespaceIDContainsFold,omitempty"`
 
-	// "namespace" edge predicates.
-	HasNamespace     *bool                        `json:"hasNamespace,omitempty"`
-	HasNamespaceWith []*StatusNamespaceWhereInput `json:"hasNamespaceWith,omitempty"`
+	// "owner_id" field predicates.
+	OwnerID             *gidx.PrefixedID  `json:"ownerID,omitempty"`
+	OwnerIDNEQ          *gidx.PrefixedID  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []gidx.PrefixedID `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []gidx.PrefixedID `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGT           *gidx.PrefixedID  `json:"ownerIDGT,omitempty"`
+	OwnerIDGTE          *gidx.PrefixedID  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLT           *gidx.PrefixedID  `json:"ownerIDLT,omitempty"`
+	OwnerIDLTE          *gidx.PrefixedID  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *gidx.PrefixedID  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *gidx.PrefixedID  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *gidx.PrefixedID  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDEqualFold    *gidx.PrefixedID  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *gidx.PrefixedID  `json:"ownerIDContainsFold,omitempty"`
 
-	// "metadata" edge predicates.
-	HasMetadata     *bool                 `json:"hasMetadata,omitempty"`
-	HasMetadataWith []*MetadataWhereInput `json:"hasMetadataWith,omitempty"`
+	// "state" field predicates.
+	State      *namespacedeletion.State  `json:"state,omitempty"`
+	StateNEQ   *namespacedeletion.State  `json:"stateNEQ,omitempty"`
+	StateIn    []namespacedeletion.State `json:"stateIn,omitempty"`
+	StateNotIn []namespacedeletion.State `json:"stateNotIn,omitempty"`
+
+	// "total_count" field predicates.
+	TotalCount      *int  `json:"totalCount,omitempty"`
+	TotalCountNEQ   *int  `json:"totalCountNEQ,omitempty"`
+	TotalCountIn    []int `json:"totalCountIn,omitempty"`
+	TotalCountNotIn []int `json:"totalCountNotIn,omitempty"`
+	TotalCountGT    *int  `json:"totalCountGT,omitempty"`
+	TotalCountGTE   *int  `json:"totalCountGTE,omitempty"`
+	TotalCountLT    *int  `json:"totalCountLT,omitempty"`
+	TotalCountLTE   *int  `json:"totalCountLTE,omitempty"`
+
+	// "deleted_count" field predicates.
+	DeletedCount      *int  `json:"deletedCount,omitempty"`
+	DeletedCountNEQ   *int  `json:"deletedCountNEQ,omitempty"`
+	DeletedCountIn    []int `json:"deletedCountIn,omitempty"`
+	DeletedCountNotIn []int `json:"deletedCountNotIn,omitempty"`
+	DeletedCountGT    *int  `json:"deletedCountGT,omitempty"`
+	DeletedCountGTE   *int  `json:"deletedCountGTE,omitempty"`
+	DeletedCountLT    *int  `json:"deletedCountLT,omitempty"`
+	DeletedCountLTE   *int  `json:"deletedCountLTE,omitempty"`
+
+	// "completed_at" field predicates.
+	CompletedAt       *time.Time  `json:"completedAt,omitempty"`
+	CompletedAtNEQ    *time.Time  `json:"completedAtNEQ,omitempty"`
+	CompletedAtIn     []time.Time `json:"completedAtIn,omitempty"`
+	CompletedAtNotIn  []time.Time `json:"completedAtNotIn,omitempty"`
+	CompletedAtGT     *time.Time  `json:"completedAtGT,omitempty"`
+	CompletedAtGTE    *time.Time  `json:"completedAtGTE,omitempty"`
+	CompletedAtLT     *time.Time  `json:"completedAtLT,omitempty"`
+	CompletedAtLTE    *time.Time  `json:"completedAtLTE,omitempty"`
+	CompletedAtIsNil  bool        `json:"completedAtIsNil,omitempty"`
+	CompletedAtNotNil bool        `json:"completedAtNotNil,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
-func (i *StatusWhereInput) AddPredicates(predicates ...predicate.Status) {
+func (i *NamespaceDeletionWhereInput) AddPredicates(predicates ...predicate.NamespaceDeletion) {
 	i.Predicates = append(i.Predicates, predicates...)
 }
 
-// Filter applies the StatusWhereInput filter on the StatusQuery builder.
-func (i *StatusWhereInput) Filter(q *StatusQuery) (*StatusQuery, error) {
+// Filter applies the NamespaceDeletionWhereInput filter on the NamespaceDeletionQuery builder.
+func (i *NamespaceDeletionWhereInput) Filter(q *NamespaceDeletionQuery) (*NamespaceDeletionQuery, error) {
 	if i == nil {
 		return q, nil
 	}
 	p, err := i.P()
 	if err != nil {
-		if err == ErrEmptyStatusWhereInput {
+		if err == ErrEmptyNamespaceDeletionWhereInput {
 			return q, nil
 		}
 		return nil, err
@@ -851,19 +1137,19 @@ func (i *StatusWhereInput) Filter(q *StatusQuery) (*StatusQuery, error) {
 	return q.Where(p), nil
 }
 
-// ErrEmptyStatusWhereInput is returned in case the StatusWhereInput is empty.
-var ErrEmptyStatusWhereInput = errors.New("generated: empty predicate StatusWhereInput")
+// ErrEmptyNamespaceDeletionWhereInput is returned in case the NamespaceDeletionWhereInput is empty.
+var ErrEmptyNamespaceDeletionWhereInput = errors.New("generated: empty predicate NamespaceDeletionWhereInput")
 
-// P returns a predicate for filtering statusslice.
+// P returns a predicate for filtering namespacedeletions.
 // An error is returned if the input is empty or invalid.
-func (i *StatusWhereInput) P() (predicate.Status, error) {
-	var predicates []predicate.Status
+func (i *NamespaceDeletionWhereInput) P() (predicate.NamespaceDeletion, error) {
+	var predicates []predicate.NamespaceDeletion
 	if i.Not != nil {
 		p, err := i.Not.P()
 		if err != nil {
 			return nil, fmt.Errorf("%w: field 'not'", err)
 		}
-		predicates = append(predicates, status.Not(p))
+		predicates = append(predicates, namespacedeletion.Not(p))
 	}
 	switch n := len(i.Or); {
 	case n == 1:
@@ -873,7 +1159,7 @@ func (i *StatusWhereInput) P() (predicate.Status, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		or := make([]predicate.Status, 0, n)
+		or := make([]predicate.NamespaceDeletion, 0, n)
 		for _, w := range i.Or {
 			p, err := w.P()
 			if err != nil {
@@ -881,7 +1167,7 @@ func (i *StatusWhereInput) P() (predicate.Status, error) {
 			}
 			or = append(or, p)
 		}
-		predicates = append(predicates, status.Or(or...))
+		predicates = append(predicates, namespacedeletion.Or(or...))
 	}
 	switch n := len(i.And); {
 	case n == 1:
@@ -891,7 +1177,7 @@ func (i *StatusWhereInput) P() (predicate.Status, error) {
 		}
 		predicates = append(predicates, p)
 	case n > 1:
-		and := make([]predicate.Status, 0, n)
+		and := make([]predicate.NamespaceDeletion, 0, n)
 		for _, w := range i.And {
 			p, err := w.P()
 			if err != nil {
@@ -899,144 +1185,569 @@ func (i *StatusWhereInput) P() (predicate.Status, error) {
 			}
 			and = append(and, p)
 		}
-		predicates = append(predicates, status.And(and...))
+		predicates = append(predicates, namespacedeletion.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
 	if i.ID != nil {
-		predicates = append(predicates, status.IDEQ(*i.ID))
+		predicates = append(predicates, namespacedeletion.IDEQ(*i.ID))
 	}
 	if i.IDNEQ != nil {
-		predicates = append(predicates, status.IDNEQ(*i.IDNEQ))
+		predicates = append(predicates, namespacedeletion.IDNEQ(*i.IDNEQ))
 	}
 	if len(i.IDIn) > 0 {
-		predicates = append(predicates, status.IDIn(i.IDIn...))
+		predicates = append(predicates, namespacedeletion.IDIn(i.IDIn...))
 	}
 	if len(i.IDNotIn) > 0 {
-		predicates = append(predicates, status.IDNotIn(i.IDNotIn...))
+		predicates = append(predicates, namespacedeletion.IDNotIn(i.IDNotIn...))
 	}
 	if i.IDGT != nil {
-		predicates = append(predicates, status.IDGT(*i.IDGT))
+		predicates = append(predicates, namespacedeletion.IDGT(*i.IDGT))
 	}
 	if i.IDGTE != nil {
-		predicates = append(predicates, status.IDGTE(*i.IDGTE))
+		predicates = append(predicates, namespacedeletion.IDGTE(*i.IDGTE))
 	}
 	if i.IDLT != nil {
-		predicates = append(predicates, status.IDLT(*i.IDLT))
+		predicates = append(predicates, namespacedeletion.IDLT(*i.IDLT))
 	}
 	if i.IDLTE != nil {
-		predicates = append(predicates, status.IDLTE(*i.IDLTE))
+		predicates = append(predicates, namespacedeletion.IDLTE(*i.IDLTE))
 	}
 	if i.CreatedAt != nil {
-		predicates = append(predicates, status.CreatedAtEQ(*i.CreatedAt))
+		predicates = append(predicates, namespacedeletion.CreatedAtEQ(*i.CreatedAt))
 	}
 	if i.CreatedAtNEQ != nil {
-		predicates = append(predicates, status.CreatedAtNEQ(*i.CreatedAtNEQ))
+		predicates = append(predicates, namespacedeletion.CreatedAtNEQ(*i.CreatedAtNEQ))
 	}
 	if len(i.CreatedAtIn) > 0 {
-		predicates = append(predicates, status.CreatedAtIn(i.CreatedAtIn...))
+		predicates = append(predicates, namespacedeletion.CreatedAtIn(i.CreatedAtIn...))
 	}
 	if len(i.CreatedAtNotIn) > 0 {
-		predicates = append(predicates, status.CreatedAtNotIn(i.CreatedAtNotIn...))
+		predicates = append(predicates, namespacedeletion.CreatedAtNotIn(i.CreatedAtNotIn...))
 	}
 	if i.CreatedAtGT != nil {
-		predicates = append(predicates, status.CreatedAtGT(*i.CreatedAtGT))
+		predicates = append(predicates, namespacedeletion.CreatedAtGT(*i.CreatedAtGT))
 	}
 	if i.CreatedAtGTE != nil {
-		predicates = append(predicates, status.CreatedAtGTE(*i.CreatedAtGTE))
+		predicates = append(predicates, namespacedeletion.CreatedAtGTE(*i.CreatedAtGTE))
 	}
 	if i.CreatedAtLT != nil {
-		predicates = append(predicates, status.CreatedAtLT(*i.CreatedAtLT))
+		predicates = append(predicates, namespacedeletion.CreatedAtLT(*i.CreatedAtLT))
 	}
 	if i.CreatedAtLTE != nil {
-		predicates = append(predicates, status.CreatedAtLTE(*i.CreatedAtLTE))
+		predicates = append(predicates, namespacedeletion.CreatedAtLTE(*i.CreatedAtLTE))
 	}
 	if i.UpdatedAt != nil {
-		predicates = append(predicates, status.UpdatedAtEQ(*i.UpdatedAt))
+		predicates = append(predicates, namespacedeletion.UpdatedAtEQ(*i.UpdatedAt))
 	}
 	if i.UpdatedAtNEQ != nil {
-		predicates = append(predicates, status.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+		predicates = append(predicates, namespacedeletion.UpdatedAtNEQ(*i.UpdatedAtNEQ))
 	}
 	if len(i.UpdatedAtIn) > 0 {
-		predicates = append(predicates, status.UpdatedAtIn(i.UpdatedAtIn...))
+		predicates = append(predicates, namespacedeletion.UpdatedAtIn(i.UpdatedAtIn...))
 	}
 	if len(i.UpdatedAtNotIn) > 0 {
-		predicates = append(predicates, status.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+		predicates = append(predicates, namespacedeletion.UpdatedAtNotIn(i.UpdatedAtNotIn...))
 	}
 	if i.UpdatedAtGT != nil {
-		predicates = append(predicates, status.UpdatedAtGT(*i.UpdatedAtGT))
+		predicates = append(predicates, namespacedeletion.UpdatedAtGT(*i.UpdatedAtGT))
 	}
 	if i.UpdatedAtGTE != nil {
-		predicates = append(predicates, status.UpdatedAtGTE(*i.UpdatedAtGTE))
+		predicates = append(predicates, namespacedeletion.UpdatedAtGTE(*i.UpdatedAtGTE))
 	}
 	if i.UpdatedAtLT != nil {
-		predicates = append(predicates, status.UpdatedAtLT(*i.UpdatedAtLT))
+		predicates = append(predicates, namespacedeletion.UpdatedAtLT(*i.UpdatedAtLT))
 	}
 	if i.UpdatedAtLTE != nil {
-		predicates = append(predicates, status.UpdatedAtLTE(*i.UpdatedAtLTE))
+		predicates = append(predicates, namespacedeletion.UpdatedAtLTE(*i.UpdatedAtLTE))
 	}
-	if i.Source != nil {
-		predicates = append(predicates, status.SourceEQ(*i.Source))
+	if i.NamespaceID != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDEQ(*i.NamespaceID))
 	}
-	if i.SourceNEQ != nil {
-		predicates = append(predicates, status.SourceNEQ(*i.SourceNEQ))
+	if i.NamespaceIDNEQ != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDNEQ(*i.NamespaceIDNEQ))
 	}
-	if len(i.SourceIn) > 0 {
-		predicates = append(predicates, status.SourceIn(i.SourceIn...))
+	if len(i.NamespaceIDIn) > 0 {
+		predicates = append(predicates, namespacedeletion.NamespaceIDIn(i.NamespaceIDIn...))
 	}
-	if len(i.SourceNotIn) > 0 {
-		predicates = append(predicates, status.SourceNotIn(i.SourceNotIn...))
+	if len(i.NamespaceIDNotIn) > 0 {
+		predicates = append(predicates, namespacedeletion.NamespaceIDNotIn(i.NamespaceIDNotIn...))
 	}
-	if i.SourceGT != nil {
-		predicates = append(predicates, status.SourceGT(*i.SourceGT))
+	if i.NamespaceIDGT != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDGT(*i.NamespaceIDGT))
 	}
-	if i.SourceGTE != nil {
-		predicates = append(predicates, status.SourceGTE(*i.SourceGTE))
+	if i.NamespaceIDGTE != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDGTE(*i.NamespaceIDGTE))
 	}
-	if i.SourceLT != nil {
-		predicates = append(predicates, status.SourceLT(*i.SourceLT))
+	if i.NamespaceIDLT != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDLT(*i.NamespaceIDLT))
 	}
-	if i.SourceLTE != nil {
-		predicates = append(predicates, status.SourceLTE(*i.SourceLTE))
+	if i.NamespaceIDLTE != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDLTE(*i.NamespaceIDLTE))
 	}
-	if i.SourceContains != nil {
-		predicates = append(predicates, status.SourceContains(*i.SourceContains))
+	if i.NamespaceIDContains != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDContains(*i.NamespaceIDContains))
 	}
-	if i.SourceHasPrefix != nil {
-		predicates = append(predicates, status.SourceHasPrefix(*i.SourceHasPrefix))
+	if i.NamespaceIDHasPrefix != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDHasPrefix(*i.NamespaceIDHasPrefix))
 	}
-	if i.SourceHasSuffix != nil {
-		predicates = append(predicates, status.SourceHasSuffix(*i.SourceHasSuffix))
+	if i.NamespaceIDHasSuffix != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDHasSuffix(*i.NamespaceIDHasSuffix))
 	}
-	if i.SourceEqualFold != nil {
-		predicates = append(predicates, status.SourceEqualFold(*i.SourceEqualFold))
+	if i.NamespaceIDEqualFold != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDEqualFold(*i.NamespaceIDEqualFold))
 	}
-	if i.SourceContainsFold != nil {
-		predicates = append(predicates, status.SourceContainsFold(*i.SourceContainsFold))
+	if i.NamespaceIDContainsFold != nil {
+		predicates = append(predicates, namespacedeletion.NamespaceIDContainsFold(*i.NamespaceIDContainsFold))
 	}
-
-	if i.HasNamespace != nil {
-		p := status.HasNamespace()
-		if !*i.HasNamespace {
-			p = status.Not(p)
-		}
-		predicates = append(predicates, p)
+	if i.OwnerID != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDEQ(*i.OwnerID))
 	}
-	if len(i.HasNamespaceWith) > 0 {
-		with := make([]predicate.StatusNamespace, 0, len(i.HasNamespaceWith))
-		for _, w := range i.HasNamespaceWith {
-			p, err := w.P()
-			if err != nil {
-				return nil, fmt.Errorf("%w: field 'HasNamespaceWith'", err)
-			}
-			with = append(with, p)
-		}
-		predicates = append(predicates, status.HasNamespaceWith(with...))
+	if i.OwnerIDNEQ != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDNEQ(*i.OwnerIDNEQ))
 	}
-	if i.HasMetadata != nil {
-		p := status.HasMetadata()
-		if !*i.HasMetadata {
-			p = status.Not(p)
-		}
+	if len(i.OwnerIDIn) > 0 {
+		predicates = append(predicates, namespacedeletion.OwnerIDIn(i.OwnerIDIn...))
+	}
+	if len(i.OwnerIDNotIn) > 0 {
+		predicates = append(predicates, namespacedeletion.OwnerIDNotIn(i.OwnerIDNotIn...))
+	}
+	if i.OwnerIDGT != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDGT(*i.OwnerIDGT))
+	}
+	if i.OwnerIDGTE != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDGTE(*i.OwnerIDGTE))
+	}
+	if i.OwnerIDLT != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDLT(*i.OwnerIDLT))
+	}
+	if i.OwnerIDLTE != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDLTE(*i.OwnerIDLTE))
+	}
+	if i.OwnerIDContains != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDContains(*i.OwnerIDContains))
+	}
+	if i.OwnerIDHasPrefix != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDHasPrefix(*i.OwnerIDHasPrefix))
+	}
+	if i.OwnerIDHasSuffix != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDHasSuffix(*i.OwnerIDHasSuffix))
+	}
+	if i.OwnerIDEqualFold != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDEqualFold(*i.OwnerIDEqualFold))
+	}
+	if i.OwnerIDContainsFold != nil {
+		predicates = append(predicates, namespacedeletion.OwnerIDContainsFold(*i.OwnerIDContainsFold))
+	}
+	if i.State != nil {
+		predicates = append(predicates, namespacedeletion.StateEQ(*i.State))
+	}
+	if i.StateNEQ != nil {
+		predicates = append(predicates, namespacedeletion.StateNEQ(*i.StateNEQ))
+	}
+	if len(i.StateIn) > 0 {
+		predicates = append(predicates, namespacedeletion.StateIn(i.StateIn...))
+	}
+	if len(i.StateNotIn) > 0 {
+		predicates = append(predicates, namespacedeletion.StateNotIn(i.StateNotIn...))
+	}
+	if i.TotalCount != nil {
+		predicates = append(predicates, namespacedeletion.TotalCountEQ(*i.TotalCount))
+	}
+	if i.TotalCountNEQ != nil {
+		predicates = append(predicates, namespacedeletion.TotalCountNEQ(*i.TotalCountNEQ))
+	}
+	if len(i.TotalCountIn) > 0 {
+		predicates = append(predicates, namespacedeletion.TotalCountIn(i.TotalCountIn...))
+	}
+	if len(i.TotalCountNotIn) > 0 {
+		predicates = append(predicates, namespacedeletion.TotalCountNotIn(i.TotalCountNotIn...))
+	}
+	if i.TotalCountGT != nil {
+		predicates = append(predicates, namespacedeletion.TotalCountGT(*i.TotalCountGT))
+	}
+	if i.TotalCountGTE != nil {
+		predicates = append(predicates, namespacedeletion.TotalCountGTE(*i.TotalCountGTE))
+	}
+	if i.TotalCountLT != nil {
+		predicates = append(predicates, namespacedeletion.TotalCountLT(*i.TotalCountLT))
+	}
+	if i.TotalCountLTE != nil {
+		predicates = append(predicates, namespacedeletion.TotalCountLTE(*i.TotalCountLTE))
+	}
+	if i.DeletedCount != nil {
+		predicates = append(predicates, namespacedeletion.DeletedCountEQ(*i.DeletedCount))
+	}
+	if i.DeletedCountNEQ != nil {
+		predicates = append(predicates, namespacedeletion.DeletedCountNEQ(*i.DeletedCountNEQ))
+	}
+	if len(i.DeletedCountIn) > 0 {
+		predicates = append(predicates, namespacedeletion.DeletedCountIn(i.DeletedCountIn...))
+	}
+	if len(i.DeletedCountNotIn) > 0 {
+		predicates = append(predicates, namespacedeletion.DeletedCountNotIn(i.DeletedCountNotIn...))
+	}
+	if i.DeletedCountGT != nil {
+		predicates = append(predicates, namespacedeletion.DeletedCountGT(*i.DeletedCountGT))
+	}
+	if i.DeletedCountGTE != nil {
+		predicates = append(predicates, namespacedeletion.DeletedCountGTE(*i.DeletedCountGTE))
+	}
+	if i.DeletedCountLT != nil {
+		predicates = append(predicates, namespacedeletion.DeletedCountLT(*i.DeletedCountLT))
+	}
+	if i.DeletedCountLTE != nil {
+		predicates = append(predicates, namespacedeletion.DeletedCountLTE(*i.DeletedCountLTE))
+	}
+	if i.CompletedAt != nil {
+		predicates = append(predicates, namespacedeletion.CompletedAtEQ(*i.CompletedAt))
+	}
+	if i.CompletedAtNEQ != nil {
+		predicates = append(predicates, namespacedeletion.CompletedAtNEQ(*i.CompletedAtNEQ))
+	}
+	if len(i.CompletedAtIn) > 0 {
+		predicates = append(predicates, namespacedeletion.CompletedAtIn(i.CompletedAtIn...))
+	}
+	if len(i.CompletedAtNotIn) > 0 {
+		predicates = append(predicates, namespacedeletion.CompletedAtNotIn(i.CompletedAtNotIn...))
+	}
+	if i.CompletedAtGT != nil {
+		predicates = append(predicates, namespacedeletion.CompletedAtGT(*i.CompletedAtGT))
+	}
+	if i.CompletedAtGTE != nil {
+		predicates = append(predicates, namespacedeletion.CompletedAtGTE(*i.CompletedAtGTE))
+	}
+	if i.CompletedAtLT != nil {
+		predicates = append(predicates, namespacedeletion.CompletedAtLT(*i.CompletedAtLT))
+	}
+	if i.CompletedAtLTE != nil {
+		predicates = append(predicates, namespacedeletion.CompletedAtLTE(*i.CompletedAtLTE))
+	}
+	if i.CompletedAtIsNil {
+		predicates = append(predicates, namespacedeletion.CompletedAtIsNil())
+	}
+	if i.CompletedAtNotNil {
+		predicates = append(predicates, namespacedeletion.CompletedAtNotNil())
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyNamespaceDeletionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return namespacedeletion.And(predicates...), nil
+	}
+}
+
+// StatusWhereInput represents a where input for filtering Status queries.
+type StatusWhereInput struct {
+	Predicates []predicate.Status  `json:"-"`
+	Not        *StatusWhereInput   `json:"not,omitempty"`
+	Or         []*StatusWhereInput `json:"or,omitempty"`
+	And        []*StatusWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *gidx.PrefixedID  `json:"id,omitempty"`
+	IDNEQ   *gidx.PrefixedID  `json:"idNEQ,omitempty"`
+	IDIn    []gidx.PrefixedID `json:"idIn,omitempty"`
+	IDNotIn []gidx.PrefixedID `json:"idNotIn,omitempty"`
+	IDGT    *gidx.PrefixedID  `json:"idGT,omitempty"`
+	IDGTE   *gidx.PrefixedID  `json:"idGTE,omitempty"`
+	IDLT    *gidx.PrefixedID  `json:"idLT,omitempty"`
+	IDLTE   *gidx.PrefixedID  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "updated_at" field predicates.
+	UpdatedAt      *time.Time  `json:"updatedAt,omitempty"`
+	UpdatedAtNEQ   *time.Time  `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGT    *time.Time  `json:"updatedAtGT,omitempty"`
+	UpdatedAtGTE   *time.Time  `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLT    *time.Time  `json:"updatedAtLT,omitempty"`
+	UpdatedAtLTE   *time.Time  `json:"updatedAtLTE,omitempty"`
+
+	// "source" field predicates.
+	Source             *string  `json:"source,omitempty"`
+	SourceNEQ          *string  `json:"sourceNEQ,omitempty"`
+	SourceIn           []string `json:"sourceIn,omitempty"`
+	SourceNotIn        []string `json:"sourceNotIn,omitempty"`
+	SourceGT           *string  `json:"sourceGT,omitempty"`
+	SourceGTE          *string  `json:"sourceGTE,omitempty"`
+	SourceLT           *string  `json:"sourceLT,omitempty"`
+	SourceLTE          *string  `json:"sourceLTE,omitempty"`
+	SourceContains     *string  `json:"sourceContains,omitempty"`
+	SourceHasPrefix    *string  `json:"sourceHasPrefix,omitempty"`
+	SourceHasSuffix    *string  `json:"sourceHasSuffix,omitempty"`
+	SourceEqualFold    *string  `json:"sourceEqualFold,omitempty"`
+	SourceContainsFold *string  `json:"sourceContainsFold,omitempty"`
+
+	// "expires_at" field predicates.
+	ExpiresAt       *time.Time  `json:"expiresAt,omitempty"`
+	ExpiresAtNEQ    *time.Time  `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn     []time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn  []time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGT     *time.Time  `json:"expiresAtGT,omitempty"`
+	ExpiresAtGTE    *time.Time  `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLT     *time.Time  `json:"expiresAtLT,omitempty"`
+	ExpiresAtLTE    *time.Time  `json:"expiresAtLTE,omitempty"`
+	ExpiresAtIsNil  bool        `json:"expiresAtIsNil,omitempty"`
+	ExpiresAtNotNil bool        `json:"expiresAtNotNil,omitempty"`
+
+	// "namespace" edge predicates.
+	HasNamespace     *bool                        `json:"hasNamespace,omitempty"`
+	HasNamespaceWith []*StatusNamespaceWhereInput `json:"hasNamespaceWith,omitempty"`
+
+	// "metadata" edge predicates.
+	HasMetadata     *bool                 `json:"hasMetadata,omitempty"`
+	HasMetadataWith []*MetadataWhereInput `json:"hasMetadataWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *StatusWhereInput) AddPredicates(predicates ...predicate.Status) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the StatusWhereInput filter on the StatusQuery builder.
+func (i *StatusWhereInput) Filter(q *StatusQuery) (*StatusQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyStatusWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyStatusWhereInput is returned in case the StatusWhereInput is empty.
+var ErrEmptyStatusWhereInput = errors.New("generated: empty predicate StatusWhereInput")
+
+// P returns a predicate for filtering statusslice.
+// An error is returned if the input is empty or invalid.
+func (i *StatusWhereInput) P() (predicate.Status, error) {
+	var predicates []predicate.Status
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, status.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.Status, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, status.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.Status, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, status.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, status.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, status.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, status.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, status.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, status.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, status.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, status.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, status.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, status.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, status.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, status.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, status.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, status.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, status.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, status.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, status.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.UpdatedAt != nil {
+		predicates = append(predicates, status.UpdatedAtEQ(*i.UpdatedAt))
+	}
+	if i.UpdatedAtNEQ != nil {
+		predicates = append(predicates, status.UpdatedAtNEQ(*i.UpdatedAtNEQ))
+	}
+	if len(i.UpdatedAtIn) > 0 {
+		predicates = append(predicates, status.UpdatedAtIn(i.UpdatedAtIn...))
+	}
+	if len(i.UpdatedAtNotIn) > 0 {
+		predicates = append(predicates, status.UpdatedAtNotIn(i.UpdatedAtNotIn...))
+	}
+	if i.UpdatedAtGT != nil {
+		predicates = append(predicates, status.UpdatedAtGT(*i.UpdatedAtGT))
+	}
+	if i.UpdatedAtGTE != nil {
+		predicates = append(predicates, status.UpdatedAtGTE(*i.UpdatedAtGTE))
+	}
+	if i.UpdatedAtLT != nil {
+		predicates = append(predicates, status.UpdatedAtLT(*i.UpdatedAtLT))
+	}
+	if i.UpdatedAtLTE != nil {
+		predicates = append(predicates, status.UpdatedAtLTE(*i.UpdatedAtLTE))
+	}
+	if i.Source != nil {
+		predicates = append(predicates, status.SourceEQ(*i.Source))
+	}
+	if i.SourceNEQ != nil {
+		predicates = append(predicates, status.SourceNEQ(*i.SourceNEQ))
+	}
+	if len(i.SourceIn) > 0 {
+		predicates = append(predicates, status.SourceIn(i.SourceIn...))
+	}
+	if len(i.SourceNotIn) > 0 {
+		predicates = append(predicates, status.SourceNotIn(i.SourceNotIn...))
+	}
+	if i.SourceGT != nil {
+		predicates = append(predicates, status.SourceGT(*i.SourceGT))
+	}
+	if i.SourceGTE != nil {
+		predicates = append(predicates, status.SourceGTE(*i.SourceGTE))
+	}
+	if i.SourceLT != nil {
+		predicates = append(predicates, status.SourceLT(*i.SourceLT))
+	}
+	if i.SourceLTE != nil {
+		predicates = append(predicates, status.SourceLTE(*i.SourceLTE))
+	}
+	if i.SourceContains != nil {
+		predicates = append(predicates, status.SourceContains(*i.SourceContains))
+	}
+	if i.SourceHasPrefix != nil {
+		predicates = append(predicates, status.SourceHasPrefix(*i.SourceHasPrefix))
+	}
+	if i.SourceHasSuffix != nil {
+		predicates = append(predicates, status.SourceHasSuffix(*i.SourceHasSuffix))
+	}
+	if i.SourceEqualFold != nil {
+		predicates = append(predicates, status.SourceEqualFold(*i.SourceEqualFold))
+	}
+	if i.SourceContainsFold != nil {
+		predicates = append(predicates, status.SourceContainsFold(*i.SourceContainsFold))
+	}
+	if i.ExpiresAt != nil {
+		predicates = append(predicates, status.ExpiresAtEQ(*i.ExpiresAt))
+	}
+	if i.ExpiresAtNEQ != nil {
+		predicates = append(predicates, status.ExpiresAtNEQ(*i.ExpiresAtNEQ))
+	}
+	if len(i.ExpiresAtIn) > 0 {
+		predicates = append(predicates, status.ExpiresAtIn(i.ExpiresAtIn...))
+	}
+	if len(i.ExpiresAtNotIn) > 0 {
+		predicates = append(predicates, status.ExpiresAtNotIn(i.ExpiresAtNotIn...))
+	}
+	if i.ExpiresAtGT != nil {
+		predicates = append(predicates, status.ExpiresAtGT(*i.ExpiresAtGT))
+	}
+	if i.ExpiresAtGTE != nil {
+		predicates = append(predicates, status.ExpiresAtGTE(*i.ExpiresAtGTE))
+	}
+	if i.ExpiresAtLT != nil {
+		predicates = append(predicates, status.ExpiresAtLT(*i.ExpiresAtLT))
+	}
+	if i.ExpiresAtLTE != nil {
+		predicates = append(predicates, status.ExpiresAtLTE(*i.ExpiresAtLTE))
+	}
+	if i.ExpiresAtIsNil {
+		predicates = append(predicates, status.ExpiresAtIsNil())
+	}
+	if i.ExpiresAtNotNil {
+		predicates = append(predicates, status.ExpiresAtNotNil())
+	}
+
+	if i.HasNamespace != nil {
+		p := status.HasNamespace()
+		if !*i.HasNamespace {
+			p = status.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasNamespaceWith) > 0 {
+		with := make([]predicate.StatusNamespace, 0, len(i.HasNamespaceWith))
+		for _, w := range i.HasNamespaceWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasNamespaceWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, status.HasNamespaceWith(with...))
+	}
+	if i.HasMetadata != nil {
+		p := status.HasMetadata()
+		if !*i.HasMetadata {
+			p = status.Not(p)
+		}
 		predicates = append(predicates, p)
 	}
 	if len(i.HasMetadataWith) > 0 {
@@ -1060,6 +1771,298 @@ func (i *StatusWhereInput) P() (predicate.Status, error) {
 	}
 }
 
+// StatusHistoryWhereInput represents a where input for filtering StatusHistory queries.
+type StatusHistoryWhereInput struct {
+	Predicates []predicate.StatusHistory  `json:"-"`
+	Not        *StatusHistoryWhereInput   `json:"not,omitempty"`
+	Or         []*StatusHistoryWhereInput `json:"or,omitempty"`
+	And        []*StatusHistoryWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID      *gidx.PrefixedID  `json:"id,omitempty"`
+	IDNEQ   *gidx.PrefixedID  `json:"idNEQ,omitempty"`
+	IDIn    []gidx.PrefixedID `json:"idIn,omitempty"`
+	IDNotIn []gidx.PrefixedID `json:"idNotIn,omitempty"`
+	IDGT    *gidx.PrefixedID  `json:"idGT,omitempty"`
+	IDGTE   *gidx.PrefixedID  `json:"idGTE,omitempty"`
+	IDLT    *gidx.PrefixedID  `json:"idLT,omitempty"`
+	IDLTE   *gidx.PrefixedID  `json:"idLTE,omitempty"`
+
+	// "created_at" field predicates.
+	CreatedAt      *time.Time  `json:"createdAt,omitempty"`
+	CreatedAtNEQ   *time.Time  `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGT    *time.Time  `json:"createdAtGT,omitempty"`
+	CreatedAtGTE   *time.Time  `json:"createdAtGTE,omitempty"`
+	CreatedAtLT    *time.Time  `json:"createdAtLT,omitempty"`
+	CreatedAtLTE   *time.Time  `json:"createdAtLTE,omitempty"`
+
+	// "source" field predicates.
+	Source             *string  `json:"source,omitempty"`
+	SourceNEQ          *string  `json:"sourceNEQ,omitempty"`
+	SourceIn           []string `json:"sourceIn,omitempty"`
+	SourceNotIn        []string `json:"sourceNotIn,omitempty"`
+	SourceGT           *string  `json:"sourceGT,omitempty"`
+	SourceGTE          *string  `json:"sourceGTE,omitempty"`
+	SourceLT           *string  `json:"sourceLT,omitempty"`
+	SourceLTE          *string  `json:"sourceLTE,omitempty"`
+	SourceContains     *string  `json:"sourceContains,omitempty"`
+	SourceHasPrefix    *string  `json:"sourceHasPrefix,omitempty"`
+	SourceHasSuffix    *string  `json:"sourceHasSuffix,omitempty"`
+	SourceEqualFold    *string  `json:"sourceEqualFold,omitempty"`
+	SourceContainsFold *string  `json:"sourceContainsFold,omitempty"`
+
+	// "operation" field predicates.
+	Operation      *statushistory.Operation  `json:"operation,omitempty"`
+	OperationNEQ   *statushistory.Operation  `json:"operationNEQ,omitempty"`
+	OperationIn    []statushistory.Operation `json:"operationIn,omitempty"`
+	OperationNotIn []statushistory.Operation `json:"operationNotIn,omitempty"`
+
+	// "actor" field predicates.
+	Actor             *string  `json:"actor,omitempty"`
+	ActorNEQ          *string  `json:"actorNEQ,omitempty"`
+	ActorIn           []string `json:"actorIn,omitempty"`
+	ActorNotIn        []string `json:"actorNotIn,omitempty"`
+	ActorGT           *string  `json:"actorGT,omitempty"`
+	ActorGTE          *string  `json:"actorGTE,omitempty"`
+	ActorLT           *string  `json:"actorLT,omitempty"`
+	ActorLTE          *string  `json:"actorLTE,omitempty"`
+	ActorContains     *string  `json:"actorContains,omitempty"`
+	ActorHasPrefix    *string  `json:"actorHasPrefix,omitempty"`
+	ActorHasSuffix    *string  `json:"actorHasSuffix,omitempty"`
+	ActorIsNil        bool     `json:"actorIsNil,omitempty"`
+	ActorNotNil       bool     `json:"actorNotNil,omitempty"`
+	ActorEqualFold    *string  `json:"actorEqualFold,omitempty"`
+	ActorContainsFold *string  `json:"actorContainsFold,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *StatusHistoryWhereInput) AddPredicates(predicates ...predicate.StatusHistory) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the StatusHistoryWhereInput filter on the StatusHistoryQuery builder.
+func (i *StatusHistoryWhereInput) Filter(q *StatusHistoryQuery) (*StatusHistoryQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyStatusHistoryWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyStatusHistoryWhereInput is returned in case the StatusHistoryWhereInput is empty.
+var ErrEmptyStatusHistoryWhereInput = errors.New("generated: empty predicate StatusHistoryWhereInput")
+
+// P returns a predicate for filtering statushistories.
+// An error is returned if the input is empty or invalid.
+func (i *StatusHistoryWhereInput) P() (predicate.StatusHistory, error) {
+	var predicates []predicate.StatusHistory
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, statushistory.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.StatusHistory, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, statushistory.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.StatusHistory, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, statushistory.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, statushistory.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, statushistory.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, statushistory.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, statushistory.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, statushistory.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, statushistory.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, statushistory.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, statushistory.IDLTE(*i.IDLTE))
+	}
+	if i.CreatedAt != nil {
+		predicates = append(predicates, statushistory.CreatedAtEQ(*i.CreatedAt))
+	}
+	if i.CreatedAtNEQ != nil {
+		predicates = append(predicates, statushistory.CreatedAtNEQ(*i.CreatedAtNEQ))
+	}
+	if len(i.CreatedAtIn) > 0 {
+		predicates = append(predicates, statushistory.CreatedAtIn(i.CreatedAtIn...))
+	}
+	if len(i.CreatedAtNotIn) > 0 {
+		predicates = append(predicates, statushistory.CreatedAtNotIn(i.CreatedAtNotIn...))
+	}
+	if i.CreatedAtGT != nil {
+		predicates = append(predicates, statushistory.CreatedAtGT(*i.CreatedAtGT))
+	}
+	if i.CreatedAtGTE != nil {
+		predicates = append(predicates, statushistory.CreatedAtGTE(*i.CreatedAtGTE))
+	}
+	if i.CreatedAtLT != nil {
+		predicates = append(predicates, statushistory.CreatedAtLT(*i.CreatedAtLT))
+	}
+	if i.CreatedAtLTE != nil {
+		predicates = append(predicates, statushistory.CreatedAtLTE(*i.CreatedAtLTE))
+	}
+	if i.Source != nil {
+		predicates = append(predicates, statushistory.SourceEQ(*i.Source))
+	}
+	if i.SourceNEQ != nil {
+		predicates = append(predicates, statushistory.SourceNEQ(*i.SourceNEQ))
+	}
+	if len(i.SourceIn) > 0 {
+		predicates = append(predicates, statushistory.SourceIn(i.SourceIn...))
+	}
+	if len(i.SourceNotIn) > 0 {
+		predicates = append(predicates, statushistory.SourceNotIn(i.SourceNotIn...))
+	}
+	if i.SourceGT != nil {
+		predicates = append(predicates, statushistory.SourceGT(*i.SourceGT))
+	}
+	if i.SourceGTE != nil {
+		predicates = append(predicates, statushistory.SourceGTE(*i.SourceGTE))
+	}
+	if i.SourceLT != nil {
+		predicates = append(predicates, statushistory.SourceLT(*i.SourceLT))
+	}
+	if i.SourceLTE != nil {
+		predicates = append(predicates, statushistory.SourceLTE(*i.SourceLTE))
+	}
+	if i.SourceContains != nil {
+		predicates = append(predicates, statushistory.SourceContains(*i.SourceContains))
+	}
+	if i.SourceHasPrefix != nil {
+		predicates = append(predicates, statushistory.SourceHasPrefix(*i.SourceHasPrefix))
+	}
+	if i.SourceHasSuffix != nil {
+		predicates = append(predicates, statushistory.SourceHasSuffix(*i.SourceHasSuffix))
+	}
+	if i.SourceEqualFold != nil {
+		predicates = append(predicates, statushistory.SourceEqualFold(*i.SourceEqualFold))
+	}
+	if i.SourceContainsFold != nil {
+		predicates = append(predicates, statushistory.SourceContainsFold(*i.SourceContainsFold))
+	}
+	if i.Operation != nil {
+		predicates = append(predicates, statushistory.OperationEQ(*i.Operation))
+	}
+	if i.OperationNEQ != nil {
+		predicates = append(predicates, statushistory.OperationNEQ(*i.OperationNEQ))
+	}
+	if len(i.OperationIn) > 0 {
+		predicates = append(predicates, statushistory.OperationIn(i.OperationIn...))
+	}
+	if len(i.OperationNotIn) > 0 {
+		predicates = append(predicates, statushistory.OperationNotIn(i.OperationNotIn...))
+	}
+	if i.Actor != nil {
+		predicates = append(predicates, statushistory.ActorEQ(*i.Actor))
+	}
+	if i.ActorNEQ != nil {
+		predicates = append(predicates, statushistory.ActorNEQ(*i.ActorNEQ))
+	}
+	if len(i.ActorIn) > 0 {
+		predicates = append(predicates, statushistory.ActorIn(i.ActorIn...))
+	}
+	if len(i.ActorNotIn) > 0 {
+		predicates = append(predicates, statushistory.ActorNotIn(i.ActorNotIn...))
+	}
+	if i.ActorGT != nil {
+		predicates = append(predicates, statushistory.ActorGT(*i.ActorGT))
+	}
+	if i.ActorGTE != nil {
+		predicates = append(predicates, statushistory.ActorGTE(*i.ActorGTE))
+	}
+	if i.ActorLT != nil {
+		predicates = append(predicates, statushistory.ActorLT(*i.ActorLT))
+	}
+	if i.ActorLTE != nil {
+		predicates = append(predicates, statushistory.ActorLTE(*i.ActorLTE))
+	}
+	if i.ActorContains != nil {
+		predicates = append(predicates, statushistory.ActorContains(*i.ActorContains))
+	}
+	if i.ActorHasPrefix != nil {
+		predicates = append(predicates, statushistory.ActorHasPrefix(*i.ActorHasPrefix))
+	}
+	if i.ActorHasSuffix != nil {
+		predicates = append(predicates, statushistory.ActorHasSuffix(*i.ActorHasSuffix))
+	}
+	if i.ActorIsNil {
+		predicates = append(predicates, statushistory.ActorIsNil())
+	}
+	if i.ActorNotNil {
+		predicates = append(predicates, statushistory.ActorNotNil())
+	}
+	if i.ActorEqualFold != nil {
+		predicates = append(predicates, statushistory.ActorEqualFold(*i.ActorEqualFold))
+	}
+	if i.ActorContainsFold != nil {
+		predicates = append(predicates, statushistory.ActorContainsFold(*i.ActorContainsFold))
+	}
+
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyStatusHistoryWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return statushistory.And(predicates...), nil
+	}
+}
+
 // StatusNamespaceWhereInput represents a where input for filtering StatusNamespace queries.
 type StatusNamespaceWhereInput struct {
 	Predicates []predicate.StatusNamespace  `json:"-"`
@@ -1111,6 +2114,10 @@ type StatusNamespaceWhereInput struct {
 	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
 	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
 	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+
+	// "statuses" edge predicates.
+	HasStatuses     *bool               `json:"hasStatuses,omitempty"`
+	HasStatusesWith []*StatusWhereInput `json:"hasStatusesWith,omitempty"`
 }
 
 // AddPredicates adds custom predicates to the where input to be used during the filtering phase.
@@ -1296,6 +2303,24 @@ func (i *StatusNamespaceWhereInput) P() (predicate.StatusNamespace, error) {
 		predicates = append(predicates, statusnamespace.NameContainsFold(*i.NameContainsFold))
 	}
 
+	if i.HasStatuses != nil {
+		p := statusnamespace.HasStatuses()
+		if !*i.HasStatuses {
+			p = statusnamespace.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasStatusesWith) > 0 {
+		with := make([]predicate.Status, 0, len(i.HasStatusesWith))
+		for _, w := range i.HasStatusesWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasStatusesWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, statusnamespace.HasStatusesWith(with...))
+	}
 	switch len(predicates) {
 	case 0:
 		return nil, ErrEmptyStatusNamespaceWhereInput
@@ -35,6 +35,18 @@ func (f AnnotationFunc) Mutate(ctx context.Context, m generated.Mutation) (gener
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AnnotationMutation", m)
 }
 
+// The AnnotationHistoryFunc type is an adapter to allow the use of ordinary
+// function as AnnotationHistory mutator.
+type AnnotationHistoryFunc func(context.Context, *generated.AnnotationHistoryMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AnnotationHistoryFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.AnnotationHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.AnnotationHistoryMutation", m)
+}
+
 // The AnnotationNamespaceFunc type is an adapter to allow the use of ordinary
 // function as AnnotationNamespace mutator.
 type AnnotationNamespaceFunc func(context.Context, *generated.AnnotationNamespaceMutation) (generated.Value, error)
@@ -59,6 +71,18 @@ func (f MetadataFunc) Mutate(ctx context.Context, m generated.Mutation) (generat
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.MetadataMutation", m)
 }
 
+// The NamespaceDeletionFunc type is an adapter to allow the use of ordinary
+// function as NamespaceDeletion mutator.
+type NamespaceDeletionFunc func(context.Context, *generated.NamespaceDeletionMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NamespaceDeletionFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.NamespaceDeletionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.NamespaceDeletionMutation", m)
+}
+
 // The StatusFunc type is an adapter to allow the use of ordinary
 // function as Status mutator.
 type StatusFunc func(context.Context, *generated.StatusMutation) (generated.Value, error)
@@ -71,6 +95,18 @@ func (f StatusFunc) Mutate(ctx context.Context, m generated.Mutation) (generated
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.StatusMutation", m)
 }
 
+// The StatusHistoryFunc type is an adapter to allow the use of ordinary
+// function as StatusHistory mutator.
+type StatusHistoryFunc func(context.Context, *generated.StatusHistoryMutation) (generated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StatusHistoryFunc) Mutate(ctx context.Context, m generated.Mutation) (generated.Value, error) {
+	if mv, ok := m.(*generated.StatusHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *generated.StatusHistoryMutation", m)
+}
+
 // The StatusNamespaceFunc type is an adapter to allow the use of ordinary
 // function as StatusNamespace mutator.
 type StatusNamespaceFunc func(context.Context, *generated.StatusNamespaceMutation) (generated.Value, error)
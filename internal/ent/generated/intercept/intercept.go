@@ -23,10 +23,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"go.infratographer.com/metadata-api/internal/ent/generated"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
 	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
 )
 
@@ -113,6 +116,33 @@ func (f TraverseAnnotation) Traverse(ctx context.Context, q generated.Query) err
 	return fmt.Errorf("unexpected query type %T. expect *generated.AnnotationQuery", q)
 }
 
+// The AnnotationHistoryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AnnotationHistoryFunc func(context.Context, *generated.AnnotationHistoryQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f AnnotationHistoryFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.AnnotationHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.AnnotationHistoryQuery", q)
+}
+
+// The TraverseAnnotationHistory type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAnnotationHistory func(context.Context, *generated.AnnotationHistoryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAnnotationHistory) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAnnotationHistory) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.AnnotationHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.AnnotationHistoryQuery", q)
+}
+
 // The AnnotationNamespaceFunc type is an adapter to allow the use of ordinary function as a Querier.
 type AnnotationNamespaceFunc func(context.Context, *generated.AnnotationNamespaceQuery) (generated.Value, error)
 
@@ -167,6 +197,33 @@ func (f TraverseMetadata) Traverse(ctx context.Context, q generated.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *generated.MetadataQuery", q)
 }
 
+// The NamespaceDeletionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type NamespaceDeletionFunc func(context.Context, *generated.NamespaceDeletionQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f NamespaceDeletionFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.NamespaceDeletionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.NamespaceDeletionQuery", q)
+}
+
+// The TraverseNamespaceDeletion type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseNamespaceDeletion func(context.Context, *generated.NamespaceDeletionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseNamespaceDeletion) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseNamespaceDeletion) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.NamespaceDeletionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.NamespaceDeletionQuery", q)
+}
+
 // The StatusFunc type is an adapter to allow the use of ordinary function as a Querier.
 type StatusFunc func(context.Context, *generated.StatusQuery) (generated.Value, error)
 
@@ -194,6 +251,33 @@ func (f TraverseStatus) Traverse(ctx context.Context, q generated.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *generated.StatusQuery", q)
 }
 
+// The StatusHistoryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type StatusHistoryFunc func(context.Context, *generated.StatusHistoryQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f StatusHistoryFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.StatusHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.StatusHistoryQuery", q)
+}
+
+// The TraverseStatusHistory type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseStatusHistory func(context.Context, *generated.StatusHistoryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseStatusHistory) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseStatusHistory) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.StatusHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.StatusHistoryQuery", q)
+}
+
 // The StatusNamespaceFunc type is an adapter to allow the use of ordinary function as a Querier.
 type StatusNamespaceFunc func(context.Context, *generated.StatusNamespaceQuery) (generated.Value, error)
 
@@ -226,12 +310,18 @@ func NewQuery(q generated.Query) (Query, error) {
 	switch q := q.(type) {
 	case *generated.AnnotationQuery:
 		return &query[*generated.AnnotationQuery, predicate.Annotation, annotation.OrderOption]{typ: generated.TypeAnnotation, tq: q}, nil
+	case *generated.AnnotationHistoryQuery:
+		return &query[*generated.AnnotationHistoryQuery, predicate.AnnotationHistory, annotationhistory.OrderOption]{typ: generated.TypeAnnotationHistory, tq: q}, nil
 	case *generated.AnnotationNamespaceQuery:
 		return &query[*generated.AnnotationNamespaceQuery, predicate.AnnotationNamespace, annotationnamespace.OrderOption]{typ: generated.TypeAnnotationNamespace, tq: q}, nil
 	case *generated.MetadataQuery:
 		return &query[*generated.MetadataQuery, predicate.Metadata, metadata.OrderOption]{typ: generated.TypeMetadata, tq: q}, nil
+	case *generated.NamespaceDeletionQuery:
+		return &query[*generated.NamespaceDeletionQuery, predicate.NamespaceDeletion, namespacedeletion.OrderOption]{typ: generated.TypeNamespaceDeletion, tq: q}, nil
 	case *generated.StatusQuery:
 		return &query[*generated.StatusQuery, predicate.Status, status.OrderOption]{typ: generated.TypeStatus, tq: q}, nil
+	case *generated.StatusHistoryQuery:
+		return &query[*generated.StatusHistoryQuery, predicate.StatusHistory, statushistory.OrderOption]{typ: generated.TypeStatusHistory, tq: q}, nil
 	case *generated.StatusNamespaceQuery:
 		return &query[*generated.StatusNamespaceQuery, predicate.StatusNamespace, statusnamespace.OrderOption]{typ: generated.TypeStatusNamespace, tq: q}, nil
 	default:
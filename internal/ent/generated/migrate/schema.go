@@ -29,6 +29,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "json_data", Type: field.TypeJSON},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
 		{Name: "annotation_namespace_id", Type: field.TypeString},
 		{Name: "metadata_id", Type: field.TypeString},
 	}
@@ -40,13 +41,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "annotations_annotation_namespaces_namespace",
-				Columns:    []*schema.Column{AnnotationsColumns[4]},
+				Columns:    []*schema.Column{AnnotationsColumns[5]},
 				RefColumns: []*schema.Column{AnnotationNamespacesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "annotations_metadata_metadata",
-				Columns:    []*schema.Column{AnnotationsColumns[5]},
+				Columns:    []*schema.Column{AnnotationsColumns[6]},
 				RefColumns: []*schema.Column{MetadataColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -65,12 +66,12 @@ var (
 			{
 				Name:    "annotation_metadata_id_annotation_namespace_id",
 				Unique:  true,
-				Columns: []*schema.Column{AnnotationsColumns[5], AnnotationsColumns[4]},
+				Columns: []*schema.Column{AnnotationsColumns[6], AnnotationsColumns[5]},
 			},
 			{
 				Name:    "annotation_annotation_namespace_id_json_data",
 				Unique:  false,
-				Columns: []*schema.Column{AnnotationsColumns[4], AnnotationsColumns[3]},
+				Columns: []*schema.Column{AnnotationsColumns[5], AnnotationsColumns[3]},
 				Annotation: &entsql.IndexAnnotation{
 					Types: map[string]string{
 						"postgres": "GIN",
@@ -79,14 +80,49 @@ var (
 			},
 		},
 	}
+	// AnnotationHistoriesColumns holds the columns for the "annotation_histories" table.
+	AnnotationHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "annotation_id", Type: field.TypeString},
+		{Name: "metadata_id", Type: field.TypeString},
+		{Name: "annotation_namespace_id", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"CREATE", "UPDATE", "DELETE"}},
+		{Name: "json_data", Type: field.TypeJSON, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "annotation_created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "annotation_updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "annotation_version", Type: field.TypeInt64, Default: 1},
+	}
+	// AnnotationHistoriesTable holds the schema information for the "annotation_histories" table.
+	AnnotationHistoriesTable = &schema.Table{
+		Name:       "annotation_histories",
+		Columns:    AnnotationHistoriesColumns,
+		PrimaryKey: []*schema.Column{AnnotationHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "annotationhistory_annotation_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AnnotationHistoriesColumns[2], AnnotationHistoriesColumns[1]},
+			},
+			{
+				Name:    "annotationhistory_metadata_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AnnotationHistoriesColumns[3], AnnotationHistoriesColumns[1]},
+			},
+		},
+	}
 	// AnnotationNamespacesColumns holds the columns for the "annotation_namespaces" table.
 	AnnotationNamespacesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "owner_id", Type: field.TypeString},
 		{Name: "private", Type: field.TypeBool, Default: false},
+		{Name: "deleting", Type: field.TypeBool, Default: false},
+		{Name: "json_schema", Type: field.TypeJSON, Nullable: true},
 	}
 	// AnnotationNamespacesTable holds the schema information for the "annotation_namespaces" table.
 	AnnotationNamespacesTable = &schema.Table{
@@ -104,15 +140,23 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{AnnotationNamespacesColumns[2]},
 			},
+			{
+				Name:    "annotationnamespace_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{AnnotationNamespacesColumns[3]},
+			},
 			{
 				Name:    "annotationnamespace_owner_id",
 				Unique:  false,
-				Columns: []*schema.Column{AnnotationNamespacesColumns[4]},
+				Columns: []*schema.Column{AnnotationNamespacesColumns[5]},
 			},
 			{
 				Name:    "annotationnamespace_owner_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{AnnotationNamespacesColumns[4], AnnotationNamespacesColumns[3]},
+				Columns: []*schema.Column{AnnotationNamespacesColumns[5], AnnotationNamespacesColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
 			},
 		},
 	}
@@ -141,6 +185,46 @@ var (
 			},
 		},
 	}
+	// NamespaceDeletionsColumns holds the columns for the "namespace_deletions" table.
+	NamespaceDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "namespace_id", Type: field.TypeString},
+		{Name: "owner_id", Type: field.TypeString},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"PENDING", "RUNNING", "COMPLETED", "CANCELED"}, Default: "PENDING"},
+		{Name: "total_count", Type: field.TypeInt},
+		{Name: "deleted_count", Type: field.TypeInt, Default: 0},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// NamespaceDeletionsTable holds the schema information for the "namespace_deletions" table.
+	NamespaceDeletionsTable = &schema.Table{
+		Name:       "namespace_deletions",
+		Columns:    NamespaceDeletionsColumns,
+		PrimaryKey: []*schema.Column{NamespaceDeletionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "namespacedeletion_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{NamespaceDeletionsColumns[1]},
+			},
+			{
+				Name:    "namespacedeletion_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{NamespaceDeletionsColumns[2]},
+			},
+			{
+				Name:    "namespacedeletion_namespace_id",
+				Unique:  false,
+				Columns: []*schema.Column{NamespaceDeletionsColumns[3]},
+			},
+			{
+				Name:    "namespacedeletion_state",
+				Unique:  false,
+				Columns: []*schema.Column{NamespaceDeletionsColumns[5]},
+			},
+		},
+	}
 	// StatusColumns holds the columns for the "status" table.
 	StatusColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -148,6 +232,8 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "source", Type: field.TypeString},
 		{Name: "json_data", Type: field.TypeJSON},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "status_namespace_id", Type: field.TypeString},
 		{Name: "metadata_id", Type: field.TypeString},
 	}
@@ -159,13 +245,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "status_status_namespaces_namespace",
-				Columns:    []*schema.Column{StatusColumns[5]},
+				Columns:    []*schema.Column{StatusColumns[7]},
 				RefColumns: []*schema.Column{StatusNamespacesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "status_metadata_metadata",
-				Columns:    []*schema.Column{StatusColumns[6]},
+				Columns:    []*schema.Column{StatusColumns[8]},
 				RefColumns: []*schema.Column{MetadataColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -184,17 +270,22 @@ var (
 			{
 				Name:    "status_metadata_id_status_namespace_id",
 				Unique:  false,
-				Columns: []*schema.Column{StatusColumns[6], StatusColumns[5]},
+				Columns: []*schema.Column{StatusColumns[8], StatusColumns[7]},
 			},
 			{
 				Name:    "status_metadata_id_status_namespace_id_source",
 				Unique:  true,
-				Columns: []*schema.Column{StatusColumns[6], StatusColumns[5], StatusColumns[3]},
+				Columns: []*schema.Column{StatusColumns[8], StatusColumns[7], StatusColumns[3]},
+			},
+			{
+				Name:    "status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{StatusColumns[6]},
 			},
 			{
 				Name:    "status_status_namespace_id_json_data",
 				Unique:  false,
-				Columns: []*schema.Column{StatusColumns[5], StatusColumns[4]},
+				Columns: []*schema.Column{StatusColumns[7], StatusColumns[4]},
 				Annotation: &entsql.IndexAnnotation{
 					Types: map[string]string{
 						"postgres": "GIN",
@@ -203,14 +294,52 @@ var (
 			},
 		},
 	}
+	// StatusHistoriesColumns holds the columns for the "status_histories" table.
+	StatusHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "status_id", Type: field.TypeString},
+		{Name: "metadata_id", Type: field.TypeString},
+		{Name: "status_namespace_id", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"CREATE", "UPDATE", "DELETE"}},
+		{Name: "json_data", Type: field.TypeJSON, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "status_created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status_updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status_version", Type: field.TypeInt64, Default: 1},
+	}
+	// StatusHistoriesTable holds the schema information for the "status_histories" table.
+	StatusHistoriesTable = &schema.Table{
+		Name:       "status_histories",
+		Columns:    StatusHistoriesColumns,
+		PrimaryKey: []*schema.Column{StatusHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "statushistory_status_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{StatusHistoriesColumns[2], StatusHistoriesColumns[1]},
+			},
+			{
+				Name:    "statushistory_metadata_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{StatusHistoriesColumns[3], StatusHistoriesColumns[1]},
+			},
+		},
+	}
 	// StatusNamespacesColumns holds the columns for the "status_namespaces" table.
 	StatusNamespacesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "resource_provider_id", Type: field.TypeString},
 		{Name: "private", Type: field.TypeBool, Default: false},
+		{Name: "deleting", Type: field.TypeBool, Default: false},
+		{Name: "json_schema", Type: field.TypeJSON, Nullable: true},
+		{Name: "default_ttl", Type: field.TypeInt64, Nullable: true},
 	}
 	// StatusNamespacesTable holds the schema information for the "status_namespaces" table.
 	StatusNamespacesTable = &schema.Table{
@@ -228,24 +357,35 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{StatusNamespacesColumns[2]},
 			},
+			{
+				Name:    "statusnamespace_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{StatusNamespacesColumns[3]},
+			},
 			{
 				Name:    "statusnamespace_resource_provider_id",
 				Unique:  false,
-				Columns: []*schema.Column{StatusNamespacesColumns[4]},
+				Columns: []*schema.Column{StatusNamespacesColumns[5]},
 			},
 			{
 				Name:    "statusnamespace_resource_provider_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{StatusNamespacesColumns[4], StatusNamespacesColumns[3]},
+				Columns: []*schema.Column{StatusNamespacesColumns[5], StatusNamespacesColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at IS NULL",
+				},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AnnotationsTable,
+		AnnotationHistoriesTable,
 		AnnotationNamespacesTable,
 		MetadataTable,
+		NamespaceDeletionsTable,
 		StatusTable,
+		StatusHistoriesTable,
 		StatusNamespacesTable,
 	}
 )
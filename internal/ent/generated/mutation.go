@@ -27,10 +27,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
 	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
 	"go.infratographer.com/x/gidx"
 )
@@ -45,9 +48,12 @@ const (
 
 	// Node types.
 	TypeAnnotation          = "Annotation"
+	TypeAnnotationHistory   = "AnnotationHistory"
 	TypeAnnotationNamespace = "AnnotationNamespace"
 	TypeMetadata            = "Metadata"
+	TypeNamespaceDeletion   = "NamespaceDeletion"
 	TypeStatus              = "Status"
+	TypeStatusHistory       = "StatusHistory"
 	TypeStatusNamespace     = "StatusNamespace"
 )
 
@@ -61,6 +67,8 @@ type AnnotationMutation struct {
 	updated_at       *time.Time
 	data             *json.RawMessage
 	appenddata       json.RawMessage
+	version          *int64
+	addversion       *int64
 	clearedFields    map[string]struct{}
 	namespace        *gidx.PrefixedID
 	clearednamespace bool
@@ -370,6 +378,62 @@ func (m *AnnotationMutation) ResetData() {
 	m.appenddata = nil
 }
 
+// SetVersion sets the "version" field.
+func (m *AnnotationMutation) SetVersion(i int64) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *AnnotationMutation) Version() (r int64, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Annotation entity.
+// If the Annotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationMutation) OldVersion(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *AnnotationMutation) AddVersion(i int64) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *AnnotationMutation) AddedVersion() (r int64, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *AnnotationMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
 // SetNamespaceID sets the "namespace" edge to the AnnotationNamespace entity by id.
 func (m *AnnotationMutation) SetNamespaceID(id gidx.PrefixedID) {
 	m.namespace = &id
@@ -471,7 +535,7 @@ func (m *AnnotationMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AnnotationMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 6)
 	if m.created_at != nil {
 		fields = append(fields, annotation.FieldCreatedAt)
 	}
@@ -487,6 +551,9 @@ func (m *AnnotationMutation) Fields() []string {
 	if m.data != nil {
 		fields = append(fields, annotation.FieldData)
 	}
+	if m.version != nil {
+		fields = append(fields, annotation.FieldVersion)
+	}
 	return fields
 }
 
@@ -505,6 +572,8 @@ func (m *AnnotationMutation) Field(name string) (ent.Value, bool) {
 		return m.AnnotationNamespaceID()
 	case annotation.FieldData:
 		return m.Data()
+	case annotation.FieldVersion:
+		return m.Version()
 	}
 	return nil, false
 }
@@ -524,6 +593,8 @@ func (m *AnnotationMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldAnnotationNamespaceID(ctx)
 	case annotation.FieldData:
 		return m.OldData(ctx)
+	case annotation.FieldVersion:
+		return m.OldVersion(ctx)
 	}
 	return nil, fmt.Errorf("unknown Annotation field %s", name)
 }
@@ -568,6 +639,13 @@ func (m *AnnotationMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetData(v)
 		return nil
+	case annotation.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Annotation field %s", name)
 }
@@ -575,13 +653,21 @@ func (m *AnnotationMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *AnnotationMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, annotation.FieldVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *AnnotationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case annotation.FieldVersion:
+		return m.AddedVersion()
+	}
 	return nil, false
 }
 
@@ -590,6 +676,13 @@ func (m *AnnotationMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *AnnotationMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case annotation.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Annotation numeric field %s", name)
 }
@@ -632,6 +725,9 @@ func (m *AnnotationMutation) ResetField(name string) error {
 	case annotation.FieldData:
 		m.ResetData()
 		return nil
+	case annotation.FieldVersion:
+		m.ResetVersion()
+		return nil
 	}
 	return fmt.Errorf("unknown Annotation field %s", name)
 }
@@ -728,37 +824,41 @@ func (m *AnnotationMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Annotation edge %s", name)
 }
 
-// AnnotationNamespaceMutation represents an operation that mutates the AnnotationNamespace nodes in the graph.
-type AnnotationNamespaceMutation struct {
+// AnnotationHistoryMutation represents an operation that mutates the AnnotationHistory nodes in the graph.
+type AnnotationHistoryMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *gidx.PrefixedID
-	created_at         *time.Time
-	updated_at         *time.Time
-	name               *string
-	owner_id           *gidx.PrefixedID
-	private            *bool
-	clearedFields      map[string]struct{}
-	annotations        map[gidx.PrefixedID]struct{}
-	removedannotations map[gidx.PrefixedID]struct{}
-	clearedannotations bool
-	done               bool
-	oldValue           func(context.Context) (*AnnotationNamespace, error)
-	predicates         []predicate.AnnotationNamespace
-}
-
-var _ ent.Mutation = (*AnnotationNamespaceMutation)(nil)
-
-// annotationnamespaceOption allows management of the mutation configuration using functional options.
-type annotationnamespaceOption func(*AnnotationNamespaceMutation)
-
-// newAnnotationNamespaceMutation creates new mutation for the AnnotationNamespace entity.
-func newAnnotationNamespaceMutation(c config, op Op, opts ...annotationnamespaceOption) *AnnotationNamespaceMutation {
-	m := &AnnotationNamespaceMutation{
+	op                      Op
+	typ                     string
+	id                      *gidx.PrefixedID
+	created_at              *time.Time
+	annotation_id           *gidx.PrefixedID
+	metadata_id             *gidx.PrefixedID
+	annotation_namespace_id *gidx.PrefixedID
+	operation               *annotationhistory.Operation
+	data                    *json.RawMessage
+	appenddata              json.RawMessage
+	actor                   *string
+	annotation_created_at   *time.Time
+	annotation_updated_at   *time.Time
+	annotation_version      *int64
+	addannotation_version   *int64
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*AnnotationHistory, error)
+	predicates              []predicate.AnnotationHistory
+}
+
+var _ ent.Mutation = (*AnnotationHistoryMutation)(nil)
+
+// annotationhistoryOption allows management of the mutation configuration using functional options.
+type annotationhistoryOption func(*AnnotationHistoryMutation)
+
+// newAnnotationHistoryMutation creates new mutation for the AnnotationHistory entity.
+func newAnnotationHistoryMutation(c config, op Op, opts ...annotationhistoryOption) *AnnotationHistoryMutation {
+	m := &AnnotationHistoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAnnotationNamespace,
+		typ:           TypeAnnotationHistory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -767,20 +867,20 @@ func newAnnotationNamespaceMutation(c config, op Op, opts ...annotationnamespace
 	return m
 }
 
-// withAnnotationNamespaceID sets the ID field of the mutation.
-func withAnnotationNamespaceID(id gidx.PrefixedID) annotationnamespaceOption {
-	return func(m *AnnotationNamespaceMutation) {
+// withAnnotationHistoryID sets the ID field of the mutation.
+func withAnnotationHistoryID(id gidx.PrefixedID) annotationhistoryOption {
+	return func(m *AnnotationHistoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AnnotationNamespace
+			value *AnnotationHistory
 		)
-		m.oldValue = func(ctx context.Context) (*AnnotationNamespace, error) {
+		m.oldValue = func(ctx context.Context) (*AnnotationHistory, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AnnotationNamespace.Get(ctx, id)
+					value, err = m.Client().AnnotationHistory.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -789,10 +889,10 @@ func withAnnotationNamespaceID(id gidx.PrefixedID) annotationnamespaceOption {
 	}
 }
 
-// withAnnotationNamespace sets the old AnnotationNamespace of the mutation.
-func withAnnotationNamespace(node *AnnotationNamespace) annotationnamespaceOption {
-	return func(m *AnnotationNamespaceMutation) {
-		m.oldValue = func(context.Context) (*AnnotationNamespace, error) {
+// withAnnotationHistory sets the old AnnotationHistory of the mutation.
+func withAnnotationHistory(node *AnnotationHistory) annotationhistoryOption {
+	return func(m *AnnotationHistoryMutation) {
+		m.oldValue = func(context.Context) (*AnnotationHistory, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -801,7 +901,7 @@ func withAnnotationNamespace(node *AnnotationNamespace) annotationnamespaceOptio
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AnnotationNamespaceMutation) Client() *Client {
+func (m AnnotationHistoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -809,7 +909,7 @@ func (m AnnotationNamespaceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AnnotationNamespaceMutation) Tx() (*Tx, error) {
+func (m AnnotationHistoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -819,14 +919,14 @@ func (m AnnotationNamespaceMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AnnotationNamespace entities.
-func (m *AnnotationNamespaceMutation) SetID(id gidx.PrefixedID) {
+// operation is only accepted on creation of AnnotationHistory entities.
+func (m *AnnotationHistoryMutation) SetID(id gidx.PrefixedID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AnnotationNamespaceMutation) ID() (id gidx.PrefixedID, exists bool) {
+func (m *AnnotationHistoryMutation) ID() (id gidx.PrefixedID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -837,7 +937,7 @@ func (m *AnnotationNamespaceMutation) ID() (id gidx.PrefixedID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AnnotationNamespaceMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+func (m *AnnotationHistoryMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -846,19 +946,19 @@ func (m *AnnotationNamespaceMutation) IDs(ctx context.Context) ([]gidx.PrefixedI
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AnnotationNamespace.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AnnotationHistory.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *AnnotationNamespaceMutation) SetCreatedAt(t time.Time) {
+func (m *AnnotationHistoryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AnnotationNamespaceMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AnnotationHistoryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -866,10 +966,10 @@ func (m *AnnotationNamespaceMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the AnnotationNamespace entity.
-// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AnnotationHistory entity.
+// If the AnnotationHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AnnotationNamespaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AnnotationHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -884,257 +984,486 @@ func (m *AnnotationNamespaceMutation) OldCreatedAt(ctx context.Context) (v time.
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AnnotationNamespaceMutation) ResetCreatedAt() {
+func (m *AnnotationHistoryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *AnnotationNamespaceMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetAnnotationID sets the "annotation_id" field.
+func (m *AnnotationHistoryMutation) SetAnnotationID(gi gidx.PrefixedID) {
+	m.annotation_id = &gi
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *AnnotationNamespaceMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// AnnotationID returns the value of the "annotation_id" field in the mutation.
+func (m *AnnotationHistoryMutation) AnnotationID() (r gidx.PrefixedID, exists bool) {
+	v := m.annotation_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the AnnotationNamespace entity.
-// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
+// OldAnnotationID returns the old "annotation_id" field's value of the AnnotationHistory entity.
+// If the AnnotationHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AnnotationNamespaceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AnnotationHistoryMutation) OldAnnotationID(ctx context.Context) (v gidx.PrefixedID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnnotationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAnnotationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnnotationID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.AnnotationID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *AnnotationNamespaceMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetAnnotationID resets all changes to the "annotation_id" field.
+func (m *AnnotationHistoryMutation) ResetAnnotationID() {
+	m.annotation_id = nil
 }
 
-// SetName sets the "name" field.
-func (m *AnnotationNamespaceMutation) SetName(s string) {
-	m.name = &s
+// SetMetadataID sets the "metadata_id" field.
+func (m *AnnotationHistoryMutation) SetMetadataID(gi gidx.PrefixedID) {
+	m.metadata_id = &gi
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *AnnotationNamespaceMutation) Name() (r string, exists bool) {
-	v := m.name
+// MetadataID returns the value of the "metadata_id" field in the mutation.
+func (m *AnnotationHistoryMutation) MetadataID() (r gidx.PrefixedID, exists bool) {
+	v := m.metadata_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the AnnotationNamespace entity.
-// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadataID returns the old "metadata_id" field's value of the AnnotationHistory entity.
+// If the AnnotationHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AnnotationNamespaceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AnnotationHistoryMutation) OldMetadataID(ctx context.Context) (v gidx.PrefixedID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadataID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldMetadataID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadataID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.MetadataID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *AnnotationNamespaceMutation) ResetName() {
-	m.name = nil
+// ResetMetadataID resets all changes to the "metadata_id" field.
+func (m *AnnotationHistoryMutation) ResetMetadataID() {
+	m.metadata_id = nil
 }
 
-// SetOwnerID sets the "owner_id" field.
-func (m *AnnotationNamespaceMutation) SetOwnerID(gi gidx.PrefixedID) {
-	m.owner_id = &gi
+// SetAnnotationNamespaceID sets the "annotation_namespace_id" field.
+func (m *AnnotationHistoryMutation) SetAnnotationNamespaceID(gi gidx.PrefixedID) {
+	m.annotation_namespace_id = &gi
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *AnnotationNamespaceMutation) OwnerID() (r gidx.PrefixedID, exists bool) {
-	v := m.owner_id
+// AnnotationNamespaceID returns the value of the "annotation_namespace_id" field in the mutation.
+func (m *AnnotationHistoryMutation) AnnotationNamespaceID() (r gidx.PrefixedID, exists bool) {
+	v := m.annotation_namespace_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the AnnotationNamespace entity.
-// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
+// OldAnnotationNamespaceID returns the old "annotation_namespace_id" field's value of the AnnotationHistory entity.
+// If the AnnotationHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AnnotationNamespaceMutation) OldOwnerID(ctx context.Context) (v gidx.PrefixedID, err error) {
+func (m *AnnotationHistoryMutation) OldAnnotationNamespaceID(ctx context.Context) (v gidx.PrefixedID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAnnotationNamespaceID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+		return v, errors.New("OldAnnotationNamespaceID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAnnotationNamespaceID: %w", err)
 	}
-	return oldValue.OwnerID, nil
+	return oldValue.AnnotationNamespaceID, nil
 }
 
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *AnnotationNamespaceMutation) ResetOwnerID() {
-	m.owner_id = nil
+// ResetAnnotationNamespaceID resets all changes to the "annotation_namespace_id" field.
+func (m *AnnotationHistoryMutation) ResetAnnotationNamespaceID() {
+	m.annotation_namespace_id = nil
 }
 
-// SetPrivate sets the "private" field.
-func (m *AnnotationNamespaceMutation) SetPrivate(b bool) {
-	m.private = &b
+// SetOperation sets the "operation" field.
+func (m *AnnotationHistoryMutation) SetOperation(a annotationhistory.Operation) {
+	m.operation = &a
 }
 
-// Private returns the value of the "private" field in the mutation.
-func (m *AnnotationNamespaceMutation) Private() (r bool, exists bool) {
-	v := m.private
+// Operation returns the value of the "operation" field in the mutation.
+func (m *AnnotationHistoryMutation) Operation() (r annotationhistory.Operation, exists bool) {
+	v := m.operation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrivate returns the old "private" field's value of the AnnotationNamespace entity.
-// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
+// OldOperation returns the old "operation" field's value of the AnnotationHistory entity.
+// If the AnnotationHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AnnotationNamespaceMutation) OldPrivate(ctx context.Context) (v bool, err error) {
+func (m *AnnotationHistoryMutation) OldOperation(ctx context.Context) (v annotationhistory.Operation, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrivate is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrivate requires an ID field in the mutation")
+		return v, errors.New("OldOperation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrivate: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
 	}
-	return oldValue.Private, nil
-}
-
-// ResetPrivate resets all changes to the "private" field.
-func (m *AnnotationNamespaceMutation) ResetPrivate() {
-	m.private = nil
+	return oldValue.Operation, nil
 }
 
-// AddAnnotationIDs adds the "annotations" edge to the Annotation entity by ids.
-func (m *AnnotationNamespaceMutation) AddAnnotationIDs(ids ...gidx.PrefixedID) {
-	if m.annotations == nil {
-		m.annotations = make(map[gidx.PrefixedID]struct{})
-	}
-	for i := range ids {
-		m.annotations[ids[i]] = struct{}{}
-	}
+// ResetOperation resets all changes to the "operation" field.
+func (m *AnnotationHistoryMutation) ResetOperation() {
+	m.operation = nil
 }
 
-// ClearAnnotations clears the "annotations" edge to the Annotation entity.
-func (m *AnnotationNamespaceMutation) ClearAnnotations() {
-	m.clearedannotations = true
+// SetData sets the "data" field.
+func (m *AnnotationHistoryMutation) SetData(jm json.RawMessage) {
+	m.data = &jm
+	m.appenddata = nil
 }
 
-// AnnotationsCleared reports if the "annotations" edge to the Annotation entity was cleared.
-func (m *AnnotationNamespaceMutation) AnnotationsCleared() bool {
-	return m.clearedannotations
+// Data returns the value of the "data" field in the mutation.
+func (m *AnnotationHistoryMutation) Data() (r json.RawMessage, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveAnnotationIDs removes the "annotations" edge to the Annotation entity by IDs.
-func (m *AnnotationNamespaceMutation) RemoveAnnotationIDs(ids ...gidx.PrefixedID) {
-	if m.removedannotations == nil {
-		m.removedannotations = make(map[gidx.PrefixedID]struct{})
+// OldData returns the old "data" field's value of the AnnotationHistory entity.
+// If the AnnotationHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationHistoryMutation) OldData(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.annotations, ids[i])
-		m.removedannotations[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
 	}
+	return oldValue.Data, nil
 }
 
-// RemovedAnnotations returns the removed IDs of the "annotations" edge to the Annotation entity.
-func (m *AnnotationNamespaceMutation) RemovedAnnotationsIDs() (ids []gidx.PrefixedID) {
-	for id := range m.removedannotations {
-		ids = append(ids, id)
-	}
-	return
+// AppendData adds jm to the "data" field.
+func (m *AnnotationHistoryMutation) AppendData(jm json.RawMessage) {
+	m.appenddata = append(m.appenddata, jm...)
 }
 
-// AnnotationsIDs returns the "annotations" edge IDs in the mutation.
-func (m *AnnotationNamespaceMutation) AnnotationsIDs() (ids []gidx.PrefixedID) {
-	for id := range m.annotations {
-		ids = append(ids, id)
+// AppendedData returns the list of values that were appended to the "data" field in this mutation.
+func (m *AnnotationHistoryMutation) AppendedData() (json.RawMessage, bool) {
+	if len(m.appenddata) == 0 {
+		return nil, false
 	}
-	return
+	return m.appenddata, true
 }
 
-// ResetAnnotations resets all changes to the "annotations" edge.
-func (m *AnnotationNamespaceMutation) ResetAnnotations() {
-	m.annotations = nil
-	m.clearedannotations = false
-	m.removedannotations = nil
+// ClearData clears the value of the "data" field.
+func (m *AnnotationHistoryMutation) ClearData() {
+	m.data = nil
+	m.appenddata = nil
+	m.clearedFields[annotationhistory.FieldData] = struct{}{}
 }
 
-// Where appends a list predicates to the AnnotationNamespaceMutation builder.
-func (m *AnnotationNamespaceMutation) Where(ps ...predicate.AnnotationNamespace) {
-	m.predicates = append(m.predicates, ps...)
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *AnnotationHistoryMutation) DataCleared() bool {
+	_, ok := m.clearedFields[annotationhistory.FieldData]
+	return ok
 }
 
-// WhereP appends storage-level predicates to the AnnotationNamespaceMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AnnotationNamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AnnotationNamespace, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ResetData resets all changes to the "data" field.
+func (m *AnnotationHistoryMutation) ResetData() {
+	m.data = nil
+	m.appenddata = nil
+	delete(m.clearedFields, annotationhistory.FieldData)
+}
+
+// SetActor sets the "actor" field.
+func (m *AnnotationHistoryMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AnnotationHistoryMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the AnnotationHistory entity.
+// If the AnnotationHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationHistoryMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ClearActor clears the value of the "actor" field.
+func (m *AnnotationHistoryMutation) ClearActor() {
+	m.actor = nil
+	m.clearedFields[annotationhistory.FieldActor] = struct{}{}
+}
+
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *AnnotationHistoryMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[annotationhistory.FieldActor]
+	return ok
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *AnnotationHistoryMutation) ResetActor() {
+	m.actor = nil
+	delete(m.clearedFields, annotationhistory.FieldActor)
+}
+
+// SetAnnotationCreatedAt sets the "annotation_created_at" field.
+func (m *AnnotationHistoryMutation) SetAnnotationCreatedAt(t time.Time) {
+	m.annotation_created_at = &t
+}
+
+// AnnotationCreatedAt returns the value of the "annotation_created_at" field in the mutation.
+func (m *AnnotationHistoryMutation) AnnotationCreatedAt() (r time.Time, exists bool) {
+	v := m.annotation_created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnnotationCreatedAt returns the old "annotation_created_at" field's value of the AnnotationHistory entity.
+// If the AnnotationHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationHistoryMutation) OldAnnotationCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnnotationCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnnotationCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnnotationCreatedAt: %w", err)
+	}
+	return oldValue.AnnotationCreatedAt, nil
+}
+
+// ClearAnnotationCreatedAt clears the value of the "annotation_created_at" field.
+func (m *AnnotationHistoryMutation) ClearAnnotationCreatedAt() {
+	m.annotation_created_at = nil
+	m.clearedFields[annotationhistory.FieldAnnotationCreatedAt] = struct{}{}
+}
+
+// AnnotationCreatedAtCleared returns if the "annotation_created_at" field was cleared in this mutation.
+func (m *AnnotationHistoryMutation) AnnotationCreatedAtCleared() bool {
+	_, ok := m.clearedFields[annotationhistory.FieldAnnotationCreatedAt]
+	return ok
+}
+
+// ResetAnnotationCreatedAt resets all changes to the "annotation_created_at" field.
+func (m *AnnotationHistoryMutation) ResetAnnotationCreatedAt() {
+	m.annotation_created_at = nil
+	delete(m.clearedFields, annotationhistory.FieldAnnotationCreatedAt)
+}
+
+// SetAnnotationUpdatedAt sets the "annotation_updated_at" field.
+func (m *AnnotationHistoryMutation) SetAnnotationUpdatedAt(t time.Time) {
+	m.annotation_updated_at = &t
+}
+
+// AnnotationUpdatedAt returns the value of the "annotation_updated_at" field in the mutation.
+func (m *AnnotationHistoryMutation) AnnotationUpdatedAt() (r time.Time, exists bool) {
+	v := m.annotation_updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnnotationUpdatedAt returns the old "annotation_updated_at" field's value of the AnnotationHistory entity.
+// If the AnnotationHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationHistoryMutation) OldAnnotationUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnnotationUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnnotationUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnnotationUpdatedAt: %w", err)
+	}
+	return oldValue.AnnotationUpdatedAt, nil
+}
+
+// ClearAnnotationUpdatedAt clears the value of the "annotation_updated_at" field.
+func (m *AnnotationHistoryMutation) ClearAnnotationUpdatedAt() {
+	m.annotation_updated_at = nil
+	m.clearedFields[annotationhistory.FieldAnnotationUpdatedAt] = struct{}{}
+}
+
+// AnnotationUpdatedAtCleared returns if the "annotation_updated_at" field was cleared in this mutation.
+func (m *AnnotationHistoryMutation) AnnotationUpdatedAtCleared() bool {
+	_, ok := m.clearedFields[annotationhistory.FieldAnnotationUpdatedAt]
+	return ok
+}
+
+// ResetAnnotationUpdatedAt resets all changes to the "annotation_updated_at" field.
+func (m *AnnotationHistoryMutation) ResetAnnotationUpdatedAt() {
+	m.annotation_updated_at = nil
+	delete(m.clearedFields, annotationhistory.FieldAnnotationUpdatedAt)
+}
+
+// SetAnnotationVersion sets the "annotation_version" field.
+func (m *AnnotationHistoryMutation) SetAnnotationVersion(i int64) {
+	m.annotation_version = &i
+	m.addannotation_version = nil
+}
+
+// AnnotationVersion returns the value of the "annotation_version" field in the mutation.
+func (m *AnnotationHistoryMutation) AnnotationVersion() (r int64, exists bool) {
+	v := m.annotation_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnnotationVersion returns the old "annotation_version" field's value of the AnnotationHistory entity.
+// If the AnnotationHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationHistoryMutation) OldAnnotationVersion(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnnotationVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnnotationVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnnotationVersion: %w", err)
+	}
+	return oldValue.AnnotationVersion, nil
+}
+
+// AddAnnotationVersion adds i to the "annotation_version" field.
+func (m *AnnotationHistoryMutation) AddAnnotationVersion(i int64) {
+	if m.addannotation_version != nil {
+		*m.addannotation_version += i
+	} else {
+		m.addannotation_version = &i
+	}
+}
+
+// AddedAnnotationVersion returns the value that was added to the "annotation_version" field in this mutation.
+func (m *AnnotationHistoryMutation) AddedAnnotationVersion() (r int64, exists bool) {
+	v := m.addannotation_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAnnotationVersion resets all changes to the "annotation_version" field.
+func (m *AnnotationHistoryMutation) ResetAnnotationVersion() {
+	m.annotation_version = nil
+	m.addannotation_version = nil
+}
+
+// Where appends a list predicates to the AnnotationHistoryMutation builder.
+func (m *AnnotationHistoryMutation) Where(ps ...predicate.AnnotationHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AnnotationHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AnnotationHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AnnotationHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
 // Op returns the operation name.
-func (m *AnnotationNamespaceMutation) Op() Op {
+func (m *AnnotationHistoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AnnotationNamespaceMutation) SetOp(op Op) {
+func (m *AnnotationHistoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AnnotationNamespace).
-func (m *AnnotationNamespaceMutation) Type() string {
+// Type returns the node type of this mutation (AnnotationHistory).
+func (m *AnnotationHistoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AnnotationNamespaceMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *AnnotationHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.created_at != nil {
-		fields = append(fields, annotationnamespace.FieldCreatedAt)
+		fields = append(fields, annotationhistory.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, annotationnamespace.FieldUpdatedAt)
+	if m.annotation_id != nil {
+		fields = append(fields, annotationhistory.FieldAnnotationID)
 	}
-	if m.name != nil {
-		fields = append(fields, annotationnamespace.FieldName)
+	if m.metadata_id != nil {
+		fields = append(fields, annotationhistory.FieldMetadataID)
 	}
-	if m.owner_id != nil {
-		fields = append(fields, annotationnamespace.FieldOwnerID)
+	if m.annotation_namespace_id != nil {
+		fields = append(fields, annotationhistory.FieldAnnotationNamespaceID)
 	}
-	if m.private != nil {
-		fields = append(fields, annotationnamespace.FieldPrivate)
+	if m.operation != nil {
+		fields = append(fields, annotationhistory.FieldOperation)
+	}
+	if m.data != nil {
+		fields = append(fields, annotationhistory.FieldData)
+	}
+	if m.actor != nil {
+		fields = append(fields, annotationhistory.FieldActor)
+	}
+	if m.annotation_created_at != nil {
+		fields = append(fields, annotationhistory.FieldAnnotationCreatedAt)
+	}
+	if m.annotation_updated_at != nil {
+		fields = append(fields, annotationhistory.FieldAnnotationUpdatedAt)
+	}
+	if m.annotation_version != nil {
+		fields = append(fields, annotationhistory.FieldAnnotationVersion)
 	}
 	return fields
 }
@@ -1142,18 +1471,28 @@ func (m *AnnotationNamespaceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AnnotationNamespaceMutation) Field(name string) (ent.Value, bool) {
+func (m *AnnotationHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case annotationnamespace.FieldCreatedAt:
+	case annotationhistory.FieldCreatedAt:
 		return m.CreatedAt()
-	case annotationnamespace.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case annotationnamespace.FieldName:
-		return m.Name()
-	case annotationnamespace.FieldOwnerID:
-		return m.OwnerID()
-	case annotationnamespace.FieldPrivate:
-		return m.Private()
+	case annotationhistory.FieldAnnotationID:
+		return m.AnnotationID()
+	case annotationhistory.FieldMetadataID:
+		return m.MetadataID()
+	case annotationhistory.FieldAnnotationNamespaceID:
+		return m.AnnotationNamespaceID()
+	case annotationhistory.FieldOperation:
+		return m.Operation()
+	case annotationhistory.FieldData:
+		return m.Data()
+	case annotationhistory.FieldActor:
+		return m.Actor()
+	case annotationhistory.FieldAnnotationCreatedAt:
+		return m.AnnotationCreatedAt()
+	case annotationhistory.FieldAnnotationUpdatedAt:
+		return m.AnnotationUpdatedAt()
+	case annotationhistory.FieldAnnotationVersion:
+		return m.AnnotationVersion()
 	}
 	return nil, false
 }
@@ -1161,246 +1500,315 @@ func (m *AnnotationNamespaceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AnnotationNamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AnnotationHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case annotationnamespace.FieldCreatedAt:
+	case annotationhistory.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case annotationnamespace.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case annotationnamespace.FieldName:
-		return m.OldName(ctx)
-	case annotationnamespace.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case annotationnamespace.FieldPrivate:
-		return m.OldPrivate(ctx)
+	case annotationhistory.FieldAnnotationID:
+		return m.OldAnnotationID(ctx)
+	case annotationhistory.FieldMetadataID:
+		return m.OldMetadataID(ctx)
+	case annotationhistory.FieldAnnotationNamespaceID:
+		return m.OldAnnotationNamespaceID(ctx)
+	case annotationhistory.FieldOperation:
+		return m.OldOperation(ctx)
+	case annotationhistory.FieldData:
+		return m.OldData(ctx)
+	case annotationhistory.FieldActor:
+		return m.OldActor(ctx)
+	case annotationhistory.FieldAnnotationCreatedAt:
+		return m.OldAnnotationCreatedAt(ctx)
+	case annotationhistory.FieldAnnotationUpdatedAt:
+		return m.OldAnnotationUpdatedAt(ctx)
+	case annotationhistory.FieldAnnotationVersion:
+		return m.OldAnnotationVersion(ctx)
 	}
-	return nil, fmt.Errorf("unknown AnnotationNamespace field %s", name)
+	return nil, fmt.Errorf("unknown AnnotationHistory field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AnnotationNamespaceMutation) SetField(name string, value ent.Value) error {
+func (m *AnnotationHistoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case annotationnamespace.FieldCreatedAt:
+	case annotationhistory.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case annotationnamespace.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case annotationhistory.FieldAnnotationID:
+		v, ok := value.(gidx.PrefixedID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetAnnotationID(v)
 		return nil
-	case annotationnamespace.FieldName:
-		v, ok := value.(string)
+	case annotationhistory.FieldMetadataID:
+		v, ok := value.(gidx.PrefixedID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetMetadataID(v)
 		return nil
-	case annotationnamespace.FieldOwnerID:
+	case annotationhistory.FieldAnnotationNamespaceID:
 		v, ok := value.(gidx.PrefixedID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerID(v)
+		m.SetAnnotationNamespaceID(v)
 		return nil
-	case annotationnamespace.FieldPrivate:
-		v, ok := value.(bool)
+	case annotationhistory.FieldOperation:
+		v, ok := value.(annotationhistory.Operation)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrivate(v)
+		m.SetOperation(v)
+		return nil
+	case annotationhistory.FieldData:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case annotationhistory.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case annotationhistory.FieldAnnotationCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnnotationCreatedAt(v)
+		return nil
+	case annotationhistory.FieldAnnotationUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnnotationUpdatedAt(v)
+		return nil
+	case annotationhistory.FieldAnnotationVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnnotationVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AnnotationNamespace field %s", name)
+	return fmt.Errorf("unknown AnnotationHistory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AnnotationNamespaceMutation) AddedFields() []string {
-	return nil
+func (m *AnnotationHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addannotation_version != nil {
+		fields = append(fields, annotationhistory.FieldAnnotationVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AnnotationNamespaceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AnnotationHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case annotationhistory.FieldAnnotationVersion:
+		return m.AddedAnnotationVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AnnotationNamespaceMutation) AddField(name string, value ent.Value) error {
+func (m *AnnotationHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case annotationhistory.FieldAnnotationVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnnotationVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown AnnotationNamespace numeric field %s", name)
+	return fmt.Errorf("unknown AnnotationHistory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AnnotationNamespaceMutation) ClearedFields() []string {
-	return nil
+func (m *AnnotationHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(annotationhistory.FieldData) {
+		fields = append(fields, annotationhistory.FieldData)
+	}
+	if m.FieldCleared(annotationhistory.FieldActor) {
+		fields = append(fields, annotationhistory.FieldActor)
+	}
+	if m.FieldCleared(annotationhistory.FieldAnnotationCreatedAt) {
+		fields = append(fields, annotationhistory.FieldAnnotationCreatedAt)
+	}
+	if m.FieldCleared(annotationhistory.FieldAnnotationUpdatedAt) {
+		fields = append(fields, annotationhistory.FieldAnnotationUpdatedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AnnotationNamespaceMutation) FieldCleared(name string) bool {
+func (m *AnnotationHistoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AnnotationNamespaceMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AnnotationNamespace nullable field %s", name)
+func (m *AnnotationHistoryMutation) ClearField(name string) error {
+	switch name {
+	case annotationhistory.FieldData:
+		m.ClearData()
+		return nil
+	case annotationhistory.FieldActor:
+		m.ClearActor()
+		return nil
+	case annotationhistory.FieldAnnotationCreatedAt:
+		m.ClearAnnotationCreatedAt()
+		return nil
+	case annotationhistory.FieldAnnotationUpdatedAt:
+		m.ClearAnnotationUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AnnotationHistory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AnnotationNamespaceMutation) ResetField(name string) error {
+func (m *AnnotationHistoryMutation) ResetField(name string) error {
 	switch name {
-	case annotationnamespace.FieldCreatedAt:
+	case annotationhistory.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case annotationnamespace.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case annotationhistory.FieldAnnotationID:
+		m.ResetAnnotationID()
 		return nil
-	case annotationnamespace.FieldName:
-		m.ResetName()
+	case annotationhistory.FieldMetadataID:
+		m.ResetMetadataID()
 		return nil
-	case annotationnamespace.FieldOwnerID:
-		m.ResetOwnerID()
+	case annotationhistory.FieldAnnotationNamespaceID:
+		m.ResetAnnotationNamespaceID()
 		return nil
-	case annotationnamespace.FieldPrivate:
-		m.ResetPrivate()
+	case annotationhistory.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case annotationhistory.FieldData:
+		m.ResetData()
+		return nil
+	case annotationhistory.FieldActor:
+		m.ResetActor()
+		return nil
+	case annotationhistory.FieldAnnotationCreatedAt:
+		m.ResetAnnotationCreatedAt()
+		return nil
+	case annotationhistory.FieldAnnotationUpdatedAt:
+		m.ResetAnnotationUpdatedAt()
+		return nil
+	case annotationhistory.FieldAnnotationVersion:
+		m.ResetAnnotationVersion()
 		return nil
 	}
-	return fmt.Errorf("unknown AnnotationNamespace field %s", name)
+	return fmt.Errorf("unknown AnnotationHistory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AnnotationNamespaceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.annotations != nil {
-		edges = append(edges, annotationnamespace.EdgeAnnotations)
-	}
+func (m *AnnotationHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AnnotationNamespaceMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case annotationnamespace.EdgeAnnotations:
-		ids := make([]ent.Value, 0, len(m.annotations))
-		for id := range m.annotations {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AnnotationHistoryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AnnotationNamespaceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedannotations != nil {
-		edges = append(edges, annotationnamespace.EdgeAnnotations)
-	}
+func (m *AnnotationHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AnnotationNamespaceMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case annotationnamespace.EdgeAnnotations:
-		ids := make([]ent.Value, 0, len(m.removedannotations))
-		for id := range m.removedannotations {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AnnotationHistoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AnnotationNamespaceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedannotations {
-		edges = append(edges, annotationnamespace.EdgeAnnotations)
-	}
+func (m *AnnotationHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AnnotationNamespaceMutation) EdgeCleared(name string) bool {
-	switch name {
-	case annotationnamespace.EdgeAnnotations:
-		return m.clearedannotations
-	}
+func (m *AnnotationHistoryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AnnotationNamespaceMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown AnnotationNamespace unique edge %s", name)
+func (m *AnnotationHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AnnotationHistory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AnnotationNamespaceMutation) ResetEdge(name string) error {
-	switch name {
-	case annotationnamespace.EdgeAnnotations:
-		m.ResetAnnotations()
-		return nil
-	}
-	return fmt.Errorf("unknown AnnotationNamespace edge %s", name)
+func (m *AnnotationHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AnnotationHistory edge %s", name)
 }
 
-// MetadataMutation represents an operation that mutates the Metadata nodes in the graph.
-type MetadataMutation struct {
+// AnnotationNamespaceMutation represents an operation that mutates the AnnotationNamespace nodes in the graph.
+type AnnotationNamespaceMutation struct {
 	config
 	op                 Op
 	typ                string
 	id                 *gidx.PrefixedID
 	created_at         *time.Time
 	updated_at         *time.Time
-	node_id            *gidx.PrefixedID
+	deleted_at         *time.Time
+	name               *string
+	owner_id           *gidx.PrefixedID
+	private            *bool
+	deleting           *bool
+	json_schema        *json.RawMessage
+	appendjson_schema  json.RawMessage
 	clearedFields      map[string]struct{}
 	annotations        map[gidx.PrefixedID]struct{}
 	removedannotations map[gidx.PrefixedID]struct{}
 	clearedannotations bool
-	statuses           map[gidx.PrefixedID]struct{}
-	removedstatuses    map[gidx.PrefixedID]struct{}
-	clearedstatuses    bool
 	done               bool
-	oldValue           func(context.Context) (*Metadata, error)
-	predicates         []predicate.Metadata
+	oldValue           func(context.Context) (*AnnotationNamespace, error)
+	predicates         []predicate.AnnotationNamespace
 }
 
-var _ ent.Mutation = (*MetadataMutation)(nil)
+var _ ent.Mutation = (*AnnotationNamespaceMutation)(nil)
 
-// metadataOption allows management of the mutation configuration using functional options.
-type metadataOption func(*MetadataMutation)
+// annotationnamespaceOption allows management of the mutation configuration using functional options.
+type annotationnamespaceOption func(*AnnotationNamespaceMutation)
 
-// newMetadataMutation creates new mutation for the Metadata entity.
-func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutation {
-	m := &MetadataMutation{
+// newAnnotationNamespaceMutation creates new mutation for the AnnotationNamespace entity.
+func newAnnotationNamespaceMutation(c config, op Op, opts ...annotationnamespaceOption) *AnnotationNamespaceMutation {
+	m := &AnnotationNamespaceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMetadata,
+		typ:           TypeAnnotationNamespace,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1409,20 +1817,20 @@ func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutat
 	return m
 }
 
-// withMetadataID sets the ID field of the mutation.
-func withMetadataID(id gidx.PrefixedID) metadataOption {
-	return func(m *MetadataMutation) {
+// withAnnotationNamespaceID sets the ID field of the mutation.
+func withAnnotationNamespaceID(id gidx.PrefixedID) annotationnamespaceOption {
+	return func(m *AnnotationNamespaceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Metadata
+			value *AnnotationNamespace
 		)
-		m.oldValue = func(ctx context.Context) (*Metadata, error) {
+		m.oldValue = func(ctx context.Context) (*AnnotationNamespace, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Metadata.Get(ctx, id)
+					value, err = m.Client().AnnotationNamespace.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1431,10 +1839,10 @@ func withMetadataID(id gidx.PrefixedID) metadataOption {
 	}
 }
 
-// withMetadata sets the old Metadata of the mutation.
-func withMetadata(node *Metadata) metadataOption {
-	return func(m *MetadataMutation) {
-		m.oldValue = func(context.Context) (*Metadata, error) {
+// withAnnotationNamespace sets the old AnnotationNamespace of the mutation.
+func withAnnotationNamespace(node *AnnotationNamespace) annotationnamespaceOption {
+	return func(m *AnnotationNamespaceMutation) {
+		m.oldValue = func(context.Context) (*AnnotationNamespace, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1443,7 +1851,7 @@ func withMetadata(node *Metadata) metadataOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MetadataMutation) Client() *Client {
+func (m AnnotationNamespaceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1451,7 +1859,7 @@ func (m MetadataMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MetadataMutation) Tx() (*Tx, error) {
+func (m AnnotationNamespaceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -1461,14 +1869,14 @@ func (m MetadataMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Metadata entities.
-func (m *MetadataMutation) SetID(id gidx.PrefixedID) {
+// operation is only accepted on creation of AnnotationNamespace entities.
+func (m *AnnotationNamespaceMutation) SetID(id gidx.PrefixedID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MetadataMutation) ID() (id gidx.PrefixedID, exists bool) {
+func (m *AnnotationNamespaceMutation) ID() (id gidx.PrefixedID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1479,7 +1887,7 @@ func (m *MetadataMutation) ID() (id gidx.PrefixedID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MetadataMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+func (m *AnnotationNamespaceMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1488,19 +1896,19 @@ func (m *MetadataMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Metadata.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AnnotationNamespace.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MetadataMutation) SetCreatedAt(t time.Time) {
+func (m *AnnotationNamespaceMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AnnotationNamespaceMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1508,10 +1916,10 @@ func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AnnotationNamespace entity.
+// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AnnotationNamespaceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1526,17 +1934,17 @@ func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MetadataMutation) ResetCreatedAt() {
+func (m *AnnotationNamespaceMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *MetadataMutation) SetUpdatedAt(t time.Time) {
+func (m *AnnotationNamespaceMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *AnnotationNamespaceMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1544,10 +1952,10 @@ func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the AnnotationNamespace entity.
+// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AnnotationNamespaceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1562,163 +1970,2628 @@ func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *MetadataMutation) ResetUpdatedAt() {
+func (m *AnnotationNamespaceMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetNodeID sets the "node_id" field.
-func (m *MetadataMutation) SetNodeID(gi gidx.PrefixedID) {
-	m.node_id = &gi
+// SetDeletedAt sets the "deleted_at" field.
+func (m *AnnotationNamespaceMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// NodeID returns the value of the "node_id" field in the mutation.
-func (m *MetadataMutation) NodeID() (r gidx.PrefixedID, exists bool) {
-	v := m.node_id
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *AnnotationNamespaceMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNodeID returns the old "node_id" field's value of the Metadata entity.
-// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the AnnotationNamespace entity.
+// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MetadataMutation) OldNodeID(ctx context.Context) (v gidx.PrefixedID, err error) {
+func (m *AnnotationNamespaceMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNodeID requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.NodeID, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ResetNodeID resets all changes to the "node_id" field.
-func (m *MetadataMutation) ResetNodeID() {
-	m.node_id = nil
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *AnnotationNamespaceMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[annotationnamespace.FieldDeletedAt] = struct{}{}
 }
 
-// AddAnnotationIDs adds the "annotations" edge to the Annotation entity by ids.
-func (m *MetadataMutation) AddAnnotationIDs(ids ...gidx.PrefixedID) {
-	if m.annotations == nil {
-		m.annotations = make(map[gidx.PrefixedID]struct{})
-	}
-	for i := range ids {
-		m.annotations[ids[i]] = struct{}{}
-	}
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *AnnotationNamespaceMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[annotationnamespace.FieldDeletedAt]
+	return ok
 }
 
-// ClearAnnotations clears the "annotations" edge to the Annotation entity.
-func (m *MetadataMutation) ClearAnnotations() {
-	m.clearedannotations = true
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *AnnotationNamespaceMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, annotationnamespace.FieldDeletedAt)
 }
 
-// AnnotationsCleared reports if the "annotations" edge to the Annotation entity was cleared.
-func (m *MetadataMutation) AnnotationsCleared() bool {
-	return m.clearedannotations
+// SetName sets the "name" field.
+func (m *AnnotationNamespaceMutation) SetName(s string) {
+	m.name = &s
 }
 
-// RemoveAnnotationIDs removes the "annotations" edge to the Annotation entity by IDs.
-func (m *MetadataMutation) RemoveAnnotationIDs(ids ...gidx.PrefixedID) {
-	if m.removedannotations == nil {
-		m.removedannotations = make(map[gidx.PrefixedID]struct{})
-	}
-	for i := range ids {
-		delete(m.annotations, ids[i])
-		m.removedannotations[ids[i]] = struct{}{}
+// Name returns the value of the "name" field in the mutation.
+func (m *AnnotationNamespaceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAnnotations returns the removed IDs of the "annotations" edge to the Annotation entity.
-func (m *MetadataMutation) RemovedAnnotationsIDs() (ids []gidx.PrefixedID) {
-	for id := range m.removedannotations {
-		ids = append(ids, id)
+// OldName returns the old "name" field's value of the AnnotationNamespace entity.
+// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationNamespaceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// AnnotationsIDs returns the "annotations" edge IDs in the mutation.
-func (m *MetadataMutation) AnnotationsIDs() (ids []gidx.PrefixedID) {
-	for id := range m.annotations {
-		ids = append(ids, id)
-	}
-	return
+// ResetName resets all changes to the "name" field.
+func (m *AnnotationNamespaceMutation) ResetName() {
+	m.name = nil
 }
 
-// ResetAnnotations resets all changes to the "annotations" edge.
-func (m *MetadataMutation) ResetAnnotations() {
-	m.annotations = nil
-	m.clearedannotations = false
-	m.removedannotations = nil
+// SetOwnerID sets the "owner_id" field.
+func (m *AnnotationNamespaceMutation) SetOwnerID(gi gidx.PrefixedID) {
+	m.owner_id = &gi
 }
 
-// AddStatusIDs adds the "statuses" edge to the Status entity by ids.
-func (m *MetadataMutation) AddStatusIDs(ids ...gidx.PrefixedID) {
-	if m.statuses == nil {
-		m.statuses = make(map[gidx.PrefixedID]struct{})
-	}
-	for i := range ids {
-		m.statuses[ids[i]] = struct{}{}
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *AnnotationNamespaceMutation) OwnerID() (r gidx.PrefixedID, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearStatuses clears the "statuses" edge to the Status entity.
-func (m *MetadataMutation) ClearStatuses() {
-	m.clearedstatuses = true
+// OldOwnerID returns the old "owner_id" field's value of the AnnotationNamespace entity.
+// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationNamespaceMutation) OldOwnerID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
 }
 
-// StatusesCleared reports if the "statuses" edge to the Status entity was cleared.
-func (m *MetadataMutation) StatusesCleared() bool {
-	return m.clearedstatuses
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *AnnotationNamespaceMutation) ResetOwnerID() {
+	m.owner_id = nil
 }
 
-// RemoveStatusIDs removes the "statuses" edge to the Status entity by IDs.
-func (m *MetadataMutation) RemoveStatusIDs(ids ...gidx.PrefixedID) {
-	if m.removedstatuses == nil {
-		m.removedstatuses = make(map[gidx.PrefixedID]struct{})
+// SetPrivate sets the "private" field.
+func (m *AnnotationNamespaceMutation) SetPrivate(b bool) {
+	m.private = &b
+}
+
+// Private returns the value of the "private" field in the mutation.
+func (m *AnnotationNamespaceMutation) Private() (r bool, exists bool) {
+	v := m.private
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivate returns the old "private" field's value of the AnnotationNamespace entity.
+// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationNamespaceMutation) OldPrivate(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivate: %w", err)
+	}
+	return oldValue.Private, nil
+}
+
+// ResetPrivate resets all changes to the "private" field.
+func (m *AnnotationNamespaceMutation) ResetPrivate() {
+	m.private = nil
+}
+
+// SetDeleting sets the "deleting" field.
+func (m *AnnotationNamespaceMutation) SetDeleting(b bool) {
+	m.deleting = &b
+}
+
+// Deleting returns the value of the "deleting" field in the mutation.
+func (m *AnnotationNamespaceMutation) Deleting() (r bool, exists bool) {
+	v := m.deleting
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleting returns the old "deleting" field's value of the AnnotationNamespace entity.
+// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationNamespaceMutation) OldDeleting(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleting is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleting requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleting: %w", err)
+	}
+	return oldValue.Deleting, nil
+}
+
+// ResetDeleting resets all changes to the "deleting" field.
+func (m *AnnotationNamespaceMutation) ResetDeleting() {
+	m.deleting = nil
+}
+
+// SetJSONSchema sets the "json_schema" field.
+func (m *AnnotationNamespaceMutation) SetJSONSchema(jm json.RawMessage) {
+	m.json_schema = &jm
+	m.appendjson_schema = nil
+}
+
+// JSONSchema returns the value of the "json_schema" field in the mutation.
+func (m *AnnotationNamespaceMutation) JSONSchema() (r json.RawMessage, exists bool) {
+	v := m.json_schema
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJSONSchema returns the old "json_schema" field's value of the AnnotationNamespace entity.
+// If the AnnotationNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AnnotationNamespaceMutation) OldJSONSchema(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJSONSchema is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJSONSchema requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJSONSchema: %w", err)
+	}
+	return oldValue.JSONSchema, nil
+}
+
+// AppendJSONSchema adds jm to the "json_schema" field.
+func (m *AnnotationNamespaceMutation) AppendJSONSchema(jm json.RawMessage) {
+	m.appendjson_schema = append(m.appendjson_schema, jm...)
+}
+
+// AppendedJSONSchema returns the list of values that were appended to the "json_schema" field in this mutation.
+func (m *AnnotationNamespaceMutation) AppendedJSONSchema() (json.RawMessage, bool) {
+	if len(m.appendjson_schema) == 0 {
+		return nil, false
+	}
+	return m.appendjson_schema, true
+}
+
+// ClearJSONSchema clears the value of the "json_schema" field.
+func (m *AnnotationNamespaceMutation) ClearJSONSchema() {
+	m.json_schema = nil
+	m.appendjson_schema = nil
+	m.clearedFields[annotationnamespace.FieldJSONSchema] = struct{}{}
+}
+
+// JSONSchemaCleared returns if the "json_schema" field was cleared in this mutation.
+func (m *AnnotationNamespaceMutation) JSONSchemaCleared() bool {
+	_, ok := m.clearedFields[annotationnamespace.FieldJSONSchema]
+	return ok
+}
+
+// ResetJSONSchema resets all changes to the "json_schema" field.
+func (m *AnnotationNamespaceMutation) ResetJSONSchema() {
+	m.json_schema = nil
+	m.appendjson_schema = nil
+	delete(m.clearedFields, annotationnamespace.FieldJSONSchema)
+}
+
+// AddAnnotationIDs adds the "annotations" edge to the Annotation entity by ids.
+func (m *AnnotationNamespaceMutation) AddAnnotationIDs(ids ...gidx.PrefixedID) {
+	if m.annotations == nil {
+		m.annotations = make(map[gidx.PrefixedID]struct{})
 	}
 	for i := range ids {
-		delete(m.statuses, ids[i])
-		m.removedstatuses[ids[i]] = struct{}{}
+		m.annotations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnnotations clears the "annotations" edge to the Annotation entity.
+func (m *AnnotationNamespaceMutation) ClearAnnotations() {
+	m.clearedannotations = true
+}
+
+// AnnotationsCleared reports if the "annotations" edge to the Annotation entity was cleared.
+func (m *AnnotationNamespaceMutation) AnnotationsCleared() bool {
+	return m.clearedannotations
+}
+
+// RemoveAnnotationIDs removes the "annotations" edge to the Annotation entity by IDs.
+func (m *AnnotationNamespaceMutation) RemoveAnnotationIDs(ids ...gidx.PrefixedID) {
+	if m.removedannotations == nil {
+		m.removedannotations = make(map[gidx.PrefixedID]struct{})
+	}
+	for i := range ids {
+		delete(m.annotations, ids[i])
+		m.removedannotations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnnotations returns the removed IDs of the "annotations" edge to the Annotation entity.
+func (m *AnnotationNamespaceMutation) RemovedAnnotationsIDs() (ids []gidx.PrefixedID) {
+	for id := range m.removedannotations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnnotationsIDs returns the "annotations" edge IDs in the mutation.
+func (m *AnnotationNamespaceMutation) AnnotationsIDs() (ids []gidx.PrefixedID) {
+	for id := range m.annotations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnnotations resets all changes to the "annotations" edge.
+func (m *AnnotationNamespaceMutation) ResetAnnotations() {
+	m.annotations = nil
+	m.clearedannotations = false
+	m.removedannotations = nil
+}
+
+// Where appends a list predicates to the AnnotationNamespaceMutation builder.
+func (m *AnnotationNamespaceMutation) Where(ps ...predicate.AnnotationNamespace) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AnnotationNamespaceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AnnotationNamespaceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AnnotationNamespace, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AnnotationNamespaceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AnnotationNamespaceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AnnotationNamespace).
+func (m *AnnotationNamespaceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AnnotationNamespaceMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, annotationnamespace.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, annotationnamespace.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, annotationnamespace.FieldDeletedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, annotationnamespace.FieldName)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, annotationnamespace.FieldOwnerID)
+	}
+	if m.private != nil {
+		fields = append(fields, annotationnamespace.FieldPrivate)
+	}
+	if m.deleting != nil {
+		fields = append(fields, annotationnamespace.FieldDeleting)
+	}
+	if m.json_schema != nil {
+		fields = append(fields, annotationnamespace.FieldJSONSchema)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AnnotationNamespaceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case annotationnamespace.FieldCreatedAt:
+		return m.CreatedAt()
+	case annotationnamespace.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case annotationnamespace.FieldDeletedAt:
+		return m.DeletedAt()
+	case annotationnamespace.FieldName:
+		return m.Name()
+	case annotationnamespace.FieldOwnerID:
+		return m.OwnerID()
+	case annotationnamespace.FieldPrivate:
+		return m.Private()
+	case annotationnamespace.FieldDeleting:
+		return m.Deleting()
+	case annotationnamespace.FieldJSONSchema:
+		return m.JSONSchema()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AnnotationNamespaceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case annotationnamespace.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case annotationnamespace.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case annotationnamespace.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case annotationnamespace.FieldName:
+		return m.OldName(ctx)
+	case annotationnamespace.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case annotationnamespace.FieldPrivate:
+		return m.OldPrivate(ctx)
+	case annotationnamespace.FieldDeleting:
+		return m.OldDeleting(ctx)
+	case annotationnamespace.FieldJSONSchema:
+		return m.OldJSONSchema(ctx)
 	}
+	return nil, fmt.Errorf("unknown AnnotationNamespace field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AnnotationNamespaceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case annotationnamespace.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case annotationnamespace.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case annotationnamespace.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case annotationnamespace.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case annotationnamespace.FieldOwnerID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case annotationnamespace.FieldPrivate:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrivate(v)
+		return nil
+	case annotationnamespace.FieldDeleting:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleting(v)
+		return nil
+	case annotationnamespace.FieldJSONSchema:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJSONSchema(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AnnotationNamespace field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AnnotationNamespaceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AnnotationNamespaceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AnnotationNamespaceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AnnotationNamespace numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AnnotationNamespaceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(annotationnamespace.FieldDeletedAt) {
+		fields = append(fields, annotationnamespace.FieldDeletedAt)
+	}
+	if m.FieldCleared(annotationnamespace.FieldJSONSchema) {
+		fields = append(fields, annotationnamespace.FieldJSONSchema)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AnnotationNamespaceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AnnotationNamespaceMutation) ClearField(name string) error {
+	switch name {
+	case annotationnamespace.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case annotationnamespace.FieldJSONSchema:
+		m.ClearJSONSchema()
+		return nil
+	}
+	return fmt.Errorf("unknown AnnotationNamespace nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AnnotationNamespaceMutation) ResetField(name string) error {
+	switch name {
+	case annotationnamespace.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case annotationnamespace.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case annotationnamespace.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case annotationnamespace.FieldName:
+		m.ResetName()
+		return nil
+	case annotationnamespace.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case annotationnamespace.FieldPrivate:
+		m.ResetPrivate()
+		return nil
+	case annotationnamespace.FieldDeleting:
+		m.ResetDeleting()
+		return nil
+	case annotationnamespace.FieldJSONSchema:
+		m.ResetJSONSchema()
+		return nil
+	}
+	return fmt.Errorf("unknown AnnotationNamespace field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AnnotationNamespaceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.annotations != nil {
+		edges = append(edges, annotationnamespace.EdgeAnnotations)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AnnotationNamespaceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case annotationnamespace.EdgeAnnotations:
+		ids := make([]ent.Value, 0, len(m.annotations))
+		for id := range m.annotations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AnnotationNamespaceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedannotations != nil {
+		edges = append(edges, annotationnamespace.EdgeAnnotations)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AnnotationNamespaceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case annotationnamespace.EdgeAnnotations:
+		ids := make([]ent.Value, 0, len(m.removedannotations))
+		for id := range m.removedannotations {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AnnotationNamespaceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedannotations {
+		edges = append(edges, annotationnamespace.EdgeAnnotations)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AnnotationNamespaceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case annotationnamespace.EdgeAnnotations:
+		return m.clearedannotations
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AnnotationNamespaceMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AnnotationNamespace unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AnnotationNamespaceMutation) ResetEdge(name string) error {
+	switch name {
+	case annotationnamespace.EdgeAnnotations:
+		m.ResetAnnotations()
+		return nil
+	}
+	return fmt.Errorf("unknown AnnotationNamespace edge %s", name)
+}
+
+// MetadataMutation represents an operation that mutates the Metadata nodes in the graph.
+type MetadataMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *gidx.PrefixedID
+	created_at         *time.Time
+	updated_at         *time.Time
+	node_id            *gidx.PrefixedID
+	clearedFields      map[string]struct{}
+	annotations        map[gidx.PrefixedID]struct{}
+	removedannotations map[gidx.PrefixedID]struct{}
+	clearedannotations bool
+	statuses           map[gidx.PrefixedID]struct{}
+	removedstatuses    map[gidx.PrefixedID]struct{}
+	clearedstatuses    bool
+	done               bool
+	oldValue           func(context.Context) (*Metadata, error)
+	predicates         []predicate.Metadata
+}
+
+var _ ent.Mutation = (*MetadataMutation)(nil)
+
+// metadataOption allows management of the mutation configuration using functional options.
+type metadataOption func(*MetadataMutation)
+
+// newMetadataMutation creates new mutation for the Metadata entity.
+func newMetadataMutation(c config, op Op, opts ...metadataOption) *MetadataMutation {
+	m := &MetadataMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMetadata,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMetadataID sets the ID field of the mutation.
+func withMetadataID(id gidx.PrefixedID) metadataOption {
+	return func(m *MetadataMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Metadata
+		)
+		m.oldValue = func(ctx context.Context) (*Metadata, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Metadata.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMetadata sets the old Metadata of the mutation.
+func withMetadata(node *Metadata) metadataOption {
+	return func(m *MetadataMutation) {
+		m.oldValue = func(context.Context) (*Metadata, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MetadataMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MetadataMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Metadata entities.
+func (m *MetadataMutation) SetID(id gidx.PrefixedID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MetadataMutation) ID() (id gidx.PrefixedID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MetadataMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []gidx.PrefixedID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Metadata.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MetadataMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MetadataMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MetadataMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *MetadataMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *MetadataMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *MetadataMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetNodeID sets the "node_id" field.
+func (m *MetadataMutation) SetNodeID(gi gidx.PrefixedID) {
+	m.node_id = &gi
+}
+
+// NodeID returns the value of the "node_id" field in the mutation.
+func (m *MetadataMutation) NodeID() (r gidx.PrefixedID, exists bool) {
+	v := m.node_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeID returns the old "node_id" field's value of the Metadata entity.
+// If the Metadata object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MetadataMutation) OldNodeID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeID: %w", err)
+	}
+	return oldValue.NodeID, nil
+}
+
+// ResetNodeID resets all changes to the "node_id" field.
+func (m *MetadataMutation) ResetNodeID() {
+	m.node_id = nil
+}
+
+// AddAnnotationIDs adds the "annotations" edge to the Annotation entity by ids.
+func (m *MetadataMutation) AddAnnotationIDs(ids ...gidx.PrefixedID) {
+	if m.annotations == nil {
+		m.annotations = make(map[gidx.PrefixedID]struct{})
+	}
+	for i := range ids {
+		m.annotations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnnotations clears the "annotations" edge to the Annotation entity.
+func (m *MetadataMutation) ClearAnnotations() {
+	m.clearedannotations = true
+}
+
+// AnnotationsCleared reports if the "annotations" edge to the Annotation entity was cleared.
+func (m *MetadataMutation) AnnotationsCleared() bool {
+	return m.clearedannotations
+}
+
+// RemoveAnnotationIDs removes the "annotations" edge to the Annotation entity by IDs.
+func (m *MetadataMutation) RemoveAnnotationIDs(ids ...gidx.PrefixedID) {
+	if m.removedannotations == nil {
+		m.removedannotations = make(map[gidx.PrefixedID]struct{})
+	}
+	for i := range ids {
+		delete(m.annotations, ids[i])
+		m.removedannotations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnnotations returns the removed IDs of the "annotations" edge to the Annotation entity.
+func (m *MetadataMutation) RemovedAnnotationsIDs() (ids []gidx.PrefixedID) {
+	for id := range m.removedannotations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnnotationsIDs returns the "annotations" edge IDs in the mutation.
+func (m *MetadataMutation) AnnotationsIDs() (ids []gidx.PrefixedID) {
+	for id := range m.annotations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnnotations resets all changes to the "annotations" edge.
+func (m *MetadataMutation) ResetAnnotations() {
+	m.annotations = nil
+	m.clearedannotations = false
+	m.removedannotations = nil
+}
+
+// AddStatusIDs adds the "statuses" edge to the Status entity by ids.
+func (m *MetadataMutation) AddStatusIDs(ids ...gidx.PrefixedID) {
+	if m.statuses == nil {
+		m.statuses = make(map[gidx.PrefixedID]struct{})
+	}
+	for i := range ids {
+		m.statuses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStatuses clears the "statuses" edge to the Status entity.
+func (m *MetadataMutation) ClearStatuses() {
+	m.clearedstatuses = true
+}
+
+// StatusesCleared reports if the "statuses" edge to the Status entity was cleared.
+func (m *MetadataMutation) StatusesCleared() bool {
+	return m.clearedstatuses
+}
+
+// RemoveStatusIDs removes the "statuses" edge to the Status entity by IDs.
+func (m *MetadataMutation) RemoveStatusIDs(ids ...gidx.PrefixedID) {
+	if m.removedstatuses == nil {
+		m.removedstatuses = make(map[gidx.PrefixedID]struct{})
+	}
+	for i := range ids {
+		delete(m.statuses, ids[i])
+		m.removedstatuses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStatuses returns the removed IDs of the "statuses" edge to the Status entity.
+func (m *MetadataMutation) RemovedStatusesIDs() (ids []gidx.PrefixedID) {
+	for id := range m.removedstatuses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StatusesIDs returns the "statuses" edge IDs in the mutation.
+func (m *MetadataMutation) StatusesIDs() (ids []gidx.PrefixedID) {
+	for id := range m.statuses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStatuses resets all changes to the "statuses" edge.
+func (m *MetadataMutation) ResetStatuses() {
+	m.statuses = nil
+	m.clearedstatuses = false
+	m.removedstatuses = nil
+}
+
+// Where appends a list predicates to the MetadataMutation builder.
+func (m *MetadataMutation) Where(ps ...predicate.Metadata) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MetadataMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Metadata, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MetadataMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MetadataMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Metadata).
+func (m *MetadataMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MetadataMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.created_at != nil {
+		fields = append(fields, metadata.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, metadata.FieldUpdatedAt)
+	}
+	if m.node_id != nil {
+		fields = append(fields, metadata.FieldNodeID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case metadata.FieldCreatedAt:
+		return m.CreatedAt()
+	case metadata.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case metadata.FieldNodeID:
+		return m.NodeID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case metadata.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case metadata.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case metadata.FieldNodeID:
+		return m.OldNodeID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Metadata field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetadataMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case metadata.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case metadata.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case metadata.FieldNodeID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Metadata field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MetadataMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MetadataMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Metadata numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MetadataMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MetadataMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MetadataMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Metadata nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MetadataMutation) ResetField(name string) error {
+	switch name {
+	case metadata.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case metadata.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case metadata.FieldNodeID:
+		m.ResetNodeID()
+		return nil
+	}
+	return fmt.Errorf("unknown Metadata field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MetadataMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.annotations != nil {
+		edges = append(edges, metadata.EdgeAnnotations)
+	}
+	if m.statuses != nil {
+		edges = append(edges, metadata.EdgeStatuses)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MetadataMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case metadata.EdgeAnnotations:
+		ids := make([]ent.Value, 0, len(m.annotations))
+		for id := range m.annotations {
+			ids = append(ids, id)
+		}
+		return ids
+	case metadata.EdgeStatuses:
+		ids := make([]ent.Value, 0, len(m.statuses))
+		for id := range m.statuses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MetadataMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedannotations != nil {
+		edges = append(edges, metadata.EdgeAnnotations)
+	}
+	if m.removedstatuses != nil {
+		edges = append(edges, metadata.EdgeStatuses)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MetadataMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case metadata.EdgeAnnotations:
+		ids := make([]ent.Value, 0, len(m.removedannotations))
+		for id := range m.removedannotations {
+			ids = append(ids, id)
+		}
+		return ids
+	case metadata.EdgeStatuses:
+		ids := make([]ent.Value, 0, len(m.removedstatuses))
+		for id := range m.removedstatuses {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MetadataMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedannotations {
+		edges = append(edges, metadata.EdgeAnnotations)
+	}
+	if m.clearedstatuses {
+		edges = append(edges, metadata.EdgeStatuses)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MetadataMutation) EdgeCleared(name string) bool {
+	switch name {
+	case metadata.EdgeAnnotations:
+		return m.clearedannotations
+	case metadata.EdgeStatuses:
+		return m.clearedstatuses
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MetadataMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Metadata unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MetadataMutation) ResetEdge(name string) error {
+	switch name {
+	case metadata.EdgeAnnotations:
+		m.ResetAnnotations()
+		return nil
+	case metadata.EdgeStatuses:
+		m.ResetStatuses()
+		return nil
+	}
+	return fmt.Errorf("unknown Metadata edge %s", name)
+}
+
+// NamespaceDeletionMutation represents an operation that mutates the NamespaceDeletion nodes in the graph.
+type NamespaceDeletionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *gidx.PrefixedID
+	created_at       *time.Time
+	updated_at       *time.Time
+	namespace_id     *gidx.PrefixedID
+	owner_id         *gidx.PrefixedID
+	state            *namespacedeletion.State
+	total_count      *int
+	addtotal_count   *int
+	deleted_count    *int
+	adddeleted_count *int
+	completed_at     *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*NamespaceDeletion, error)
+	predicates       []predicate.NamespaceDeletion
+}
+
+var _ ent.Mutation = (*NamespaceDeletionMutation)(nil)
+
+// namespacedeletionOption allows management of the mutation configuration using functional options.
+type namespacedeletionOption func(*NamespaceDeletionMutation)
+
+// newNamespaceDeletionMutation creates new mutation for the NamespaceDeletion entity.
+func newNamespaceDeletionMutation(c config, op Op, opts ...namespacedeletionOption) *NamespaceDeletionMutation {
+	m := &NamespaceDeletionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNamespaceDeletion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNamespaceDeletionID sets the ID field of the mutation.
+func withNamespaceDeletionID(id gidx.PrefixedID) namespacedeletionOption {
+	return func(m *NamespaceDeletionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NamespaceDeletion
+		)
+		m.oldValue = func(ctx context.Context) (*NamespaceDeletion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NamespaceDeletion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNamespaceDeletion sets the old NamespaceDeletion of the mutation.
+func withNamespaceDeletion(node *NamespaceDeletion) namespacedeletionOption {
+	return func(m *NamespaceDeletionMutation) {
+		m.oldValue = func(context.Context) (*NamespaceDeletion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NamespaceDeletionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NamespaceDeletionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of NamespaceDeletion entities.
+func (m *NamespaceDeletionMutation) SetID(id gidx.PrefixedID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NamespaceDeletionMutation) ID() (id gidx.PrefixedID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NamespaceDeletionMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []gidx.PrefixedID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NamespaceDeletion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NamespaceDeletionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NamespaceDeletionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the NamespaceDeletion entity.
+// If the NamespaceDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceDeletionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NamespaceDeletionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NamespaceDeletionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NamespaceDeletionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the NamespaceDeletion entity.
+// If the NamespaceDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceDeletionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NamespaceDeletionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (m *NamespaceDeletionMutation) SetNamespaceID(gi gidx.PrefixedID) {
+	m.namespace_id = &gi
+}
+
+// NamespaceID returns the value of the "namespace_id" field in the mutation.
+func (m *NamespaceDeletionMutation) NamespaceID() (r gidx.PrefixedID, exists bool) {
+	v := m.namespace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNamespaceID returns the old "namespace_id" field's value of the NamespaceDeletion entity.
+// If the NamespaceDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceDeletionMutation) OldNamespaceID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNamespaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNamespaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNamespaceID: %w", err)
+	}
+	return oldValue.NamespaceID, nil
+}
+
+// ResetNamespaceID resets all changes to the "namespace_id" field.
+func (m *NamespaceDeletionMutation) ResetNamespaceID() {
+	m.namespace_id = nil
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *NamespaceDeletionMutation) SetOwnerID(gi gidx.PrefixedID) {
+	m.owner_id = &gi
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *NamespaceDeletionMutation) OwnerID() (r gidx.PrefixedID, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the NamespaceDeletion entity.
+// If the NamespaceDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceDeletionMutation) OldOwnerID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *NamespaceDeletionMutation) ResetOwnerID() {
+	m.owner_id = nil
+}
+
+// SetState sets the "state" field.
+func (m *NamespaceDeletionMutation) SetState(n namespacedeletion.State) {
+	m.state = &n
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *NamespaceDeletionMutation) State() (r namespacedeletion.State, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the NamespaceDeletion entity.
+// If the NamespaceDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceDeletionMutation) OldState(ctx context.Context) (v namespacedeletion.State, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *NamespaceDeletionMutation) ResetState() {
+	m.state = nil
+}
+
+// SetTotalCount sets the "total_count" field.
+func (m *NamespaceDeletionMutation) SetTotalCount(i int) {
+	m.total_count = &i
+	m.addtotal_count = nil
+}
+
+// TotalCount returns the value of the "total_count" field in the mutation.
+func (m *NamespaceDeletionMutation) TotalCount() (r int, exists bool) {
+	v := m.total_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalCount returns the old "total_count" field's value of the NamespaceDeletion entity.
+// If the NamespaceDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceDeletionMutation) OldTotalCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalCount: %w", err)
+	}
+	return oldValue.TotalCount, nil
+}
+
+// AddTotalCount adds i to the "total_count" field.
+func (m *NamespaceDeletionMutation) AddTotalCount(i int) {
+	if m.addtotal_count != nil {
+		*m.addtotal_count += i
+	} else {
+		m.addtotal_count = &i
+	}
+}
+
+// AddedTotalCount returns the value that was added to the "total_count" field in this mutation.
+func (m *NamespaceDeletionMutation) AddedTotalCount() (r int, exists bool) {
+	v := m.addtotal_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalCount resets all changes to the "total_count" field.
+func (m *NamespaceDeletionMutation) ResetTotalCount() {
+	m.total_count = nil
+	m.addtotal_count = nil
+}
+
+// SetDeletedCount sets the "deleted_count" field.
+func (m *NamespaceDeletionMutation) SetDeletedCount(i int) {
+	m.deleted_count = &i
+	m.adddeleted_count = nil
+}
+
+// DeletedCount returns the value of the "deleted_count" field in the mutation.
+func (m *NamespaceDeletionMutation) DeletedCount() (r int, exists bool) {
+	v := m.deleted_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedCount returns the old "deleted_count" field's value of the NamespaceDeletion entity.
+// If the NamespaceDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceDeletionMutation) OldDeletedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedCount: %w", err)
+	}
+	return oldValue.DeletedCount, nil
+}
+
+// AddDeletedCount adds i to the "deleted_count" field.
+func (m *NamespaceDeletionMutation) AddDeletedCount(i int) {
+	if m.adddeleted_count != nil {
+		*m.adddeleted_count += i
+	} else {
+		m.adddeleted_count = &i
+	}
+}
+
+// AddedDeletedCount returns the value that was added to the "deleted_count" field in this mutation.
+func (m *NamespaceDeletionMutation) AddedDeletedCount() (r int, exists bool) {
+	v := m.adddeleted_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletedCount resets all changes to the "deleted_count" field.
+func (m *NamespaceDeletionMutation) ResetDeletedCount() {
+	m.deleted_count = nil
+	m.adddeleted_count = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *NamespaceDeletionMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *NamespaceDeletionMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the NamespaceDeletion entity.
+// If the NamespaceDeletion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NamespaceDeletionMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *NamespaceDeletionMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[namespacedeletion.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *NamespaceDeletionMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[namespacedeletion.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *NamespaceDeletionMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, namespacedeletion.FieldCompletedAt)
+}
+
+// Where appends a list predicates to the NamespaceDeletionMutation builder.
+func (m *NamespaceDeletionMutation) Where(ps ...predicate.NamespaceDeletion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NamespaceDeletionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NamespaceDeletionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NamespaceDeletion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NamespaceDeletionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NamespaceDeletionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NamespaceDeletion).
+func (m *NamespaceDeletionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NamespaceDeletionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, namespacedeletion.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, namespacedeletion.FieldUpdatedAt)
+	}
+	if m.namespace_id != nil {
+		fields = append(fields, namespacedeletion.FieldNamespaceID)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, namespacedeletion.FieldOwnerID)
+	}
+	if m.state != nil {
+		fields = append(fields, namespacedeletion.FieldState)
+	}
+	if m.total_count != nil {
+		fields = append(fields, namespacedeletion.FieldTotalCount)
+	}
+	if m.deleted_count != nil {
+		fields = append(fields, namespacedeletion.FieldDeletedCount)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, namespacedeletion.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NamespaceDeletionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case namespacedeletion.FieldCreatedAt:
+		return m.CreatedAt()
+	case namespacedeletion.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case namespacedeletion.FieldNamespaceID:
+		return m.NamespaceID()
+	case namespacedeletion.FieldOwnerID:
+		return m.OwnerID()
+	case namespacedeletion.FieldState:
+		return m.State()
+	case namespacedeletion.FieldTotalCount:
+		return m.TotalCount()
+	case namespacedeletion.FieldDeletedCount:
+		return m.DeletedCount()
+	case namespacedeletion.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NamespaceDeletionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case namespacedeletion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case namespacedeletion.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case namespacedeletion.FieldNamespaceID:
+		return m.OldNamespaceID(ctx)
+	case namespacedeletion.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case namespacedeletion.FieldState:
+		return m.OldState(ctx)
+	case namespacedeletion.FieldTotalCount:
+		return m.OldTotalCount(ctx)
+	case namespacedeletion.FieldDeletedCount:
+		return m.OldDeletedCount(ctx)
+	case namespacedeletion.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NamespaceDeletion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NamespaceDeletionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case namespacedeletion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case namespacedeletion.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case namespacedeletion.FieldNamespaceID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNamespaceID(v)
+		return nil
+	case namespacedeletion.FieldOwnerID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case namespacedeletion.FieldState:
+		v, ok := value.(namespacedeletion.State)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case namespacedeletion.FieldTotalCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalCount(v)
+		return nil
+	case namespacedeletion.FieldDeletedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedCount(v)
+		return nil
+	case namespacedeletion.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NamespaceDeletion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NamespaceDeletionMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_count != nil {
+		fields = append(fields, namespacedeletion.FieldTotalCount)
+	}
+	if m.adddeleted_count != nil {
+		fields = append(fields, namespacedeletion.FieldDeletedCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NamespaceDeletionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case namespacedeletion.FieldTotalCount:
+		return m.AddedTotalCount()
+	case namespacedeletion.FieldDeletedCount:
+		return m.AddedDeletedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NamespaceDeletionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case namespacedeletion.FieldTotalCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalCount(v)
+		return nil
+	case namespacedeletion.FieldDeletedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NamespaceDeletion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NamespaceDeletionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(namespacedeletion.FieldCompletedAt) {
+		fields = append(fields, namespacedeletion.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NamespaceDeletionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NamespaceDeletionMutation) ClearField(name string) error {
+	switch name {
+	case namespacedeletion.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NamespaceDeletion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NamespaceDeletionMutation) ResetField(name string) error {
+	switch name {
+	case namespacedeletion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case namespacedeletion.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case namespacedeletion.FieldNamespaceID:
+		m.ResetNamespaceID()
+		return nil
+	case namespacedeletion.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case namespacedeletion.FieldState:
+		m.ResetState()
+		return nil
+	case namespacedeletion.FieldTotalCount:
+		m.ResetTotalCount()
+		return nil
+	case namespacedeletion.FieldDeletedCount:
+		m.ResetDeletedCount()
+		return nil
+	case namespacedeletion.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NamespaceDeletion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NamespaceDeletionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NamespaceDeletionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NamespaceDeletionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NamespaceDeletionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NamespaceDeletionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NamespaceDeletionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NamespaceDeletionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NamespaceDeletion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NamespaceDeletionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NamespaceDeletion edge %s", name)
+}
+
+// StatusMutation represents an operation that mutates the Status nodes in the graph.
+type StatusMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *gidx.PrefixedID
+	created_at       *time.Time
+	updated_at       *time.Time
+	source           *string
+	data             *json.RawMessage
+	appenddata       json.RawMessage
+	version          *int64
+	addversion       *int64
+	expires_at       *time.Time
+	clearedFields    map[string]struct{}
+	namespace        *gidx.PrefixedID
+	clearednamespace bool
+	metadata         *gidx.PrefixedID
+	clearedmetadata  bool
+	done             bool
+	oldValue         func(context.Context) (*Status, error)
+	predicates       []predicate.Status
+}
+
+var _ ent.Mutation = (*StatusMutation)(nil)
+
+// statusOption allows management of the mutation configuration using functional options.
+type statusOption func(*StatusMutation)
+
+// newStatusMutation creates new mutation for the Status entity.
+func newStatusMutation(c config, op Op, opts ...statusOption) *StatusMutation {
+	m := &StatusMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStatus,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStatusID sets the ID field of the mutation.
+func withStatusID(id gidx.PrefixedID) statusOption {
+	return func(m *StatusMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Status
+		)
+		m.oldValue = func(ctx context.Context) (*Status, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Status.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStatus sets the old Status of the mutation.
+func withStatus(node *Status) statusOption {
+	return func(m *StatusMutation) {
+		m.oldValue = func(context.Context) (*Status, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StatusMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StatusMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("generated: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Status entities.
+func (m *StatusMutation) SetID(id gidx.PrefixedID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StatusMutation) ID() (id gidx.PrefixedID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StatusMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []gidx.PrefixedID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Status.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StatusMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StatusMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Status entity.
+// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StatusMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StatusMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StatusMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Status entity.
+// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StatusMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetMetadataID sets the "metadata_id" field.
+func (m *StatusMutation) SetMetadataID(gi gidx.PrefixedID) {
+	m.metadata = &gi
+}
+
+// MetadataID returns the value of the "metadata_id" field in the mutation.
+func (m *StatusMutation) MetadataID() (r gidx.PrefixedID, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadataID returns the old "metadata_id" field's value of the Status entity.
+// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusMutation) OldMetadataID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadataID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadataID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadataID: %w", err)
+	}
+	return oldValue.MetadataID, nil
+}
+
+// ResetMetadataID resets all changes to the "metadata_id" field.
+func (m *StatusMutation) ResetMetadataID() {
+	m.metadata = nil
+}
+
+// SetStatusNamespaceID sets the "status_namespace_id" field.
+func (m *StatusMutation) SetStatusNamespaceID(gi gidx.PrefixedID) {
+	m.namespace = &gi
+}
+
+// StatusNamespaceID returns the value of the "status_namespace_id" field in the mutation.
+func (m *StatusMutation) StatusNamespaceID() (r gidx.PrefixedID, exists bool) {
+	v := m.namespace
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusNamespaceID returns the old "status_namespace_id" field's value of the Status entity.
+// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusMutation) OldStatusNamespaceID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusNamespaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusNamespaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusNamespaceID: %w", err)
+	}
+	return oldValue.StatusNamespaceID, nil
+}
+
+// ResetStatusNamespaceID resets all changes to the "status_namespace_id" field.
+func (m *StatusMutation) ResetStatusNamespaceID() {
+	m.namespace = nil
+}
+
+// SetSource sets the "source" field.
+func (m *StatusMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *StatusMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Status entity.
+// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *StatusMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetData sets the "data" field.
+func (m *StatusMutation) SetData(jm json.RawMessage) {
+	m.data = &jm
+	m.appenddata = nil
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *StatusMutation) Data() (r json.RawMessage, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Status entity.
+// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusMutation) OldData(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// AppendData adds jm to the "data" field.
+func (m *StatusMutation) AppendData(jm json.RawMessage) {
+	m.appenddata = append(m.appenddata, jm...)
+}
+
+// AppendedData returns the list of values that were appended to the "data" field in this mutation.
+func (m *StatusMutation) AppendedData() (json.RawMessage, bool) {
+	if len(m.appenddata) == 0 {
+		return nil, false
+	}
+	return m.appenddata, true
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *StatusMutation) ResetData() {
+	m.data = nil
+	m.appenddata = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *StatusMutation) SetVersion(i int64) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *StatusMutation) Version() (r int64, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Status entity.
+// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusMutation) OldVersion(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *StatusMutation) AddVersion(i int64) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *StatusMutation) AddedVersion() (r int64, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *StatusMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *StatusMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *StatusMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Status entity.
+// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *StatusMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[status.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *StatusMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[status.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *StatusMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, status.FieldExpiresAt)
+}
+
+// SetNamespaceID sets the "namespace" edge to the StatusNamespace entity by id.
+func (m *StatusMutation) SetNamespaceID(id gidx.PrefixedID) {
+	m.namespace = &id
+}
+
+// ClearNamespace clears the "namespace" edge to the StatusNamespace entity.
+func (m *StatusMutation) ClearNamespace() {
+	m.clearednamespace = true
+	m.clearedFields[status.FieldStatusNamespaceID] = struct{}{}
+}
+
+// NamespaceCleared reports if the "namespace" edge to the StatusNamespace entity was cleared.
+func (m *StatusMutation) NamespaceCleared() bool {
+	return m.clearednamespace
+}
+
+// NamespaceID returns the "namespace" edge ID in the mutation.
+func (m *StatusMutation) NamespaceID() (id gidx.PrefixedID, exists bool) {
+	if m.namespace != nil {
+		return *m.namespace, true
+	}
+	return
+}
+
+// NamespaceIDs returns the "namespace" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// NamespaceID instead. It exists only for internal usage by the builders.
+func (m *StatusMutation) NamespaceIDs() (ids []gidx.PrefixedID) {
+	if id := m.namespace; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetNamespace resets all changes to the "namespace" edge.
+func (m *StatusMutation) ResetNamespace() {
+	m.namespace = nil
+	m.clearednamespace = false
 }
 
-// RemovedStatuses returns the removed IDs of the "statuses" edge to the Status entity.
-func (m *MetadataMutation) RemovedStatusesIDs() (ids []gidx.PrefixedID) {
-	for id := range m.removedstatuses {
-		ids = append(ids, id)
-	}
-	return
+// ClearMetadata clears the "metadata" edge to the Metadata entity.
+func (m *StatusMutation) ClearMetadata() {
+	m.clearedmetadata = true
+	m.clearedFields[status.FieldMetadataID] = struct{}{}
 }
 
-// StatusesIDs returns the "statuses" edge IDs in the mutation.
-func (m *MetadataMutation) StatusesIDs() (ids []gidx.PrefixedID) {
-	for id := range m.statuses {
-		ids = append(ids, id)
+// MetadataCleared reports if the "metadata" edge to the Metadata entity was cleared.
+func (m *StatusMutation) MetadataCleared() bool {
+	return m.clearedmetadata
+}
+
+// MetadataIDs returns the "metadata" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MetadataID instead. It exists only for internal usage by the builders.
+func (m *StatusMutation) MetadataIDs() (ids []gidx.PrefixedID) {
+	if id := m.metadata; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetStatuses resets all changes to the "statuses" edge.
-func (m *MetadataMutation) ResetStatuses() {
-	m.statuses = nil
-	m.clearedstatuses = false
-	m.removedstatuses = nil
+// ResetMetadata resets all changes to the "metadata" edge.
+func (m *StatusMutation) ResetMetadata() {
+	m.metadata = nil
+	m.clearedmetadata = false
 }
 
-// Where appends a list predicates to the MetadataMutation builder.
-func (m *MetadataMutation) Where(ps ...predicate.Metadata) {
+// Where appends a list predicates to the StatusMutation builder.
+func (m *StatusMutation) Where(ps ...predicate.Status) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MetadataMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StatusMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Metadata, len(ps))
+func (m *StatusMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Status, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1726,33 +4599,48 @@ func (m *MetadataMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MetadataMutation) Op() Op {
+func (m *StatusMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MetadataMutation) SetOp(op Op) {
+func (m *StatusMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Metadata).
-func (m *MetadataMutation) Type() string {
+// Type returns the node type of this mutation (Status).
+func (m *StatusMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MetadataMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *StatusMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, metadata.FieldCreatedAt)
+		fields = append(fields, status.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, metadata.FieldUpdatedAt)
+		fields = append(fields, status.FieldUpdatedAt)
 	}
-	if m.node_id != nil {
-		fields = append(fields, metadata.FieldNodeID)
+	if m.metadata != nil {
+		fields = append(fields, status.FieldMetadataID)
+	}
+	if m.namespace != nil {
+		fields = append(fields, status.FieldStatusNamespaceID)
+	}
+	if m.source != nil {
+		fields = append(fields, status.FieldSource)
+	}
+	if m.data != nil {
+		fields = append(fields, status.FieldData)
+	}
+	if m.version != nil {
+		fields = append(fields, status.FieldVersion)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, status.FieldExpiresAt)
 	}
 	return fields
 }
@@ -1760,14 +4648,24 @@ func (m *MetadataMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
+func (m *StatusMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case status.FieldCreatedAt:
 		return m.CreatedAt()
-	case metadata.FieldUpdatedAt:
+	case status.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case metadata.FieldNodeID:
-		return m.NodeID()
+	case status.FieldMetadataID:
+		return m.MetadataID()
+	case status.FieldStatusNamespaceID:
+		return m.StatusNamespaceID()
+	case status.FieldSource:
+		return m.Source()
+	case status.FieldData:
+		return m.Data()
+	case status.FieldVersion:
+		return m.Version()
+	case status.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -1775,248 +4673,319 @@ func (m *MetadataMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MetadataMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case status.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case metadata.FieldUpdatedAt:
+	case status.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case metadata.FieldNodeID:
-		return m.OldNodeID(ctx)
+	case status.FieldMetadataID:
+		return m.OldMetadataID(ctx)
+	case status.FieldStatusNamespaceID:
+		return m.OldStatusNamespaceID(ctx)
+	case status.FieldSource:
+		return m.OldSource(ctx)
+	case status.FieldData:
+		return m.OldData(ctx)
+	case status.FieldVersion:
+		return m.OldVersion(ctx)
+	case status.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Metadata field %s", name)
+	return nil, fmt.Errorf("unknown Status field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetadataMutation) SetField(name string, value ent.Value) error {
+func (m *StatusMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case status.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case metadata.FieldUpdatedAt:
+	case status.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case metadata.FieldNodeID:
+	case status.FieldMetadataID:
 		v, ok := value.(gidx.PrefixedID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNodeID(v)
+		m.SetMetadataID(v)
+		return nil
+	case status.FieldStatusNamespaceID:
+		v, ok := value.(gidx.PrefixedID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusNamespaceID(v)
+		return nil
+	case status.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case status.FieldData:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case status.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case status.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata field %s", name)
+	return fmt.Errorf("unknown Status field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MetadataMutation) AddedFields() []string {
-	return nil
+func (m *StatusMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, status.FieldVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MetadataMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StatusMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case status.FieldVersion:
+		return m.AddedVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MetadataMutation) AddField(name string, value ent.Value) error {
+func (m *StatusMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case status.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Metadata numeric field %s", name)
+	return fmt.Errorf("unknown Status numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MetadataMutation) ClearedFields() []string {
-	return nil
+func (m *StatusMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(status.FieldExpiresAt) {
+		fields = append(fields, status.FieldExpiresAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MetadataMutation) FieldCleared(name string) bool {
+func (m *StatusMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MetadataMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Metadata nullable field %s", name)
+func (m *StatusMutation) ClearField(name string) error {
+	switch name {
+	case status.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Status nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MetadataMutation) ResetField(name string) error {
+func (m *StatusMutation) ResetField(name string) error {
 	switch name {
-	case metadata.FieldCreatedAt:
+	case status.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case metadata.FieldUpdatedAt:
+	case status.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case metadata.FieldNodeID:
-		m.ResetNodeID()
+	case status.FieldMetadataID:
+		m.ResetMetadataID()
+		return nil
+	case status.FieldStatusNamespaceID:
+		m.ResetStatusNamespaceID()
+		return nil
+	case status.FieldSource:
+		m.ResetSource()
+		return nil
+	case status.FieldData:
+		m.ResetData()
+		return nil
+	case status.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case status.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata field %s", name)
+	return fmt.Errorf("unknown Status field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MetadataMutation) AddedEdges() []string {
+func (m *StatusMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.annotations != nil {
-		edges = append(edges, metadata.EdgeAnnotations)
+	if m.namespace != nil {
+		edges = append(edges, status.EdgeNamespace)
 	}
-	if m.statuses != nil {
-		edges = append(edges, metadata.EdgeStatuses)
+	if m.metadata != nil {
+		edges = append(edges, status.EdgeMetadata)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MetadataMutation) AddedIDs(name string) []ent.Value {
+func (m *StatusMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case metadata.EdgeAnnotations:
-		ids := make([]ent.Value, 0, len(m.annotations))
-		for id := range m.annotations {
-			ids = append(ids, id)
+	case status.EdgeNamespace:
+		if id := m.namespace; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case metadata.EdgeStatuses:
-		ids := make([]ent.Value, 0, len(m.statuses))
-		for id := range m.statuses {
-			ids = append(ids, id)
+	case status.EdgeMetadata:
+		if id := m.metadata; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MetadataMutation) RemovedEdges() []string {
+func (m *StatusMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedannotations != nil {
-		edges = append(edges, metadata.EdgeAnnotations)
-	}
-	if m.removedstatuses != nil {
-		edges = append(edges, metadata.EdgeStatuses)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *MetadataMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case metadata.EdgeAnnotations:
-		ids := make([]ent.Value, 0, len(m.removedannotations))
-		for id := range m.removedannotations {
-			ids = append(ids, id)
-		}
-		return ids
-	case metadata.EdgeStatuses:
-		ids := make([]ent.Value, 0, len(m.removedstatuses))
-		for id := range m.removedstatuses {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+// the given name in this mutation.
+func (m *StatusMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MetadataMutation) ClearedEdges() []string {
+func (m *StatusMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedannotations {
-		edges = append(edges, metadata.EdgeAnnotations)
+	if m.clearednamespace {
+		edges = append(edges, status.EdgeNamespace)
 	}
-	if m.clearedstatuses {
-		edges = append(edges, metadata.EdgeStatuses)
+	if m.clearedmetadata {
+		edges = append(edges, status.EdgeMetadata)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MetadataMutation) EdgeCleared(name string) bool {
+func (m *StatusMutation) EdgeCleared(name string) bool {
 	switch name {
-	case metadata.EdgeAnnotations:
-		return m.clearedannotations
-	case metadata.EdgeStatuses:
-		return m.clearedstatuses
+	case status.EdgeNamespace:
+		return m.clearednamespace
+	case status.EdgeMetadata:
+		return m.clearedmetadata
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MetadataMutation) ClearEdge(name string) error {
+func (m *StatusMutation) ClearEdge(name string) error {
 	switch name {
+	case status.EdgeNamespace:
+		m.ClearNamespace()
+		return nil
+	case status.EdgeMetadata:
+		m.ClearMetadata()
+		return nil
 	}
-	return fmt.Errorf("unknown Metadata unique edge %s", name)
+	return fmt.Errorf("unknown Status unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MetadataMutation) ResetEdge(name string) error {
+func (m *StatusMutation) ResetEdge(name string) error {
 	switch name {
-	case metadata.EdgeAnnotations:
-		m.ResetAnnotations()
+	case status.EdgeNamespace:
+		m.ResetNamespace()
 		return nil
-	case metadata.EdgeStatuses:
-		m.ResetStatuses()
+	case status.EdgeMetadata:
+		m.ResetMetadata()
 		return nil
 	}
-	return fmt.Errorf("unknown Metadata edge %s", name)
+	return fmt.Errorf("unknown Status edge %s", name)
 }
 
-// StatusMutation represents an operation that mutates the Status nodes in the graph.
-type StatusMutation struct {
+// StatusHistoryMutation represents an operation that mutates the StatusHistory nodes in the graph.
+type StatusHistoryMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *gidx.PrefixedID
-	created_at       *time.Time
-	updated_at       *time.Time
-	source           *string
-	data             *json.RawMessage
-	appenddata       json.RawMessage
-	clearedFields    map[string]struct{}
-	namespace        *gidx.PrefixedID
-	clearednamespace bool
-	metadata         *gidx.PrefixedID
-	clearedmetadata  bool
-	done             bool
-	oldValue         func(context.Context) (*Status, error)
-	predicates       []predicate.Status
-}
-
-var _ ent.Mutation = (*StatusMutation)(nil)
-
-// statusOption allows management of the mutation configuration using functional options.
-type statusOption func(*StatusMutation)
-
-// newStatusMutation creates new mutation for the Status entity.
-func newStatusMutation(c config, op Op, opts ...statusOption) *StatusMutation {
-	m := &StatusMutation{
+	op                  Op
+	typ                 string
+	id                  *gidx.PrefixedID
+	created_at          *time.Time
+	status_id           *gidx.PrefixedID
+	metadata_id         *gidx.PrefixedID
+	status_namespace_id *gidx.PrefixedID
+	source              *string
+	operation           *statushistory.Operation
+	data                *json.RawMessage
+	appenddata          json.RawMessage
+	actor               *string
+	status_created_at   *time.Time
+	status_updated_at   *time.Time
+	status_expires_at   *time.Time
+	status_version      *int64
+	addstatus_version   *int64
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*StatusHistory, error)
+	predicates          []predicate.StatusHistory
+}
+
+var _ ent.Mutation = (*StatusHistoryMutation)(nil)
+
+// statushistoryOption allows management of the mutation configuration using functional options.
+type statushistoryOption func(*StatusHistoryMutation)
+
+// newStatusHistoryMutation creates new mutation for the StatusHistory entity.
+func newStatusHistoryMutation(c config, op Op, opts ...statushistoryOption) *StatusHistoryMutation {
+	m := &StatusHistoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStatus,
+		typ:           TypeStatusHistory,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2025,20 +4994,20 @@ func newStatusMutation(c config, op Op, opts ...statusOption) *StatusMutation {
 	return m
 }
 
-// withStatusID sets the ID field of the mutation.
-func withStatusID(id gidx.PrefixedID) statusOption {
-	return func(m *StatusMutation) {
+// withStatusHistoryID sets the ID field of the mutation.
+func withStatusHistoryID(id gidx.PrefixedID) statushistoryOption {
+	return func(m *StatusHistoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Status
+			value *StatusHistory
 		)
-		m.oldValue = func(ctx context.Context) (*Status, error) {
+		m.oldValue = func(ctx context.Context) (*StatusHistory, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Status.Get(ctx, id)
+					value, err = m.Client().StatusHistory.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2047,10 +5016,10 @@ func withStatusID(id gidx.PrefixedID) statusOption {
 	}
 }
 
-// withStatus sets the old Status of the mutation.
-func withStatus(node *Status) statusOption {
-	return func(m *StatusMutation) {
-		m.oldValue = func(context.Context) (*Status, error) {
+// withStatusHistory sets the old StatusHistory of the mutation.
+func withStatusHistory(node *StatusHistory) statushistoryOption {
+	return func(m *StatusHistoryMutation) {
+		m.oldValue = func(context.Context) (*StatusHistory, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2059,7 +5028,7 @@ func withStatus(node *Status) statusOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StatusMutation) Client() *Client {
+func (m StatusHistoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2067,7 +5036,7 @@ func (m StatusMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StatusMutation) Tx() (*Tx, error) {
+func (m StatusHistoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("generated: mutation is not running in a transaction")
 	}
@@ -2077,14 +5046,14 @@ func (m StatusMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Status entities.
-func (m *StatusMutation) SetID(id gidx.PrefixedID) {
+// operation is only accepted on creation of StatusHistory entities.
+func (m *StatusHistoryMutation) SetID(id gidx.PrefixedID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StatusMutation) ID() (id gidx.PrefixedID, exists bool) {
+func (m *StatusHistoryMutation) ID() (id gidx.PrefixedID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2095,7 +5064,7 @@ func (m *StatusMutation) ID() (id gidx.PrefixedID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StatusMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
+func (m *StatusHistoryMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2104,19 +5073,19 @@ func (m *StatusMutation) IDs(ctx context.Context) ([]gidx.PrefixedID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Status.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().StatusHistory.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *StatusMutation) SetCreatedAt(t time.Time) {
+func (m *StatusHistoryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StatusMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *StatusHistoryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2124,10 +5093,10 @@ func (m *StatusMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Status entity.
-// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StatusMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *StatusHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2142,281 +5111,516 @@ func (m *StatusMutation) OldCreatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StatusMutation) ResetCreatedAt() {
+func (m *StatusHistoryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *StatusMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetStatusID sets the "status_id" field.
+func (m *StatusHistoryMutation) SetStatusID(gi gidx.PrefixedID) {
+	m.status_id = &gi
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *StatusMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// StatusID returns the value of the "status_id" field in the mutation.
+func (m *StatusHistoryMutation) StatusID() (r gidx.PrefixedID, exists bool) {
+	v := m.status_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Status entity.
-// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// OldStatusID returns the old "status_id" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StatusMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *StatusHistoryMutation) OldStatusID(ctx context.Context) (v gidx.PrefixedID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatusID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatusID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatusID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.StatusID, nil
+}
+
+// ResetStatusID resets all changes to the "status_id" field.
+func (m *StatusHistoryMutation) ResetStatusID() {
+	m.status_id = nil
+}
+
+// SetMetadataID sets the "metadata_id" field.
+func (m *StatusHistoryMutation) SetMetadataID(gi gidx.PrefixedID) {
+	m.metadata_id = &gi
+}
+
+// MetadataID returns the value of the "metadata_id" field in the mutation.
+func (m *StatusHistoryMutation) MetadataID() (r gidx.PrefixedID, exists bool) {
+	v := m.metadata_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadataID returns the old "metadata_id" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusHistoryMutation) OldMetadataID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadataID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadataID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadataID: %w", err)
+	}
+	return oldValue.MetadataID, nil
+}
+
+// ResetMetadataID resets all changes to the "metadata_id" field.
+func (m *StatusHistoryMutation) ResetMetadataID() {
+	m.metadata_id = nil
+}
+
+// SetStatusNamespaceID sets the "status_namespace_id" field.
+func (m *StatusHistoryMutation) SetStatusNamespaceID(gi gidx.PrefixedID) {
+	m.status_namespace_id = &gi
+}
+
+// StatusNamespaceID returns the value of the "status_namespace_id" field in the mutation.
+func (m *StatusHistoryMutation) StatusNamespaceID() (r gidx.PrefixedID, exists bool) {
+	v := m.status_namespace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusNamespaceID returns the old "status_namespace_id" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusHistoryMutation) OldStatusNamespaceID(ctx context.Context) (v gidx.PrefixedID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusNamespaceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusNamespaceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusNamespaceID: %w", err)
+	}
+	return oldValue.StatusNamespaceID, nil
+}
+
+// ResetStatusNamespaceID resets all changes to the "status_namespace_id" field.
+func (m *StatusHistoryMutation) ResetStatusNamespaceID() {
+	m.status_namespace_id = nil
+}
+
+// SetSource sets the "source" field.
+func (m *StatusHistoryMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *StatusHistoryMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusHistoryMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *StatusHistoryMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetOperation sets the "operation" field.
+func (m *StatusHistoryMutation) SetOperation(s statushistory.Operation) {
+	m.operation = &s
+}
+
+// Operation returns the value of the "operation" field in the mutation.
+func (m *StatusHistoryMutation) Operation() (r statushistory.Operation, exists bool) {
+	v := m.operation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperation returns the old "operation" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusHistoryMutation) OldOperation(ctx context.Context) (v statushistory.Operation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+	}
+	return oldValue.Operation, nil
+}
+
+// ResetOperation resets all changes to the "operation" field.
+func (m *StatusHistoryMutation) ResetOperation() {
+	m.operation = nil
+}
+
+// SetData sets the "data" field.
+func (m *StatusHistoryMutation) SetData(jm json.RawMessage) {
+	m.data = &jm
+	m.appenddata = nil
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *StatusHistoryMutation) Data() (r json.RawMessage, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusHistoryMutation) OldData(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// AppendData adds jm to the "data" field.
+func (m *StatusHistoryMutation) AppendData(jm json.RawMessage) {
+	m.appenddata = append(m.appenddata, jm...)
+}
+
+// AppendedData returns the list of values that were appended to the "data" field in this mutation.
+func (m *StatusHistoryMutation) AppendedData() (json.RawMessage, bool) {
+	if len(m.appenddata) == 0 {
+		return nil, false
+	}
+	return m.appenddata, true
+}
+
+// ClearData clears the value of the "data" field.
+func (m *StatusHistoryMutation) ClearData() {
+	m.data = nil
+	m.appenddata = nil
+	m.clearedFields[statushistory.FieldData] = struct{}{}
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *StatusMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *StatusHistoryMutation) DataCleared() bool {
+	_, ok := m.clearedFields[statushistory.FieldData]
+	return ok
 }
 
-// SetMetadataID sets the "metadata_id" field.
-func (m *StatusMutation) SetMetadataID(gi gidx.PrefixedID) {
-	m.metadata = &gi
+// ResetData resets all changes to the "data" field.
+func (m *StatusHistoryMutation) ResetData() {
+	m.data = nil
+	m.appenddata = nil
+	delete(m.clearedFields, statushistory.FieldData)
 }
 
-// MetadataID returns the value of the "metadata_id" field in the mutation.
-func (m *StatusMutation) MetadataID() (r gidx.PrefixedID, exists bool) {
-	v := m.metadata
+// SetActor sets the "actor" field.
+func (m *StatusHistoryMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *StatusHistoryMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadataID returns the old "metadata_id" field's value of the Status entity.
-// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StatusMutation) OldMetadataID(ctx context.Context) (v gidx.PrefixedID, err error) {
+func (m *StatusHistoryMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadataID is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadataID requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadataID: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.MetadataID, nil
+	return oldValue.Actor, nil
 }
 
-// ResetMetadataID resets all changes to the "metadata_id" field.
-func (m *StatusMutation) ResetMetadataID() {
-	m.metadata = nil
+// ClearActor clears the value of the "actor" field.
+func (m *StatusHistoryMutation) ClearActor() {
+	m.actor = nil
+	m.clearedFields[statushistory.FieldActor] = struct{}{}
 }
 
-// SetStatusNamespaceID sets the "status_namespace_id" field.
-func (m *StatusMutation) SetStatusNamespaceID(gi gidx.PrefixedID) {
-	m.namespace = &gi
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *StatusHistoryMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[statushistory.FieldActor]
+	return ok
 }
 
-// StatusNamespaceID returns the value of the "status_namespace_id" field in the mutation.
-func (m *StatusMutation) StatusNamespaceID() (r gidx.PrefixedID, exists bool) {
-	v := m.namespace
+// ResetActor resets all changes to the "actor" field.
+func (m *StatusHistoryMutation) ResetActor() {
+	m.actor = nil
+	delete(m.clearedFields, statushistory.FieldActor)
+}
+
+// SetStatusCreatedAt sets the "status_created_at" field.
+func (m *StatusHistoryMutation) SetStatusCreatedAt(t time.Time) {
+	m.status_created_at = &t
+}
+
+// StatusCreatedAt returns the value of the "status_created_at" field in the mutation.
+func (m *StatusHistoryMutation) StatusCreatedAt() (r time.Time, exists bool) {
+	v := m.status_created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatusNamespaceID returns the old "status_namespace_id" field's value of the Status entity.
-// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// OldStatusCreatedAt returns the old "status_created_at" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StatusMutation) OldStatusNamespaceID(ctx context.Context) (v gidx.PrefixedID, err error) {
+func (m *StatusHistoryMutation) OldStatusCreatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatusNamespaceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatusCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatusNamespaceID requires an ID field in the mutation")
+		return v, errors.New("OldStatusCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatusNamespaceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatusCreatedAt: %w", err)
 	}
-	return oldValue.StatusNamespaceID, nil
+	return oldValue.StatusCreatedAt, nil
 }
 
-// ResetStatusNamespaceID resets all changes to the "status_namespace_id" field.
-func (m *StatusMutation) ResetStatusNamespaceID() {
-	m.namespace = nil
+// ClearStatusCreatedAt clears the value of the "status_created_at" field.
+func (m *StatusHistoryMutation) ClearStatusCreatedAt() {
+	m.status_created_at = nil
+	m.clearedFields[statushistory.FieldStatusCreatedAt] = struct{}{}
 }
 
-// SetSource sets the "source" field.
-func (m *StatusMutation) SetSource(s string) {
-	m.source = &s
+// StatusCreatedAtCleared returns if the "status_created_at" field was cleared in this mutation.
+func (m *StatusHistoryMutation) StatusCreatedAtCleared() bool {
+	_, ok := m.clearedFields[statushistory.FieldStatusCreatedAt]
+	return ok
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *StatusMutation) Source() (r string, exists bool) {
-	v := m.source
+// ResetStatusCreatedAt resets all changes to the "status_created_at" field.
+func (m *StatusHistoryMutation) ResetStatusCreatedAt() {
+	m.status_created_at = nil
+	delete(m.clearedFields, statushistory.FieldStatusCreatedAt)
+}
+
+// SetStatusUpdatedAt sets the "status_updated_at" field.
+func (m *StatusHistoryMutation) SetStatusUpdatedAt(t time.Time) {
+	m.status_updated_at = &t
+}
+
+// StatusUpdatedAt returns the value of the "status_updated_at" field in the mutation.
+func (m *StatusHistoryMutation) StatusUpdatedAt() (r time.Time, exists bool) {
+	v := m.status_updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the Status entity.
-// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// OldStatusUpdatedAt returns the old "status_updated_at" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StatusMutation) OldSource(ctx context.Context) (v string, err error) {
+func (m *StatusHistoryMutation) OldStatusUpdatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatusUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldStatusUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatusUpdatedAt: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.StatusUpdatedAt, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *StatusMutation) ResetSource() {
-	m.source = nil
+// ClearStatusUpdatedAt clears the value of the "status_updated_at" field.
+func (m *StatusHistoryMutation) ClearStatusUpdatedAt() {
+	m.status_updated_at = nil
+	m.clearedFields[statushistory.FieldStatusUpdatedAt] = struct{}{}
 }
 
-// SetData sets the "data" field.
-func (m *StatusMutation) SetData(jm json.RawMessage) {
-	m.data = &jm
-	m.appenddata = nil
+// StatusUpdatedAtCleared returns if the "status_updated_at" field was cleared in this mutation.
+func (m *StatusHistoryMutation) StatusUpdatedAtCleared() bool {
+	_, ok := m.clearedFields[statushistory.FieldStatusUpdatedAt]
+	return ok
 }
 
-// Data returns the value of the "data" field in the mutation.
-func (m *StatusMutation) Data() (r json.RawMessage, exists bool) {
-	v := m.data
+// ResetStatusUpdatedAt resets all changes to the "status_updated_at" field.
+func (m *StatusHistoryMutation) ResetStatusUpdatedAt() {
+	m.status_updated_at = nil
+	delete(m.clearedFields, statushistory.FieldStatusUpdatedAt)
+}
+
+// SetStatusExpiresAt sets the "status_expires_at" field.
+func (m *StatusHistoryMutation) SetStatusExpiresAt(t time.Time) {
+	m.status_expires_at = &t
+}
+
+// StatusExpiresAt returns the value of the "status_expires_at" field in the mutation.
+func (m *StatusHistoryMutation) StatusExpiresAt() (r time.Time, exists bool) {
+	v := m.status_expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldData returns the old "data" field's value of the Status entity.
-// If the Status object wasn't provided to the builder, the object is fetched from the database.
+// OldStatusExpiresAt returns the old "status_expires_at" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StatusMutation) OldData(ctx context.Context) (v json.RawMessage, err error) {
+func (m *StatusHistoryMutation) OldStatusExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldData is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatusExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldData requires an ID field in the mutation")
+		return v, errors.New("OldStatusExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldData: %w", err)
-	}
-	return oldValue.Data, nil
-}
-
-// AppendData adds jm to the "data" field.
-func (m *StatusMutation) AppendData(jm json.RawMessage) {
-	m.appenddata = append(m.appenddata, jm...)
-}
-
-// AppendedData returns the list of values that were appended to the "data" field in this mutation.
-func (m *StatusMutation) AppendedData() (json.RawMessage, bool) {
-	if len(m.appenddata) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldStatusExpiresAt: %w", err)
 	}
-	return m.appenddata, true
+	return oldValue.StatusExpiresAt, nil
 }
 
-// ResetData resets all changes to the "data" field.
-func (m *StatusMutation) ResetData() {
-	m.data = nil
-	m.appenddata = nil
+// ClearStatusExpiresAt clears the value of the "status_expires_at" field.
+func (m *StatusHistoryMutation) ClearStatusExpiresAt() {
+	m.status_expires_at = nil
+	m.clearedFields[statushistory.FieldStatusExpiresAt] = struct{}{}
 }
 
-// SetNamespaceID sets the "namespace" edge to the StatusNamespace entity by id.
-func (m *StatusMutation) SetNamespaceID(id gidx.PrefixedID) {
-	m.namespace = &id
+// StatusExpiresAtCleared returns if the "status_expires_at" field was cleared in this mutation.
+func (m *StatusHistoryMutation) StatusExpiresAtCleared() bool {
+	_, ok := m.clearedFields[statushistory.FieldStatusExpiresAt]
+	return ok
 }
 
-// ClearNamespace clears the "namespace" edge to the StatusNamespace entity.
-func (m *StatusMutation) ClearNamespace() {
-	m.clearednamespace = true
-	m.clearedFields[status.FieldStatusNamespaceID] = struct{}{}
+// ResetStatusExpiresAt resets all changes to the "status_expires_at" field.
+func (m *StatusHistoryMutation) ResetStatusExpiresAt() {
+	m.status_expires_at = nil
+	delete(m.clearedFields, statushistory.FieldStatusExpiresAt)
 }
 
-// NamespaceCleared reports if the "namespace" edge to the StatusNamespace entity was cleared.
-func (m *StatusMutation) NamespaceCleared() bool {
-	return m.clearednamespace
+// SetStatusVersion sets the "status_version" field.
+func (m *StatusHistoryMutation) SetStatusVersion(i int64) {
+	m.status_version = &i
+	m.addstatus_version = nil
 }
 
-// NamespaceID returns the "namespace" edge ID in the mutation.
-func (m *StatusMutation) NamespaceID() (id gidx.PrefixedID, exists bool) {
-	if m.namespace != nil {
-		return *m.namespace, true
+// StatusVersion returns the value of the "status_version" field in the mutation.
+func (m *StatusHistoryMutation) StatusVersion() (r int64, exists bool) {
+	v := m.status_version
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// NamespaceIDs returns the "namespace" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// NamespaceID instead. It exists only for internal usage by the builders.
-func (m *StatusMutation) NamespaceIDs() (ids []gidx.PrefixedID) {
-	if id := m.namespace; id != nil {
-		ids = append(ids, *id)
+// OldStatusVersion returns the old "status_version" field's value of the StatusHistory entity.
+// If the StatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusHistoryMutation) OldStatusVersion(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusVersion is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetNamespace resets all changes to the "namespace" edge.
-func (m *StatusMutation) ResetNamespace() {
-	m.namespace = nil
-	m.clearednamespace = false
-}
-
-// ClearMetadata clears the "metadata" edge to the Metadata entity.
-func (m *StatusMutation) ClearMetadata() {
-	m.clearedmetadata = true
-	m.clearedFields[status.FieldMetadataID] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusVersion: %w", err)
+	}
+	return oldValue.StatusVersion, nil
 }
 
-// MetadataCleared reports if the "metadata" edge to the Metadata entity was cleared.
-func (m *StatusMutation) MetadataCleared() bool {
-	return m.clearedmetadata
+// AddStatusVersion adds i to the "status_version" field.
+func (m *StatusHistoryMutation) AddStatusVersion(i int64) {
+	if m.addstatus_version != nil {
+		*m.addstatus_version += i
+	} else {
+		m.addstatus_version = &i
+	}
 }
 
-// MetadataIDs returns the "metadata" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MetadataID instead. It exists only for internal usage by the builders.
-func (m *StatusMutation) MetadataIDs() (ids []gidx.PrefixedID) {
-	if id := m.metadata; id != nil {
-		ids = append(ids, *id)
+// AddedStatusVersion returns the value that was added to the "status_version" field in this mutation.
+func (m *StatusHistoryMutation) AddedStatusVersion() (r int64, exists bool) {
+	v := m.addstatus_version
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetMetadata resets all changes to the "metadata" edge.
-func (m *StatusMutation) ResetMetadata() {
-	m.metadata = nil
-	m.clearedmetadata = false
+// ResetStatusVersion resets all changes to the "status_version" field.
+func (m *StatusHistoryMutation) ResetStatusVersion() {
+	m.status_version = nil
+	m.addstatus_version = nil
 }
 
-// Where appends a list predicates to the StatusMutation builder.
-func (m *StatusMutation) Where(ps ...predicate.Status) {
+// Where appends a list predicates to the StatusHistoryMutation builder.
+func (m *StatusHistoryMutation) Where(ps ...predicate.StatusHistory) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StatusMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StatusHistoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StatusMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Status, len(ps))
+func (m *StatusHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StatusHistory, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2424,42 +5628,60 @@ func (m *StatusMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StatusMutation) Op() Op {
+func (m *StatusHistoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StatusMutation) SetOp(op Op) {
+func (m *StatusHistoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Status).
-func (m *StatusMutation) Type() string {
+// Type returns the node type of this mutation (StatusHistory).
+func (m *StatusHistoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StatusMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *StatusHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.created_at != nil {
-		fields = append(fields, status.FieldCreatedAt)
+		fields = append(fields, statushistory.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, status.FieldUpdatedAt)
+	if m.status_id != nil {
+		fields = append(fields, statushistory.FieldStatusID)
 	}
-	if m.metadata != nil {
-		fields = append(fields, status.FieldMetadataID)
+	if m.metadata_id != nil {
+		fields = append(fields, statushistory.FieldMetadataID)
 	}
-	if m.namespace != nil {
-		fields = append(fields, status.FieldStatusNamespaceID)
+	if m.status_namespace_id != nil {
+		fields = append(fields, statushistory.FieldStatusNamespaceID)
 	}
 	if m.source != nil {
-		fields = append(fields, status.FieldSource)
+		fields = append(fields, statushistory.FieldSource)
+	}
+	if m.operation != nil {
+		fields = append(fields, statushistory.FieldOperation)
 	}
 	if m.data != nil {
-		fields = append(fields, status.FieldData)
+		fields = append(fields, statushistory.FieldData)
+	}
+	if m.actor != nil {
+		fields = append(fields, statushistory.FieldActor)
+	}
+	if m.status_created_at != nil {
+		fields = append(fields, statushistory.FieldStatusCreatedAt)
+	}
+	if m.status_updated_at != nil {
+		fields = append(fields, statushistory.FieldStatusUpdatedAt)
+	}
+	if m.status_expires_at != nil {
+		fields = append(fields, statushistory.FieldStatusExpiresAt)
+	}
+	if m.status_version != nil {
+		fields = append(fields, statushistory.FieldStatusVersion)
 	}
 	return fields
 }
@@ -2467,20 +5689,32 @@ func (m *StatusMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StatusMutation) Field(name string) (ent.Value, bool) {
+func (m *StatusHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case status.FieldCreatedAt:
+	case statushistory.FieldCreatedAt:
 		return m.CreatedAt()
-	case status.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case status.FieldMetadataID:
+	case statushistory.FieldStatusID:
+		return m.StatusID()
+	case statushistory.FieldMetadataID:
 		return m.MetadataID()
-	case status.FieldStatusNamespaceID:
+	case statushistory.FieldStatusNamespaceID:
 		return m.StatusNamespaceID()
-	case status.FieldSource:
+	case statushistory.FieldSource:
 		return m.Source()
-	case status.FieldData:
+	case statushistory.FieldOperation:
+		return m.Operation()
+	case statushistory.FieldData:
 		return m.Data()
+	case statushistory.FieldActor:
+		return m.Actor()
+	case statushistory.FieldStatusCreatedAt:
+		return m.StatusCreatedAt()
+	case statushistory.FieldStatusUpdatedAt:
+		return m.StatusUpdatedAt()
+	case statushistory.FieldStatusExpiresAt:
+		return m.StatusExpiresAt()
+	case statushistory.FieldStatusVersion:
+		return m.StatusVersion()
 	}
 	return nil, false
 }
@@ -2488,232 +5722,308 @@ func (m *StatusMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StatusHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case status.FieldCreatedAt:
+	case statushistory.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case status.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case status.FieldMetadataID:
+	case statushistory.FieldStatusID:
+		return m.OldStatusID(ctx)
+	case statushistory.FieldMetadataID:
 		return m.OldMetadataID(ctx)
-	case status.FieldStatusNamespaceID:
+	case statushistory.FieldStatusNamespaceID:
 		return m.OldStatusNamespaceID(ctx)
-	case status.FieldSource:
+	case statushistory.FieldSource:
 		return m.OldSource(ctx)
-	case status.FieldData:
+	case statushistory.FieldOperation:
+		return m.OldOperation(ctx)
+	case statushistory.FieldData:
 		return m.OldData(ctx)
+	case statushistory.FieldActor:
+		return m.OldActor(ctx)
+	case statushistory.FieldStatusCreatedAt:
+		return m.OldStatusCreatedAt(ctx)
+	case statushistory.FieldStatusUpdatedAt:
+		return m.OldStatusUpdatedAt(ctx)
+	case statushistory.FieldStatusExpiresAt:
+		return m.OldStatusExpiresAt(ctx)
+	case statushistory.FieldStatusVersion:
+		return m.OldStatusVersion(ctx)
 	}
-	return nil, fmt.Errorf("unknown Status field %s", name)
+	return nil, fmt.Errorf("unknown StatusHistory field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StatusMutation) SetField(name string, value ent.Value) error {
+func (m *StatusHistoryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case status.FieldCreatedAt:
+	case statushistory.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case status.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case statushistory.FieldStatusID:
+		v, ok := value.(gidx.PrefixedID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetStatusID(v)
 		return nil
-	case status.FieldMetadataID:
+	case statushistory.FieldMetadataID:
 		v, ok := value.(gidx.PrefixedID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMetadataID(v)
 		return nil
-	case status.FieldStatusNamespaceID:
+	case statushistory.FieldStatusNamespaceID:
 		v, ok := value.(gidx.PrefixedID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatusNamespaceID(v)
 		return nil
-	case status.FieldSource:
+	case statushistory.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSource(v)
 		return nil
-	case status.FieldData:
+	case statushistory.FieldOperation:
+		v, ok := value.(statushistory.Operation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case statushistory.FieldData:
 		v, ok := value.(json.RawMessage)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetData(v)
 		return nil
+	case statushistory.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case statushistory.FieldStatusCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCreatedAt(v)
+		return nil
+	case statushistory.FieldStatusUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusUpdatedAt(v)
+		return nil
+	case statushistory.FieldStatusExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusExpiresAt(v)
+		return nil
+	case statushistory.FieldStatusVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Status field %s", name)
+	return fmt.Errorf("unknown StatusHistory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StatusMutation) AddedFields() []string {
-	return nil
+func (m *StatusHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus_version != nil {
+		fields = append(fields, statushistory.FieldStatusVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StatusMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StatusHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case statushistory.FieldStatusVersion:
+		return m.AddedStatusVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StatusMutation) AddField(name string, value ent.Value) error {
+func (m *StatusHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case statushistory.FieldStatusVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Status numeric field %s", name)
+	return fmt.Errorf("unknown StatusHistory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StatusMutation) ClearedFields() []string {
-	return nil
+func (m *StatusHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(statushistory.FieldData) {
+		fields = append(fields, statushistory.FieldData)
+	}
+	if m.FieldCleared(statushistory.FieldActor) {
+		fields = append(fields, statushistory.FieldActor)
+	}
+	if m.FieldCleared(statushistory.FieldStatusCreatedAt) {
+		fields = append(fields, statushistory.FieldStatusCreatedAt)
+	}
+	if m.FieldCleared(statushistory.FieldStatusUpdatedAt) {
+		fields = append(fields, statushistory.FieldStatusUpdatedAt)
+	}
+	if m.FieldCleared(statushistory.FieldStatusExpiresAt) {
+		fields = append(fields, statushistory.FieldStatusExpiresAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StatusMutation) FieldCleared(name string) bool {
+func (m *StatusHistoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StatusMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Status nullable field %s", name)
+func (m *StatusHistoryMutation) ClearField(name string) error {
+	switch name {
+	case statushistory.FieldData:
+		m.ClearData()
+		return nil
+	case statushistory.FieldActor:
+		m.ClearActor()
+		return nil
+	case statushistory.FieldStatusCreatedAt:
+		m.ClearStatusCreatedAt()
+		return nil
+	case statushistory.FieldStatusUpdatedAt:
+		m.ClearStatusUpdatedAt()
+		return nil
+	case statushistory.FieldStatusExpiresAt:
+		m.ClearStatusExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StatusHistory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StatusMutation) ResetField(name string) error {
+func (m *StatusHistoryMutation) ResetField(name string) error {
 	switch name {
-	case status.FieldCreatedAt:
+	case statushistory.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case status.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case statushistory.FieldStatusID:
+		m.ResetStatusID()
 		return nil
-	case status.FieldMetadataID:
+	case statushistory.FieldMetadataID:
 		m.ResetMetadataID()
 		return nil
-	case status.FieldStatusNamespaceID:
+	case statushistory.FieldStatusNamespaceID:
 		m.ResetStatusNamespaceID()
 		return nil
-	case status.FieldSource:
+	case statushistory.FieldSource:
 		m.ResetSource()
 		return nil
-	case status.FieldData:
+	case statushistory.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case statushistory.FieldData:
 		m.ResetData()
 		return nil
+	case statushistory.FieldActor:
+		m.ResetActor()
+		return nil
+	case statushistory.FieldStatusCreatedAt:
+		m.ResetStatusCreatedAt()
+		return nil
+	case statushistory.FieldStatusUpdatedAt:
+		m.ResetStatusUpdatedAt()
+		return nil
+	case statushistory.FieldStatusExpiresAt:
+		m.ResetStatusExpiresAt()
+		return nil
+	case statushistory.FieldStatusVersion:
+		m.ResetStatusVersion()
+		return nil
 	}
-	return fmt.Errorf("unknown Status field %s", name)
+	return fmt.Errorf("unknown StatusHistory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StatusMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.namespace != nil {
-		edges = append(edges, status.EdgeNamespace)
-	}
-	if m.metadata != nil {
-		edges = append(edges, status.EdgeMetadata)
-	}
+func (m *StatusHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StatusMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case status.EdgeNamespace:
-		if id := m.namespace; id != nil {
-			return []ent.Value{*id}
-		}
-	case status.EdgeMetadata:
-		if id := m.metadata; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *StatusHistoryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StatusMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *StatusHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StatusMutation) RemovedIDs(name string) []ent.Value {
+func (m *StatusHistoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StatusMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearednamespace {
-		edges = append(edges, status.EdgeNamespace)
-	}
-	if m.clearedmetadata {
-		edges = append(edges, status.EdgeMetadata)
-	}
+func (m *StatusHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StatusMutation) EdgeCleared(name string) bool {
-	switch name {
-	case status.EdgeNamespace:
-		return m.clearednamespace
-	case status.EdgeMetadata:
-		return m.clearedmetadata
-	}
+func (m *StatusHistoryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StatusMutation) ClearEdge(name string) error {
-	switch name {
-	case status.EdgeNamespace:
-		m.ClearNamespace()
-		return nil
-	case status.EdgeMetadata:
-		m.ClearMetadata()
-		return nil
-	}
-	return fmt.Errorf("unknown Status unique edge %s", name)
+func (m *StatusHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown StatusHistory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StatusMutation) ResetEdge(name string) error {
-	switch name {
-	case status.EdgeNamespace:
-		m.ResetNamespace()
-		return nil
-	case status.EdgeMetadata:
-		m.ResetMetadata()
-		return nil
-	}
-	return fmt.Errorf("unknown Status edge %s", name)
+func (m *StatusHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown StatusHistory edge %s", name)
 }
 
 // StatusNamespaceMutation represents an operation that mutates the StatusNamespace nodes in the graph.
@@ -2724,9 +6034,15 @@ type StatusNamespaceMutation struct {
 	id                   *gidx.PrefixedID
 	created_at           *time.Time
 	updated_at           *time.Time
+	deleted_at           *time.Time
 	name                 *string
 	resource_provider_id *gidx.PrefixedID
 	private              *bool
+	deleting             *bool
+	json_schema          *json.RawMessage
+	appendjson_schema    json.RawMessage
+	default_ttl          *int64
+	adddefault_ttl       *int64
 	clearedFields        map[string]struct{}
 	statuses             map[gidx.PrefixedID]struct{}
 	removedstatuses      map[gidx.PrefixedID]struct{}
@@ -2912,6 +6228,55 @@ func (m *StatusNamespaceMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *StatusNamespaceMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *StatusNamespaceMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the StatusNamespace entity.
+// If the StatusNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusNamespaceMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *StatusNamespaceMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[statusnamespace.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *StatusNamespaceMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[statusnamespace.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *StatusNamespaceMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, statusnamespace.FieldDeletedAt)
+}
+
 // SetName sets the "name" field.
 func (m *StatusNamespaceMutation) SetName(s string) {
 	m.name = &s
@@ -3020,6 +6385,177 @@ func (m *StatusNamespaceMutation) ResetPrivate() {
 	m.private = nil
 }
 
+// SetDeleting sets the "deleting" field.
+func (m *StatusNamespaceMutation) SetDeleting(b bool) {
+	m.deleting = &b
+}
+
+// Deleting returns the value of the "deleting" field in the mutation.
+func (m *StatusNamespaceMutation) Deleting() (r bool, exists bool) {
+	v := m.deleting
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleting returns the old "deleting" field's value of the StatusNamespace entity.
+// If the StatusNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusNamespaceMutation) OldDeleting(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleting is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleting requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleting: %w", err)
+	}
+	return oldValue.Deleting, nil
+}
+
+// ResetDeleting resets all changes to the "deleting" field.
+func (m *StatusNamespaceMutation) ResetDeleting() {
+	m.deleting = nil
+}
+
+// SetJSONSchema sets the "json_schema" field.
+func (m *StatusNamespaceMutation) SetJSONSchema(jm json.RawMessage) {
+	m.json_schema = &jm
+	m.appendjson_schema = nil
+}
+
+// JSONSchema returns the value of the "json_schema" field in the mutation.
+func (m *StatusNamespaceMutation) JSONSchema() (r json.RawMessage, exists bool) {
+	v := m.json_schema
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJSONSchema returns the old "json_schema" field's value of the StatusNamespace entity.
+// If the StatusNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusNamespaceMutation) OldJSONSchema(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJSONSchema is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJSONSchema requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJSONSchema: %w", err)
+	}
+	return oldValue.JSONSchema, nil
+}
+
+// AppendJSONSchema adds jm to the "json_schema" field.
+func (m *StatusNamespaceMutation) AppendJSONSchema(jm json.RawMessage) {
+	m.appendjson_schema = append(m.appendjson_schema, jm...)
+}
+
+// AppendedJSONSchema returns the list of values that were appended to the "json_schema" field in this mutation.
+func (m *StatusNamespaceMutation) AppendedJSONSchema() (json.RawMessage, bool) {
+	if len(m.appendjson_schema) == 0 {
+		return nil, false
+	}
+	return m.appendjson_schema, true
+}
+
+// ClearJSONSchema clears the value of the "json_schema" field.
+func (m *StatusNamespaceMutation) ClearJSONSchema() {
+	m.json_schema = nil
+	m.appendjson_schema = nil
+	m.clearedFields[statusnamespace.FieldJSONSchema] = struct{}{}
+}
+
+// JSONSchemaCleared returns if the "json_schema" field was cleared in this mutation.
+func (m *StatusNamespaceMutation) JSONSchemaCleared() bool {
+	_, ok := m.clearedFields[statusnamespace.FieldJSONSchema]
+	return ok
+}
+
+// ResetJSONSchema resets all changes to the "json_schema" field.
+func (m *StatusNamespaceMutation) ResetJSONSchema() {
+	m.json_schema = nil
+	m.appendjson_schema = nil
+	delete(m.clearedFields, statusnamespace.FieldJSONSchema)
+}
+
+// SetDefaultTTL sets the "default_ttl" field.
+func (m *StatusNamespaceMutation) SetDefaultTTL(i int64) {
+	m.default_ttl = &i
+	m.adddefault_ttl = nil
+}
+
+// DefaultTTL returns the value of the "default_ttl" field in the mutation.
+func (m *StatusNamespaceMutation) DefaultTTL() (r int64, exists bool) {
+	v := m.default_ttl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefaultTTL returns the old "default_ttl" field's value of the StatusNamespace entity.
+// If the StatusNamespace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StatusNamespaceMutation) OldDefaultTTL(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDefaultTTL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDefaultTTL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefaultTTL: %w", err)
+	}
+	return oldValue.DefaultTTL, nil
+}
+
+// AddDefaultTTL adds i to the "default_ttl" field.
+func (m *StatusNamespaceMutation) AddDefaultTTL(i int64) {
+	if m.adddefault_ttl != nil {
+		*m.adddefault_ttl += i
+	} else {
+		m.adddefault_ttl = &i
+	}
+}
+
+// AddedDefaultTTL returns the value that was added to the "default_ttl" field in this mutation.
+func (m *StatusNamespaceMutation) AddedDefaultTTL() (r int64, exists bool) {
+	v := m.adddefault_ttl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDefaultTTL clears the value of the "default_ttl" field.
+func (m *StatusNamespaceMutation) ClearDefaultTTL() {
+	m.default_ttl = nil
+	m.adddefault_ttl = nil
+	m.clearedFields[statusnamespace.FieldDefaultTTL] = struct{}{}
+}
+
+// DefaultTTLCleared returns if the "default_ttl" field was cleared in this mutation.
+func (m *StatusNamespaceMutation) DefaultTTLCleared() bool {
+	_, ok := m.clearedFields[statusnamespace.FieldDefaultTTL]
+	return ok
+}
+
+// ResetDefaultTTL resets all changes to the "default_ttl" field.
+func (m *StatusNamespaceMutation) ResetDefaultTTL() {
+	m.default_ttl = nil
+	m.adddefault_ttl = nil
+	delete(m.clearedFields, statusnamespace.FieldDefaultTTL)
+}
+
 // AddStatusIDs adds the "statuses" edge to the Status entity by ids.
 func (m *StatusNamespaceMutation) AddStatusIDs(ids ...gidx.PrefixedID) {
 	if m.statuses == nil {
@@ -3108,13 +6644,16 @@ func (m *StatusNamespaceMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *StatusNamespaceMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
 		fields = append(fields, statusnamespace.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
 		fields = append(fields, statusnamespace.FieldUpdatedAt)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, statusnamespace.FieldDeletedAt)
+	}
 	if m.name != nil {
 		fields = append(fields, statusnamespace.FieldName)
 	}
@@ -3124,6 +6663,15 @@ func (m *StatusNamespaceMutation) Fields() []string {
 	if m.private != nil {
 		fields = append(fields, statusnamespace.FieldPrivate)
 	}
+	if m.deleting != nil {
+		fields = append(fields, statusnamespace.FieldDeleting)
+	}
+	if m.json_schema != nil {
+		fields = append(fields, statusnamespace.FieldJSONSchema)
+	}
+	if m.default_ttl != nil {
+		fields = append(fields, statusnamespace.FieldDefaultTTL)
+	}
 	return fields
 }
 
@@ -3136,12 +6684,20 @@ func (m *StatusNamespaceMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedAt()
 	case statusnamespace.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case statusnamespace.FieldDeletedAt:
+		return m.DeletedAt()
 	case statusnamespace.FieldName:
 		return m.Name()
 	case statusnamespace.FieldResourceProviderID:
 		return m.ResourceProviderID()
 	case statusnamespace.FieldPrivate:
 		return m.Private()
+	case statusnamespace.FieldDeleting:
+		return m.Deleting()
+	case statusnamespace.FieldJSONSchema:
+		return m.JSONSchema()
+	case statusnamespace.FieldDefaultTTL:
+		return m.DefaultTTL()
 	}
 	return nil, false
 }
@@ -3155,12 +6711,20 @@ func (m *StatusNamespaceMutation) OldField(ctx context.Context, name string) (en
 		return m.OldCreatedAt(ctx)
 	case statusnamespace.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case statusnamespace.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	case statusnamespace.FieldName:
 		return m.OldName(ctx)
 	case statusnamespace.FieldResourceProviderID:
 		return m.OldResourceProviderID(ctx)
 	case statusnamespace.FieldPrivate:
 		return m.OldPrivate(ctx)
+	case statusnamespace.FieldDeleting:
+		return m.OldDeleting(ctx)
+	case statusnamespace.FieldJSONSchema:
+		return m.OldJSONSchema(ctx)
+	case statusnamespace.FieldDefaultTTL:
+		return m.OldDefaultTTL(ctx)
 	}
 	return nil, fmt.Errorf("unknown StatusNamespace field %s", name)
 }
@@ -3184,6 +6748,13 @@ func (m *StatusNamespaceMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case statusnamespace.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	case statusnamespace.FieldName:
 		v, ok := value.(string)
 		if !ok {
@@ -3205,6 +6776,27 @@ func (m *StatusNamespaceMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPrivate(v)
 		return nil
+	case statusnamespace.FieldDeleting:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleting(v)
+		return nil
+	case statusnamespace.FieldJSONSchema:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJSONSchema(v)
+		return nil
+	case statusnamespace.FieldDefaultTTL:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefaultTTL(v)
+		return nil
 	}
 	return fmt.Errorf("unknown StatusNamespace field %s", name)
 }
@@ -3212,13 +6804,21 @@ func (m *StatusNamespaceMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *StatusNamespaceMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.adddefault_ttl != nil {
+		fields = append(fields, statusnamespace.FieldDefaultTTL)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *StatusNamespaceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case statusnamespace.FieldDefaultTTL:
+		return m.AddedDefaultTTL()
+	}
 	return nil, false
 }
 
@@ -3227,6 +6827,13 @@ func (m *StatusNamespaceMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *StatusNamespaceMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case statusnamespace.FieldDefaultTTL:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDefaultTTL(v)
+		return nil
 	}
 	return fmt.Errorf("unknown StatusNamespace numeric field %s", name)
 }
@@ -3234,7 +6841,17 @@ func (m *StatusNamespaceMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *StatusNamespaceMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(statusnamespace.FieldDeletedAt) {
+		fields = append(fields, statusnamespace.FieldDeletedAt)
+	}
+	if m.FieldCleared(statusnamespace.FieldJSONSchema) {
+		fields = append(fields, statusnamespace.FieldJSONSchema)
+	}
+	if m.FieldCleared(statusnamespace.FieldDefaultTTL) {
+		fields = append(fields, statusnamespace.FieldDefaultTTL)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -3247,6 +6864,17 @@ func (m *StatusNamespaceMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *StatusNamespaceMutation) ClearField(name string) error {
+	switch name {
+	case statusnamespace.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case statusnamespace.FieldJSONSchema:
+		m.ClearJSONSchema()
+		return nil
+	case statusnamespace.FieldDefaultTTL:
+		m.ClearDefaultTTL()
+		return nil
+	}
 	return fmt.Errorf("unknown StatusNamespace nullable field %s", name)
 }
 
@@ -3260,6 +6888,9 @@ func (m *StatusNamespaceMutation) ResetField(name string) error {
 	case statusnamespace.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case statusnamespace.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	case statusnamespace.FieldName:
 		m.ResetName()
 		return nil
@@ -3269,6 +6900,15 @@ func (m *StatusNamespaceMutation) ResetField(name string) error {
 	case statusnamespace.FieldPrivate:
 		m.ResetPrivate()
 		return nil
+	case statusnamespace.FieldDeleting:
+		m.ResetDeleting()
+		return nil
+	case statusnamespace.FieldJSONSchema:
+		m.ResetJSONSchema()
+		return nil
+	case statusnamespace.FieldDefaultTTL:
+		m.ResetDefaultTTL()
+		return nil
 	}
 	return fmt.Errorf("unknown StatusNamespace field %s", name)
 }
@@ -0,0 +1,207 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
+	"go.infratographer.com/x/gidx"
+)
+
+// Representation of the deletion of a namespace with its statuses or annotations, which runs in the background.
+type NamespaceDeletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The ID for the namespace deletion.
+	ID gidx.PrefixedID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ID of the annotation or status namespace being deleted.
+	NamespaceID gidx.PrefixedID `json:"namespace_id,omitempty"`
+	// ID of the owner or resource provider of the namespace.
+	OwnerID gidx.PrefixedID `json:"owner_id,omitempty"`
+	// The state of the deletion. Pending deletions are canceled when the namespace is restored.
+	State namespacedeletion.State `json:"state,omitempty"`
+	// Number of statuses or annotations in the namespace when the deletion was started.
+	TotalCount int `json:"total_count,omitempty"`
+	// Number of statuses or annotations deleted so far.
+	DeletedCount int `json:"deleted_count,omitempty"`
+	// Time the namespace was deleted.
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NamespaceDeletion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case namespacedeletion.FieldID, namespacedeletion.FieldNamespaceID, namespacedeletion.FieldOwnerID:
+			values[i] = new(gidx.PrefixedID)
+		case namespacedeletion.FieldTotalCount, namespacedeletion.FieldDeletedCount:
+			values[i] = new(sql.NullInt64)
+		case namespacedeletion.FieldState:
+			values[i] = new(sql.NullString)
+		case namespacedeletion.FieldCreatedAt, namespacedeletion.FieldUpdatedAt, namespacedeletion.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NamespaceDeletion fields.
+func (nd *NamespaceDeletion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case namespacedeletion.FieldID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				nd.ID = *value
+			}
+		case namespacedeletion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nd.CreatedAt = value.Time
+			}
+		case namespacedeletion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				nd.UpdatedAt = value.Time
+			}
+		case namespacedeletion.FieldNamespaceID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field namespace_id", values[i])
+			} else if value != nil {
+				nd.NamespaceID = *value
+			}
+		case namespacedeletion.FieldOwnerID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value != nil {
+				nd.OwnerID = *value
+			}
+		case namespacedeletion.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				nd.State = namespacedeletion.State(value.String)
+			}
+		case namespacedeletion.FieldTotalCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_count", values[i])
+			} else if value.Valid {
+				nd.TotalCount = int(value.Int64)
+			}
+		case namespacedeletion.FieldDeletedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_count", values[i])
+			} else if value.Valid {
+				nd.DeletedCount = int(value.Int64)
+			}
+		case namespacedeletion.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				nd.CompletedAt = new(time.Time)
+				*nd.CompletedAt = value.Time
+			}
+		default:
+			nd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NamespaceDeletion.
+// This includes values selected through modifiers, order, etc.
+func (nd *NamespaceDeletion) Value(name string) (ent.Value, error) {
+	return nd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NamespaceDeletion.
+// Note that you need to call NamespaceDeletion.Unwrap() before calling this method if this NamespaceDeletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nd *NamespaceDeletion) Update() *NamespaceDeletionUpdateOne {
+	return NewNamespaceDeletionClient(nd.config).UpdateOne(nd)
+}
+
+// Unwrap unwraps the NamespaceDeletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nd *NamespaceDeletion) Unwrap() *NamespaceDeletion {
+	_tx, ok := nd.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: NamespaceDeletion is not a transactional entity")
+	}
+	nd.config.driver = _tx.drv
+	return nd
+}
+
+// String implements the fmt.Stringer.
+func (nd *NamespaceDeletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("NamespaceDeletion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nd.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(nd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(nd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("namespace_id=")
+	builder.WriteString(fmt.Sprintf("%v", nd.NamespaceID))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", nd.OwnerID))
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", nd.State))
+	builder.WriteString(", ")
+	builder.WriteString("total_count=")
+	builder.WriteString(fmt.Sprintf("%v", nd.TotalCount))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_count=")
+	builder.WriteString(fmt.Sprintf("%v", nd.DeletedCount))
+	builder.WriteString(", ")
+	if v := nd.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IsEntity implement fedruntime.Entity
+func (nd NamespaceDeletion) IsEntity() {}
+
+// NamespaceDeletions is a parsable slice of NamespaceDeletion.
+type NamespaceDeletions []*NamespaceDeletion
@@ -0,0 +1,190 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package namespacedeletion
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/x/gidx"
+)
+
+const (
+	// Label holds the string label denoting the namespacedeletion type in the database.
+	Label = "namespace_deletion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldNamespaceID holds the string denoting the namespace_id field in the database.
+	FieldNamespaceID = "namespace_id"
+	// FieldOwnerID holds the string denoting the owner_id field in the database.
+	FieldOwnerID = "owner_id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldTotalCount holds the string denoting the total_count field in the database.
+	FieldTotalCount = "total_count"
+	// FieldDeletedCount holds the string denoting the deleted_count field in the database.
+	FieldDeletedCount = "deleted_count"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// Table holds the table name of the namespacedeletion in the database.
+	Table = "namespace_deletions"
+)
+
+// Columns holds all SQL columns for namespacedeletion fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldNamespaceID,
+	FieldOwnerID,
+	FieldState,
+	FieldTotalCount,
+	FieldDeletedCount,
+	FieldCompletedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NamespaceIDValidator is a validator for the "namespace_id" field. It is called by the builders before save.
+	NamespaceIDValidator func(string) error
+	// OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	OwnerIDValidator func(string) error
+	// TotalCountValidator is a validator for the "total_count" field. It is called by the builders before save.
+	TotalCountValidator func(int) error
+	// DefaultDeletedCount holds the default value on creation for the "deleted_count" field.
+	DefaultDeletedCount int
+	// DeletedCountValidator is a validator for the "deleted_count" field. It is called by the builders before save.
+	DeletedCountValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() gidx.PrefixedID
+)
+
+// State defines the type for the "state" enum field.
+type State string
+
+// StatePENDING is the default value of the State enum.
+const DefaultState = StatePENDING
+
+// State values.
+const (
+	StatePENDING   State = "PENDING"
+	StateRUNNING   State = "RUNNING"
+	StateCOMPLETED State = "COMPLETED"
+	StateCANCELED  State = "CANCELED"
+)
+
+func (s State) String() string {
+	return string(s)
+}
+
+// StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
+func StateValidator(s State) error {
+	switch s {
+	case StatePENDING, StateRUNNING, StateCOMPLETED, StateCANCELED:
+		return nil
+	default:
+		return fmt.Errorf("namespacedeletion: invalid enum value for state field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the NamespaceDeletion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByNamespaceID orders the results by the namespace_id field.
+func ByNamespaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNamespaceID, opts...).ToFunc()
+}
+
+// ByOwnerID orders the results by the owner_id field.
+func ByOwnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwnerID, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByTotalCount orders the results by the total_count field.
+func ByTotalCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalCount, opts...).ToFunc()
+}
+
+// ByDeletedCount orders the results by the deleted_count field.
+func ByDeletedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedCount, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e State) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *State) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = State(str)
+	if err := StateValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid State", str)
+	}
+	return nil
+}
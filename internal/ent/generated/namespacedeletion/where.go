@@ -0,0 +1,490 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package namespacedeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/x/gidx"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NamespaceID applies equality check predicate on the "namespace_id" field. It's identical to NamespaceIDEQ.
+func NamespaceID(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldNamespaceID, v))
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// TotalCount applies equality check predicate on the "total_count" field. It's identical to TotalCountEQ.
+func TotalCount(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldTotalCount, v))
+}
+
+// DeletedCount applies equality check predicate on the "deleted_count" field. It's identical to DeletedCountEQ.
+func DeletedCount(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldDeletedCount, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NamespaceIDEQ applies the EQ predicate on the "namespace_id" field.
+func NamespaceIDEQ(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldNamespaceID, v))
+}
+
+// NamespaceIDNEQ applies the NEQ predicate on the "namespace_id" field.
+func NamespaceIDNEQ(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNEQ(FieldNamespaceID, v))
+}
+
+// NamespaceIDIn applies the In predicate on the "namespace_id" field.
+func NamespaceIDIn(vs ...gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldIn(FieldNamespaceID, vs...))
+}
+
+// NamespaceIDNotIn applies the NotIn predicate on the "namespace_id" field.
+func NamespaceIDNotIn(vs ...gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNotIn(FieldNamespaceID, vs...))
+}
+
+// NamespaceIDGT applies the GT predicate on the "namespace_id" field.
+func NamespaceIDGT(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGT(FieldNamespaceID, v))
+}
+
+// NamespaceIDGTE applies the GTE predicate on the "namespace_id" field.
+func NamespaceIDGTE(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGTE(FieldNamespaceID, v))
+}
+
+// NamespaceIDLT applies the LT predicate on the "namespace_id" field.
+func NamespaceIDLT(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLT(FieldNamespaceID, v))
+}
+
+// NamespaceIDLTE applies the LTE predicate on the "namespace_id" field.
+func NamespaceIDLTE(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLTE(FieldNamespaceID, v))
+}
+
+// NamespaceIDContains applies the Contains predicate on the "namespace_id" field.
+func NamespaceIDContains(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	vc := string(v)
+	return predicate.NamespaceDeletion(sql.FieldContains(FieldNamespaceID, vc))
+}
+
+// NamespaceIDHasPrefix applies the HasPrefix predicate on the "namespace_id" field.
+func NamespaceIDHasPrefix(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	vc := string(v)
+	return predicate.NamespaceDeletion(sql.FieldHasPrefix(FieldNamespaceID, vc))
+}
+
+// NamespaceIDHasSuffix applies the HasSuffix predicate on the "namespace_id" field.
+func NamespaceIDHasSuffix(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	vc := string(v)
+	return predicate.NamespaceDeletion(sql.FieldHasSuffix(FieldNamespaceID, vc))
+}
+
+// NamespaceIDEqualFold applies the EqualFold predicate on the "namespace_id" field.
+func NamespaceIDEqualFold(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	vc := string(v)
+	return predicate.NamespaceDeletion(sql.FieldEqualFold(FieldNamespaceID, vc))
+}
+
+// NamespaceIDContainsFold applies the ContainsFold predicate on the "namespace_id" field.
+func NamespaceIDContainsFold(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	vc := string(v)
+	return predicate.NamespaceDeletion(sql.FieldContainsFold(FieldNamespaceID, vc))
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldOwnerID, v))
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNEQ(FieldOwnerID, v))
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNotIn(FieldOwnerID, vs...))
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGT(FieldOwnerID, v))
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGTE(FieldOwnerID, v))
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLT(FieldOwnerID, v))
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLTE(FieldOwnerID, v))
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	vc := string(v)
+	return predicate.NamespaceDeletion(sql.FieldContains(FieldOwnerID, vc))
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	vc := string(v)
+	return predicate.NamespaceDeletion(sql.FieldHasPrefix(FieldOwnerID, vc))
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	vc := string(v)
+	return predicate.NamespaceDeletion(sql.FieldHasSuffix(FieldOwnerID, vc))
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	vc := string(v)
+	return predicate.NamespaceDeletion(sql.FieldEqualFold(FieldOwnerID, vc))
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v gidx.PrefixedID) predicate.NamespaceDeletion {
+	vc := string(v)
+	return predicate.NamespaceDeletion(sql.FieldContainsFold(FieldOwnerID, vc))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v State) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v State) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...State) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...State) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNotIn(FieldState, vs...))
+}
+
+// TotalCountEQ applies the EQ predicate on the "total_count" field.
+func TotalCountEQ(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldTotalCount, v))
+}
+
+// TotalCountNEQ applies the NEQ predicate on the "total_count" field.
+func TotalCountNEQ(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNEQ(FieldTotalCount, v))
+}
+
+// TotalCountIn applies the In predicate on the "total_count" field.
+func TotalCountIn(vs ...int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldIn(FieldTotalCount, vs...))
+}
+
+// TotalCountNotIn applies the NotIn predicate on the "total_count" field.
+func TotalCountNotIn(vs ...int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNotIn(FieldTotalCount, vs...))
+}
+
+// TotalCountGT applies the GT predicate on the "total_count" field.
+func TotalCountGT(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGT(FieldTotalCount, v))
+}
+
+// TotalCountGTE applies the GTE predicate on the "total_count" field.
+func TotalCountGTE(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGTE(FieldTotalCount, v))
+}
+
+// TotalCountLT applies the LT predicate on the "total_count" field.
+func TotalCountLT(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLT(FieldTotalCount, v))
+}
+
+// TotalCountLTE applies the LTE predicate on the "total_count" field.
+func TotalCountLTE(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLTE(FieldTotalCount, v))
+}
+
+// DeletedCountEQ applies the EQ predicate on the "deleted_count" field.
+func DeletedCountEQ(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldDeletedCount, v))
+}
+
+// DeletedCountNEQ applies the NEQ predicate on the "deleted_count" field.
+func DeletedCountNEQ(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNEQ(FieldDeletedCount, v))
+}
+
+// DeletedCountIn applies the In predicate on the "deleted_count" field.
+func DeletedCountIn(vs ...int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldIn(FieldDeletedCount, vs...))
+}
+
+// DeletedCountNotIn applies the NotIn predicate on the "deleted_count" field.
+func DeletedCountNotIn(vs ...int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNotIn(FieldDeletedCount, vs...))
+}
+
+// DeletedCountGT applies the GT predicate on the "deleted_count" field.
+func DeletedCountGT(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGT(FieldDeletedCount, v))
+}
+
+// DeletedCountGTE applies the GTE predicate on the "deleted_count" field.
+func DeletedCountGTE(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGTE(FieldDeletedCount, v))
+}
+
+// DeletedCountLT applies the LT predicate on the "deleted_count" field.
+func DeletedCountLT(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLT(FieldDeletedCount, v))
+}
+
+// DeletedCountLTE applies the LTE predicate on the "deleted_count" field.
+func DeletedCountLTE(v int) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLTE(FieldDeletedCount, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NamespaceDeletion) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NamespaceDeletion) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NamespaceDeletion) predicate.NamespaceDeletion {
+	return predicate.NamespaceDeletion(sql.NotPredicates(p))
+}
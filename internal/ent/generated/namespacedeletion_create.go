@@ -0,0 +1,397 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
+	"go.infratographer.com/x/gidx"
+)
+
+// NamespaceDeletionCreate is the builder for creating a NamespaceDeletion entity.
+type NamespaceDeletionCreate struct {
+	config
+	mutation *NamespaceDeletionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ndc *NamespaceDeletionCreate) SetCreatedAt(t time.Time) *NamespaceDeletionCreate {
+	ndc.mutation.SetCreatedAt(t)
+	return ndc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ndc *NamespaceDeletionCreate) SetNillableCreatedAt(t *time.Time) *NamespaceDeletionCreate {
+	if t != nil {
+		ndc.SetCreatedAt(*t)
+	}
+	return ndc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ndc *NamespaceDeletionCreate) SetUpdatedAt(t time.Time) *NamespaceDeletionCreate {
+	ndc.mutation.SetUpdatedAt(t)
+	return ndc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ndc *NamespaceDeletionCreate) SetNillableUpdatedAt(t *time.Time) *NamespaceDeletionCreate {
+	if t != nil {
+		ndc.SetUpdatedAt(*t)
+	}
+	return ndc
+}
+
+// SetNamespaceID sets the "namespace_id" field.
+func (ndc *NamespaceDeletionCreate) SetNamespaceID(gi gidx.PrefixedID) *NamespaceDeletionCreate {
+	ndc.mutation.SetNamespaceID(gi)
+	return ndc
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (ndc *NamespaceDeletionCreate) SetOwnerID(gi gidx.PrefixedID) *NamespaceDeletionCreate {
+	ndc.mutation.SetOwnerID(gi)
+	return ndc
+}
+
+// SetState sets the "state" field.
+func (ndc *NamespaceDeletionCreate) SetState(n namespacedeletion.State) *NamespaceDeletionCreate {
+	ndc.mutation.SetState(n)
+	return ndc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ndc *NamespaceDeletionCreate) SetNillableState(n *namespacedeletion.State) *NamespaceDeletionCreate {
+	if n != nil {
+		ndc.SetState(*n)
+	}
+	return ndc
+}
+
+// SetTotalCount sets the "total_count" field.
+func (ndc *NamespaceDeletionCreate) SetTotalCount(i int) *NamespaceDeletionCreate {
+	ndc.mutation.SetTotalCount(i)
+	return ndc
+}
+
+// SetDeletedCount sets the "deleted_count" field.
+func (ndc *NamespaceDeletionCreate) SetDeletedCount(i int) *NamespaceDeletionCreate {
+	ndc.mutation.SetDeletedCount(i)
+	return ndc
+}
+
+// SetNillableDeletedCount sets the "deleted_count" field if the given value is not nil.
+func (ndc *NamespaceDeletionCreate) SetNillableDeletedCount(i *int) *NamespaceDeletionCreate {
+	if i != nil {
+		ndc.SetDeletedCount(*i)
+	}
+	return ndc
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (ndc *NamespaceDeletionCreate) SetCompletedAt(t time.Time) *NamespaceDeletionCreate {
+	ndc.mutation.SetCompletedAt(t)
+	return ndc
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ndc *NamespaceDeletionCreate) SetNillableCompletedAt(t *time.Time) *NamespaceDeletionCreate {
+	if t != nil {
+		ndc.SetCompletedAt(*t)
+	}
+	return ndc
+}
+
+// SetID sets the "id" field.
+func (ndc *NamespaceDeletionCreate) SetID(gi gidx.PrefixedID) *NamespaceDeletionCreate {
+	ndc.mutation.SetID(gi)
+	return ndc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ndc *NamespaceDeletionCreate) SetNillableID(gi *gidx.PrefixedID) *NamespaceDeletionCreate {
+	if gi != nil {
+		ndc.SetID(*gi)
+	}
+	return ndc
+}
+
+// Mutation returns the NamespaceDeletionMutation object of the builder.
+func (ndc *NamespaceDeletionCreate) Mutation() *NamespaceDeletionMutation {
+	return ndc.mutation
+}
+
+// Save creates the NamespaceDeletion in the database.
+func (ndc *NamespaceDeletionCreate) Save(ctx context.Context) (*NamespaceDeletion, error) {
+	ndc.defaults()
+	return withHooks(ctx, ndc.sqlSave, ndc.mutation, ndc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ndc *NamespaceDeletionCreate) SaveX(ctx context.Context) *NamespaceDeletion {
+	v, err := ndc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ndc *NamespaceDeletionCreate) Exec(ctx context.Context) error {
+	_, err := ndc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndc *NamespaceDeletionCreate) ExecX(ctx context.Context) {
+	if err := ndc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ndc *NamespaceDeletionCreate) defaults() {
+	if _, ok := ndc.mutation.CreatedAt(); !ok {
+		v := namespacedeletion.DefaultCreatedAt()
+		ndc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ndc.mutation.UpdatedAt(); !ok {
+		v := namespacedeletion.DefaultUpdatedAt()
+		ndc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ndc.mutation.State(); !ok {
+		v := namespacedeletion.DefaultState
+		ndc.mutation.SetState(v)
+	}
+	if _, ok := ndc.mutation.DeletedCount(); !ok {
+		v := namespacedeletion.DefaultDeletedCount
+		ndc.mutation.SetDeletedCount(v)
+	}
+	if _, ok := ndc.mutation.ID(); !ok {
+		v := namespacedeletion.DefaultID()
+		ndc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ndc *NamespaceDeletionCreate) check() error {
+	if _, ok := ndc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "NamespaceDeletion.created_at"`)}
+	}
+	if _, ok := ndc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`generated: missing required field "NamespaceDeletion.updated_at"`)}
+	}
+	if _, ok := ndc.mutation.NamespaceID(); !ok {
+		return &ValidationError{Name: "namespace_id", err: errors.New(`generated: missing required field "NamespaceDeletion.namespace_id"`)}
+	}
+	if v, ok := ndc.mutation.NamespaceID(); ok {
+		if err := namespacedeletion.NamespaceIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "namespace_id", err: fmt.Errorf(`generated: validator failed for field "NamespaceDeletion.namespace_id": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`generated: missing required field "NamespaceDeletion.owner_id"`)}
+	}
+	if v, ok := ndc.mutation.OwnerID(); ok {
+		if err := namespacedeletion.OwnerIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`generated: validator failed for field "NamespaceDeletion.owner_id": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`generated: missing required field "NamespaceDeletion.state"`)}
+	}
+	if v, ok := ndc.mutation.State(); ok {
+		if err := namespacedeletion.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`generated: validator failed for field "NamespaceDeletion.state": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.TotalCount(); !ok {
+		return &ValidationError{Name: "total_count", err: errors.New(`generated: missing required field "NamespaceDeletion.total_count"`)}
+	}
+	if v, ok := ndc.mutation.TotalCount(); ok {
+		if err := namespacedeletion.TotalCountValidator(v); err != nil {
+			return &ValidationError{Name: "total_count", err: fmt.Errorf(`generated: validator failed for field "NamespaceDeletion.total_count": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.DeletedCount(); !ok {
+		return &ValidationError{Name: "deleted_count", err: errors.New(`generated: missing required field "NamespaceDeletion.deleted_count"`)}
+	}
+	if v, ok := ndc.mutation.DeletedCount(); ok {
+		if err := namespacedeletion.DeletedCountValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_count", err: fmt.Errorf(`generated: validator failed for field "NamespaceDeletion.deleted_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ndc *NamespaceDeletionCreate) sqlSave(ctx context.Context) (*NamespaceDeletion, error) {
+	if err := ndc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ndc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ndc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*gidx.PrefixedID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ndc.mutation.id = &_node.ID
+	ndc.mutation.done = true
+	return _node, nil
+}
+
+func (ndc *NamespaceDeletionCreate) createSpec() (*NamespaceDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NamespaceDeletion{config: ndc.config}
+		_spec = sqlgraph.NewCreateSpec(namespacedeletion.Table, sqlgraph.NewFieldSpec(namespacedeletion.FieldID, field.TypeString))
+	)
+	if id, ok := ndc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ndc.mutation.CreatedAt(); ok {
+		_spec.SetField(namespacedeletion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ndc.mutation.UpdatedAt(); ok {
+		_spec.SetField(namespacedeletion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ndc.mutation.NamespaceID(); ok {
+		_spec.SetField(namespacedeletion.FieldNamespaceID, field.TypeString, value)
+		_node.NamespaceID = value
+	}
+	if value, ok := ndc.mutation.OwnerID(); ok {
+		_spec.SetField(namespacedeletion.FieldOwnerID, field.TypeString, value)
+		_node.OwnerID = value
+	}
+	if value, ok := ndc.mutation.State(); ok {
+		_spec.SetField(namespacedeletion.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := ndc.mutation.TotalCount(); ok {
+		_spec.SetField(namespacedeletion.FieldTotalCount, field.TypeInt, value)
+		_node.TotalCount = value
+	}
+	if value, ok := ndc.mutation.DeletedCount(); ok {
+		_spec.SetField(namespacedeletion.FieldDeletedCount, field.TypeInt, value)
+		_node.DeletedCount = value
+	}
+	if value, ok := ndc.mutation.CompletedAt(); ok {
+		_spec.SetField(namespacedeletion.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// NamespaceDeletionCreateBulk is the builder for creating many NamespaceDeletion entities in bulk.
+type NamespaceDeletionCreateBulk struct {
+	config
+	err      error
+	builders []*NamespaceDeletionCreate
+}
+
+// Save creates the NamespaceDeletion entities in the database.
+func (ndcb *NamespaceDeletionCreateBulk) Save(ctx context.Context) ([]*NamespaceDeletion, error) {
+	if ndcb.err != nil {
+		return nil, ndcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ndcb.builders))
+	nodes := make([]*NamespaceDeletion, len(ndcb.builders))
+	mutators := make([]Mutator, len(ndcb.builders))
+	for i := range ndcb.builders {
+		func(i int, root context.Context) {
+			builder := ndcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NamespaceDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ndcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ndcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ndcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ndcb *NamespaceDeletionCreateBulk) SaveX(ctx context.Context) []*NamespaceDeletion {
+	v, err := ndcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ndcb *NamespaceDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := ndcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndcb *NamespaceDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := ndcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
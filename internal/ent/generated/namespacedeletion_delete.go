@@ -0,0 +1,102 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+)
+
+// NamespaceDeletionDelete is the builder for deleting a NamespaceDeletion entity.
+type NamespaceDeletionDelete struct {
+	config
+	hooks    []Hook
+	mutation *NamespaceDeletionMutation
+}
+
+// Where appends a list predicates to the NamespaceDeletionDelete builder.
+func (ndd *NamespaceDeletionDelete) Where(ps ...predicate.NamespaceDeletion) *NamespaceDeletionDelete {
+	ndd.mutation.Where(ps...)
+	return ndd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ndd *NamespaceDeletionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ndd.sqlExec, ndd.mutation, ndd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndd *NamespaceDeletionDelete) ExecX(ctx context.Context) int {
+	n, err := ndd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ndd *NamespaceDeletionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(namespacedeletion.Table, sqlgraph.NewFieldSpec(namespacedeletion.FieldID, field.TypeString))
+	if ps := ndd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ndd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ndd.mutation.done = true
+	return affected, err
+}
+
+// NamespaceDeletionDeleteOne is the builder for deleting a single NamespaceDeletion entity.
+type NamespaceDeletionDeleteOne struct {
+	ndd *NamespaceDeletionDelete
+}
+
+// Where appends a list predicates to the NamespaceDeletionDelete builder.
+func (nddo *NamespaceDeletionDeleteOne) Where(ps ...predicate.NamespaceDeletion) *NamespaceDeletionDeleteOne {
+	nddo.ndd.mutation.Where(ps...)
+	return nddo
+}
+
+// Exec executes the deletion query.
+func (nddo *NamespaceDeletionDeleteOne) Exec(ctx context.Context) error {
+	n, err := nddo.ndd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{namespacedeletion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nddo *NamespaceDeletionDeleteOne) ExecX(ctx context.Context) {
+	if err := nddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
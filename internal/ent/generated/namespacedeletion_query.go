@@ -0,0 +1,554 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/x/gidx"
+)
+
+// NamespaceDeletionQuery is the builder for querying NamespaceDeletion entities.
+type NamespaceDeletionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []namespacedeletion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.NamespaceDeletion
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*NamespaceDeletion) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NamespaceDeletionQuery builder.
+func (ndq *NamespaceDeletionQuery) Where(ps ...predicate.NamespaceDeletion) *NamespaceDeletionQuery {
+	ndq.predicates = append(ndq.predicates, ps...)
+	return ndq
+}
+
+// Limit the number of records to be returned by this query.
+func (ndq *NamespaceDeletionQuery) Limit(limit int) *NamespaceDeletionQuery {
+	ndq.ctx.Limit = &limit
+	return ndq
+}
+
+// Offset to start from.
+func (ndq *NamespaceDeletionQuery) Offset(offset int) *NamespaceDeletionQuery {
+	ndq.ctx.Offset = &offset
+	return ndq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ndq *NamespaceDeletionQuery) Unique(unique bool) *NamespaceDeletionQuery {
+	ndq.ctx.Unique = &unique
+	return ndq
+}
+
+// Order specifies how the records should be ordered.
+func (ndq *NamespaceDeletionQuery) Order(o ...namespacedeletion.OrderOption) *NamespaceDeletionQuery {
+	ndq.order = append(ndq.order, o...)
+	return ndq
+}
+
+// First returns the first NamespaceDeletion entity from the query.
+// Returns a *NotFoundError when no NamespaceDeletion was found.
+func (ndq *NamespaceDeletionQuery) First(ctx context.Context) (*NamespaceDeletion, error) {
+	nodes, err := ndq.Limit(1).All(setContextOp(ctx, ndq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{namespacedeletion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ndq *NamespaceDeletionQuery) FirstX(ctx context.Context) *NamespaceDeletion {
+	node, err := ndq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NamespaceDeletion ID from the query.
+// Returns a *NotFoundError when no NamespaceDeletion ID was found.
+func (ndq *NamespaceDeletionQuery) FirstID(ctx context.Context) (id gidx.PrefixedID, err error) {
+	var ids []gidx.PrefixedID
+	if ids, err = ndq.Limit(1).IDs(setContextOp(ctx, ndq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{namespacedeletion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ndq *NamespaceDeletionQuery) FirstIDX(ctx context.Context) gidx.PrefixedID {
+	id, err := ndq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NamespaceDeletion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NamespaceDeletion entity is found.
+// Returns a *NotFoundError when no NamespaceDeletion entities are found.
+func (ndq *NamespaceDeletionQuery) Only(ctx context.Context) (*NamespaceDeletion, error) {
+	nodes, err := ndq.Limit(2).All(setContextOp(ctx, ndq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{namespacedeletion.Label}
+	default:
+		return nil, &NotSingularError{namespacedeletion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ndq *NamespaceDeletionQuery) OnlyX(ctx context.Context) *NamespaceDeletion {
+	node, err := ndq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NamespaceDeletion ID in the query.
+// Returns a *NotSingularError when more than one NamespaceDeletion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ndq *NamespaceDeletionQuery) OnlyID(ctx context.Context) (id gidx.PrefixedID, err error) {
+	var ids []gidx.PrefixedID
+	if ids, err = ndq.Limit(2).IDs(setContextOp(ctx, ndq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{namespacedeletion.Label}
+	default:
+		err = &NotSingularError{namespacedeletion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ndq *NamespaceDeletionQuery) OnlyIDX(ctx context.Context) gidx.PrefixedID {
+	id, err := ndq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NamespaceDeletions.
+func (ndq *NamespaceDeletionQuery) All(ctx context.Context) ([]*NamespaceDeletion, error) {
+	ctx = setContextOp(ctx, ndq.ctx, "All")
+	if err := ndq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NamespaceDeletion, *NamespaceDeletionQuery]()
+	return withInterceptors[[]*NamespaceDeletion](ctx, ndq, qr, ndq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ndq *NamespaceDeletionQuery) AllX(ctx context.Context) []*NamespaceDeletion {
+	nodes, err := ndq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NamespaceDeletion IDs.
+func (ndq *NamespaceDeletionQuery) IDs(ctx context.Context) (ids []gidx.PrefixedID, err error) {
+	if ndq.ctx.Unique == nil && ndq.path != nil {
+		ndq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ndq.ctx, "IDs")
+	if err = ndq.Select(namespacedeletion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ndq *NamespaceDeletionQuery) IDsX(ctx context.Context) []gidx.PrefixedID {
+	ids, err := ndq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ndq *NamespaceDeletionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ndq.ctx, "Count")
+	if err := ndq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ndq, querierCount[*NamespaceDeletionQuery](), ndq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ndq *NamespaceDeletionQuery) CountX(ctx context.Context) int {
+	count, err := ndq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ndq *NamespaceDeletionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ndq.ctx, "Exist")
+	switch _, err := ndq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ndq *NamespaceDeletionQuery) ExistX(ctx context.Context) bool {
+	exist, err := ndq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NamespaceDeletionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ndq *NamespaceDeletionQuery) Clone() *NamespaceDeletionQuery {
+	if ndq == nil {
+		return nil
+	}
+	return &NamespaceDeletionQuery{
+		config:     ndq.config,
+		ctx:        ndq.ctx.Clone(),
+		order:      append([]namespacedeletion.OrderOption{}, ndq.order...),
+		inters:     append([]Interceptor{}, ndq.inters...),
+		predicates: append([]predicate.NamespaceDeletion{}, ndq.predicates...),
+		// clone intermediate query.
+		sql:  ndq.sql.Clone(),
+		path: ndq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NamespaceDeletion.Query().
+//		GroupBy(namespacedeletion.FieldCreatedAt).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (ndq *NamespaceDeletionQuery) GroupBy(field string, fields ...string) *NamespaceDeletionGroupBy {
+	ndq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NamespaceDeletionGroupBy{build: ndq}
+	grbuild.flds = &ndq.ctx.Fields
+	grbuild.label = namespacedeletion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.NamespaceDeletion.Query().
+//		Select(namespacedeletion.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ndq *NamespaceDeletionQuery) Select(fields ...string) *NamespaceDeletionSelect {
+	ndq.ctx.Fields = append(ndq.ctx.Fields, fields...)
+	sbuild := &NamespaceDeletionSelect{NamespaceDeletionQuery: ndq}
+	sbuild.label = namespacedeletion.Label
+	sbuild.flds, sbuild.scan = &ndq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NamespaceDeletionSelect configured with the given aggregations.
+func (ndq *NamespaceDeletionQuery) Aggregate(fns ...AggregateFunc) *NamespaceDeletionSelect {
+	return ndq.Select().Aggregate(fns...)
+}
+
+func (ndq *NamespaceDeletionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ndq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ndq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ndq.ctx.Fields {
+		if !namespacedeletion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if ndq.path != nil {
+		prev, err := ndq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ndq.sql = prev
+	}
+	return nil
+}
+
+func (ndq *NamespaceDeletionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NamespaceDeletion, error) {
+	var (
+		nodes = []*NamespaceDeletion{}
+		_spec = ndq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NamespaceDeletion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NamespaceDeletion{config: ndq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ndq.modifiers) > 0 {
+		_spec.Modifiers = ndq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ndq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range ndq.loadTotal {
+		if err := ndq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ndq *NamespaceDeletionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ndq.querySpec()
+	if len(ndq.modifiers) > 0 {
+		_spec.Modifiers = ndq.modifiers
+	}
+	_spec.Node.Columns = ndq.ctx.Fields
+	if len(ndq.ctx.Fields) > 0 {
+		_spec.Unique = ndq.ctx.Unique != nil && *ndq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ndq.driver, _spec)
+}
+
+func (ndq *NamespaceDeletionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(namespacedeletion.Table, namespacedeletion.Columns, sqlgraph.NewFieldSpec(namespacedeletion.FieldID, field.TypeString))
+	_spec.From = ndq.sql
+	if unique := ndq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ndq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ndq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, namespacedeletion.FieldID)
+		for i := range fields {
+			if fields[i] != namespacedeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ndq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ndq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ndq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ndq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ndq *NamespaceDeletionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ndq.driver.Dialect())
+	t1 := builder.Table(namespacedeletion.Table)
+	columns := ndq.ctx.Fields
+	if len(columns) == 0 {
+		columns = namespacedeletion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ndq.sql != nil {
+		selector = ndq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ndq.ctx.Unique != nil && *ndq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ndq.predicates {
+		p(selector)
+	}
+	for _, p := range ndq.order {
+		p(selector)
+	}
+	if offset := ndq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ndq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NamespaceDeletionGroupBy is the group-by builder for NamespaceDeletion entities.
+type NamespaceDeletionGroupBy struct {
+	selector
+	build *NamespaceDeletionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ndgb *NamespaceDeletionGroupBy) Aggregate(fns ...AggregateFunc) *NamespaceDeletionGroupBy {
+	ndgb.fns = append(ndgb.fns, fns...)
+	return ndgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ndgb *NamespaceDeletionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ndgb.build.ctx, "GroupBy")
+	if err := ndgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NamespaceDeletionQuery, *NamespaceDeletionGroupBy](ctx, ndgb.build, ndgb, ndgb.build.inters, v)
+}
+
+func (ndgb *NamespaceDeletionGroupBy) sqlScan(ctx context.Context, root *NamespaceDeletionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ndgb.fns))
+	for _, fn := range ndgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ndgb.flds)+len(ndgb.fns))
+		for _, f := range *ndgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ndgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ndgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NamespaceDeletionSelect is the builder for selecting fields of NamespaceDeletion entities.
+type NamespaceDeletionSelect struct {
+	*NamespaceDeletionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nds *NamespaceDeletionSelect) Aggregate(fns ...AggregateFunc) *NamespaceDeletionSelect {
+	nds.fns = append(nds.fns, fns...)
+	return nds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nds *NamespaceDeletionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nds.ctx, "Select")
+	if err := nds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NamespaceDeletionQuery, *NamespaceDeletionSelect](ctx, nds.NamespaceDeletionQuery, nds, nds.inters, v)
+}
+
+func (nds *NamespaceDeletionSelect) sqlScan(ctx context.Context, root *NamespaceDeletionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nds.fns))
+	for _, fn := range nds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
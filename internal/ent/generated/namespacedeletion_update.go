@@ -0,0 +1,390 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+)
+
+// NamespaceDeletionUpdate is the builder for updating NamespaceDeletion entities.
+type NamespaceDeletionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NamespaceDeletionMutation
+}
+
+// Where appends a list predicates to the NamespaceDeletionUpdate builder.
+func (ndu *NamespaceDeletionUpdate) Where(ps ...predicate.NamespaceDeletion) *NamespaceDeletionUpdate {
+	ndu.mutation.Where(ps...)
+	return ndu
+}
+
+// SetState sets the "state" field.
+func (ndu *NamespaceDeletionUpdate) SetState(n namespacedeletion.State) *NamespaceDeletionUpdate {
+	ndu.mutation.SetState(n)
+	return ndu
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ndu *NamespaceDeletionUpdate) SetNillableState(n *namespacedeletion.State) *NamespaceDeletionUpdate {
+	if n != nil {
+		ndu.SetState(*n)
+	}
+	return ndu
+}
+
+// SetDeletedCount sets the "deleted_count" field.
+func (ndu *NamespaceDeletionUpdate) SetDeletedCount(i int) *NamespaceDeletionUpdate {
+	ndu.mutation.ResetDeletedCount()
+	ndu.mutation.SetDeletedCount(i)
+	return ndu
+}
+
+// SetNillableDeletedCount sets the "deleted_count" field if the given value is not nil.
+func (ndu *NamespaceDeletionUpdate) SetNillableDeletedCount(i *int) *NamespaceDeletionUpdate {
+	if i != nil {
+		ndu.SetDeletedCount(*i)
+	}
+	return ndu
+}
+
+// AddDeletedCount adds i to the "deleted_count" field.
+func (ndu *NamespaceDeletionUpdate) AddDeletedCount(i int) *NamespaceDeletionUpdate {
+	ndu.mutation.AddDeletedCount(i)
+	return ndu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (ndu *NamespaceDeletionUpdate) SetCompletedAt(t time.Time) *NamespaceDeletionUpdate {
+	ndu.mutation.SetCompletedAt(t)
+	return ndu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (ndu *NamespaceDeletionUpdate) SetNillableCompletedAt(t *time.Time) *NamespaceDeletionUpdate {
+	if t != nil {
+		ndu.SetCompletedAt(*t)
+	}
+	return ndu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (ndu *NamespaceDeletionUpdate) ClearCompletedAt() *NamespaceDeletionUpdate {
+	ndu.mutation.ClearCompletedAt()
+	return ndu
+}
+
+// Mutation returns the NamespaceDeletionMutation object of the builder.
+func (ndu *NamespaceDeletionUpdate) Mutation() *NamespaceDeletionMutation {
+	return ndu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ndu *NamespaceDeletionUpdate) Save(ctx context.Context) (int, error) {
+	ndu.defaults()
+	return withHooks(ctx, ndu.sqlSave, ndu.mutation, ndu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ndu *NamespaceDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ndu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ndu *NamespaceDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := ndu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndu *NamespaceDeletionUpdate) ExecX(ctx context.Context) {
+	if err := ndu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ndu *NamespaceDeletionUpdate) defaults() {
+	if _, ok := ndu.mutation.UpdatedAt(); !ok {
+		v := namespacedeletion.UpdateDefaultUpdatedAt()
+		ndu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ndu *NamespaceDeletionUpdate) check() error {
+	if v, ok := ndu.mutation.State(); ok {
+		if err := namespacedeletion.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`generated: validator failed for field "NamespaceDeletion.state": %w`, err)}
+		}
+	}
+	if v, ok := ndu.mutation.DeletedCount(); ok {
+		if err := namespacedeletion.DeletedCountValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_count", err: fmt.Errorf(`generated: validator failed for field "NamespaceDeletion.deleted_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ndu *NamespaceDeletionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ndu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(namespacedeletion.Table, namespacedeletion.Columns, sqlgraph.NewFieldSpec(namespacedeletion.FieldID, field.TypeString))
+	if ps := ndu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ndu.mutation.UpdatedAt(); ok {
+		_spec.SetField(namespacedeletion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ndu.mutation.State(); ok {
+		_spec.SetField(namespacedeletion.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := ndu.mutation.DeletedCount(); ok {
+		_spec.SetField(namespacedeletion.FieldDeletedCount, field.TypeInt, value)
+	}
+	if value, ok := ndu.mutation.AddedDeletedCount(); ok {
+		_spec.AddField(namespacedeletion.FieldDeletedCount, field.TypeInt, value)
+	}
+	if value, ok := ndu.mutation.CompletedAt(); ok {
+		_spec.SetField(namespacedeletion.FieldCompletedAt, field.TypeTime, value)
+	}
+	if ndu.mutation.CompletedAtCleared() {
+		_spec.ClearField(namespacedeletion.FieldCompletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ndu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{namespacedeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ndu.mutation.done = true
+	return n, nil
+}
+
+// NamespaceDeletionUpdateOne is the builder for updating a single NamespaceDeletion entity.
+type NamespaceDeletionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NamespaceDeletionMutation
+}
+
+// SetState sets the "state" field.
+func (nduo *NamespaceDeletionUpdateOne) SetState(n namespacedeletion.State) *NamespaceDeletionUpdateOne {
+	nduo.mutation.SetState(n)
+	return nduo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (nduo *NamespaceDeletionUpdateOne) SetNillableState(n *namespacedeletion.State) *NamespaceDeletionUpdateOne {
+	if n != nil {
+		nduo.SetState(*n)
+	}
+	return nduo
+}
+
+// SetDeletedCount sets the "deleted_count" field.
+func (nduo *NamespaceDeletionUpdateOne) SetDeletedCount(i int) *NamespaceDeletionUpdateOne {
+	nduo.mutation.ResetDeletedCount()
+	nduo.mutation.SetDeletedCount(i)
+	return nduo
+}
+
+// SetNillableDeletedCount sets the "deleted_count" field if the given value is not nil.
+func (nduo *NamespaceDeletionUpdateOne) SetNillableDeletedCount(i *int) *NamespaceDeletionUpdateOne {
+	if i != nil {
+		nduo.SetDeletedCount(*i)
+	}
+	return nduo
+}
+
+// AddDeletedCount adds i to the "deleted_count" field.
+func (nduo *NamespaceDeletionUpdateOne) AddDeletedCount(i int) *NamespaceDeletionUpdateOne {
+	nduo.mutation.AddDeletedCount(i)
+	return nduo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (nduo *NamespaceDeletionUpdateOne) SetCompletedAt(t time.Time) *NamespaceDeletionUpdateOne {
+	nduo.mutation.SetCompletedAt(t)
+	return nduo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (nduo *NamespaceDeletionUpdateOne) SetNillableCompletedAt(t *time.Time) *NamespaceDeletionUpdateOne {
+	if t != nil {
+		nduo.SetCompletedAt(*t)
+	}
+	return nduo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (nduo *NamespaceDeletionUpdateOne) ClearCompletedAt() *NamespaceDeletionUpdateOne {
+	nduo.mutation.ClearCompletedAt()
+	return nduo
+}
+
+// Mutation returns the NamespaceDeletionMutation object of the builder.
+func (nduo *NamespaceDeletionUpdateOne) Mutation() *NamespaceDeletionMutation {
+	return nduo.mutation
+}
+
+// Where appends a list predicates to the NamespaceDeletionUpdate builder.
+func (nduo *NamespaceDeletionUpdateOne) Where(ps ...predicate.NamespaceDeletion) *NamespaceDeletionUpdateOne {
+	nduo.mutation.Where(ps...)
+	return nduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nduo *NamespaceDeletionUpdateOne) Select(field string, fields ...string) *NamespaceDeletionUpdateOne {
+	nduo.fields = append([]string{field}, fields...)
+	return nduo
+}
+
+// Save executes the query and returns the updated NamespaceDeletion entity.
+func (nduo *NamespaceDeletionUpdateOne) Save(ctx context.Context) (*NamespaceDeletion, error) {
+	nduo.defaults()
+	return withHooks(ctx, nduo.sqlSave, nduo.mutation, nduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nduo *NamespaceDeletionUpdateOne) SaveX(ctx context.Context) *NamespaceDeletion {
+	node, err := nduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nduo *NamespaceDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := nduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nduo *NamespaceDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := nduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nduo *NamespaceDeletionUpdateOne) defaults() {
+	if _, ok := nduo.mutation.UpdatedAt(); !ok {
+		v := namespacedeletion.UpdateDefaultUpdatedAt()
+		nduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nduo *NamespaceDeletionUpdateOne) check() error {
+	if v, ok := nduo.mutation.State(); ok {
+		if err := namespacedeletion.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`generated: validator failed for field "NamespaceDeletion.state": %w`, err)}
+		}
+	}
+	if v, ok := nduo.mutation.DeletedCount(); ok {
+		if err := namespacedeletion.DeletedCountValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_count", err: fmt.Errorf(`generated: validator failed for field "NamespaceDeletion.deleted_count": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (nduo *NamespaceDeletionUpdateOne) sqlSave(ctx context.Context) (_node *NamespaceDeletion, err error) {
+	if err := nduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(namespacedeletion.Table, namespacedeletion.Columns, sqlgraph.NewFieldSpec(namespacedeletion.FieldID, field.TypeString))
+	id, ok := nduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "NamespaceDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, namespacedeletion.FieldID)
+		for _, f := range fields {
+			if !namespacedeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != namespacedeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(namespacedeletion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := nduo.mutation.State(); ok {
+		_spec.SetField(namespacedeletion.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := nduo.mutation.DeletedCount(); ok {
+		_spec.SetField(namespacedeletion.FieldDeletedCount, field.TypeInt, value)
+	}
+	if value, ok := nduo.mutation.AddedDeletedCount(); ok {
+		_spec.AddField(namespacedeletion.FieldDeletedCount, field.TypeInt, value)
+	}
+	if value, ok := nduo.mutation.CompletedAt(); ok {
+		_spec.SetField(namespacedeletion.FieldCompletedAt, field.TypeTime, value)
+	}
+	if nduo.mutation.CompletedAtCleared() {
+		_spec.ClearField(namespacedeletion.FieldCompletedAt, field.TypeTime)
+	}
+	_node = &NamespaceDeletion{config: nduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{namespacedeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nduo.mutation.done = true
+	return _node, nil
+}
@@ -23,14 +23,23 @@ import (
 // Annotation is the predicate function for annotation builders.
 type Annotation func(*sql.Selector)
 
+// AnnotationHistory is the predicate function for annotationhistory builders.
+type AnnotationHistory func(*sql.Selector)
+
 // AnnotationNamespace is the predicate function for annotationnamespace builders.
 type AnnotationNamespace func(*sql.Selector)
 
 // Metadata is the predicate function for metadata builders.
 type Metadata func(*sql.Selector)
 
+// NamespaceDeletion is the predicate function for namespacedeletion builders.
+type NamespaceDeletion func(*sql.Selector)
+
 // Status is the predicate function for status builders.
 type Status func(*sql.Selector)
 
+// StatusHistory is the predicate function for statushistory builders.
+type StatusHistory func(*sql.Selector)
+
 // StatusNamespace is the predicate function for statusnamespace builders.
 type StatusNamespace func(*sql.Selector)
@@ -20,9 +20,12 @@ import (
 	"time"
 
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
 	"go.infratographer.com/metadata-api/internal/ent/schema"
 	"go.infratographer.com/x/gidx"
@@ -51,10 +54,40 @@ func init() {
 	annotationDescAnnotationNamespaceID := annotationFields[2].Descriptor()
 	// annotation.AnnotationNamespaceIDValidator is a validator for the "annotation_namespace_id" field. It is called by the builders before save.
 	annotation.AnnotationNamespaceIDValidator = annotationDescAnnotationNamespaceID.Validators[0].(func(string) error)
+	// annotationDescVersion is the schema descriptor for version field.
+	annotationDescVersion := annotationFields[4].Descriptor()
+	// annotation.DefaultVersion holds the default value on creation for the version field.
+	annotation.DefaultVersion = annotationDescVersion.Default.(int64)
 	// annotationDescID is the schema descriptor for id field.
 	annotationDescID := annotationFields[0].Descriptor()
 	// annotation.DefaultID holds the default value on creation for the id field.
 	annotation.DefaultID = annotationDescID.Default.(func() gidx.PrefixedID)
+	annotationhistoryFields := schema.AnnotationHistory{}.Fields()
+	_ = annotationhistoryFields
+	// annotationhistoryDescCreatedAt is the schema descriptor for created_at field.
+	annotationhistoryDescCreatedAt := annotationhistoryFields[1].Descriptor()
+	// annotationhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	annotationhistory.DefaultCreatedAt = annotationhistoryDescCreatedAt.Default.(func() time.Time)
+	// annotationhistoryDescAnnotationID is the schema descriptor for annotation_id field.
+	annotationhistoryDescAnnotationID := annotationhistoryFields[2].Descriptor()
+	// annotationhistory.AnnotationIDValidator is a validator for the "annotation_id" field. It is called by the builders before save.
+	annotationhistory.AnnotationIDValidator = annotationhistoryDescAnnotationID.Validators[0].(func(string) error)
+	// annotationhistoryDescMetadataID is the schema descriptor for metadata_id field.
+	annotationhistoryDescMetadataID := annotationhistoryFields[3].Descriptor()
+	// annotationhistory.MetadataIDValidator is a validator for the "metadata_id" field. It is called by the builders before save.
+	annotationhistory.MetadataIDValidator = annotationhistoryDescMetadataID.Validators[0].(func(string) error)
+	// annotationhistoryDescAnnotationNamespaceID is the schema descriptor for annotation_namespace_id field.
+	annotationhistoryDescAnnotationNamespaceID := annotationhistoryFields[4].Descriptor()
+	// annotationhistory.AnnotationNamespaceIDValidator is a validator for the "annotation_namespace_id" field. It is called by the builders before save.
+	annotationhistory.AnnotationNamespaceIDValidator = annotationhistoryDescAnnotationNamespaceID.Validators[0].(func(string) error)
+	// annotationhistoryDescAnnotationVersion is the schema descriptor for annotation_version field.
+	annotationhistoryDescAnnotationVersion := annotationhistoryFields[10].Descriptor()
+	// annotationhistory.DefaultAnnotationVersion holds the default value on creation for the annotation_version field.
+	annotationhistory.DefaultAnnotationVersion = annotationhistoryDescAnnotationVersion.Default.(int64)
+	// annotationhistoryDescID is the schema descriptor for id field.
+	annotationhistoryDescID := annotationhistoryFields[0].Descriptor()
+	// annotationhistory.DefaultID holds the default value on creation for the id field.
+	annotationhistory.DefaultID = annotationhistoryDescID.Default.(func() gidx.PrefixedID)
 	annotationnamespaceMixin := schema.AnnotationNamespace{}.Mixin()
 	annotationnamespaceMixinFields0 := annotationnamespaceMixin[0].Fields()
 	_ = annotationnamespaceMixinFields0
@@ -82,6 +115,10 @@ func init() {
 	annotationnamespaceDescPrivate := annotationnamespaceFields[3].Descriptor()
 	// annotationnamespace.DefaultPrivate holds the default value on creation for the private field.
 	annotationnamespace.DefaultPrivate = annotationnamespaceDescPrivate.Default.(bool)
+	// annotationnamespaceDescDeleting is the schema descriptor for deleting field.
+	annotationnamespaceDescDeleting := annotationnamespaceFields[4].Descriptor()
+	// annotationnamespace.DefaultDeleting holds the default value on creation for the deleting field.
+	annotationnamespace.DefaultDeleting = annotationnamespaceDescDeleting.Default.(bool)
 	// annotationnamespaceDescID is the schema descriptor for id field.
 	annotationnamespaceDescID := annotationnamespaceFields[0].Descriptor()
 	// annotationnamespace.DefaultID holds the default value on creation for the id field.
@@ -109,6 +146,43 @@ func init() {
 	metadataDescID := metadataFields[0].Descriptor()
 	// metadata.DefaultID holds the default value on creation for the id field.
 	metadata.DefaultID = metadataDescID.Default.(func() gidx.PrefixedID)
+	namespacedeletionMixin := schema.NamespaceDeletion{}.Mixin()
+	namespacedeletionMixinFields0 := namespacedeletionMixin[0].Fields()
+	_ = namespacedeletionMixinFields0
+	namespacedeletionFields := schema.NamespaceDeletion{}.Fields()
+	_ = namespacedeletionFields
+	// namespacedeletionDescCreatedAt is the schema descriptor for created_at field.
+	namespacedeletionDescCreatedAt := namespacedeletionMixinFields0[0].Descriptor()
+	// namespacedeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	namespacedeletion.DefaultCreatedAt = namespacedeletionDescCreatedAt.Default.(func() time.Time)
+	// namespacedeletionDescUpdatedAt is the schema descriptor for updated_at field.
+	namespacedeletionDescUpdatedAt := namespacedeletionMixinFields0[1].Descriptor()
+	// namespacedeletion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	namespacedeletion.DefaultUpdatedAt = namespacedeletionDescUpdatedAt.Default.(func() time.Time)
+	// namespacedeletion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	namespacedeletion.UpdateDefaultUpdatedAt = namespacedeletionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// namespacedeletionDescNamespaceID is the schema descriptor for namespace_id field.
+	namespacedeletionDescNamespaceID := namespacedeletionFields[1].Descriptor()
+	// namespacedeletion.NamespaceIDValidator is a validator for the "namespace_id" field. It is called by the builders before save.
+	namespacedeletion.NamespaceIDValidator = namespacedeletionDescNamespaceID.Validators[0].(func(string) error)
+	// namespacedeletionDescOwnerID is the schema descriptor for owner_id field.
+	namespacedeletionDescOwnerID := namespacedeletionFields[2].Descriptor()
+	// namespacedeletion.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	namespacedeletion.OwnerIDValidator = namespacedeletionDescOwnerID.Validators[0].(func(string) error)
+	// namespacedeletionDescTotalCount is the schema descriptor for total_count field.
+	namespacedeletionDescTotalCount := namespacedeletionFields[4].Descriptor()
+	// namespacedeletion.TotalCountValidator is a validator for the "total_count" field. It is called by the builders before save.
+	namespacedeletion.TotalCountValidator = namespacedeletionDescTotalCount.Validators[0].(func(int) error)
+	// namespacedeletionDescDeletedCount is the schema descriptor for deleted_count field.
+	namespacedeletionDescDeletedCount := namespacedeletionFields[5].Descriptor()
+	// namespacedeletion.DefaultDeletedCount holds the default value on creation for the deleted_count field.
+	namespacedeletion.DefaultDeletedCount = namespacedeletionDescDeletedCount.Default.(int)
+	// namespacedeletion.DeletedCountValidator is a validator for the "deleted_count" field. It is called by the builders before save.
+	namespacedeletion.DeletedCountValidator = namespacedeletionDescDeletedCount.Validators[0].(func(int) error)
+	// namespacedeletionDescID is the schema descriptor for id field.
+	namespacedeletionDescID := namespacedeletionFields[0].Descriptor()
+	// namespacedeletion.DefaultID holds the default value on creation for the id field.
+	namespacedeletion.DefaultID = namespacedeletionDescID.Default.(func() gidx.PrefixedID)
 	statusMixin := schema.Status{}.Mixin()
 	statusMixinFields0 := statusMixin[0].Fields()
 	_ = statusMixinFields0
@@ -136,10 +210,44 @@ func init() {
 	statusDescSource := statusFields[3].Descriptor()
 	// status.SourceValidator is a validator for the "source" field. It is called by the builders before save.
 	status.SourceValidator = statusDescSource.Validators[0].(func(string) error)
+	// statusDescVersion is the schema descriptor for version field.
+	statusDescVersion := statusFields[5].Descriptor()
+	// status.DefaultVersion holds the default value on creation for the version field.
+	status.DefaultVersion = statusDescVersion.Default.(int64)
 	// statusDescID is the schema descriptor for id field.
 	statusDescID := statusFields[0].Descriptor()
 	// status.DefaultID holds the default value on creation for the id field.
 	status.DefaultID = statusDescID.Default.(func() gidx.PrefixedID)
+	statushistoryFields := schema.StatusHistory{}.Fields()
+	_ = statushistoryFields
+	// statushistoryDescCreatedAt is the schema descriptor for created_at field.
+	statushistoryDescCreatedAt := statushistoryFields[1].Descriptor()
+	// statushistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	statushistory.DefaultCreatedAt = statushistoryDescCreatedAt.Default.(func() time.Time)
+	// statushistoryDescStatusID is the schema descriptor for status_id field.
+	statushistoryDescStatusID := statushistoryFields[2].Descriptor()
+	// statushistory.StatusIDValidator is a validator for the "status_id" field. It is called by the builders before save.
+	statushistory.StatusIDValidator = statushistoryDescStatusID.Validators[0].(func(string) error)
+	// statushistoryDescMetadataID is the schema descriptor for metadata_id field.
+	statushistoryDescMetadataID := statushistoryFields[3].Descriptor()
+	// statushistory.MetadataIDValidator is a validator for the "metadata_id" field. It is called by the builders before save.
+	statushistory.MetadataIDValidator = statushistoryDescMetadataID.Validators[0].(func(string) error)
+	// statushistoryDescStatusNamespaceID is the schema descriptor for status_namespace_id field.
+	statushistoryDescStatusNamespaceID := statushistoryFields[4].Descriptor()
+	// statushistory.StatusNamespaceIDValidator is a validator for the "status_namespace_id" field. It is called by the builders before save.
+	statushistory.StatusNamespaceIDValidator = statushistoryDescStatusNamespaceID.Validators[0].(func(string) error)
+	// statushistoryDescSource is the schema descriptor for source field.
+	statushistoryDescSource := statushistoryFields[5].Descriptor()
+	// statushistory.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	statushistory.SourceValidator = statushistoryDescSource.Validators[0].(func(string) error)
+	// statushistoryDescStatusVersion is the schema descriptor for status_version field.
+	statushistoryDescStatusVersion := statushistoryFields[12].Descriptor()
+	// statushistory.DefaultStatusVersion holds the default value on creation for the status_version field.
+	statushistory.DefaultStatusVersion = statushistoryDescStatusVersion.Default.(int64)
+	// statushistoryDescID is the schema descriptor for id field.
+	statushistoryDescID := statushistoryFields[0].Descriptor()
+	// statushistory.DefaultID holds the default value on creation for the id field.
+	statushistory.DefaultID = statushistoryDescID.Default.(func() gidx.PrefixedID)
 	statusnamespaceMixin := schema.StatusNamespace{}.Mixin()
 	statusnamespaceMixinFields0 := statusnamespaceMixin[0].Fields()
 	_ = statusnamespaceMixinFields0
@@ -167,6 +275,14 @@ func init() {
 	statusnamespaceDescPrivate := statusnamespaceFields[3].Descriptor()
 	// statusnamespace.DefaultPrivate holds the default value on creation for the private field.
 	statusnamespace.DefaultPrivate = statusnamespaceDescPrivate.Default.(bool)
+	// statusnamespaceDescDeleting is the schema descriptor for deleting field.
+	statusnamespaceDescDeleting := statusnamespaceFields[4].Descriptor()
+	// statusnamespace.DefaultDeleting holds the default value on creation for the deleting field.
+	statusnamespace.DefaultDeleting = statusnamespaceDescDeleting.Default.(bool)
+	// statusnamespaceDescDefaultTTL is the schema descriptor for default_ttl field.
+	statusnamespaceDescDefaultTTL := statusnamespaceFields[6].Descriptor()
+	// statusnamespace.DefaultTTLValidator is a validator for the "default_ttl" field. It is called by the builders before save.
+	statusnamespace.DefaultTTLValidator = statusnamespaceDescDefaultTTL.Validators[0].(func(int64) error)
 	// statusnamespaceDescID is the schema descriptor for id field.
 	statusnamespaceDescID := statusnamespaceFields[0].Descriptor()
 	// statusnamespace.DefaultID holds the default value on creation for the id field.
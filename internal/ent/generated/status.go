@@ -47,6 +47,10 @@ type Status struct {
 	Source string `json:"source,omitempty"`
 	// JSON formatted data of this annotation.
 	Data json.RawMessage `json:"data,omitempty"`
+	// Version of the status, incremented each time its data is set.
+	Version int64 `json:"version,omitempty"`
+	// Time the status expires. Expired statuses are hidden and eventually deleted.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the StatusQuery when eager-loading is set.
 	Edges        StatusEdges `json:"edges"`
@@ -101,9 +105,11 @@ func (*Status) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case status.FieldID, status.FieldMetadataID, status.FieldStatusNamespaceID:
 			values[i] = new(gidx.PrefixedID)
+		case status.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case status.FieldSource:
 			values[i] = new(sql.NullString)
-		case status.FieldCreatedAt, status.FieldUpdatedAt:
+		case status.FieldCreatedAt, status.FieldUpdatedAt, status.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -164,6 +170,19 @@ func (s *Status) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field data: %w", err)
 				}
 			}
+		case status.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				s.Version = value.Int64
+			}
+		case status.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				s.ExpiresAt = new(time.Time)
+				*s.ExpiresAt = value.Time
+			}
 		default:
 			s.selectValues.Set(columns[i], values[i])
 		}
@@ -227,6 +246,14 @@ func (s *Status) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("data=")
 	builder.WriteString(fmt.Sprintf("%v", s.Data))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", s.Version))
+	builder.WriteString(", ")
+	if v := s.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
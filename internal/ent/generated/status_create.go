@@ -90,6 +90,34 @@ func (sc *StatusCreate) SetData(jm json.RawMessage) *StatusCreate {
 	return sc
 }
 
+// SetVersion sets the "version" field.
+func (sc *StatusCreate) SetVersion(i int64) *StatusCreate {
+	sc.mutation.SetVersion(i)
+	return sc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (sc *StatusCreate) SetNillableVersion(i *int64) *StatusCreate {
+	if i != nil {
+		sc.SetVersion(*i)
+	}
+	return sc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sc *StatusCreate) SetExpiresAt(t time.Time) *StatusCreate {
+	sc.mutation.SetExpiresAt(t)
+	return sc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sc *StatusCreate) SetNillableExpiresAt(t *time.Time) *StatusCreate {
+	if t != nil {
+		sc.SetExpiresAt(*t)
+	}
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *StatusCreate) SetID(gi gidx.PrefixedID) *StatusCreate {
 	sc.mutation.SetID(gi)
@@ -163,6 +191,10 @@ func (sc *StatusCreate) defaults() {
 		v := status.DefaultUpdatedAt()
 		sc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := sc.mutation.Version(); !ok {
+		v := status.DefaultVersion
+		sc.mutation.SetVersion(v)
+	}
 	if _, ok := sc.mutation.ID(); !ok {
 		v := status.DefaultID()
 		sc.mutation.SetID(v)
@@ -204,6 +236,9 @@ func (sc *StatusCreate) check() error {
 	if _, ok := sc.mutation.Data(); !ok {
 		return &ValidationError{Name: "data", err: errors.New(`generated: missing required field "Status.data"`)}
 	}
+	if _, ok := sc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`generated: missing required field "Status.version"`)}
+	}
 	if _, ok := sc.mutation.NamespaceID(); !ok {
 		return &ValidationError{Name: "namespace", err: errors.New(`generated: missing required edge "Status.namespace"`)}
 	}
@@ -261,6 +296,14 @@ func (sc *StatusCreate) createSpec() (*Status, *sqlgraph.CreateSpec) {
 		_spec.SetField(status.FieldData, field.TypeJSON, value)
 		_node.Data = value
 	}
+	if value, ok := sc.mutation.Version(); ok {
+		_spec.SetField(status.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
+	if value, ok := sc.mutation.ExpiresAt(); ok {
+		_spec.SetField(status.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
 	if nodes := sc.mutation.NamespaceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -55,6 +56,47 @@ func (su *StatusUpdate) AppendData(jm json.RawMessage) *StatusUpdate {
 	return su
 }
 
+// SetVersion sets the "version" field.
+func (su *StatusUpdate) SetVersion(i int64) *StatusUpdate {
+	su.mutation.ResetVersion()
+	su.mutation.SetVersion(i)
+	return su
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (su *StatusUpdate) SetNillableVersion(i *int64) *StatusUpdate {
+	if i != nil {
+		su.SetVersion(*i)
+	}
+	return su
+}
+
+// AddVersion adds i to the "version" field.
+func (su *StatusUpdate) AddVersion(i int64) *StatusUpdate {
+	su.mutation.AddVersion(i)
+	return su
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (su *StatusUpdate) SetExpiresAt(t time.Time) *StatusUpdate {
+	su.mutation.SetExpiresAt(t)
+	return su
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (su *StatusUpdate) SetNillableExpiresAt(t *time.Time) *StatusUpdate {
+	if t != nil {
+		su.SetExpiresAt(*t)
+	}
+	return su
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (su *StatusUpdate) ClearExpiresAt() *StatusUpdate {
+	su.mutation.ClearExpiresAt()
+	return su
+}
+
 // Mutation returns the StatusMutation object of the builder.
 func (su *StatusUpdate) Mutation() *StatusMutation {
 	return su.mutation
@@ -130,6 +172,18 @@ func (su *StatusUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, status.FieldData, value)
 		})
 	}
+	if value, ok := su.mutation.Version(); ok {
+		_spec.SetField(status.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.AddedVersion(); ok {
+		_spec.AddField(status.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := su.mutation.ExpiresAt(); ok {
+		_spec.SetField(status.FieldExpiresAt, field.TypeTime, value)
+	}
+	if su.mutation.ExpiresAtCleared() {
+		_spec.ClearField(status.FieldExpiresAt, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{status.Label}
@@ -162,6 +216,47 @@ func (suo *StatusUpdateOne) AppendData(jm json.RawMessage) *StatusUpdateOne {
 	return suo
 }
 
+// SetVersion sets the "version" field.
+func (suo *StatusUpdateOne) SetVersion(i int64) *StatusUpdateOne {
+	suo.mutation.ResetVersion()
+	suo.mutation.SetVersion(i)
+	return suo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (suo *StatusUpdateOne) SetNillableVersion(i *int64) *StatusUpdateOne {
+	if i != nil {
+		suo.SetVersion(*i)
+	}
+	return suo
+}
+
+// AddVersion adds i to the "version" field.
+func (suo *StatusUpdateOne) AddVersion(i int64) *StatusUpdateOne {
+	suo.mutation.AddVersion(i)
+	return suo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (suo *StatusUpdateOne) SetExpiresAt(t time.Time) *StatusUpdateOne {
+	suo.mutation.SetExpiresAt(t)
+	return suo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (suo *StatusUpdateOne) SetNillableExpiresAt(t *time.Time) *StatusUpdateOne {
+	if t != nil {
+		suo.SetExpiresAt(*t)
+	}
+	return suo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (suo *StatusUpdateOne) ClearExpiresAt() *StatusUpdateOne {
+	suo.mutation.ClearExpiresAt()
+	return suo
+}
+
 // Mutation returns the StatusMutation object of the builder.
 func (suo *StatusUpdateOne) Mutation() *StatusMutation {
 	return suo.mutation
@@ -267,6 +362,18 @@ func (suo *StatusUpdateOne) sqlSave(ctx context.Context) (_node *Status, err err
 			sqljson.Append(u, status.FieldData, value)
 		})
 	}
+	if value, ok := suo.mutation.Version(); ok {
+		_spec.SetField(status.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.AddedVersion(); ok {
+		_spec.AddField(status.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := suo.mutation.ExpiresAt(); ok {
+		_spec.SetField(status.FieldExpiresAt, field.TypeTime, value)
+	}
+	if suo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(status.FieldExpiresAt, field.TypeTime)
+	}
 	_node = &Status{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,261 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
+	"go.infratographer.com/x/gidx"
+)
+
+// StatusHistory is the model entity for the StatusHistory schema.
+type StatusHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID gidx.PrefixedID `json:"id,omitempty"`
+	// Time the change to the status was made.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ID of the status that was changed.
+	StatusID gidx.PrefixedID `json:"status_id,omitempty"`
+	// ID of the metadata of the status.
+	MetadataID gidx.PrefixedID `json:"metadata_id,omitempty"`
+	// ID of the status namespace of the status.
+	StatusNamespaceID gidx.PrefixedID `json:"status_namespace_id,omitempty"`
+	// Source of the status.
+	Source string `json:"source,omitempty"`
+	// The kind of change made to the status.
+	Operation statushistory.Operation `json:"operation,omitempty"`
+	// JSON formatted data of the status before the change. Empty when the status was created.
+	Data json.RawMessage `json:"data,omitempty"`
+	// The subject that made the change, if known.
+	Actor string `json:"actor,omitempty"`
+	// Time the status was created. Empty when the status was created by this change.
+	StatusCreatedAt *time.Time `json:"status_created_at,omitempty"`
+	// Time the status was last updated before this change. Empty when the status was created by this change.
+	StatusUpdatedAt *time.Time `json:"status_updated_at,omitempty"`
+	// Time the status expired at before this change, if it had an expiry.
+	StatusExpiresAt *time.Time `json:"status_expires_at,omitempty"`
+	// Version of the status before this change.
+	StatusVersion int64 `json:"status_version,omitempty"`
+	selectValues  sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StatusHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case statushistory.FieldData:
+			values[i] = new([]byte)
+		case statushistory.FieldID, statushistory.FieldStatusID, statushistory.FieldMetadataID, statushistory.FieldStatusNamespaceID:
+			values[i] = new(gidx.PrefixedID)
+		case statushistory.FieldStatusVersion:
+			values[i] = new(sql.NullInt64)
+		case statushistory.FieldSource, statushistory.FieldOperation, statushistory.FieldActor:
+			values[i] = new(sql.NullString)
+		case statushistory.FieldCreatedAt, statushistory.FieldStatusCreatedAt, statushistory.FieldStatusUpdatedAt, statushistory.FieldStatusExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StatusHistory fields.
+func (sh *StatusHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case statushistory.FieldID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sh.ID = *value
+			}
+		case statushistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sh.CreatedAt = value.Time
+			}
+		case statushistory.FieldStatusID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field status_id", values[i])
+			} else if value != nil {
+				sh.StatusID = *value
+			}
+		case statushistory.FieldMetadataID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata_id", values[i])
+			} else if value != nil {
+				sh.MetadataID = *value
+			}
+		case statushistory.FieldStatusNamespaceID:
+			if value, ok := values[i].(*gidx.PrefixedID); !ok {
+				return fmt.Errorf("unexpected type %T for field status_namespace_id", values[i])
+			} else if value != nil {
+				sh.StatusNamespaceID = *value
+			}
+		case statushistory.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				sh.Source = value.String
+			}
+		case statushistory.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				sh.Operation = statushistory.Operation(value.String)
+			}
+		case statushistory.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sh.Data); err != nil {
+					return fmt.Errorf("unmarshal field data: %w", err)
+				}
+			}
+		case statushistory.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				sh.Actor = value.String
+			}
+		case statushistory.FieldStatusCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field status_created_at", values[i])
+			} else if value.Valid {
+				sh.StatusCreatedAt = new(time.Time)
+				*sh.StatusCreatedAt = value.Time
+			}
+		case statushistory.FieldStatusUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field status_updated_at", values[i])
+			} else if value.Valid {
+				sh.StatusUpdatedAt = new(time.Time)
+				*sh.StatusUpdatedAt = value.Time
+			}
+		case statushistory.FieldStatusExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field status_expires_at", values[i])
+			} else if value.Valid {
+				sh.StatusExpiresAt = new(time.Time)
+				*sh.StatusExpiresAt = value.Time
+			}
+		case statushistory.FieldStatusVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_version", values[i])
+			} else if value.Valid {
+				sh.StatusVersion = value.Int64
+			}
+		default:
+			sh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StatusHistory.
+// This includes values selected through modifiers, order, etc.
+func (sh *StatusHistory) Value(name string) (ent.Value, error) {
+	return sh.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StatusHistory.
+// Note that you need to call StatusHistory.Unwrap() before calling this method if this StatusHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sh *StatusHistory) Update() *StatusHistoryUpdateOne {
+	return NewStatusHistoryClient(sh.config).UpdateOne(sh)
+}
+
+// Unwrap unwraps the StatusHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sh *StatusHistory) Unwrap() *StatusHistory {
+	_tx, ok := sh.config.driver.(*txDriver)
+	if !ok {
+		panic("generated: StatusHistory is not a transactional entity")
+	}
+	sh.config.driver = _tx.drv
+	return sh
+}
+
+// String implements the fmt.Stringer.
+func (sh *StatusHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("StatusHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sh.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sh.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status_id=")
+	builder.WriteString(fmt.Sprintf("%v", sh.StatusID))
+	builder.WriteString(", ")
+	builder.WriteString("metadata_id=")
+	builder.WriteString(fmt.Sprintf("%v", sh.MetadataID))
+	builder.WriteString(", ")
+	builder.WriteString("status_namespace_id=")
+	builder.WriteString(fmt.Sprintf("%v", sh.StatusNamespaceID))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(sh.Source)
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", sh.Operation))
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", sh.Data))
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(sh.Actor)
+	builder.WriteString(", ")
+	if v := sh.StatusCreatedAt; v != nil {
+		builder.WriteString("status_created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sh.StatusUpdatedAt; v != nil {
+		builder.WriteString("status_updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sh.StatusExpiresAt; v != nil {
+		builder.WriteString("status_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status_version=")
+	builder.WriteString(fmt.Sprintf("%v", sh.StatusVersion))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IsEntity implement fedruntime.Entity
+func (sh StatusHistory) IsEntity() {}
+
+// StatusHistories is a parsable slice of StatusHistory.
+type StatusHistories []*StatusHistory
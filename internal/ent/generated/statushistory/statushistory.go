@@ -0,0 +1,209 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package statushistory
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/x/gidx"
+)
+
+const (
+	// Label holds the string label denoting the statushistory type in the database.
+	Label = "status_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldStatusID holds the string denoting the status_id field in the database.
+	FieldStatusID = "status_id"
+	// FieldMetadataID holds the string denoting the metadata_id field in the database.
+	FieldMetadataID = "metadata_id"
+	// FieldStatusNamespaceID holds the string denoting the status_namespace_id field in the database.
+	FieldStatusNamespaceID = "status_namespace_id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldOperation holds the string denoting the operation field in the database.
+	FieldOperation = "operation"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "json_data"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldStatusCreatedAt holds the string denoting the status_created_at field in the database.
+	FieldStatusCreatedAt = "status_created_at"
+	// FieldStatusUpdatedAt holds the string denoting the status_updated_at field in the database.
+	FieldStatusUpdatedAt = "status_updated_at"
+	// FieldStatusExpiresAt holds the string denoting the status_expires_at field in the database.
+	FieldStatusExpiresAt = "status_expires_at"
+	// FieldStatusVersion holds the string denoting the status_version field in the database.
+	FieldStatusVersion = "status_version"
+	// Table holds the table name of the statushistory in the database.
+	Table = "status_histories"
+)
+
+// Columns holds all SQL columns for statushistory fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldStatusID,
+	FieldMetadataID,
+	FieldStatusNamespaceID,
+	FieldSource,
+	FieldOperation,
+	FieldData,
+	FieldActor,
+	FieldStatusCreatedAt,
+	FieldStatusUpdatedAt,
+	FieldStatusExpiresAt,
+	FieldStatusVersion,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// StatusIDValidator is a validator for the "status_id" field. It is called by the builders before save.
+	StatusIDValidator func(string) error
+	// MetadataIDValidator is a validator for the "metadata_id" field. It is called by the builders before save.
+	MetadataIDValidator func(string) error
+	// StatusNamespaceIDValidator is a validator for the "status_namespace_id" field. It is called by the builders before save.
+	StatusNamespaceIDValidator func(string) error
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// DefaultStatusVersion holds the default value on creation for the "status_version" field.
+	DefaultStatusVersion int64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() gidx.PrefixedID
+)
+
+// Operation defines the type for the "operation" enum field.
+type Operation string
+
+// Operation values.
+const (
+	OperationCREATE Operation = "CREATE"
+	OperationUPDATE Operation = "UPDATE"
+	OperationDELETE Operation = "DELETE"
+)
+
+func (o Operation) String() string {
+	return string(o)
+}
+
+// OperationValidator is a validator for the "operation" field enum values. It is called by the builders before save.
+func OperationValidator(o Operation) error {
+	switch o {
+	case OperationCREATE, OperationUPDATE, OperationDELETE:
+		return nil
+	default:
+		return fmt.Errorf("statushistory: invalid enum value for operation field: %q", o)
+	}
+}
+
+// OrderOption defines the ordering options for the StatusHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByStatusID orders the results by the status_id field.
+func ByStatusID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusID, opts...).ToFunc()
+}
+
+// ByMetadataID orders the results by the metadata_id field.
+func ByMetadataID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetadataID, opts...).ToFunc()
+}
+
+// ByStatusNamespaceID orders the results by the status_namespace_id field.
+func ByStatusNamespaceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusNamespaceID, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByOperation orders the results by the operation field.
+func ByOperation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperation, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// ByStatusCreatedAt orders the results by the status_created_at field.
+func ByStatusCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusCreatedAt, opts...).ToFunc()
+}
+
+// ByStatusUpdatedAt orders the results by the status_updated_at field.
+func ByStatusUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusUpdatedAt, opts...).ToFunc()
+}
+
+// ByStatusExpiresAt orders the results by the status_expires_at field.
+func ByStatusExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusExpiresAt, opts...).ToFunc()
+}
+
+// ByStatusVersion orders the results by the status_version field.
+func ByStatusVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusVersion, opts...).ToFunc()
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Operation) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Operation) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Operation(str)
+	if err := OperationValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Operation", str)
+	}
+	return nil
+}
@@ -0,0 +1,745 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package statushistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/x/gidx"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// StatusID applies equality check predicate on the "status_id" field. It's identical to StatusIDEQ.
+func StatusID(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusID, v))
+}
+
+// MetadataID applies equality check predicate on the "metadata_id" field. It's identical to MetadataIDEQ.
+func MetadataID(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldMetadataID, v))
+}
+
+// StatusNamespaceID applies equality check predicate on the "status_namespace_id" field. It's identical to StatusNamespaceIDEQ.
+func StatusNamespaceID(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusNamespaceID, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldSource, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// StatusCreatedAt applies equality check predicate on the "status_created_at" field. It's identical to StatusCreatedAtEQ.
+func StatusCreatedAt(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusCreatedAt, v))
+}
+
+// StatusUpdatedAt applies equality check predicate on the "status_updated_at" field. It's identical to StatusUpdatedAtEQ.
+func StatusUpdatedAt(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusUpdatedAt, v))
+}
+
+// StatusExpiresAt applies equality check predicate on the "status_expires_at" field. It's identical to StatusExpiresAtEQ.
+func StatusExpiresAt(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusExpiresAt, v))
+}
+
+// StatusVersion applies equality check predicate on the "status_version" field. It's identical to StatusVersionEQ.
+func StatusVersion(v int64) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusVersion, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// StatusIDEQ applies the EQ predicate on the "status_id" field.
+func StatusIDEQ(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusID, v))
+}
+
+// StatusIDNEQ applies the NEQ predicate on the "status_id" field.
+func StatusIDNEQ(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldStatusID, v))
+}
+
+// StatusIDIn applies the In predicate on the "status_id" field.
+func StatusIDIn(vs ...gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldStatusID, vs...))
+}
+
+// StatusIDNotIn applies the NotIn predicate on the "status_id" field.
+func StatusIDNotIn(vs ...gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldStatusID, vs...))
+}
+
+// StatusIDGT applies the GT predicate on the "status_id" field.
+func StatusIDGT(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldStatusID, v))
+}
+
+// StatusIDGTE applies the GTE predicate on the "status_id" field.
+func StatusIDGTE(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldStatusID, v))
+}
+
+// StatusIDLT applies the LT predicate on the "status_id" field.
+func StatusIDLT(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldStatusID, v))
+}
+
+// StatusIDLTE applies the LTE predicate on the "status_id" field.
+func StatusIDLTE(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldStatusID, v))
+}
+
+// StatusIDContains applies the Contains predicate on the "status_id" field.
+func StatusIDContains(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldContains(FieldStatusID, vc))
+}
+
+// StatusIDHasPrefix applies the HasPrefix predicate on the "status_id" field.
+func StatusIDHasPrefix(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldHasPrefix(FieldStatusID, vc))
+}
+
+// StatusIDHasSuffix applies the HasSuffix predicate on the "status_id" field.
+func StatusIDHasSuffix(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldHasSuffix(FieldStatusID, vc))
+}
+
+// StatusIDEqualFold applies the EqualFold predicate on the "status_id" field.
+func StatusIDEqualFold(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldEqualFold(FieldStatusID, vc))
+}
+
+// StatusIDContainsFold applies the ContainsFold predicate on the "status_id" field.
+func StatusIDContainsFold(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldContainsFold(FieldStatusID, vc))
+}
+
+// MetadataIDEQ applies the EQ predicate on the "metadata_id" field.
+func MetadataIDEQ(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldMetadataID, v))
+}
+
+// MetadataIDNEQ applies the NEQ predicate on the "metadata_id" field.
+func MetadataIDNEQ(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldMetadataID, v))
+}
+
+// MetadataIDIn applies the In predicate on the "metadata_id" field.
+func MetadataIDIn(vs ...gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldMetadataID, vs...))
+}
+
+// MetadataIDNotIn applies the NotIn predicate on the "metadata_id" field.
+func MetadataIDNotIn(vs ...gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldMetadataID, vs...))
+}
+
+// MetadataIDGT applies the GT predicate on the "metadata_id" field.
+func MetadataIDGT(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldMetadataID, v))
+}
+
+// MetadataIDGTE applies the GTE predicate on the "metadata_id" field.
+func MetadataIDGTE(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldMetadataID, v))
+}
+
+// MetadataIDLT applies the LT predicate on the "metadata_id" field.
+func MetadataIDLT(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldMetadataID, v))
+}
+
+// MetadataIDLTE applies the LTE predicate on the "metadata_id" field.
+func MetadataIDLTE(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldMetadataID, v))
+}
+
+// MetadataIDContains applies the Contains predicate on the "metadata_id" field.
+func MetadataIDContains(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldContains(FieldMetadataID, vc))
+}
+
+// MetadataIDHasPrefix applies the HasPrefix predicate on the "metadata_id" field.
+func MetadataIDHasPrefix(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldHasPrefix(FieldMetadataID, vc))
+}
+
+// MetadataIDHasSuffix applies the HasSuffix predicate on the "metadata_id" field.
+func MetadataIDHasSuffix(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldHasSuffix(FieldMetadataID, vc))
+}
+
+// MetadataIDEqualFold applies the EqualFold predicate on the "metadata_id" field.
+func MetadataIDEqualFold(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldEqualFold(FieldMetadataID, vc))
+}
+
+// MetadataIDContainsFold applies the ContainsFold predicate on the "metadata_id" field.
+func MetadataIDContainsFold(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldContainsFold(FieldMetadataID, vc))
+}
+
+// StatusNamespaceIDEQ applies the EQ predicate on the "status_namespace_id" field.
+func StatusNamespaceIDEQ(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusNamespaceID, v))
+}
+
+// StatusNamespaceIDNEQ applies the NEQ predicate on the "status_namespace_id" field.
+func StatusNamespaceIDNEQ(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldStatusNamespaceID, v))
+}
+
+// StatusNamespaceIDIn applies the In predicate on the "status_namespace_id" field.
+func StatusNamespaceIDIn(vs ...gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldStatusNamespaceID, vs...))
+}
+
+// StatusNamespaceIDNotIn applies the NotIn predicate on the "status_namespace_id" field.
+func StatusNamespaceIDNotIn(vs ...gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldStatusNamespaceID, vs...))
+}
+
+// StatusNamespaceIDGT applies the GT predicate on the "status_namespace_id" field.
+func StatusNamespaceIDGT(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldStatusNamespaceID, v))
+}
+
+// StatusNamespaceIDGTE applies the GTE predicate on the "status_namespace_id" field.
+func StatusNamespaceIDGTE(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldStatusNamespaceID, v))
+}
+
+// StatusNamespaceIDLT applies the LT predicate on the "status_namespace_id" field.
+func StatusNamespaceIDLT(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldStatusNamespaceID, v))
+}
+
+// StatusNamespaceIDLTE applies the LTE predicate on the "status_namespace_id" field.
+func StatusNamespaceIDLTE(v gidx.PrefixedID) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldStatusNamespaceID, v))
+}
+
+// StatusNamespaceIDContains applies the Contains predicate on the "status_namespace_id" field.
+func StatusNamespaceIDContains(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldContains(FieldStatusNamespaceID, vc))
+}
+
+// StatusNamespaceIDHasPrefix applies the HasPrefix predicate on the "status_namespace_id" field.
+func StatusNamespaceIDHasPrefix(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldHasPrefix(FieldStatusNamespaceID, vc))
+}
+
+// StatusNamespaceIDHasSuffix applies the HasSuffix predicate on the "status_namespace_id" field.
+func StatusNamespaceIDHasSuffix(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldHasSuffix(FieldStatusNamespaceID, vc))
+}
+
+// StatusNamespaceIDEqualFold applies the EqualFold predicate on the "status_namespace_id" field.
+func StatusNamespaceIDEqualFold(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldEqualFold(FieldStatusNamespaceID, vc))
+}
+
+// StatusNamespaceIDContainsFold applies the ContainsFold predicate on the "status_namespace_id" field.
+func StatusNamespaceIDContainsFold(v gidx.PrefixedID) predicate.StatusHistory {
+	vc := string(v)
+	return predicate.StatusHistory(sql.FieldContainsFold(FieldStatusNamespaceID, vc))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldContainsFold(FieldSource, v))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v Operation) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v Operation) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...Operation) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...Operation) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// DataIsNil applies the IsNil predicate on the "data" field.
+func DataIsNil() predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIsNull(FieldData))
+}
+
+// DataNotNil applies the NotNil predicate on the "data" field.
+func DataNotNil() predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotNull(FieldData))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorIsNil applies the IsNil predicate on the "actor" field.
+func ActorIsNil() predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIsNull(FieldActor))
+}
+
+// ActorNotNil applies the NotNil predicate on the "actor" field.
+func ActorNotNil() predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotNull(FieldActor))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldContainsFold(FieldActor, v))
+}
+
+// StatusCreatedAtEQ applies the EQ predicate on the "status_created_at" field.
+func StatusCreatedAtEQ(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusCreatedAt, v))
+}
+
+// StatusCreatedAtNEQ applies the NEQ predicate on the "status_created_at" field.
+func StatusCreatedAtNEQ(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldStatusCreatedAt, v))
+}
+
+// StatusCreatedAtIn applies the In predicate on the "status_created_at" field.
+func StatusCreatedAtIn(vs ...time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldStatusCreatedAt, vs...))
+}
+
+// StatusCreatedAtNotIn applies the NotIn predicate on the "status_created_at" field.
+func StatusCreatedAtNotIn(vs ...time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldStatusCreatedAt, vs...))
+}
+
+// StatusCreatedAtGT applies the GT predicate on the "status_created_at" field.
+func StatusCreatedAtGT(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldStatusCreatedAt, v))
+}
+
+// StatusCreatedAtGTE applies the GTE predicate on the "status_created_at" field.
+func StatusCreatedAtGTE(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldStatusCreatedAt, v))
+}
+
+// StatusCreatedAtLT applies the LT predicate on the "status_created_at" field.
+func StatusCreatedAtLT(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldStatusCreatedAt, v))
+}
+
+// StatusCreatedAtLTE applies the LTE predicate on the "status_created_at" field.
+func StatusCreatedAtLTE(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldStatusCreatedAt, v))
+}
+
+// StatusCreatedAtIsNil applies the IsNil predicate on the "status_created_at" field.
+func StatusCreatedAtIsNil() predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIsNull(FieldStatusCreatedAt))
+}
+
+// StatusCreatedAtNotNil applies the NotNil predicate on the "status_created_at" field.
+func StatusCreatedAtNotNil() predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotNull(FieldStatusCreatedAt))
+}
+
+// StatusUpdatedAtEQ applies the EQ predicate on the "status_updated_at" field.
+func StatusUpdatedAtEQ(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusUpdatedAt, v))
+}
+
+// StatusUpdatedAtNEQ applies the NEQ predicate on the "status_updated_at" field.
+func StatusUpdatedAtNEQ(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldStatusUpdatedAt, v))
+}
+
+// StatusUpdatedAtIn applies the In predicate on the "status_updated_at" field.
+func StatusUpdatedAtIn(vs ...time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldStatusUpdatedAt, vs...))
+}
+
+// StatusUpdatedAtNotIn applies the NotIn predicate on the "status_updated_at" field.
+func StatusUpdatedAtNotIn(vs ...time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldStatusUpdatedAt, vs...))
+}
+
+// StatusUpdatedAtGT applies the GT predicate on the "status_updated_at" field.
+func StatusUpdatedAtGT(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldStatusUpdatedAt, v))
+}
+
+// StatusUpdatedAtGTE applies the GTE predicate on the "status_updated_at" field.
+func StatusUpdatedAtGTE(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldStatusUpdatedAt, v))
+}
+
+// StatusUpdatedAtLT applies the LT predicate on the "status_updated_at" field.
+func StatusUpdatedAtLT(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldStatusUpdatedAt, v))
+}
+
+// StatusUpdatedAtLTE applies the LTE predicate on the "status_updated_at" field.
+func StatusUpdatedAtLTE(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldStatusUpdatedAt, v))
+}
+
+// StatusUpdatedAtIsNil applies the IsNil predicate on the "status_updated_at" field.
+func StatusUpdatedAtIsNil() predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIsNull(FieldStatusUpdatedAt))
+}
+
+// StatusUpdatedAtNotNil applies the NotNil predicate on the "status_updated_at" field.
+func StatusUpdatedAtNotNil() predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotNull(FieldStatusUpdatedAt))
+}
+
+// StatusExpiresAtEQ applies the EQ predicate on the "status_expires_at" field.
+func StatusExpiresAtEQ(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusExpiresAt, v))
+}
+
+// StatusExpiresAtNEQ applies the NEQ predicate on the "status_expires_at" field.
+func StatusExpiresAtNEQ(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldStatusExpiresAt, v))
+}
+
+// StatusExpiresAtIn applies the In predicate on the "status_expires_at" field.
+func StatusExpiresAtIn(vs ...time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldStatusExpiresAt, vs...))
+}
+
+// StatusExpiresAtNotIn applies the NotIn predicate on the "status_expires_at" field.
+func StatusExpiresAtNotIn(vs ...time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldStatusExpiresAt, vs...))
+}
+
+// StatusExpiresAtGT applies the GT predicate on the "status_expires_at" field.
+func StatusExpiresAtGT(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldStatusExpiresAt, v))
+}
+
+// StatusExpiresAtGTE applies the GTE predicate on the "status_expires_at" field.
+func StatusExpiresAtGTE(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldStatusExpiresAt, v))
+}
+
+// StatusExpiresAtLT applies the LT predicate on the "status_expires_at" field.
+func StatusExpiresAtLT(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldStatusExpiresAt, v))
+}
+
+// StatusExpiresAtLTE applies the LTE predicate on the "status_expires_at" field.
+func StatusExpiresAtLTE(v time.Time) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldStatusExpiresAt, v))
+}
+
+// StatusExpiresAtIsNil applies the IsNil predicate on the "status_expires_at" field.
+func StatusExpiresAtIsNil() predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIsNull(FieldStatusExpiresAt))
+}
+
+// StatusExpiresAtNotNil applies the NotNil predicate on the "status_expires_at" field.
+func StatusExpiresAtNotNil() predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotNull(FieldStatusExpiresAt))
+}
+
+// StatusVersionEQ applies the EQ predicate on the "status_version" field.
+func StatusVersionEQ(v int64) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldEQ(FieldStatusVersion, v))
+}
+
+// StatusVersionNEQ applies the NEQ predicate on the "status_version" field.
+func StatusVersionNEQ(v int64) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNEQ(FieldStatusVersion, v))
+}
+
+// StatusVersionIn applies the In predicate on the "status_version" field.
+func StatusVersionIn(vs ...int64) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldIn(FieldStatusVersion, vs...))
+}
+
+// StatusVersionNotIn applies the NotIn predicate on the "status_version" field.
+func StatusVersionNotIn(vs ...int64) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldNotIn(FieldStatusVersion, vs...))
+}
+
+// StatusVersionGT applies the GT predicate on the "status_version" field.
+func StatusVersionGT(v int64) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGT(FieldStatusVersion, v))
+}
+
+// StatusVersionGTE applies the GTE predicate on the "status_version" field.
+func StatusVersionGTE(v int64) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldGTE(FieldStatusVersion, v))
+}
+
+// StatusVersionLT applies the LT predicate on the "status_version" field.
+func StatusVersionLT(v int64) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLT(FieldStatusVersion, v))
+}
+
+// StatusVersionLTE applies the LTE predicate on the "status_version" field.
+func StatusVersionLTE(v int64) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.FieldLTE(FieldStatusVersion, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StatusHistory) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StatusHistory) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StatusHistory) predicate.StatusHistory {
+	return predicate.StatusHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,438 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
+	"go.infratographer.com/x/gidx"
+)
+
+// StatusHistoryCreate is the builder for creating a StatusHistory entity.
+type StatusHistoryCreate struct {
+	config
+	mutation *StatusHistoryMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (shc *StatusHistoryCreate) SetCreatedAt(t time.Time) *StatusHistoryCreate {
+	shc.mutation.SetCreatedAt(t)
+	return shc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (shc *StatusHistoryCreate) SetNillableCreatedAt(t *time.Time) *StatusHistoryCreate {
+	if t != nil {
+		shc.SetCreatedAt(*t)
+	}
+	return shc
+}
+
+// SetStatusID sets the "status_id" field.
+func (shc *StatusHistoryCreate) SetStatusID(gi gidx.PrefixedID) *StatusHistoryCreate {
+	shc.mutation.SetStatusID(gi)
+	return shc
+}
+
+// SetMetadataID sets the "metadata_id" field.
+func (shc *StatusHistoryCreate) SetMetadataID(gi gidx.PrefixedID) *StatusHistoryCreate {
+	shc.mutation.SetMetadataID(gi)
+	return shc
+}
+
+// SetStatusNamespaceID sets the "status_namespace_id" field.
+func (shc *StatusHistoryCreate) SetStatusNamespaceID(gi gidx.PrefixedID) *StatusHistoryCreate {
+	shc.mutation.SetStatusNamespaceID(gi)
+	return shc
+}
+
+// SetSource sets the "source" field.
+func (shc *StatusHistoryCreate) SetSource(s string) *StatusHistoryCreate {
+	shc.mutation.SetSource(s)
+	return shc
+}
+
+// SetOperation sets the "operation" field.
+func (shc *StatusHistoryCreate) SetOperation(s statushistory.Operation) *StatusHistoryCreate {
+	shc.mutation.SetOperation(s)
+	return shc
+}
+
+// SetData sets the "data" field.
+func (shc *StatusHistoryCreate) SetData(jm json.RawMessage) *StatusHistoryCreate {
+	shc.mutation.SetData(jm)
+	return shc
+}
+
+// SetActor sets the "actor" field.
+func (shc *StatusHistoryCreate) SetActor(s string) *StatusHistoryCreate {
+	shc.mutation.SetActor(s)
+	return shc
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (shc *StatusHistoryCreate) SetNillableActor(s *string) *StatusHistoryCreate {
+	if s != nil {
+		shc.SetActor(*s)
+	}
+	return shc
+}
+
+// SetStatusCreatedAt sets the "status_created_at" field.
+func (shc *StatusHistoryCreate) SetStatusCreatedAt(t time.Time) *StatusHistoryCreate {
+	shc.mutation.SetStatusCreatedAt(t)
+	return shc
+}
+
+// SetNillableStatusCreatedAt sets the "status_created_at" field if the given value is not nil.
+func (shc *StatusHistoryCreate) SetNillableStatusCreatedAt(t *time.Time) *StatusHistoryCreate {
+	if t != nil {
+		shc.SetStatusCreatedAt(*t)
+	}
+	return shc
+}
+
+// SetStatusUpdatedAt sets the "status_updated_at" field.
+func (shc *StatusHistoryCreate) SetStatusUpdatedAt(t time.Time) *StatusHistoryCreate {
+	shc.mutation.SetStatusUpdatedAt(t)
+	return shc
+}
+
+// SetNillableStatusUpdatedAt sets the "status_updated_at" field if the given value is not nil.
+func (shc *StatusHistoryCreate) SetNillableStatusUpdatedAt(t *time.Time) *StatusHistoryCreate {
+	if t != nil {
+		shc.SetStatusUpdatedAt(*t)
+	}
+	return shc
+}
+
+// SetStatusExpiresAt sets the "status_expires_at" field.
+func (shc *StatusHistoryCreate) SetStatusExpiresAt(t time.Time) *StatusHistoryCreate {
+	shc.mutation.SetStatusExpiresAt(t)
+	return shc
+}
+
+// SetNillableStatusExpiresAt sets the "status_expires_at" field if the given value is not nil.
+func (shc *StatusHistoryCreate) SetNillableStatusExpiresAt(t *time.Time) *StatusHistoryCreate {
+	if t != nil {
+		shc.SetStatusExpiresAt(*t)
+	}
+	return shc
+}
+
+// SetStatusVersion sets the "status_version" field.
+func (shc *StatusHistoryCreate) SetStatusVersion(i int64) *StatusHistoryCreate {
+	shc.mutation.SetStatusVersion(i)
+	return shc
+}
+
+// SetNillableStatusVersion sets the "status_version" field if the given value is not nil.
+func (shc *StatusHistoryCreate) SetNillableStatusVersion(i *int64) *StatusHistoryCreate {
+	if i != nil {
+		shc.SetStatusVersion(*i)
+	}
+	return shc
+}
+
+// SetID sets the "id" field.
+func (shc *StatusHistoryCreate) SetID(gi gidx.PrefixedID) *StatusHistoryCreate {
+	shc.mutation.SetID(gi)
+	return shc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (shc *StatusHistoryCreate) SetNillableID(gi *gidx.PrefixedID) *StatusHistoryCreate {
+	if gi != nil {
+		shc.SetID(*gi)
+	}
+	return shc
+}
+
+// Mutation returns the StatusHistoryMutation object of the builder.
+func (shc *StatusHistoryCreate) Mutation() *StatusHistoryMutation {
+	return shc.mutation
+}
+
+// Save creates the StatusHistory in the database.
+func (shc *StatusHistoryCreate) Save(ctx context.Context) (*StatusHistory, error) {
+	shc.defaults()
+	return withHooks(ctx, shc.sqlSave, shc.mutation, shc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (shc *StatusHistoryCreate) SaveX(ctx context.Context) *StatusHistory {
+	v, err := shc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (shc *StatusHistoryCreate) Exec(ctx context.Context) error {
+	_, err := shc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shc *StatusHistoryCreate) ExecX(ctx context.Context) {
+	if err := shc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (shc *StatusHistoryCreate) defaults() {
+	if _, ok := shc.mutation.CreatedAt(); !ok {
+		v := statushistory.DefaultCreatedAt()
+		shc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := shc.mutation.StatusVersion(); !ok {
+		v := statushistory.DefaultStatusVersion
+		shc.mutation.SetStatusVersion(v)
+	}
+	if _, ok := shc.mutation.ID(); !ok {
+		v := statushistory.DefaultID()
+		shc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (shc *StatusHistoryCreate) check() error {
+	if _, ok := shc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`generated: missing required field "StatusHistory.created_at"`)}
+	}
+	if _, ok := shc.mutation.StatusID(); !ok {
+		return &ValidationError{Name: "status_id", err: errors.New(`generated: missing required field "StatusHistory.status_id"`)}
+	}
+	if v, ok := shc.mutation.StatusID(); ok {
+		if err := statushistory.StatusIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status_id", err: fmt.Errorf(`generated: validator failed for field "StatusHistory.status_id": %w`, err)}
+		}
+	}
+	if _, ok := shc.mutation.MetadataID(); !ok {
+		return &ValidationError{Name: "metadata_id", err: errors.New(`generated: missing required field "StatusHistory.metadata_id"`)}
+	}
+	if v, ok := shc.mutation.MetadataID(); ok {
+		if err := statushistory.MetadataIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "metadata_id", err: fmt.Errorf(`generated: validator failed for field "StatusHistory.metadata_id": %w`, err)}
+		}
+	}
+	if _, ok := shc.mutation.StatusNamespaceID(); !ok {
+		return &ValidationError{Name: "status_namespace_id", err: errors.New(`generated: missing required field "StatusHistory.status_namespace_id"`)}
+	}
+	if v, ok := shc.mutation.StatusNamespaceID(); ok {
+		if err := statushistory.StatusNamespaceIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "status_namespace_id", err: fmt.Errorf(`generated: validator failed for field "StatusHistory.status_namespace_id": %w`, err)}
+		}
+	}
+	if _, ok := shc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`generated: missing required field "StatusHistory.source"`)}
+	}
+	if v, ok := shc.mutation.Source(); ok {
+		if err := statushistory.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`generated: validator failed for field "StatusHistory.source": %w`, err)}
+		}
+	}
+	if _, ok := shc.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`generated: missing required field "StatusHistory.operation"`)}
+	}
+	if v, ok := shc.mutation.Operation(); ok {
+		if err := statushistory.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`generated: validator failed for field "StatusHistory.operation": %w`, err)}
+		}
+	}
+	if _, ok := shc.mutation.StatusVersion(); !ok {
+		return &ValidationError{Name: "status_version", err: errors.New(`generated: missing required field "StatusHistory.status_version"`)}
+	}
+	return nil
+}
+
+func (shc *StatusHistoryCreate) sqlSave(ctx context.Context) (*StatusHistory, error) {
+	if err := shc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := shc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, shc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*gidx.PrefixedID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	shc.mutation.id = &_node.ID
+	shc.mutation.done = true
+	return _node, nil
+}
+
+func (shc *StatusHistoryCreate) createSpec() (*StatusHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StatusHistory{config: shc.config}
+		_spec = sqlgraph.NewCreateSpec(statushistory.Table, sqlgraph.NewFieldSpec(statushistory.FieldID, field.TypeString))
+	)
+	if id, ok := shc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := shc.mutation.CreatedAt(); ok {
+		_spec.SetField(statushistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := shc.mutation.StatusID(); ok {
+		_spec.SetField(statushistory.FieldStatusID, field.TypeString, value)
+		_node.StatusID = value
+	}
+	if value, ok := shc.mutation.MetadataID(); ok {
+		_spec.SetField(statushistory.FieldMetadataID, field.TypeString, value)
+		_node.MetadataID = value
+	}
+	if value, ok := shc.mutation.StatusNamespaceID(); ok {
+		_spec.SetField(statushistory.FieldStatusNamespaceID, field.TypeString, value)
+		_node.StatusNamespaceID = value
+	}
+	if value, ok := shc.mutation.Source(); ok {
+		_spec.SetField(statushistory.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := shc.mutation.Operation(); ok {
+		_spec.SetField(statushistory.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := shc.mutation.Data(); ok {
+		_spec.SetField(statushistory.FieldData, field.TypeJSON, value)
+		_node.Data = value
+	}
+	if value, ok := shc.mutation.Actor(); ok {
+		_spec.SetField(statushistory.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := shc.mutation.StatusCreatedAt(); ok {
+		_spec.SetField(statushistory.FieldStatusCreatedAt, field.TypeTime, value)
+		_node.StatusCreatedAt = &value
+	}
+	if value, ok := shc.mutation.StatusUpdatedAt(); ok {
+		_spec.SetField(statushistory.FieldStatusUpdatedAt, field.TypeTime, value)
+		_node.StatusUpdatedAt = &value
+	}
+	if value, ok := shc.mutation.StatusExpiresAt(); ok {
+		_spec.SetField(statushistory.FieldStatusExpiresAt, field.TypeTime, value)
+		_node.StatusExpiresAt = &value
+	}
+	if value, ok := shc.mutation.StatusVersion(); ok {
+		_spec.SetField(statushistory.FieldStatusVersion, field.TypeInt64, value)
+		_node.StatusVersion = value
+	}
+	return _node, _spec
+}
+
+// StatusHistoryCreateBulk is the builder for creating many StatusHistory entities in bulk.
+type StatusHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*StatusHistoryCreate
+}
+
+// Save creates the StatusHistory entities in the database.
+func (shcb *StatusHistoryCreateBulk) Save(ctx context.Context) ([]*StatusHistory, error) {
+	if shcb.err != nil {
+		return nil, shcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(shcb.builders))
+	nodes := make([]*StatusHistory, len(shcb.builders))
+	mutators := make([]Mutator, len(shcb.builders))
+	for i := range shcb.builders {
+		func(i int, root context.Context) {
+			builder := shcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StatusHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, shcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, shcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, shcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (shcb *StatusHistoryCreateBulk) SaveX(ctx context.Context) []*StatusHistory {
+	v, err := shcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (shcb *StatusHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := shcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shcb *StatusHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := shcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
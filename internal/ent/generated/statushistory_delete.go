@@ -0,0 +1,102 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
+)
+
+// StatusHistoryDelete is the builder for deleting a StatusHistory entity.
+type StatusHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *StatusHistoryMutation
+}
+
+// Where appends a list predicates to the StatusHistoryDelete builder.
+func (shd *StatusHistoryDelete) Where(ps ...predicate.StatusHistory) *StatusHistoryDelete {
+	shd.mutation.Where(ps...)
+	return shd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (shd *StatusHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, shd.sqlExec, shd.mutation, shd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shd *StatusHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := shd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (shd *StatusHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(statushistory.Table, sqlgraph.NewFieldSpec(statushistory.FieldID, field.TypeString))
+	if ps := shd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, shd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	shd.mutation.done = true
+	return affected, err
+}
+
+// StatusHistoryDeleteOne is the builder for deleting a single StatusHistory entity.
+type StatusHistoryDeleteOne struct {
+	shd *StatusHistoryDelete
+}
+
+// Where appends a list predicates to the StatusHistoryDelete builder.
+func (shdo *StatusHistoryDeleteOne) Where(ps ...predicate.StatusHistory) *StatusHistoryDeleteOne {
+	shdo.shd.mutation.Where(ps...)
+	return shdo
+}
+
+// Exec executes the deletion query.
+func (shdo *StatusHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := shdo.shd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{statushistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shdo *StatusHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := shdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,554 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
+	"go.infratographer.com/x/gidx"
+)
+
+// StatusHistoryQuery is the builder for querying StatusHistory entities.
+type StatusHistoryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []statushistory.OrderOption
+	inters     []Interceptor
+	predicates []predicate.StatusHistory
+	modifiers  []func(*sql.Selector)
+	loadTotal  []func(context.Context, []*StatusHistory) error
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StatusHistoryQuery builder.
+func (shq *StatusHistoryQuery) Where(ps ...predicate.StatusHistory) *StatusHistoryQuery {
+	shq.predicates = append(shq.predicates, ps...)
+	return shq
+}
+
+// Limit the number of records to be returned by this query.
+func (shq *StatusHistoryQuery) Limit(limit int) *StatusHistoryQuery {
+	shq.ctx.Limit = &limit
+	return shq
+}
+
+// Offset to start from.
+func (shq *StatusHistoryQuery) Offset(offset int) *StatusHistoryQuery {
+	shq.ctx.Offset = &offset
+	return shq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (shq *StatusHistoryQuery) Unique(unique bool) *StatusHistoryQuery {
+	shq.ctx.Unique = &unique
+	return shq
+}
+
+// Order specifies how the records should be ordered.
+func (shq *StatusHistoryQuery) Order(o ...statushistory.OrderOption) *StatusHistoryQuery {
+	shq.order = append(shq.order, o...)
+	return shq
+}
+
+// First returns the first StatusHistory entity from the query.
+// Returns a *NotFoundError when no StatusHistory was found.
+func (shq *StatusHistoryQuery) First(ctx context.Context) (*StatusHistory, error) {
+	nodes, err := shq.Limit(1).All(setContextOp(ctx, shq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{statushistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (shq *StatusHistoryQuery) FirstX(ctx context.Context) *StatusHistory {
+	node, err := shq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StatusHistory ID from the query.
+// Returns a *NotFoundError when no StatusHistory ID was found.
+func (shq *StatusHistoryQuery) FirstID(ctx context.Context) (id gidx.PrefixedID, err error) {
+	var ids []gidx.PrefixedID
+	if ids, err = shq.Limit(1).IDs(setContextOp(ctx, shq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{statushistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (shq *StatusHistoryQuery) FirstIDX(ctx context.Context) gidx.PrefixedID {
+	id, err := shq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StatusHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StatusHistory entity is found.
+// Returns a *NotFoundError when no StatusHistory entities are found.
+func (shq *StatusHistoryQuery) Only(ctx context.Context) (*StatusHistory, error) {
+	nodes, err := shq.Limit(2).All(setContextOp(ctx, shq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{statushistory.Label}
+	default:
+		return nil, &NotSingularError{statushistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (shq *StatusHistoryQuery) OnlyX(ctx context.Context) *StatusHistory {
+	node, err := shq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StatusHistory ID in the query.
+// Returns a *NotSingularError when more than one StatusHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (shq *StatusHistoryQuery) OnlyID(ctx context.Context) (id gidx.PrefixedID, err error) {
+	var ids []gidx.PrefixedID
+	if ids, err = shq.Limit(2).IDs(setContextOp(ctx, shq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{statushistory.Label}
+	default:
+		err = &NotSingularError{statushistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (shq *StatusHistoryQuery) OnlyIDX(ctx context.Context) gidx.PrefixedID {
+	id, err := shq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StatusHistories.
+func (shq *StatusHistoryQuery) All(ctx context.Context) ([]*StatusHistory, error) {
+	ctx = setContextOp(ctx, shq.ctx, "All")
+	if err := shq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StatusHistory, *StatusHistoryQuery]()
+	return withInterceptors[[]*StatusHistory](ctx, shq, qr, shq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (shq *StatusHistoryQuery) AllX(ctx context.Context) []*StatusHistory {
+	nodes, err := shq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StatusHistory IDs.
+func (shq *StatusHistoryQuery) IDs(ctx context.Context) (ids []gidx.PrefixedID, err error) {
+	if shq.ctx.Unique == nil && shq.path != nil {
+		shq.Unique(true)
+	}
+	ctx = setContextOp(ctx, shq.ctx, "IDs")
+	if err = shq.Select(statushistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (shq *StatusHistoryQuery) IDsX(ctx context.Context) []gidx.PrefixedID {
+	ids, err := shq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (shq *StatusHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, shq.ctx, "Count")
+	if err := shq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, shq, querierCount[*StatusHistoryQuery](), shq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (shq *StatusHistoryQuery) CountX(ctx context.Context) int {
+	count, err := shq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (shq *StatusHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, shq.ctx, "Exist")
+	switch _, err := shq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("generated: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (shq *StatusHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := shq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StatusHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (shq *StatusHistoryQuery) Clone() *StatusHistoryQuery {
+	if shq == nil {
+		return nil
+	}
+	return &StatusHistoryQuery{
+		config:     shq.config,
+		ctx:        shq.ctx.Clone(),
+		order:      append([]statushistory.OrderOption{}, shq.order...),
+		inters:     append([]Interceptor{}, shq.inters...),
+		predicates: append([]predicate.StatusHistory{}, shq.predicates...),
+		// clone intermediate query.
+		sql:  shq.sql.Clone(),
+		path: shq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StatusHistory.Query().
+//		GroupBy(statushistory.FieldCreatedAt).
+//		Aggregate(generated.Count()).
+//		Scan(ctx, &v)
+func (shq *StatusHistoryQuery) GroupBy(field string, fields ...string) *StatusHistoryGroupBy {
+	shq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StatusHistoryGroupBy{build: shq}
+	grbuild.flds = &shq.ctx.Fields
+	grbuild.label = statushistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.StatusHistory.Query().
+//		Select(statushistory.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (shq *StatusHistoryQuery) Select(fields ...string) *StatusHistorySelect {
+	shq.ctx.Fields = append(shq.ctx.Fields, fields...)
+	sbuild := &StatusHistorySelect{StatusHistoryQuery: shq}
+	sbuild.label = statushistory.Label
+	sbuild.flds, sbuild.scan = &shq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StatusHistorySelect configured with the given aggregations.
+func (shq *StatusHistoryQuery) Aggregate(fns ...AggregateFunc) *StatusHistorySelect {
+	return shq.Select().Aggregate(fns...)
+}
+
+func (shq *StatusHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range shq.inters {
+		if inter == nil {
+			return fmt.Errorf("generated: uninitialized interceptor (forgotten import generated/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, shq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range shq.ctx.Fields {
+		if !statushistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+		}
+	}
+	if shq.path != nil {
+		prev, err := shq.path(ctx)
+		if err != nil {
+			return err
+		}
+		shq.sql = prev
+	}
+	return nil
+}
+
+func (shq *StatusHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StatusHistory, error) {
+	var (
+		nodes = []*StatusHistory{}
+		_spec = shq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StatusHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StatusHistory{config: shq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(shq.modifiers) > 0 {
+		_spec.Modifiers = shq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, shq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	for i := range shq.loadTotal {
+		if err := shq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (shq *StatusHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := shq.querySpec()
+	if len(shq.modifiers) > 0 {
+		_spec.Modifiers = shq.modifiers
+	}
+	_spec.Node.Columns = shq.ctx.Fields
+	if len(shq.ctx.Fields) > 0 {
+		_spec.Unique = shq.ctx.Unique != nil && *shq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, shq.driver, _spec)
+}
+
+func (shq *StatusHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(statushistory.Table, statushistory.Columns, sqlgraph.NewFieldSpec(statushistory.FieldID, field.TypeString))
+	_spec.From = shq.sql
+	if unique := shq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if shq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := shq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statushistory.FieldID)
+		for i := range fields {
+			if fields[i] != statushistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := shq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := shq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := shq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := shq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (shq *StatusHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(shq.driver.Dialect())
+	t1 := builder.Table(statushistory.Table)
+	columns := shq.ctx.Fields
+	if len(columns) == 0 {
+		columns = statushistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if shq.sql != nil {
+		selector = shq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if shq.ctx.Unique != nil && *shq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range shq.predicates {
+		p(selector)
+	}
+	for _, p := range shq.order {
+		p(selector)
+	}
+	if offset := shq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := shq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// StatusHistoryGroupBy is the group-by builder for StatusHistory entities.
+type StatusHistoryGroupBy struct {
+	selector
+	build *StatusHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (shgb *StatusHistoryGroupBy) Aggregate(fns ...AggregateFunc) *StatusHistoryGroupBy {
+	shgb.fns = append(shgb.fns, fns...)
+	return shgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (shgb *StatusHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, shgb.build.ctx, "GroupBy")
+	if err := shgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StatusHistoryQuery, *StatusHistoryGroupBy](ctx, shgb.build, shgb, shgb.build.inters, v)
+}
+
+func (shgb *StatusHistoryGroupBy) sqlScan(ctx context.Context, root *StatusHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(shgb.fns))
+	for _, fn := range shgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*shgb.flds)+len(shgb.fns))
+		for _, f := range *shgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*shgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := shgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StatusHistorySelect is the builder for selecting fields of StatusHistory entities.
+type StatusHistorySelect struct {
+	*StatusHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (shs *StatusHistorySelect) Aggregate(fns ...AggregateFunc) *StatusHistorySelect {
+	shs.fns = append(shs.fns, fns...)
+	return shs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (shs *StatusHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, shs.ctx, "Select")
+	if err := shs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StatusHistoryQuery, *StatusHistorySelect](ctx, shs.StatusHistoryQuery, shs, shs.inters, v)
+}
+
+func (shs *StatusHistorySelect) sqlScan(ctx context.Context, root *StatusHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(shs.fns))
+	for _, fn := range shs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*shs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := shs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
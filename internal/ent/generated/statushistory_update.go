@@ -0,0 +1,219 @@
+// Copyright 2023 The Infratographer Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
+)
+
+// StatusHistoryUpdate is the builder for updating StatusHistory entities.
+type StatusHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StatusHistoryMutation
+}
+
+// Where appends a list predicates to the StatusHistoryUpdate builder.
+func (shu *StatusHistoryUpdate) Where(ps ...predicate.StatusHistory) *StatusHistoryUpdate {
+	shu.mutation.Where(ps...)
+	return shu
+}
+
+// Mutation returns the StatusHistoryMutation object of the builder.
+func (shu *StatusHistoryUpdate) Mutation() *StatusHistoryMutation {
+	return shu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (shu *StatusHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, shu.sqlSave, shu.mutation, shu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (shu *StatusHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := shu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (shu *StatusHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := shu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shu *StatusHistoryUpdate) ExecX(ctx context.Context) {
+	if err := shu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (shu *StatusHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(statushistory.Table, statushistory.Columns, sqlgraph.NewFieldSpec(statushistory.FieldID, field.TypeString))
+	if ps := shu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if shu.mutation.DataCleared() {
+		_spec.ClearField(statushistory.FieldData, field.TypeJSON)
+	}
+	if shu.mutation.ActorCleared() {
+		_spec.ClearField(statushistory.FieldActor, field.TypeString)
+	}
+	if shu.mutation.StatusCreatedAtCleared() {
+		_spec.ClearField(statushistory.FieldStatusCreatedAt, field.TypeTime)
+	}
+	if shu.mutation.StatusUpdatedAtCleared() {
+		_spec.ClearField(statushistory.FieldStatusUpdatedAt, field.TypeTime)
+	}
+	if shu.mutation.StatusExpiresAtCleared() {
+		_spec.ClearField(statushistory.FieldStatusExpiresAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, shu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statushistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	shu.mutation.done = true
+	return n, nil
+}
+
+// StatusHistoryUpdateOne is the builder for updating a single StatusHistory entity.
+type StatusHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StatusHistoryMutation
+}
+
+// Mutation returns the StatusHistoryMutation object of the builder.
+func (shuo *StatusHistoryUpdateOne) Mutation() *StatusHistoryMutation {
+	return shuo.mutation
+}
+
+// Where appends a list predicates to the StatusHistoryUpdate builder.
+func (shuo *StatusHistoryUpdateOne) Where(ps ...predicate.StatusHistory) *StatusHistoryUpdateOne {
+	shuo.mutation.Where(ps...)
+	return shuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (shuo *StatusHistoryUpdateOne) Select(field string, fields ...string) *StatusHistoryUpdateOne {
+	shuo.fields = append([]string{field}, fields...)
+	return shuo
+}
+
+// Save executes the query and returns the updated StatusHistory entity.
+func (shuo *StatusHistoryUpdateOne) Save(ctx context.Context) (*StatusHistory, error) {
+	return withHooks(ctx, shuo.sqlSave, shuo.mutation, shuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (shuo *StatusHistoryUpdateOne) SaveX(ctx context.Context) *StatusHistory {
+	node, err := shuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (shuo *StatusHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := shuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shuo *StatusHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := shuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (shuo *StatusHistoryUpdateOne) sqlSave(ctx context.Context) (_node *StatusHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(statushistory.Table, statushistory.Columns, sqlgraph.NewFieldSpec(statushistory.FieldID, field.TypeString))
+	id, ok := shuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`generated: missing "StatusHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := shuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, statushistory.FieldID)
+		for _, f := range fields {
+			if !statushistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("generated: invalid field %q for query", f)}
+			}
+			if f != statushistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := shuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if shuo.mutation.DataCleared() {
+		_spec.ClearField(statushistory.FieldData, field.TypeJSON)
+	}
+	if shuo.mutation.ActorCleared() {
+		_spec.ClearField(statushistory.FieldActor, field.TypeString)
+	}
+	if shuo.mutation.StatusCreatedAtCleared() {
+		_spec.ClearField(statushistory.FieldStatusCreatedAt, field.TypeTime)
+	}
+	if shuo.mutation.StatusUpdatedAtCleared() {
+		_spec.ClearField(statushistory.FieldStatusUpdatedAt, field.TypeTime)
+	}
+	if shuo.mutation.StatusExpiresAtCleared() {
+		_spec.ClearField(statushistory.FieldStatusExpiresAt, field.TypeTime)
+	}
+	_node = &StatusHistory{config: shuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, shuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{statushistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	shuo.mutation.done = true
+	return _node, nil
+}
@@ -17,6 +17,7 @@
 package generated
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -37,12 +38,20 @@ type StatusNamespace struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Time the record was deleted, it's purged once the purge delay has passed.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// The name of the status namespace.
 	Name string `json:"name,omitempty"`
 	// The ID of the resource provider for this status namespace.
 	ResourceProviderID gidx.PrefixedID `json:"resource_provider_id,omitempty"`
 	// Flag for if this namespace is private.
 	Private bool `json:"private,omitempty"`
+	// Flag for if this namespace is being deleted with its statuses. No statuses can be written to it.
+	Deleting bool `json:"deleting,omitempty"`
+	// JSON Schema that status data in this namespace must validate against.
+	JSONSchema json.RawMessage `json:"json_schema,omitempty"`
+	// Number of seconds statuses in this namespace are kept after they were last updated, unless the update sets its own expiry.
+	DefaultTTL *int64 `json:"default_ttl,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the StatusNamespaceQuery when eager-loading is set.
 	Edges        StatusNamespaceEdges `json:"edges"`
@@ -56,6 +65,8 @@ type StatusNamespaceEdges struct {
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
 
 	namedStatuses map[string][]*Status
 }
@@ -74,13 +85,17 @@ func (*StatusNamespace) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case statusnamespace.FieldJSONSchema:
+			values[i] = new([]byte)
 		case statusnamespace.FieldID, statusnamespace.FieldResourceProviderID:
 			values[i] = new(gidx.PrefixedID)
-		case statusnamespace.FieldPrivate:
+		case statusnamespace.FieldPrivate, statusnamespace.FieldDeleting:
 			values[i] = new(sql.NullBool)
+		case statusnamespace.FieldDefaultTTL:
+			values[i] = new(sql.NullInt64)
 		case statusnamespace.FieldName:
 			values[i] = new(sql.NullString)
-		case statusnamespace.FieldCreatedAt, statusnamespace.FieldUpdatedAt:
+		case statusnamespace.FieldCreatedAt, statusnamespace.FieldUpdatedAt, statusnamespace.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -115,6 +130,13 @@ func (sn *StatusNamespace) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sn.UpdatedAt = value.Time
 			}
+		case statusnamespace.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sn.DeletedAt = new(time.Time)
+				*sn.DeletedAt = value.Time
+			}
 		case statusnamespace.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -133,6 +155,27 @@ func (sn *StatusNamespace) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				sn.Private = value.Bool
 			}
+		case statusnamespace.FieldDeleting:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field deleting", values[i])
+			} else if value.Valid {
+				sn.Deleting = value.Bool
+			}
+		case statusnamespace.FieldJSONSchema:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field json_schema", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sn.JSONSchema); err != nil {
+					return fmt.Errorf("unmarshal field json_schema: %w", err)
+				}
+			}
+		case statusnamespace.FieldDefaultTTL:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field default_ttl", values[i])
+			} else if value.Valid {
+				sn.DefaultTTL = new(int64)
+				*sn.DefaultTTL = value.Int64
+			}
 		default:
 			sn.selectValues.Set(columns[i], values[i])
 		}
@@ -180,6 +223,11 @@ func (sn *StatusNamespace) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(sn.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := sn.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(sn.Name)
 	builder.WriteString(", ")
@@ -188,6 +236,17 @@ func (sn *StatusNamespace) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("private=")
 	builder.WriteString(fmt.Sprintf("%v", sn.Private))
+	builder.WriteString(", ")
+	builder.WriteString("deleting=")
+	builder.WriteString(fmt.Sprintf("%v", sn.Deleting))
+	builder.WriteString(", ")
+	builder.WriteString("json_schema=")
+	builder.WriteString(fmt.Sprintf("%v", sn.JSONSchema))
+	builder.WriteString(", ")
+	if v := sn.DefaultTTL; v != nil {
+		builder.WriteString("default_ttl=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
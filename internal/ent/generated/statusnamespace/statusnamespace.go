@@ -33,12 +33,20 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldResourceProviderID holds the string denoting the resource_provider_id field in the database.
 	FieldResourceProviderID = "resource_provider_id"
 	// FieldPrivate holds the string denoting the private field in the database.
 	FieldPrivate = "private"
+	// FieldDeleting holds the string denoting the deleting field in the database.
+	FieldDeleting = "deleting"
+	// FieldJSONSchema holds the string denoting the json_schema field in the database.
+	FieldJSONSchema = "json_schema"
+	// FieldDefaultTTL holds the string denoting the default_ttl field in the database.
+	FieldDefaultTTL = "default_ttl"
 	// EdgeStatuses holds the string denoting the statuses edge name in mutations.
 	EdgeStatuses = "statuses"
 	// Table holds the table name of the statusnamespace in the database.
@@ -57,9 +65,13 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 	FieldName,
 	FieldResourceProviderID,
 	FieldPrivate,
+	FieldDeleting,
+	FieldJSONSchema,
+	FieldDefaultTTL,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -85,6 +97,10 @@ var (
 	ResourceProviderIDValidator func(string) error
 	// DefaultPrivate holds the default value on creation for the "private" field.
 	DefaultPrivate bool
+	// DefaultDeleting holds the default value on creation for the "deleting" field.
+	DefaultDeleting bool
+	// DefaultTTLValidator is a validator for the "default_ttl" field. It is called by the builders before save.
+	DefaultTTLValidator func(int64) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() gidx.PrefixedID
 )
@@ -107,6 +123,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -122,6 +143,16 @@ func ByPrivate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPrivate, opts...).ToFunc()
 }
 
+// ByDeleting orders the results by the deleting field.
+func ByDeleting(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleting, opts...).ToFunc()
+}
+
+// ByDefaultTTL orders the results by the default_ttl field.
+func ByDefaultTTL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultTTL, opts...).ToFunc()
+}
+
 // ByStatusesCount orders the results by statuses count.
 func ByStatusesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
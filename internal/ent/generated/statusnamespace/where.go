@@ -80,6 +80,11 @@ func UpdatedAt(v time.Time) predicate.StatusNamespace {
 	return predicate.StatusNamespace(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.StatusNamespace {
 	return predicate.StatusNamespace(sql.FieldEQ(FieldName, v))
@@ -95,6 +100,16 @@ func Private(v bool) predicate.StatusNamespace {
 	return predicate.StatusNamespace(sql.FieldEQ(FieldPrivate, v))
 }
 
+// Deleting applies equality check predicate on the "deleting" field. It's identical to DeletingEQ.
+func Deleting(v bool) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldEQ(FieldDeleting, v))
+}
+
+// DefaultTTL applies equality check predicate on the "default_ttl" field. It's identical to DefaultTTLEQ.
+func DefaultTTL(v int64) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldEQ(FieldDefaultTTL, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.StatusNamespace {
 	return predicate.StatusNamespace(sql.FieldEQ(FieldCreatedAt, v))
@@ -175,6 +190,56 @@ func UpdatedAtLTE(v time.Time) predicate.StatusNamespace {
 	return predicate.StatusNamespace(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.StatusNamespace {
 	return predicate.StatusNamespace(sql.FieldEQ(FieldName, v))
@@ -320,6 +385,76 @@ func PrivateNEQ(v bool) predicate.StatusNamespace {
 	return predicate.StatusNamespace(sql.FieldNEQ(FieldPrivate, v))
 }
 
+// DeletingEQ applies the EQ predicate on the "deleting" field.
+func DeletingEQ(v bool) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldEQ(FieldDeleting, v))
+}
+
+// DeletingNEQ applies the NEQ predicate on the "deleting" field.
+func DeletingNEQ(v bool) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldNEQ(FieldDeleting, v))
+}
+
+// JSONSchemaIsNil applies the IsNil predicate on the "json_schema" field.
+func JSONSchemaIsNil() predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldIsNull(FieldJSONSchema))
+}
+
+// JSONSchemaNotNil applies the NotNil predicate on the "json_schema" field.
+func JSONSchemaNotNil() predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldNotNull(FieldJSONSchema))
+}
+
+// DefaultTTLEQ applies the EQ predicate on the "default_ttl" field.
+func DefaultTTLEQ(v int64) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldEQ(FieldDefaultTTL, v))
+}
+
+// DefaultTTLNEQ applies the NEQ predicate on the "default_ttl" field.
+func DefaultTTLNEQ(v int64) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldNEQ(FieldDefaultTTL, v))
+}
+
+// DefaultTTLIn applies the In predicate on the "default_ttl" field.
+func DefaultTTLIn(vs ...int64) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldIn(FieldDefaultTTL, vs...))
+}
+
+// DefaultTTLNotIn applies the NotIn predicate on the "default_ttl" field.
+func DefaultTTLNotIn(vs ...int64) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldNotIn(FieldDefaultTTL, vs...))
+}
+
+// DefaultTTLGT applies the GT predicate on the "default_ttl" field.
+func DefaultTTLGT(v int64) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldGT(FieldDefaultTTL, v))
+}
+
+// DefaultTTLGTE applies the GTE predicate on the "default_ttl" field.
+func DefaultTTLGTE(v int64) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldGTE(FieldDefaultTTL, v))
+}
+
+// DefaultTTLLT applies the LT predicate on the "default_ttl" field.
+func DefaultTTLLT(v int64) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldLT(FieldDefaultTTL, v))
+}
+
+// DefaultTTLLTE applies the LTE predicate on the "default_ttl" field.
+func DefaultTTLLTE(v int64) predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldLTE(FieldDefaultTTL, v))
+}
+
+// DefaultTTLIsNil applies the IsNil predicate on the "default_ttl" field.
+func DefaultTTLIsNil() predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldIsNull(FieldDefaultTTL))
+}
+
+// DefaultTTLNotNil applies the NotNil predicate on the "default_ttl" field.
+func DefaultTTLNotNil() predicate.StatusNamespace {
+	return predicate.StatusNamespace(sql.FieldNotNull(FieldDefaultTTL))
+}
+
 // HasStatuses applies the HasEdge predicate on the "statuses" edge.
 func HasStatuses() predicate.StatusNamespace {
 	return predicate.StatusNamespace(func(s *sql.Selector) {
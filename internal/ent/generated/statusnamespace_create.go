@@ -18,6 +18,7 @@ package generated
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -64,6 +65,20 @@ func (snc *StatusNamespaceCreate) SetNillableUpdatedAt(t *time.Time) *StatusName
 	return snc
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (snc *StatusNamespaceCreate) SetDeletedAt(t time.Time) *StatusNamespaceCreate {
+	snc.mutation.SetDeletedAt(t)
+	return snc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (snc *StatusNamespaceCreate) SetNillableDeletedAt(t *time.Time) *StatusNamespaceCreate {
+	if t != nil {
+		snc.SetDeletedAt(*t)
+	}
+	return snc
+}
+
 // SetName sets the "name" field.
 func (snc *StatusNamespaceCreate) SetName(s string) *StatusNamespaceCreate {
 	snc.mutation.SetName(s)
@@ -90,6 +105,40 @@ func (snc *StatusNamespaceCreate) SetNillablePrivate(b *bool) *StatusNamespaceCr
 	return snc
 }
 
+// SetDeleting sets the "deleting" field.
+func (snc *StatusNamespaceCreate) SetDeleting(b bool) *StatusNamespaceCreate {
+	snc.mutation.SetDeleting(b)
+	return snc
+}
+
+// SetNillableDeleting sets the "deleting" field if the given value is not nil.
+func (snc *StatusNamespaceCreate) SetNillableDeleting(b *bool) *StatusNamespaceCreate {
+	if b != nil {
+		snc.SetDeleting(*b)
+	}
+	return snc
+}
+
+// SetJSONSchema sets the "json_schema" field.
+func (snc *StatusNamespaceCreate) SetJSONSchema(jm json.RawMessage) *StatusNamespaceCreate {
+	snc.mutation.SetJSONSchema(jm)
+	return snc
+}
+
+// SetDefaultTTL sets the "default_ttl" field.
+func (snc *StatusNamespaceCreate) SetDefaultTTL(i int64) *StatusNamespaceCreate {
+	snc.mutation.SetDefaultTTL(i)
+	return snc
+}
+
+// SetNillableDefaultTTL sets the "default_ttl" field if the given value is not nil.
+func (snc *StatusNamespaceCreate) SetNillableDefaultTTL(i *int64) *StatusNamespaceCreate {
+	if i != nil {
+		snc.SetDefaultTTL(*i)
+	}
+	return snc
+}
+
 // SetID sets the "id" field.
 func (snc *StatusNamespaceCreate) SetID(gi gidx.PrefixedID) *StatusNamespaceCreate {
 	snc.mutation.SetID(gi)
@@ -166,6 +215,10 @@ func (snc *StatusNamespaceCreate) defaults() {
 		v := statusnamespace.DefaultPrivate
 		snc.mutation.SetPrivate(v)
 	}
+	if _, ok := snc.mutation.Deleting(); !ok {
+		v := statusnamespace.DefaultDeleting
+		snc.mutation.SetDeleting(v)
+	}
 	if _, ok := snc.mutation.ID(); !ok {
 		v := statusnamespace.DefaultID()
 		snc.mutation.SetID(v)
@@ -199,6 +252,14 @@ func (snc *StatusNamespaceCreate) check() error {
 	if _, ok := snc.mutation.Private(); !ok {
 		return &ValidationError{Name: "private", err: errors.New(`generated: missing required field "StatusNamespace.private"`)}
 	}
+	if _, ok := snc.mutation.Deleting(); !ok {
+		return &ValidationError{Name: "deleting", err: errors.New(`generated: missing required field "StatusNamespace.deleting"`)}
+	}
+	if v, ok := snc.mutation.DefaultTTL(); ok {
+		if err := statusnamespace.DefaultTTLValidator(v); err != nil {
+			return &ValidationError{Name: "default_ttl", err: fmt.Errorf(`generated: validator failed for field "StatusNamespace.default_ttl": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -242,6 +303,10 @@ func (snc *StatusNamespaceCreate) createSpec() (*StatusNamespace, *sqlgraph.Crea
 		_spec.SetField(statusnamespace.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := snc.mutation.DeletedAt(); ok {
+		_spec.SetField(statusnamespace.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := snc.mutation.Name(); ok {
 		_spec.SetField(statusnamespace.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -254,6 +319,18 @@ func (snc *StatusNamespaceCreate) createSpec() (*StatusNamespace, *sqlgraph.Crea
 		_spec.SetField(statusnamespace.FieldPrivate, field.TypeBool, value)
 		_node.Private = value
 	}
+	if value, ok := snc.mutation.Deleting(); ok {
+		_spec.SetField(statusnamespace.FieldDeleting, field.TypeBool, value)
+		_node.Deleting = value
+	}
+	if value, ok := snc.mutation.JSONSchema(); ok {
+		_spec.SetField(statusnamespace.FieldJSONSchema, field.TypeJSON, value)
+		_node.JSONSchema = value
+	}
+	if value, ok := snc.mutation.DefaultTTL(); ok {
+		_spec.SetField(statusnamespace.FieldDefaultTTL, field.TypeInt64, value)
+		_node.DefaultTTL = &value
+	}
 	if nodes := snc.mutation.StatusesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
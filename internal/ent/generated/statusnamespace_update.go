@@ -18,11 +18,14 @@ package generated
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
@@ -43,6 +46,26 @@ func (snu *StatusNamespaceUpdate) Where(ps ...predicate.StatusNamespace) *Status
 	return snu
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (snu *StatusNamespaceUpdate) SetDeletedAt(t time.Time) *StatusNamespaceUpdate {
+	snu.mutation.SetDeletedAt(t)
+	return snu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (snu *StatusNamespaceUpdate) SetNillableDeletedAt(t *time.Time) *StatusNamespaceUpdate {
+	if t != nil {
+		snu.SetDeletedAt(*t)
+	}
+	return snu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (snu *StatusNamespaceUpdate) ClearDeletedAt() *StatusNamespaceUpdate {
+	snu.mutation.ClearDeletedAt()
+	return snu
+}
+
 // SetName sets the "name" field.
 func (snu *StatusNamespaceUpdate) SetName(s string) *StatusNamespaceUpdate {
 	snu.mutation.SetName(s)
@@ -63,6 +86,65 @@ func (snu *StatusNamespaceUpdate) SetNillablePrivate(b *bool) *StatusNamespaceUp
 	return snu
 }
 
+// SetDeleting sets the "deleting" field.
+func (snu *StatusNamespaceUpdate) SetDeleting(b bool) *StatusNamespaceUpdate {
+	snu.mutation.SetDeleting(b)
+	return snu
+}
+
+// SetNillableDeleting sets the "deleting" field if the given value is not nil.
+func (snu *StatusNamespaceUpdate) SetNillableDeleting(b *bool) *StatusNamespaceUpdate {
+	if b != nil {
+		snu.SetDeleting(*b)
+	}
+	return snu
+}
+
+// SetJSONSchema sets the "json_schema" field.
+func (snu *StatusNamespaceUpdate) SetJSONSchema(jm json.RawMessage) *StatusNamespaceUpdate {
+	snu.mutation.SetJSONSchema(jm)
+	return snu
+}
+
+// AppendJSONSchema appends jm to the "json_schema" field.
+func (snu *StatusNamespaceUpdate) AppendJSONSchema(jm json.RawMessage) *StatusNamespaceUpdate {
+	snu.mutation.AppendJSONSchema(jm)
+	return snu
+}
+
+// ClearJSONSchema clears the value of the "json_schema" field.
+func (snu *StatusNamespaceUpdate) ClearJSONSchema() *StatusNamespaceUpdate {
+	snu.mutation.ClearJSONSchema()
+	return snu
+}
+
+// SetDefaultTTL sets the "default_ttl" field.
+func (snu *StatusNamespaceUpdate) SetDefaultTTL(i int64) *StatusNamespaceUpdate {
+	snu.mutation.ResetDefaultTTL()
+	snu.mutation.SetDefaultTTL(i)
+	return snu
+}
+
+// SetNillableDefaultTTL sets the "default_ttl" field if the given value is not nil.
+func (snu *StatusNamespaceUpdate) SetNillableDefaultTTL(i *int64) *StatusNamespaceUpdate {
+	if i != nil {
+		snu.SetDefaultTTL(*i)
+	}
+	return snu
+}
+
+// AddDefaultTTL adds i to the "default_ttl" field.
+func (snu *StatusNamespaceUpdate) AddDefaultTTL(i int64) *StatusNamespaceUpdate {
+	snu.mutation.AddDefaultTTL(i)
+	return snu
+}
+
+// ClearDefaultTTL clears the value of the "default_ttl" field.
+func (snu *StatusNamespaceUpdate) ClearDefaultTTL() *StatusNamespaceUpdate {
+	snu.mutation.ClearDefaultTTL()
+	return snu
+}
+
 // AddStatusIDs adds the "statuses" edge to the Status entity by IDs.
 func (snu *StatusNamespaceUpdate) AddStatusIDs(ids ...gidx.PrefixedID) *StatusNamespaceUpdate {
 	snu.mutation.AddStatusIDs(ids...)
@@ -147,6 +229,11 @@ func (snu *StatusNamespaceUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "StatusNamespace.name": %w`, err)}
 		}
 	}
+	if v, ok := snu.mutation.DefaultTTL(); ok {
+		if err := statusnamespace.DefaultTTLValidator(v); err != nil {
+			return &ValidationError{Name: "default_ttl", err: fmt.Errorf(`generated: validator failed for field "StatusNamespace.default_ttl": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -165,12 +252,41 @@ func (snu *StatusNamespaceUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := snu.mutation.UpdatedAt(); ok {
 		_spec.SetField(statusnamespace.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := snu.mutation.DeletedAt(); ok {
+		_spec.SetField(statusnamespace.FieldDeletedAt, field.TypeTime, value)
+	}
+	if snu.mutation.DeletedAtCleared() {
+		_spec.ClearField(statusnamespace.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := snu.mutation.Name(); ok {
 		_spec.SetField(statusnamespace.FieldName, field.TypeString, value)
 	}
 	if value, ok := snu.mutation.Private(); ok {
 		_spec.SetField(statusnamespace.FieldPrivate, field.TypeBool, value)
 	}
+	if value, ok := snu.mutation.Deleting(); ok {
+		_spec.SetField(statusnamespace.FieldDeleting, field.TypeBool, value)
+	}
+	if value, ok := snu.mutation.JSONSchema(); ok {
+		_spec.SetField(statusnamespace.FieldJSONSchema, field.TypeJSON, value)
+	}
+	if value, ok := snu.mutation.AppendedJSONSchema(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, statusnamespace.FieldJSONSchema, value)
+		})
+	}
+	if snu.mutation.JSONSchemaCleared() {
+		_spec.ClearField(statusnamespace.FieldJSONSchema, field.TypeJSON)
+	}
+	if value, ok := snu.mutation.DefaultTTL(); ok {
+		_spec.SetField(statusnamespace.FieldDefaultTTL, field.TypeInt64, value)
+	}
+	if value, ok := snu.mutation.AddedDefaultTTL(); ok {
+		_spec.AddField(statusnamespace.FieldDefaultTTL, field.TypeInt64, value)
+	}
+	if snu.mutation.DefaultTTLCleared() {
+		_spec.ClearField(statusnamespace.FieldDefaultTTL, field.TypeInt64)
+	}
 	if snu.mutation.StatusesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -236,6 +352,26 @@ type StatusNamespaceUpdateOne struct {
 	mutation *StatusNamespaceMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (snuo *StatusNamespaceUpdateOne) SetDeletedAt(t time.Time) *StatusNamespaceUpdateOne {
+	snuo.mutation.SetDeletedAt(t)
+	return snuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (snuo *StatusNamespaceUpdateOne) SetNillableDeletedAt(t *time.Time) *StatusNamespaceUpdateOne {
+	if t != nil {
+		snuo.SetDeletedAt(*t)
+	}
+	return snuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (snuo *StatusNamespaceUpdateOne) ClearDeletedAt() *StatusNamespaceUpdateOne {
+	snuo.mutation.ClearDeletedAt()
+	return snuo
+}
+
 // SetName sets the "name" field.
 func (snuo *StatusNamespaceUpdateOne) SetName(s string) *StatusNamespaceUpdateOne {
 	snuo.mutation.SetName(s)
@@ -256,6 +392,65 @@ func (snuo *StatusNamespaceUpdateOne) SetNillablePrivate(b *bool) *StatusNamespa
 	return snuo
 }
 
+// SetDeleting sets the "deleting" field.
+func (snuo *StatusNamespaceUpdateOne) SetDeleting(b bool) *StatusNamespaceUpdateOne {
+	snuo.mutation.SetDeleting(b)
+	return snuo
+}
+
+// SetNillableDeleting sets the "deleting" field if the given value is not nil.
+func (snuo *StatusNamespaceUpdateOne) SetNillableDeleting(b *bool) *StatusNamespaceUpdateOne {
+	if b != nil {
+		snuo.SetDeleting(*b)
+	}
+	return snuo
+}
+
+// SetJSONSchema sets the "json_schema" field.
+func (snuo *StatusNamespaceUpdateOne) SetJSONSchema(jm json.RawMessage) *StatusNamespaceUpdateOne {
+	snuo.mutation.SetJSONSchema(jm)
+	return snuo
+}
+
+// AppendJSONSchema appends jm to the "json_schema" field.
+func (snuo *StatusNamespaceUpdateOne) AppendJSONSchema(jm json.RawMessage) *StatusNamespaceUpdateOne {
+	snuo.mutation.AppendJSONSchema(jm)
+	return snuo
+}
+
+// ClearJSONSchema clears the value of the "json_schema" field.
+func (snuo *StatusNamespaceUpdateOne) ClearJSONSchema() *StatusNamespaceUpdateOne {
+	snuo.mutation.ClearJSONSchema()
+	return snuo
+}
+
+// SetDefaultTTL sets the "default_ttl" field.
+func (snuo *StatusNamespaceUpdateOne) SetDefaultTTL(i int64) *StatusNamespaceUpdateOne {
+	snuo.mutation.ResetDefaultTTL()
+	snuo.mutation.SetDefaultTTL(i)
+	return snuo
+}
+
+// SetNillableDefaultTTL sets the "default_ttl" field if the given value is not nil.
+func (snuo *StatusNamespaceUpdateOne) SetNillableDefaultTTL(i *int64) *StatusNamespaceUpdateOne {
+	if i != nil {
+		snuo.SetDefaultTTL(*i)
+	}
+	return snuo
+}
+
+// AddDefaultTTL adds i to the "default_ttl" field.
+func (snuo *StatusNamespaceUpdateOne) AddDefaultTTL(i int64) *StatusNamespaceUpdateOne {
+	snuo.mutation.AddDefaultTTL(i)
+	return snuo
+}
+
+// ClearDefaultTTL clears the value of the "default_ttl" field.
+func (snuo *StatusNamespaceUpdateOne) ClearDefaultTTL() *StatusNamespaceUpdateOne {
+	snuo.mutation.ClearDefaultTTL()
+	return snuo
+}
+
 // AddStatusIDs adds the "statuses" edge to the Status entity by IDs.
 func (snuo *StatusNamespaceUpdateOne) AddStatusIDs(ids ...gidx.PrefixedID) *StatusNamespaceUpdateOne {
 	snuo.mutation.AddStatusIDs(ids...)
@@ -353,6 +548,11 @@ func (snuo *StatusNamespaceUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`generated: validator failed for field "StatusNamespace.name": %w`, err)}
 		}
 	}
+	if v, ok := snuo.mutation.DefaultTTL(); ok {
+		if err := statusnamespace.DefaultTTLValidator(v); err != nil {
+			return &ValidationError{Name: "default_ttl", err: fmt.Errorf(`generated: validator failed for field "StatusNamespace.default_ttl": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -388,12 +588,41 @@ func (snuo *StatusNamespaceUpdateOne) sqlSave(ctx context.Context) (_node *Statu
 	if value, ok := snuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(statusnamespace.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := snuo.mutation.DeletedAt(); ok {
+		_spec.SetField(statusnamespace.FieldDeletedAt, field.TypeTime, value)
+	}
+	if snuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(statusnamespace.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := snuo.mutation.Name(); ok {
 		_spec.SetField(statusnamespace.FieldName, field.TypeString, value)
 	}
 	if value, ok := snuo.mutation.Private(); ok {
 		_spec.SetField(statusnamespace.FieldPrivate, field.TypeBool, value)
 	}
+	if value, ok := snuo.mutation.Deleting(); ok {
+		_spec.SetField(statusnamespace.FieldDeleting, field.TypeBool, value)
+	}
+	if value, ok := snuo.mutation.JSONSchema(); ok {
+		_spec.SetField(statusnamespace.FieldJSONSchema, field.TypeJSON, value)
+	}
+	if value, ok := snuo.mutation.AppendedJSONSchema(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, statusnamespace.FieldJSONSchema, value)
+		})
+	}
+	if snuo.mutation.JSONSchemaCleared() {
+		_spec.ClearField(statusnamespace.FieldJSONSchema, field.TypeJSON)
+	}
+	if value, ok := snuo.mutation.DefaultTTL(); ok {
+		_spec.SetField(statusnamespace.FieldDefaultTTL, field.TypeInt64, value)
+	}
+	if value, ok := snuo.mutation.AddedDefaultTTL(); ok {
+		_spec.AddField(statusnamespace.FieldDefaultTTL, field.TypeInt64, value)
+	}
+	if snuo.mutation.DefaultTTLCleared() {
+		_spec.ClearField(statusnamespace.FieldDefaultTTL, field.TypeInt64)
+	}
 	if snuo.mutation.StatusesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
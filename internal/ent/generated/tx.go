@@ -28,12 +28,18 @@ type Tx struct {
 	config
 	// Annotation is the client for interacting with the Annotation builders.
 	Annotation *AnnotationClient
+	// AnnotationHistory is the client for interacting with the AnnotationHistory builders.
+	AnnotationHistory *AnnotationHistoryClient
 	// AnnotationNamespace is the client for interacting with the AnnotationNamespace builders.
 	AnnotationNamespace *AnnotationNamespaceClient
 	// Metadata is the client for interacting with the Metadata builders.
 	Metadata *MetadataClient
+	// NamespaceDeletion is the client for interacting with the NamespaceDeletion builders.
+	NamespaceDeletion *NamespaceDeletionClient
 	// Status is the client for interacting with the Status builders.
 	Status *StatusClient
+	// StatusHistory is the client for interacting with the StatusHistory builders.
+	StatusHistory *StatusHistoryClient
 	// StatusNamespace is the client for interacting with the StatusNamespace builders.
 	StatusNamespace *StatusNamespaceClient
 
@@ -168,9 +174,12 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Annotation = NewAnnotationClient(tx.config)
+	tx.AnnotationHistory = NewAnnotationHistoryClient(tx.config)
 	tx.AnnotationNamespace = NewAnnotationNamespaceClient(tx.config)
 	tx.Metadata = NewMetadataClient(tx.config)
+	tx.NamespaceDeletion = NewNamespaceDeletionClient(tx.config)
 	tx.Status = NewStatusClient(tx.config)
+	tx.StatusHistory = NewStatusHistoryClient(tx.config)
 	tx.StatusNamespace = NewStatusNamespaceClient(tx.config)
 }
 
@@ -0,0 +1,230 @@
+// Package historyhooks provides ent hooks which record the history of changes
+// made to statuses and annotations.
+package historyhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"go.infratographer.com/x/echojwtx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
+	"go.infratographer.com/metadata-api/internal/ent/generated/hook"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
+)
+
+// ErrUnexpectedResult is returned when a create mutation doesn't return the created entity.
+var ErrUnexpectedResult = errors.New("unexpected mutation result")
+
+// HistoryHooks registers the history hooks on the client.
+func HistoryHooks(c *generated.Client) {
+	c.Annotation.Use(AnnotationHooks()...)
+
+	c.Status.Use(StatusHooks()...)
+}
+
+// StatusHooks returns the hooks which add a StatusHistory record for each status
+// that is created, updated or deleted.
+func StatusHooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.StatusFunc(func(ctx context.Context, m *generated.StatusMutation) (ent.Value, error) {
+					retValue, err := next.Mutate(ctx, m)
+					if err != nil {
+						return retValue, err
+					}
+
+					st, ok := retValue.(*generated.Status)
+					if !ok {
+						return retValue, fmt.Errorf("%w: %T", ErrUnexpectedResult, retValue)
+					}
+
+					if err := m.Client().StatusHistory.Create().
+						SetCreatedAt(st.CreatedAt).
+						SetStatusID(st.ID).
+						SetMetadataID(st.MetadataID).
+						SetStatusNamespaceID(st.StatusNamespaceID).
+						SetSource(st.Source).
+						SetOperation(statushistory.OperationCREATE).
+						SetActor(actor(ctx)).
+						Exec(ctx); err != nil {
+						return nil, fmt.Errorf("failed to record status history: %w", err)
+					}
+
+					return retValue, nil
+				})
+			},
+			ent.OpCreate,
+		),
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.StatusFunc(func(ctx context.Context, m *generated.StatusMutation) (ent.Value, error) {
+					ids, err := m.IDs(ctx)
+					if err != nil {
+						return nil, err
+					}
+
+					// load the statuses before they are changed so the previous values can be recorded
+					prev, err := m.Client().Status.Query().Where(status.IDIn(ids...)).All(ctx)
+					if err != nil {
+						return nil, err
+					}
+
+					retValue, err := next.Mutate(ctx, m)
+					if err != nil {
+						return retValue, err
+					}
+
+					if len(prev) == 0 {
+						return retValue, nil
+					}
+
+					op := statushistory.OperationDELETE
+					changedAt := time.Now()
+
+					if m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+						op = statushistory.OperationUPDATE
+
+						if updatedAt, ok := m.UpdatedAt(); ok {
+							changedAt = updatedAt
+						}
+					}
+
+					builders := make([]*generated.StatusHistoryCreate, len(prev))
+
+					for i, st := range prev {
+						builders[i] = m.Client().StatusHistory.Create().
+							SetCreatedAt(changedAt).
+							SetStatusID(st.ID).
+							SetMetadataID(st.MetadataID).
+							SetStatusNamespaceID(st.StatusNamespaceID).
+							SetSource(st.Source).
+							SetOperation(op).
+							SetData(st.Data).
+							SetActor(actor(ctx)).
+							SetStatusCreatedAt(st.CreatedAt).
+							SetStatusUpdatedAt(st.UpdatedAt).
+							SetNillableStatusExpiresAt(st.ExpiresAt).
+							SetStatusVersion(st.Version)
+					}
+
+					if err := m.Client().StatusHistory.CreateBulk(builders...).Exec(ctx); err != nil {
+						return nil, fmt.Errorf("failed to record status history: %w", err)
+					}
+
+					return retValue, nil
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+		),
+	}
+}
+
+// AnnotationHooks returns the hooks which add an AnnotationHistory record for each
+// annotation that is created, updated or deleted.
+func AnnotationHooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.AnnotationFunc(func(ctx context.Context, m *generated.AnnotationMutation) (ent.Value, error) {
+					retValue, err := next.Mutate(ctx, m)
+					if err != nil {
+						return retValue, err
+					}
+
+					ant, ok := retValue.(*generated.Annotation)
+					if !ok {
+						return retValue, fmt.Errorf("%w: %T", ErrUnexpectedResult, retValue)
+					}
+
+					if err := m.Client().AnnotationHistory.Create().
+						SetCreatedAt(ant.CreatedAt).
+						SetAnnotationID(ant.ID).
+						SetMetadataID(ant.MetadataID).
+						SetAnnotationNamespaceID(ant.AnnotationNamespaceID).
+						SetOperation(annotationhistory.OperationCREATE).
+						SetActor(actor(ctx)).
+						Exec(ctx); err != nil {
+						return nil, fmt.Errorf("failed to record annotation history: %w", err)
+					}
+
+					return retValue, nil
+				})
+			},
+			ent.OpCreate,
+		),
+		hook.On(
+			func(next ent.Mutator) ent.Mutator {
+				return hook.AnnotationFunc(func(ctx context.Context, m *generated.AnnotationMutation) (ent.Value, error) {
+					ids, err := m.IDs(ctx)
+					if err != nil {
+						return nil, err
+					}
+
+					// load the annotations before they are changed so the previous values can be recorded
+					prev, err := m.Client().Annotation.Query().Where(annotation.IDIn(ids...)).All(ctx)
+					if err != nil {
+						return nil, err
+					}
+
+					retValue, err := next.Mutate(ctx, m)
+					if err != nil {
+						return retValue, err
+					}
+
+					if len(prev) == 0 {
+						return retValue, nil
+					}
+
+					op := annotationhistory.OperationDELETE
+					changedAt := time.Now()
+
+					if m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+						op = annotationhistory.OperationUPDATE
+
+						if updatedAt, ok := m.UpdatedAt(); ok {
+							changedAt = updatedAt
+						}
+					}
+
+					builders := make([]*generated.AnnotationHistoryCreate, len(prev))
+
+					for i, ant := range prev {
+						builders[i] = m.Client().AnnotationHistory.Create().
+							SetCreatedAt(changedAt).
+							SetAnnotationID(ant.ID).
+							SetMetadataID(ant.MetadataID).
+							SetAnnotationNamespaceID(ant.AnnotationNamespaceID).
+							SetOperation(op).
+							SetData(ant.Data).
+							SetActor(actor(ctx)).
+							SetAnnotationCreatedAt(ant.CreatedAt).
+							SetAnnotationUpdatedAt(ant.UpdatedAt).
+							SetAnnotationVersion(ant.Version)
+					}
+
+					if err := m.Client().AnnotationHistory.CreateBulk(builders...).Exec(ctx); err != nil {
+						return nil, fmt.Errorf("failed to record annotation history: %w", err)
+					}
+
+					return retValue, nil
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+		),
+	}
+}
+
+// actor returns the subject of the authenticated request, if there is one.
+func actor(ctx context.Context) string {
+	subject, _ := ctx.Value(echojwtx.ActorCtxKey).(string)
+
+	return subject
+}
@@ -64,6 +64,12 @@ func (Annotation) Fields() []ent.Field {
 			Annotations(
 				entgql.Type("JSON"),
 			),
+		field.Int64("version").
+			Comment("Version of the annotation, incremented each time its data is set.").
+			Default(1).
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput, entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 	}
 }
 
@@ -121,3 +127,15 @@ func prefixIDDirective(prefix string) entgql.Annotation {
 
 	return entgql.Directives(entgql.NewDirective("prefixedID", args...))
 }
+
+// forceResolverDirective makes gqlgen generate a resolver for the field, so access
+// to it can be checked before it is loaded.
+func forceResolverDirective() entgql.Annotation {
+	return entgql.Directives(entgql.NewDirective("goField", &ast.Argument{
+		Name: "forceResolver",
+		Value: &ast.Value{
+			Raw:  "true",
+			Kind: ast.BooleanValue,
+		},
+	}))
+}
@@ -0,0 +1,119 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"go.infratographer.com/x/gidx"
+)
+
+// AnnotationHistory holds the schema definition for the AnnotationHistory entity. Each
+// record is an append-only snapshot of an annotation taken before it was changed.
+type AnnotationHistory struct {
+	ent.Schema
+}
+
+// Fields of the AnnotationHistory.
+func (AnnotationHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			GoType(gidx.PrefixedID("")).
+			DefaultFunc(func() gidx.PrefixedID { return gidx.MustNewID(AnnotationHistoryPrefix) }).
+			Unique().
+			Immutable(),
+		field.Time("created_at").
+			Comment("Time the change to the annotation was made.").
+			Default(time.Now).
+			Immutable().
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
+		field.String("annotation_id").
+			Comment("ID of the annotation that was changed.").
+			GoType(gidx.PrefixedID("")).
+			NotEmpty().
+			Immutable().
+			Annotations(
+				entgql.Type("ID"),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		field.String("metadata_id").
+			Comment("ID of the metadata of the annotation.").
+			GoType(gidx.PrefixedID("")).
+			NotEmpty().
+			Immutable().
+			Annotations(
+				entgql.Type("ID"),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		field.String("annotation_namespace_id").
+			Comment("ID of the annotation namespace of the annotation.").
+			GoType(gidx.PrefixedID("")).
+			NotEmpty().
+			Immutable().
+			Annotations(
+				entgql.Type("ID"),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		field.Enum("operation").
+			Comment("The kind of change made to the annotation.").
+			Values("CREATE", "UPDATE", "DELETE").
+			Immutable(),
+		field.JSON("data", json.RawMessage{}).
+			Comment("JSON formatted data of the annotation before the change. Empty when the annotation was created.").
+			StorageKey("json_data").
+			Optional().
+			Immutable().
+			Annotations(
+				entgql.Type("JSON"),
+			),
+		field.String("actor").
+			Comment("The subject that made the change, if known.").
+			Optional().
+			Immutable(),
+		field.Time("annotation_created_at").
+			Comment("Time the annotation was created. Empty when the annotation was created by this change.").
+			Optional().
+			Nillable().
+			Immutable().
+			Annotations(
+				entgql.Skip(),
+			),
+		field.Time("annotation_updated_at").
+			Comment("Time the annotation was last updated before this change. Empty when the annotation was created by this change.").
+			Optional().
+			Nillable().
+			Immutable().
+			Annotations(
+				entgql.Skip(),
+			),
+		field.Int64("annotation_version").
+			Comment("Version of the annotation before this change.").
+			Default(1).
+			Immutable().
+			Annotations(
+				entgql.Skip(),
+			),
+	}
+}
+
+// Indexes of the AnnotationHistory
+func (AnnotationHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("annotation_id", "created_at"),
+		index.Fields("metadata_id", "created_at"),
+	}
+}
+
+// Annotations for the AnnotationHistory
+func (AnnotationHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		prefixIDDirective(AnnotationHistoryPrefix),
+		entgql.RelayConnection(),
+	}
+}
@@ -1,8 +1,11 @@
 package schema
 
 import (
+	"encoding/json"
+
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -20,6 +23,7 @@ type AnnotationNamespace struct {
 func (AnnotationNamespace) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		entx.NewTimestampMixin(),
+		SoftDeleteMixin{},
 	}
 }
 
@@ -61,6 +65,18 @@ func (AnnotationNamespace) Fields() []ent.Field {
 				entgql.Skip(entgql.SkipWhereInput),
 				entgql.OrderField("PRIVATE"),
 			),
+		field.Bool("deleting").
+			Default(false).
+			Comment("Flag for if this namespace is being deleted with its annotations. No annotations can be written to it.").
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput, entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.JSON("json_schema", json.RawMessage{}).
+			Optional().
+			Comment("JSON Schema that annotation data in this namespace must validate against.").
+			Annotations(
+				entgql.Type("JSON"),
+			),
 	}
 }
 
@@ -68,7 +84,8 @@ func (AnnotationNamespace) Fields() []ent.Field {
 func (AnnotationNamespace) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("owner_id"),
-		index.Fields("owner_id", "name").Unique(),
+		// the names of deleted namespaces can be reused while they wait to be purged
+		index.Fields("owner_id", "name").Unique().Annotations(entsql.IndexWhere("deleted_at IS NULL")),
 	}
 }
 
@@ -79,6 +96,8 @@ func (AnnotationNamespace) Edges() []ent.Edge {
 			Ref("namespace").
 			Annotations(
 				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+				entgql.RelayConnection(),
+				forceResolverDirective(),
 			),
 	}
 }
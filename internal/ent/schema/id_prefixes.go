@@ -7,10 +7,16 @@ const (
 	MetadataPrefix string = ApplicationPrefix + "dat"
 	// AnnotationPrefix is the prefix for all annotations
 	AnnotationPrefix string = ApplicationPrefix + "ano"
+	// AnnotationHistoryPrefix is the prefix for all annotation history records
+	AnnotationHistoryPrefix string = ApplicationPrefix + "anh"
 	// AnnotationNamespacePrefix is the prefix for all annotation namespaces
 	AnnotationNamespacePrefix string = ApplicationPrefix + "mns"
 	// StatusPrefix is the prefix for all statuses
 	StatusPrefix string = ApplicationPrefix + "sts"
+	// StatusHistoryPrefix is the prefix for all status history records
+	StatusHistoryPrefix string = ApplicationPrefix + "sth"
 	// StatusNamespacePrefix is the prefix for all status namespaces
 	StatusNamespacePrefix string = ApplicationPrefix + "sns"
+	// NamespaceDeletionPrefix is the prefix for all namespace deletion jobs
+	NamespaceDeletionPrefix string = ApplicationPrefix + "ndl"
 )
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"go.infratographer.com/x/entx"
+	"go.infratographer.com/x/gidx"
+)
+
+// NamespaceDeletion holds the schema definition for the NamespaceDeletion entity.
+// Each record is a job which deletes a namespace with its statuses or annotations
+// in the background.
+type NamespaceDeletion struct {
+	ent.Schema
+}
+
+// Mixin of the NamespaceDeletion
+func (NamespaceDeletion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		entx.NewTimestampMixin(),
+	}
+}
+
+// Fields of the NamespaceDeletion.
+func (NamespaceDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			GoType(gidx.PrefixedID("")).
+			DefaultFunc(func() gidx.PrefixedID { return gidx.MustNewID(NamespaceDeletionPrefix) }).
+			Unique().
+			Immutable().
+			Comment("The ID for the namespace deletion."),
+		field.String("namespace_id").
+			GoType(gidx.PrefixedID("")).
+			NotEmpty().
+			Immutable().
+			Comment("ID of the annotation or status namespace being deleted.").
+			Annotations(
+				entgql.Type("ID"),
+			),
+		field.String("owner_id").
+			GoType(gidx.PrefixedID("")).
+			NotEmpty().
+			Immutable().
+			Comment("ID of the owner or resource provider of the namespace.").
+			Annotations(
+				entgql.Type("ID"),
+			),
+		field.Enum("state").
+			Values("PENDING", "RUNNING", "COMPLETED", "CANCELED").
+			Default("PENDING").
+			Comment("The state of the deletion. Pending deletions are canceled when the namespace is restored."),
+		field.Int("total_count").
+			NonNegative().
+			Immutable().
+			Comment("Number of statuses or annotations in the namespace when the deletion was started."),
+		field.Int("deleted_count").
+			NonNegative().
+			Default(0).
+			Comment("Number of statuses or annotations deleted so far."),
+		field.Time("completed_at").
+			Optional().
+			Nillable().
+			Comment("Time the namespace was deleted."),
+	}
+}
+
+// Indexes of the NamespaceDeletion
+func (NamespaceDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("namespace_id"),
+		index.Fields("state"),
+	}
+}
+
+// Annotations for the NamespaceDeletion
+func (NamespaceDeletion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		prefixIDDirective(NamespaceDeletionPrefix),
+		schema.Comment("Representation of the deletion of a namespace with its statuses or annotations, which runs in the background."),
+	}
+}
@@ -68,6 +68,20 @@ func (Status) Fields() []ent.Field {
 			Annotations(
 				entgql.Type("JSON"),
 			),
+		field.Int64("version").
+			Comment("Version of the status, incremented each time its data is set.").
+			Default(1).
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput, entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.Time("expires_at").
+			Comment("Time the status expires. Expired statuses are hidden and eventually deleted.").
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.OrderField("EXPIRES_AT"),
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 	}
 }
 
@@ -76,6 +90,7 @@ func (Status) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("metadata_id", "status_namespace_id"),
 		index.Fields("metadata_id", "status_namespace_id", "source").Unique(),
+		index.Fields("expires_at"),
 		index.Fields("status_namespace_id", "data").Annotations(
 			entsql.IndexTypes(map[string]string{
 				dialect.Postgres: "GIN",
@@ -0,0 +1,131 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"go.infratographer.com/x/gidx"
+)
+
+// StatusHistory holds the schema definition for the StatusHistory entity. Each
+// record is an append-only snapshot of a status taken before it was changed.
+type StatusHistory struct {
+	ent.Schema
+}
+
+// Fields of the StatusHistory.
+func (StatusHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			GoType(gidx.PrefixedID("")).
+			DefaultFunc(func() gidx.PrefixedID { return gidx.MustNewID(StatusHistoryPrefix) }).
+			Unique().
+			Immutable(),
+		field.Time("created_at").
+			Comment("Time the change to the status was made.").
+			Default(time.Now).
+			Immutable().
+			Annotations(
+				entgql.OrderField("CREATED_AT"),
+			),
+		field.String("status_id").
+			Comment("ID of the status that was changed.").
+			GoType(gidx.PrefixedID("")).
+			NotEmpty().
+			Immutable().
+			Annotations(
+				entgql.Type("ID"),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		field.String("metadata_id").
+			Comment("ID of the metadata of the status.").
+			GoType(gidx.PrefixedID("")).
+			NotEmpty().
+			Immutable().
+			Annotations(
+				entgql.Type("ID"),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		field.String("status_namespace_id").
+			Comment("ID of the status namespace of the status.").
+			GoType(gidx.PrefixedID("")).
+			NotEmpty().
+			Immutable().
+			Annotations(
+				entgql.Type("ID"),
+				entgql.Skip(entgql.SkipWhereInput),
+			),
+		field.String("source").
+			Comment("Source of the status.").
+			NotEmpty().
+			Immutable(),
+		field.Enum("operation").
+			Comment("The kind of change made to the status.").
+			Values("CREATE", "UPDATE", "DELETE").
+			Immutable(),
+		field.JSON("data", json.RawMessage{}).
+			Comment("JSON formatted data of the status before the change. Empty when the status was created.").
+			StorageKey("json_data").
+			Optional().
+			Immutable().
+			Annotations(
+				entgql.Type("JSON"),
+			),
+		field.String("actor").
+			Comment("The subject that made the change, if known.").
+			Optional().
+			Immutable(),
+		field.Time("status_created_at").
+			Comment("Time the status was created. Empty when the status was created by this change.").
+			Optional().
+			Nillable().
+			Immutable().
+			Annotations(
+				entgql.Skip(),
+			),
+		field.Time("status_updated_at").
+			Comment("Time the status was last updated before this change. Empty when the status was created by this change.").
+			Optional().
+			Nillable().
+			Immutable().
+			Annotations(
+				entgql.Skip(),
+			),
+		field.Time("status_expires_at").
+			Comment("Time the status expired at before this change, if it had an expiry.").
+			Optional().
+			Nillable().
+			Immutable().
+			Annotations(
+				entgql.Skip(),
+			),
+		field.Int64("status_version").
+			Comment("Version of the status before this change.").
+			Default(1).
+			Immutable().
+			Annotations(
+				entgql.Skip(),
+			),
+	}
+}
+
+// Indexes of the StatusHistory
+func (StatusHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status_id", "created_at"),
+		index.Fields("metadata_id", "created_at"),
+	}
+}
+
+// Annotations for the StatusHistory
+func (StatusHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		prefixIDDirective(StatusHistoryPrefix),
+		entgql.RelayConnection(),
+	}
+}
@@ -1,8 +1,11 @@
 package schema
 
 import (
+	"encoding/json"
+
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -20,6 +23,7 @@ type StatusNamespace struct {
 func (StatusNamespace) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		entx.NewTimestampMixin(),
+		SoftDeleteMixin{},
 	}
 }
 
@@ -61,6 +65,26 @@ func (StatusNamespace) Fields() []ent.Field {
 				entgql.Skip(entgql.SkipWhereInput),
 				entgql.OrderField("PRIVATE"),
 			),
+		field.Bool("deleting").
+			Default(false).
+			Comment("Flag for if this namespace is being deleted with its statuses. No statuses can be written to it.").
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput, entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
+		field.JSON("json_schema", json.RawMessage{}).
+			Optional().
+			Comment("JSON Schema that status data in this namespace must validate against.").
+			Annotations(
+				entgql.Type("JSON"),
+			),
+		field.Int64("default_ttl").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Number of seconds statuses in this namespace are kept after they were last updated, unless the update sets its own expiry.").
+			Annotations(
+				entgql.Skip(entgql.SkipWhereInput),
+			),
 	}
 }
 
@@ -68,7 +92,8 @@ func (StatusNamespace) Fields() []ent.Field {
 func (StatusNamespace) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("resource_provider_id"),
-		index.Fields("resource_provider_id", "name").Unique(),
+		// the names of deleted namespaces can be reused while they wait to be purged
+		index.Fields("resource_provider_id", "name").Unique().Annotations(entsql.IndexWhere("deleted_at IS NULL")),
 	}
 }
 
@@ -78,7 +103,9 @@ func (StatusNamespace) Edges() []ent.Edge {
 		edge.From("statuses", Status.Type).
 			Ref("namespace").
 			Annotations(
-				entgql.Skip(entgql.SkipAll),
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+				entgql.RelayConnection(),
+				forceResolverDirective(),
 			),
 	}
 }
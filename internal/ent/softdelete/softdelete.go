@@ -0,0 +1,69 @@
+// Package softdelete provides ent interceptors which hide soft deleted namespaces
+// from queries, along with their statuses and annotations.
+package softdelete
+
+import (
+	"context"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/generated/intercept"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+)
+
+type includeDeletedCtxKey struct{}
+
+// IncludeDeleted returns a context in which queries don't hide soft deleted
+// records, such as to restore or purge them.
+func IncludeDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedCtxKey{}, true)
+}
+
+func includeDeleted(ctx context.Context) bool {
+	include, _ := ctx.Value(includeDeletedCtxKey{}).(bool)
+
+	return include
+}
+
+// Interceptors registers the interceptors which hide soft deleted namespaces on
+// the client. The statuses and annotations of a namespace aren't changed when it's
+// deleted, they're hidden through their namespace instead, so deleting and
+// restoring a namespace is a single update however many records it has.
+//
+// Statuses and annotations are hidden by joining them with the namespaces which
+// aren't deleted, which are found with the deleted_at index.
+func Interceptors(c *generated.Client) {
+	c.AnnotationNamespace.Intercept(intercept.TraverseAnnotationNamespace(func(ctx context.Context, q *generated.AnnotationNamespaceQuery) error {
+		if !includeDeleted(ctx) {
+			q.Where(annotationnamespace.DeletedAtIsNil())
+		}
+
+		return nil
+	}))
+
+	c.Annotation.Intercept(intercept.TraverseAnnotation(func(ctx context.Context, q *generated.AnnotationQuery) error {
+		if !includeDeleted(ctx) {
+			q.Where(annotation.HasNamespaceWith(annotationnamespace.DeletedAtIsNil()))
+		}
+
+		return nil
+	}))
+
+	c.StatusNamespace.Intercept(intercept.TraverseStatusNamespace(func(ctx context.Context, q *generated.StatusNamespaceQuery) error {
+		if !includeDeleted(ctx) {
+			q.Where(statusnamespace.DeletedAtIsNil())
+		}
+
+		return nil
+	}))
+
+	c.Status.Intercept(intercept.TraverseStatus(func(ctx context.Context, q *generated.StatusQuery) error {
+		if !includeDeleted(ctx) {
+			q.Where(status.HasNamespaceWith(statusnamespace.DeletedAtIsNil()))
+		}
+
+		return nil
+	}))
+}
@@ -0,0 +1,194 @@
+// Package gc removes the metadata of nodes once they are deleted.
+package gc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/schema"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
+)
+
+const (
+	// retryDelay is how long a message is delayed before it's redelivered after
+	// failing to remove the metadata of the node.
+	retryDelay = 10 * time.Second
+)
+
+// Config defines the gc configuration structure
+type Config struct {
+	// Topics are the change topics to listen for node delete events on. The gc is disabled when there are none.
+	Topics []string
+}
+
+// Collector removes the metadata of nodes when a delete event is received for them.
+type Collector struct {
+	client     *ent.Client
+	subscriber events.Subscriber
+	logger     *zap.SugaredLogger
+	topics     []string
+}
+
+// New returns a collector which listens for delete events with the subscriber
+// and removes metadata with the client.
+func New(client *ent.Client, subscriber events.Subscriber, logger *zap.SugaredLogger, cfg Config) *Collector {
+	return &Collector{
+		client:     client,
+		subscriber: subscriber,
+		logger:     logger,
+		topics:     cfg.Topics,
+	}
+}
+
+// Run subscribes to the topics and handles delete events until the context is
+// canceled.
+func (c *Collector) Run(ctx context.Context) error {
+	if len(c.topics) == 0 {
+		c.logger.Info("metadata gc disabled")
+
+		return nil
+	}
+
+	msgs := make([]<-chan events.Message[events.ChangeMessage], len(c.topics))
+
+	for i, topic := range c.topics {
+		ch, err := c.subscriber.SubscribeChanges(ctx, topic)
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to %s: %w", topic, err)
+		}
+
+		msgs[i] = ch
+	}
+
+	done := make(chan struct{}, len(msgs))
+
+	for _, ch := range msgs {
+		go func(ch <-chan events.Message[events.ChangeMessage]) {
+			defer func() { done <- struct{}{} }()
+
+			for msg := range ch {
+				c.handle(ctx, msg)
+			}
+		}(ch)
+	}
+
+	for range msgs {
+		<-done
+	}
+
+	return nil
+}
+
+// handle removes the metadata of the subject of a delete event. Messages are
+// redelivered when the metadata couldn't be removed.
+func (c *Collector) handle(ctx context.Context, msg events.Message[events.ChangeMessage]) {
+	logger := c.logger.With("topic", msg.Topic(), "messageID", msg.ID())
+
+	if err := msg.Error(); err != nil {
+		logger.Errorw("failed to decode message", "error", err)
+
+		if err := msg.Term(); err != nil {
+			logger.Errorw("failed to terminate message", "error", err)
+		}
+
+		return
+	}
+
+	change := msg.Message()
+
+	// the records of this service don't have metadata, their delete events are published
+	// on the same topics when topics such as delete.> are subscribed to
+	if change.EventType != string(events.DeleteChangeType) || strings.HasPrefix(change.SubjectID.Prefix(), schema.ApplicationPrefix) {
+		if err := msg.Ack(); err != nil {
+			logger.Errorw("failed to ack message", "error", err)
+		}
+
+		return
+	}
+
+	ctx = change.GetTraceContext(ctx)
+
+	if err := c.CollectNode(ctx, change.SubjectID); err != nil {
+		logger.Errorw("failed to remove metadata of deleted node", "nodeID", change.SubjectID, "error", err)
+
+		if err := msg.Nak(retryDelay); err != nil {
+			logger.Errorw("failed to nak message", "error", err)
+		}
+
+		return
+	}
+
+	if err := msg.Ack(); err != nil {
+		logger.Errorw("failed to ack message", "error", err)
+	}
+}
+
+// CollectNode removes the metadata of the node along with its annotations and
+// statuses. Each record is deleted on its own so the hooks of the client publish
+// a delete event and record the history of each. Nothing is done if the node has
+// no metadata.
+func (c *Collector) CollectNode(ctx context.Context, nodeID gidx.PrefixedID) error {
+	// statuses and annotations in soft deleted namespaces are removed as well
+	ctx = softdelete.IncludeDeleted(ctx)
+
+	tx, err := c.client.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	md, err := tx.Metadata.Query().Where(metadata.NodeID(nodeID)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	antIDs, err := tx.Annotation.Query().Where(annotation.MetadataID(md.ID)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range antIDs {
+		if err := tx.Annotation.DeleteOneID(id).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	stIDs, err := tx.Status.Query().Where(status.MetadataID(md.ID)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range stIDs {
+		if err := tx.Status.DeleteOneID(id).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Metadata.DeleteOneID(md.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	c.logger.Infow("removed metadata of deleted node", "nodeID", nodeID, "annotations", len(antIDs), "statuses", len(stIDs))
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package gc_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"go.infratographer.com/x/testing/eventtools"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/metadata-api/internal/ent/bulkhooks"
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/historyhooks"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
+	"go.infratographer.com/metadata-api/internal/gc"
+)
+
+func TestCollectorRemovesMetadataOfDeletedNodes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	nats, err := eventtools.NewNatsServer()
+	require.NoError(t, err)
+
+	defer nats.Close()
+
+	conn, err := events.NewConnection(nats.Config)
+	require.NoError(t, err)
+
+	client, err := ent.Open(dialect.SQLite, "file:gc?mode=memory&cache=shared&_fk=1", ent.EventsPublisher(conn))
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	require.NoError(t, client.Schema.Create(ctx))
+
+	bulkhooks.EventHooks(client)
+	historyhooks.HistoryHooks(client)
+
+	deletedNode := gidx.MustNewID("loadbal")
+	otherNode := gidx.MustNewID("loadbal")
+
+	antNS := client.AnnotationNamespace.Create().SetName("gc-tests").SetOwnerID(gidx.MustNewID("tnntten")).SaveX(ctx)
+	stNS := client.StatusNamespace.Create().SetName("gc-tests").SetResourceProviderID(gidx.MustNewID("rcrspro")).SaveX(ctx)
+
+	var deletedStatus gidx.PrefixedID
+
+	for _, nodeID := range []gidx.PrefixedID{deletedNode, otherNode} {
+		md := client.Metadata.Create().SetNodeID(nodeID).SaveX(ctx)
+		client.Annotation.Create().SetMetadata(md).SetNamespace(antNS).SetData(json.RawMessage(`{"a":1}`)).SaveX(ctx)
+		st := client.Status.Create().SetMetadata(md).SetNamespace(stNS).SetSource("gc-tests").SetData(json.RawMessage(`{"b":2}`)).SaveX(ctx)
+
+		if nodeID == deletedNode {
+			deletedStatus = st.ID
+		}
+	}
+
+	statusDeletes, err := conn.SubscribeChanges(ctx, "delete.status")
+	require.NoError(t, err)
+
+	go func() {
+		assert.NoError(t, gc.New(client, conn, zap.NewNop().Sugar(), gc.Config{Topics: []string{"delete.>"}}).Run(ctx))
+	}()
+
+	// changes that aren't deletes are ignored
+	_, err = conn.PublishChange(ctx, "load-balancer", events.ChangeMessage{
+		SubjectID: otherNode,
+		EventType: string(events.UpdateChangeType),
+		Timestamp: time.Now().UTC(),
+	})
+	require.NoError(t, err)
+
+	_, err = conn.PublishChange(ctx, "load-balancer", events.ChangeMessage{
+		SubjectID: deletedNode,
+		EventType: string(events.DeleteChangeType),
+		Timestamp: time.Now().UTC(),
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return !client.Metadata.Query().Where(metadata.NodeID(deletedNode)).ExistX(ctx)
+	}, 5*time.Second, 50*time.Millisecond)
+
+	md := client.Metadata.Query().Where(metadata.NodeID(otherNode)).OnlyX(ctx)
+	assert.Equal(t, 1, md.QueryAnnotations().CountX(ctx))
+	assert.Equal(t, 1, md.QueryStatuses().CountX(ctx))
+
+	assert.Equal(t, 1, client.Annotation.Query().CountX(ctx))
+	assert.Equal(t, 1, client.Status.Query().CountX(ctx))
+
+	// the statuses are deleted through ent, so their delete events are published
+	select {
+	case msg := <-statusDeletes:
+		require.NoError(t, msg.Error())
+		assert.Equal(t, deletedStatus, msg.Message().SubjectID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the status delete event")
+	}
+}
+
+func TestCollectNodeWithoutMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := ent.Open(dialect.SQLite, "file:gc-empty?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	require.NoError(t, client.Schema.Create(ctx))
+
+	err = gc.New(client, nil, zap.NewNop().Sugar(), gc.Config{}).CollectNode(ctx, gidx.MustNewID("loadbal"))
+	assert.NoError(t, err)
+}
+
+func TestCollectNodeInDeletedNamespaces(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := ent.Open(dialect.SQLite, "file:gc-soft-deleted?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+
+	defer client.Close()
+
+	require.NoError(t, client.Schema.Create(ctx))
+
+	softdelete.Interceptors(client)
+
+	nodeID := gidx.MustNewID("loadbal")
+
+	antNS := client.AnnotationNamespace.Create().SetName("gc-deleted").SetOwnerID(gidx.MustNewID("tnntten")).SetDeletedAt(time.Now()).SaveX(ctx)
+	md := client.Metadata.Create().SetNodeID(nodeID).SaveX(ctx)
+	client.Annotation.Create().SetMetadata(md).SetNamespace(antNS).SetData(json.RawMessage(`{"a":1}`)).SaveX(ctx)
+
+	// the annotation is hidden, but still has to be removed with the metadata
+	err = gc.New(client, nil, zap.NewNop().Sugar(), gc.Config{}).CollectNode(ctx, nodeID)
+	require.NoError(t, err)
+
+	assert.False(t, client.Metadata.Query().Where(metadata.NodeID(nodeID)).ExistX(ctx))
+	assert.Equal(t, 0, client.Annotation.Query().CountX(softdelete.IncludeDeleted(ctx)))
+}
@@ -7,107 +7,122 @@ package graphapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
+	"entgo.io/contrib/entgql"
 	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
 
 	"go.infratographer.com/metadata-api/internal/ent/generated"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/pubsub"
 )
 
+// History is the resolver for the history field.
+func (r *annotationResolver) History(ctx context.Context, obj *generated.Annotation, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AnnotationHistoryOrder, where *generated.AnnotationHistoryWhereInput) (*generated.AnnotationHistoryConnection, error) {
+	// history is listed in the order the changes were made unless asked otherwise
+	if orderBy == nil {
+		orderBy = &generated.AnnotationHistoryOrder{Direction: entgql.OrderDirectionAsc, Field: generated.AnnotationHistoryOrderFieldCreatedAt}
+	}
+
+	return r.client.AnnotationHistory.Query().Where(annotationhistory.AnnotationID(obj.ID)).Paginate(ctx, after, first, before, last, generated.WithAnnotationHistoryOrder(orderBy), generated.WithAnnotationHistoryFilter(where.Filter))
+}
+
 // AnnotationUpdate is the resolver for the annotationUpdate field.
 func (r *mutationResolver) AnnotationUpdate(ctx context.Context, input AnnotationUpdateInput) (*AnnotationUpdateResponse, error) {
-	logger := r.logger.With("nodeID", input.NodeID, "namespaceID", input.NamespaceID)
-
-	if input.NamespaceID == "" {
-		return nil, NewInvalidFieldError("namespaceID", ErrFieldEmpty)
+	ref, err := validateAnnotationUpdateInput(input)
+	if err != nil {
+		return nil, err
 	}
 
-	if _, err := gidx.Parse(input.NamespaceID.String()); err != nil {
-		return nil, NewInvalidFieldError("namespaceID", err)
+	nsID, err := r.annotationNamespaceID(ctx, ref)
+	if err != nil {
+		return nil, err
 	}
 
-	if input.NodeID == "" {
-		return nil, NewInvalidFieldError("nodeID", ErrFieldEmpty)
+	ns, err := r.annotationNamespaceForUpdate(ctx, nsID)
+	if err != nil {
+		return nil, err
 	}
 
-	if _, err := gidx.Parse(input.NodeID.String()); err != nil {
-		return nil, NewInvalidFieldError("nodeID", err)
+	var ant *generated.Annotation
+
+	err = r.upsertTx(ctx, func(tx *generated.Tx) error {
+		ant, err = r.upsertAnnotation(ctx, tx, ns, input)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if !json.Valid(input.Data) {
-		return nil, NewInvalidFieldError("data", ErrInvalidJSON)
+	return &AnnotationUpdateResponse{Annotation: ant.Unwrap()}, nil
+}
+
+// AnnotationUpdateBatch is the resolver for the annotationUpdateBatch field.
+func (r *mutationResolver) AnnotationUpdateBatch(ctx context.Context, input AnnotationUpdateBatchInput) (*AnnotationUpdateBatchResponse, error) {
+	if len(input.Items) == 0 {
+		return nil, NewInvalidFieldError("items", ErrFieldEmpty)
 	}
 
-	_, err := r.client.AnnotationNamespace.Get(ctx, input.NamespaceID)
-	if err != nil {
-		if generated.IsNotFound(err) {
+	// each namespace is only looked up and checked once
+	namespace := memoize(func(ref namespaceRef) (*generated.AnnotationNamespace, error) {
+		id, err := r.annotationNamespaceID(ctx, ref)
+		if err != nil {
 			return nil, err
 		}
 
-		logger.Errorw("failed to get annotation namespace", "error", err)
-		return nil, ErrInternalServerError
-	}
+		return r.annotationNamespaceForUpdate(ctx, id)
+	})
 
-	if err := permissions.CheckAccess(ctx, input.NamespaceID, actionMetadataAnnotationNamespaceUpdate); err != nil {
-		return nil, err
-	}
+	var (
+		annotations []*generated.Annotation
+		errs        []error
+	)
 
-	ant, err := r.client.Annotation.Query().Where(
-		annotation.AnnotationNamespaceID(input.NamespaceID),
-		annotation.HasMetadataWith(metadata.NodeID(input.NodeID)),
-	).First(ctx)
-	if err != nil {
-		// The annotation doesn't exist, create it
-		if generated.IsNotFound(err) {
-			md, err := r.client.Metadata.Query().Where(metadata.NodeID(input.NodeID)).First(ctx)
+	err := r.upsertTx(ctx, func(tx *generated.Tx) (err error) {
+		annotations, errs, err = runBatch(len(input.Items), input.Mode, func(i int) (*generated.Annotation, error) {
+			item := *input.Items[i]
+
+			ref, err := validateAnnotationUpdateInput(item)
 			if err != nil {
-				// metadata doesn't exist, create it
-				if generated.IsNotFound(err) {
-					md, err = r.client.Metadata.Create().SetNodeID(input.NodeID).Save(ctx)
-					if err != nil {
-						logger.Errorw("failed to create metadata", "error", err)
-						return nil, ErrInternalServerError
-					}
-				} else {
-					logger.Errorw("failed to get metadata", "error", err)
-					return nil, ErrInternalServerError
-				}
+				return nil, err
 			}
 
-			ant, err = r.client.Annotation.Create().SetMetadata(md).SetAnnotationNamespaceID(input.NamespaceID).SetData(input.Data).Save(ctx)
+			ns, err := namespace(ref)
 			if err != nil {
-				logger.Errorw("failed to create annotation", "error", err)
-				return nil, ErrInternalServerError
+				return nil, err
 			}
 
-			return &AnnotationUpdateResponse{Annotation: ant}, nil
-		} else {
-			logger.Errorw("failed to get annotation", "error", err)
-			return nil, ErrInternalServerError
-		}
-	}
+			return r.upsertAnnotation(ctx, tx, ns, item)
+		})
 
-	ant, err = ant.Update().SetData(input.Data).Save(ctx)
+		return err
+	})
 	if err != nil {
-		logger.Errorw("failed to update annotation", "error", err)
-		return nil, ErrInternalServerError
+		return nil, err
 	}
 
-	return &AnnotationUpdateResponse{Annotation: ant}, nil
-}
+	results := make([]*AnnotationUpdateBatchResult, len(annotations))
 
-// AnnotationDelete is the resolver for the annotationDelete field.
-func (r *mutationResolver) AnnotationDelete(ctx context.Context, input AnnotationDeleteInput) (*AnnotationDeleteResponse, error) {
-	logger := r.logger.With("nodeID", input.NodeID, "namespaceID", input.NamespaceID)
+	for i, ant := range annotations {
+		results[i] = &AnnotationUpdateBatchResult{Error: batchError(errs[i])}
 
-	if input.NamespaceID == "" {
-		return nil, NewInvalidFieldError("namespaceID", ErrFieldEmpty)
+		if ant != nil {
+			results[i].Annotation = ant.Unwrap()
+		}
 	}
 
-	if _, err := gidx.Parse(input.NamespaceID.String()); err != nil {
-		return nil, NewInvalidFieldError("namespaceID", err)
+	return &AnnotationUpdateBatchResponse{Results: results}, nil
+}
+
+// AnnotationDelete is the resolver for the annotationDelete field.
+func (r *mutationResolver) AnnotationDelete(ctx context.Context, input AnnotationDeleteInput) (*AnnotationDeleteResponse, error) {
+	ref, err := newNamespaceRef(input.NamespaceID, input.OwnerID, input.NamespaceName)
+	if err != nil {
+		return nil, err
 	}
 
 	if input.NodeID == "" {
@@ -118,8 +133,15 @@ func (r *mutationResolver) AnnotationDelete(ctx context.Context, input Annotatio
 		return nil, NewInvalidFieldError("nodeID", err)
 	}
 
+	nsID, err := r.annotationNamespaceID(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := r.logger.With("nodeID", input.NodeID, "namespaceID", nsID)
+
 	ant, err := r.client.Annotation.Query().Where(
-		annotation.AnnotationNamespaceID(input.NamespaceID),
+		annotation.AnnotationNamespaceID(nsID),
 		annotation.HasMetadataWith(metadata.NodeID(input.NodeID)),
 	).First(ctx)
 	if err != nil {
@@ -131,11 +153,36 @@ func (r *mutationResolver) AnnotationDelete(ctx context.Context, input Annotatio
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, input.NamespaceID, actionMetadataAnnotationNamespaceUpdate); err != nil {
+	if err := permissions.CheckAccess(ctx, nsID, actionMetadataAnnotationNamespaceUpdate); err != nil {
 		return nil, err
 	}
 
-	if err = r.client.Annotation.DeleteOne(ant).Exec(ctx); err != nil {
+	if input.ExpectedVersion != nil {
+		if err := checkVersion(input.ExpectedVersion, ant.Version); err != nil {
+			return nil, err
+		}
+	}
+
+	// delete in a transaction so the history of the annotation is recorded with the delete
+	err = r.runTx(ctx, func(tx *generated.Tx) error {
+		del := tx.Annotation.DeleteOneID(ant.ID)
+
+		if input.ExpectedVersion != nil {
+			// only delete the version checked, in case the annotation changed since
+			del.Where(annotation.Version(ant.Version))
+		}
+
+		return del.Exec(ctx)
+	})
+	if err != nil {
+		if errors.Is(err, ErrInternalServerError) {
+			return nil, err
+		}
+
+		if generated.IsNotFound(err) && input.ExpectedVersion != nil {
+			return nil, NewInvalidFieldError("expectedVersion", ErrVersionConflict)
+		}
+
 		logger.Errorw("failed to delete annotation", "error", err)
 		return nil, ErrInternalServerError
 	}
@@ -143,7 +190,83 @@ func (r *mutationResolver) AnnotationDelete(ctx context.Context, input Annotatio
 	return &AnnotationDeleteResponse{DeletedID: ant.ID}, nil
 }
 
+// AnnotationChanged is the resolver for the annotationChanged field.
+func (r *subscriptionResolver) AnnotationChanged(ctx context.Context, nodeID gidx.PrefixedID, namespaceID *gidx.PrefixedID) (<-chan *AnnotationChange, error) {
+	if err := validateSubscription(nodeID, namespaceID); err != nil {
+		return nil, err
+	}
+
+	if err := permissions.CheckAccess(ctx, nodeID, actionMetadataAnnotationList); err != nil {
+		return nil, err
+	}
+
+	changes, err := r.subscribeChanges(ctx, pubsub.TopicAnnotation, nodeID, namespaceID, actionMetadataAnnotationNamespaceGet, r.annotationNamespaceHidden)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *AnnotationChange)
+
+	go func() {
+		defer close(out)
+
+		for change := range changes {
+			ac := &AnnotationChange{Operation: ChangeOperation(change.Operation), AnnotationID: change.ID}
+
+			if change.Operation != pubsub.OperationDelete {
+				ant, err := r.client.Annotation.Get(ctx, change.ID)
+				if err != nil && !generated.IsNotFound(err) {
+					r.logger.Errorw("failed to get changed annotation", "annotationID", change.ID, "error", err)
+					continue
+				}
+
+				ac.Annotation = ant
+			}
+
+			select {
+			case out <- ac:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// DataContains is the resolver for the dataContains field.
+func (r *annotationWhereInputResolver) DataContains(ctx context.Context, obj *generated.AnnotationWhereInput, data json.RawMessage) error {
+	p, err := dataContains(annotation.FieldData, data)
+	if err != nil {
+		return NewInvalidFieldError("dataContains", err)
+	}
+
+	obj.AddPredicates(p)
+
+	return nil
+}
+
+// DataPath is the resolver for the dataPath field.
+func (r *annotationWhereInputResolver) DataPath(ctx context.Context, obj *generated.AnnotationWhereInput, data *DataPathPredicate) error {
+	if data == nil {
+		return nil
+	}
+
+	p, err := dataPathEQ(annotation.FieldData, data.Path, data.Value)
+	if err != nil {
+		return NewInvalidFieldError("dataPath", err)
+	}
+
+	obj.AddPredicates(p)
+
+	return nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type mutationResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
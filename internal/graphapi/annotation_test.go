@@ -3,8 +3,11 @@ package graphapi_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/99designs/gqlgen/client"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -31,13 +34,21 @@ func TestAnnotationUpdate(t *testing.T) {
 
 	meta1 := MetadataBuilder{}.MustNew(ctx)
 	ant1 := AnnotationBuilder{Metadata: meta1}.MustNew(ctx)
+	schemaNS := AnnotationNamespaceBuilder{JSONSchema: json.RawMessage(`{"type":"object","properties":{"size":{"type":"integer"}},"required":["size"]}`)}.MustNew(ctx)
+
+	patchAnt := AnnotationBuilder{Metadata: meta1, Data: json.RawMessage(`{"owner":"team-a","labels":{"env":"prod","tier":"web"}}`)}.MustNew(ctx)
+
+	mergePatch := testclient.DataUpdateModeMergePatch
+	jsonPatch := testclient.DataUpdateModeJSONPatch
 
 	testCases := []struct {
-		TestName    string
-		NodeID      gidx.PrefixedID
-		NamespaceID gidx.PrefixedID
-		JSONData    json.RawMessage // optional, otherwise generated
-		ErrorMsg    string
+		TestName     string
+		NodeID       gidx.PrefixedID
+		NamespaceID  gidx.PrefixedID
+		JSONData     json.RawMessage // optional, otherwise generated
+		Mode         *testclient.DataUpdateMode
+		ExpectedData json.RawMessage // optional, otherwise JSONData
+		ErrorMsg     string
 	}{
 		{
 			TestName:    "Will create annotation for a node we don't have metadata for",
@@ -91,6 +102,50 @@ func TestAnnotationUpdate(t *testing.T) {
 			JSONData:    json.RawMessage(`{{}`),
 			ErrorMsg:    "error calling MarshalJSON",
 		},
+		{
+			TestName:    "Will create annotation when data matches the namespace json schema",
+			NodeID:      gidx.MustNewID("testing"),
+			NamespaceID: schemaNS.ID,
+			JSONData:    json.RawMessage(`{"size":3}`),
+		},
+		{
+			TestName:    "Fails when data doesn't match the namespace json schema",
+			NodeID:      gidx.MustNewID("testing"),
+			NamespaceID: schemaNS.ID,
+			JSONData:    json.RawMessage(`{"size":"large"}`),
+			ErrorMsg:    "data: does not match namespace json schema: /size: expected integer, but got string",
+		},
+		{
+			TestName:    "Fails when data is missing a field required by the namespace json schema",
+			NodeID:      gidx.MustNewID("testing"),
+			NamespaceID: schemaNS.ID,
+			JSONData:    json.RawMessage(`{"color":"blue"}`),
+			ErrorMsg:    "missing properties: 'size'",
+		},
+		{
+			TestName:     "Will merge patch the stored annotation data",
+			NodeID:       meta1.NodeID,
+			NamespaceID:  patchAnt.AnnotationNamespaceID,
+			JSONData:     json.RawMessage(`{"labels":{"tier":null,"region":"us"}}`),
+			Mode:         &mergePatch,
+			ExpectedData: json.RawMessage(`{"owner":"team-a","labels":{"env":"prod","region":"us"}}`),
+		},
+		{
+			TestName:     "Will apply a json patch to the stored annotation data",
+			NodeID:       meta1.NodeID,
+			NamespaceID:  patchAnt.AnnotationNamespaceID,
+			JSONData:     json.RawMessage(`[{"op":"test","path":"/owner","value":"team-a"},{"op":"replace","path":"/owner","value":"team-b"}]`),
+			Mode:         &jsonPatch,
+			ExpectedData: json.RawMessage(`{"owner":"team-b","labels":{"env":"prod","region":"us"}}`),
+		},
+		{
+			TestName:    "Fails when a json patch test operation doesn't match",
+			NodeID:      meta1.NodeID,
+			NamespaceID: patchAnt.AnnotationNamespaceID,
+			JSONData:    json.RawMessage(`[{"op":"test","path":"/owner","value":"team-a"}]`),
+			Mode:        &jsonPatch,
+			ErrorMsg:    "data: invalid patch",
+		},
 	}
 
 	for _, tt := range testCases {
@@ -101,7 +156,7 @@ func TestAnnotationUpdate(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			resp, err := graphTestClient().AnnotationUpdate(ctx, testclient.AnnotationUpdateInput{NodeID: tt.NodeID, NamespaceID: tt.NamespaceID, Data: tt.JSONData})
+			resp, err := graphTestClient().AnnotationUpdate(ctx, testclient.AnnotationUpdateInput{NodeID: tt.NodeID, NamespaceID: newID(tt.NamespaceID), Data: tt.JSONData, Mode: tt.Mode})
 
 			if tt.ErrorMsg != "" {
 				assert.Error(t, err)
@@ -110,9 +165,13 @@ func TestAnnotationUpdate(t *testing.T) {
 				return
 			}
 
+			if tt.ExpectedData == nil {
+				tt.ExpectedData = tt.JSONData
+			}
+
 			require.NoError(t, err)
 			assert.NotNil(t, resp.AnnotationUpdate.Annotation)
-			assert.JSONEq(t, string(tt.JSONData), string(resp.AnnotationUpdate.Annotation.Data))
+			assert.JSONEq(t, string(tt.ExpectedData), string(resp.AnnotationUpdate.Annotation.Data))
 
 			antCount := EntClient.Annotation.Query().Where(annotation.AnnotationNamespaceID(tt.NamespaceID), annotation.HasMetadataWith(metadata.NodeID(tt.NodeID))).CountX(ctx)
 			assert.Equal(t, 1, antCount)
@@ -177,7 +236,7 @@ func TestAnnotationDelete(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.TestName, func(t *testing.T) {
-			resp, err := graphTestClient().AnnotationDelete(ctx, testclient.AnnotationDeleteInput{NodeID: tt.NodeID, NamespaceID: tt.NamespaceID})
+			resp, err := graphTestClient().AnnotationDelete(ctx, testclient.AnnotationDeleteInput{NodeID: tt.NodeID, NamespaceID: newID(tt.NamespaceID)})
 
 			if tt.ErrorMsg != "" {
 				assert.Error(t, err)
@@ -195,3 +254,400 @@ func TestAnnotationDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestAnnotationUpdateVersion(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	nodeID := gidx.MustNewID("testing")
+
+	// the steps are run in order against the same annotation
+	testCases := []struct {
+		TestName        string
+		ExpectedVersion *int64
+		Delete          bool
+		Version         int64
+		errorMsg        string
+	}{
+		{
+			TestName:        "Creates an annotation that must not exist yet",
+			ExpectedVersion: newInt64(0),
+			Version:         1,
+		},
+		{
+			TestName:        "Fails to create an annotation that exists",
+			ExpectedVersion: newInt64(0),
+			errorMsg:        "expectedVersion: doesn't match the current version",
+		},
+		{
+			TestName:        "Updates the current version",
+			ExpectedVersion: newInt64(1),
+			Version:         2,
+		},
+		{
+			TestName: "Increments the version without an expected version",
+			Version:  3,
+		},
+		{
+			TestName:        "Fails to update an outdated version",
+			ExpectedVersion: newInt64(2),
+			errorMsg:        "expectedVersion: doesn't match the current version",
+		},
+		{
+			TestName:        "Fails to delete an outdated version",
+			ExpectedVersion: newInt64(2),
+			Delete:          true,
+			errorMsg:        "expectedVersion: doesn't match the current version",
+		},
+		{
+			TestName:        "Deletes the current version",
+			ExpectedVersion: newInt64(3),
+			Delete:          true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			if tt.Delete {
+				_, err := graphTestClient().AnnotationDelete(ctx, testclient.AnnotationDeleteInput{
+					NodeID:          nodeID,
+					NamespaceID:     newID(ns.ID),
+					ExpectedVersion: tt.ExpectedVersion,
+				})
+
+				if tt.errorMsg != "" {
+					require.Error(t, err)
+					assert.ErrorContains(t, err, tt.errorMsg)
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				return
+			}
+
+			resp, err := graphTestClient().AnnotationUpdate(ctx, testclient.AnnotationUpdateInput{
+				NodeID:          nodeID,
+				NamespaceID:     newID(ns.ID),
+				Data:            json.RawMessage(`{"owner":"team-a"}`),
+				ExpectedVersion: tt.ExpectedVersion,
+			})
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tt.Version, resp.AnnotationUpdate.Annotation.Version)
+		})
+	}
+}
+
+func TestAnnotationUpdateChecksAccessFirst(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns := AnnotationNamespaceBuilder{}.MustNew(ctx)
+
+	denyCtx := context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, _ ...permissions.AccessRequest) error {
+		return permissions.ErrPermissionDenied
+	}))
+
+	// callers without access can't tell whether the namespace exists
+	for _, nsID := range []gidx.PrefixedID{ns.ID, gidx.MustNewID("metamns")} {
+		_, err := graphTestClient().AnnotationUpdate(denyCtx, testclient.AnnotationUpdateInput{
+			NodeID:      gidx.MustNewID("testing"),
+			NamespaceID: newID(nsID),
+			Data:        json.RawMessage(`{"tier":"web"}`),
+		})
+		assert.ErrorContains(t, err, permissions.ErrPermissionDenied.Error())
+	}
+}
+
+func TestAnnotationUpdateConcurrent(t *testing.T) {
+	skipUnlessPostgres(t)
+
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	nodeID := gidx.MustNewID("testing")
+	ns := AnnotationNamespaceBuilder{}.MustNew(ctx)
+
+	const writers = 10
+
+	errs := runConcurrently(writers, func(i int) error {
+		_, err := graphTestClient().AnnotationUpdate(ctx, testclient.AnnotationUpdateInput{
+			NodeID:      nodeID,
+			NamespaceID: newID(ns.ID),
+			Data:        json.RawMessage(fmt.Sprintf(`{"writer":%d}`, i)),
+		})
+
+		return err
+	})
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	ant, err := EntClient.Annotation.Query().Where(
+		annotation.HasMetadataWith(metadata.NodeID(nodeID)),
+		annotation.AnnotationNamespaceID(ns.ID),
+	).Only(ctx)
+	require.NoError(t, err)
+
+	// every write is applied exactly once
+	assert.Equal(t, int64(writers), ant.Version)
+}
+
+func TestAnnotationUpdateBatch(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns1 := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	ns2 := AnnotationNamespaceBuilder{}.MustNew(ctx)
+
+	jsonPatch := testclient.DataUpdateModeJSONPatch
+	bestEffort := testclient.BatchModeBestEffort
+
+	testCases := []struct {
+		TestName       string
+		Mode           *testclient.BatchMode
+		Items          []*testclient.AnnotationUpdateInput
+		ExpectedData   []json.RawMessage
+		ExpectedErrors []string
+		ErrorMsg       string
+	}{
+		{
+			TestName: "creates annotations",
+			Items: []*testclient.AnnotationUpdateInput{
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns1.ID), Data: json.RawMessage(`{"tier":"web"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns2.ID), Data: json.RawMessage(`{"tier":"db"}`)},
+			},
+			ExpectedData:   []json.RawMessage{json.RawMessage(`{"tier":"web"}`), json.RawMessage(`{"tier":"db"}`)},
+			ExpectedErrors: []string{"", ""},
+		},
+		{
+			TestName: "fails all items when one fails",
+			Items: []*testclient.AnnotationUpdateInput{
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns1.ID), Data: json.RawMessage(`{"tier":"web"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns2.ID), Data: json.RawMessage(`[{"op":"test","path":"/tier","value":"db"}]`), Mode: &jsonPatch},
+			},
+			ErrorMsg: "items[1]: data: invalid patch",
+		},
+		{
+			TestName: "returns the error of each failed item in best effort mode",
+			Mode:     &bestEffort,
+			Items: []*testclient.AnnotationUpdateInput{
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns1.ID), Data: json.RawMessage(`{"tier":"web"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(gidx.MustNewID("testing")), Data: json.RawMessage(`{"tier":"web"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns2.ID), Data: json.RawMessage(`[{"op":"add","path":"/tier","value":"db"}]`), Mode: &jsonPatch},
+			},
+			ExpectedData:   []json.RawMessage{json.RawMessage(`{"tier":"web"}`), nil, json.RawMessage(`{"tier":"db"}`)},
+			ExpectedErrors: []string{"", "annotation_namespace not found", ""},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			resp, err := graphTestClient().AnnotationUpdateBatch(ctx, testclient.AnnotationUpdateBatchInput{Items: tt.Items, Mode: tt.Mode})
+
+			if tt.ErrorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.ErrorMsg)
+
+				// nothing in a failed batch is stored
+				for _, item := range tt.Items {
+					exists, err := EntClient.Annotation.Query().Where(annotation.HasMetadataWith(metadata.NodeID(item.NodeID))).Exist(ctx)
+					require.NoError(t, err)
+					assert.False(t, exists)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			results := resp.AnnotationUpdateBatch.Results
+			require.Len(t, results, len(tt.Items))
+
+			for i, result := range results {
+				if tt.ExpectedErrors[i] != "" {
+					require.NotNil(t, result.Error)
+					assert.Contains(t, *result.Error, tt.ExpectedErrors[i])
+					assert.Nil(t, result.Annotation)
+
+					continue
+				}
+
+				require.Nil(t, result.Error)
+				require.NotNil(t, result.Annotation)
+				assert.Equal(t, tt.Items[i].NodeID, result.Annotation.Metadata.NodeID)
+				assert.Equal(t, *tt.Items[i].NamespaceID, result.Annotation.Namespace.ID)
+				assert.JSONEq(t, string(tt.ExpectedData[i]), string(result.Annotation.Data))
+			}
+		})
+	}
+}
+
+func TestAnnotationChangedSubscription(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	deniedOwner := gidx.MustNewID("tstownr")
+
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.ResourceID == deniedOwner {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}))
+
+	nodeID := gidx.MustNewID("testing")
+	ns := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	otherNS := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	privateNS := AnnotationNamespaceBuilder{Private: true, OwnerID: deniedOwner}.MustNew(ctx)
+
+	type annotationChanged struct {
+		AnnotationChanged struct {
+			Operation    string `json:"operation"`
+			AnnotationID string `json:"annotationID"`
+			Annotation   *struct {
+				Data map[string]interface{} `json:"data"`
+			} `json:"annotation"`
+		} `json:"annotationChanged"`
+	}
+
+	query := `subscription($nodeID: ID!, $namespaceID: ID) {
+		annotationChanged(nodeID: $nodeID, namespaceID: $namespaceID) { operation annotationID annotation { data } }
+	}`
+
+	update := func(nodeID, namespaceID gidx.PrefixedID) gidx.PrefixedID {
+		resp, err := graphTestClient().AnnotationUpdate(ctx, testclient.AnnotationUpdateInput{
+			NodeID:      nodeID,
+			NamespaceID: newID(namespaceID),
+			Data:        json.RawMessage(`{"owner":"team-a"}`),
+		})
+		require.NoError(t, err)
+
+		return resp.AnnotationUpdate.Annotation.ID
+	}
+
+	sync := func(responses <-chan subscriptionResponse[annotationChanged], namespaceID gidx.PrefixedID) {
+		// the subscription is started in the background, so update until it receives a change
+		require.Eventually(t, func() bool {
+			update(nodeID, namespaceID)
+
+			select {
+			case r := <-responses:
+				require.NoError(t, r.err)
+				return true
+			case <-time.After(100 * time.Millisecond):
+				return false
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("receives changes to the annotations of the node", func(t *testing.T) {
+		responses := graphTestSubscription[annotationChanged](t, ctx, query, client.Var("nodeID", nodeID), client.Var("namespaceID", otherNS.ID))
+
+		sync(responses, otherNS.ID)
+
+		update(gidx.MustNewID("testing"), otherNS.ID)
+		update(nodeID, ns.ID)
+		noResponse(t, responses)
+
+		id := update(nodeID, otherNS.ID)
+
+		resp, err := nextResponse(t, responses)
+		require.NoError(t, err)
+		assert.Equal(t, "UPDATE", resp.AnnotationChanged.Operation)
+		assert.Equal(t, id.String(), resp.AnnotationChanged.AnnotationID)
+		require.NotNil(t, resp.AnnotationChanged.Annotation)
+		assert.Equal(t, map[string]interface{}{"owner": "team-a"}, resp.AnnotationChanged.Annotation.Data)
+
+		_, err = graphTestClient().AnnotationDelete(ctx, testclient.AnnotationDeleteInput{NodeID: nodeID, NamespaceID: newID(otherNS.ID)})
+		require.NoError(t, err)
+
+		resp, err = nextResponse(t, responses)
+		require.NoError(t, err)
+		assert.Equal(t, "DELETE", resp.AnnotationChanged.Operation)
+		assert.Equal(t, id.String(), resp.AnnotationChanged.AnnotationID)
+		assert.Nil(t, resp.AnnotationChanged.Annotation)
+
+		id = update(nodeID, otherNS.ID)
+
+		resp, err = nextResponse(t, responses)
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE", resp.AnnotationChanged.Operation)
+		assert.Equal(t, id.String(), resp.AnnotationChanged.AnnotationID)
+	})
+
+	t.Run("leaves out changes in namespaces that can't be read", func(t *testing.T) {
+		responses := graphTestSubscription[annotationChanged](t, ctx, query, client.Var("nodeID", nodeID))
+
+		sync(responses, ns.ID)
+
+		update(nodeID, privateNS.ID)
+		noResponse(t, responses)
+
+		id := update(nodeID, otherNS.ID)
+
+		resp, err := nextResponse(t, responses)
+		require.NoError(t, err)
+		assert.Equal(t, id.String(), resp.AnnotationChanged.AnnotationID)
+	})
+
+	t.Run("fails when the namespace can't be read", func(t *testing.T) {
+		responses := graphTestSubscription[annotationChanged](t, ctx, query, client.Var("nodeID", nodeID), client.Var("namespaceID", privateNS.ID))
+
+		_, err := nextResponse(t, responses)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "subject doesn't have access")
+	})
+
+	t.Run("fails when the node id is invalid", func(t *testing.T) {
+		responses := graphTestSubscription[annotationChanged](t, ctx, query, client.Var("nodeID", "invalid"))
+
+		_, err := nextResponse(t, responses)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "nodeID: invalid id")
+	})
+}
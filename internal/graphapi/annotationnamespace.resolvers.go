@@ -6,13 +6,15 @@ package graphapi
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 
 	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
 
 	"go.infratographer.com/metadata-api/internal/ent/generated"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
 )
 
 // AnnotationNamespaceCreate is the resolver for the annotationNamespaceCreate field.
@@ -29,6 +31,12 @@ func (r *mutationResolver) AnnotationNamespaceCreate(ctx context.Context, input
 		return nil, NewInvalidFieldError("ownerID", err)
 	}
 
+	if input.JSONSchema != nil {
+		if _, err := compileJSONSchema(input.JSONSchema); err != nil {
+			return nil, NewInvalidFieldError("jsonSchema", err)
+		}
+	}
+
 	if err := permissions.CheckAccess(ctx, input.OwnerID, actionMetadataAnnotationNamespaceUpdate); err != nil {
 		return nil, err
 	}
@@ -63,7 +71,7 @@ func (r *mutationResolver) AnnotationNamespaceDelete(ctx context.Context, id gid
 		return nil, NewInvalidFieldError("id", err)
 	}
 
-	_, err := r.client.AnnotationNamespace.Get(ctx, id)
+	ns, err := r.client.AnnotationNamespace.Get(ctx, id)
 	if err != nil {
 		if generated.IsNotFound(err) {
 			return nil, err
@@ -77,48 +85,80 @@ func (r *mutationResolver) AnnotationNamespaceDelete(ctx context.Context, id gid
 		return nil, err
 	}
 
-	tx, err := r.client.BeginTx(ctx, &sql.TxOptions{})
+	antCount, err := r.client.Annotation.Query().Where(annotation.AnnotationNamespaceID(id)).Count(ctx)
 	if err != nil {
-		return nil, err
+		logger.Errorw("failed to count annotations", "error", err)
+		return nil, ErrInternalServerError
 	}
 
-	defer tx.Rollback()
+	if antCount != 0 && !force {
+		return nil, ErrNamespaceInUse
+	}
 
-	annotations, err := tx.Annotation.Query().Where(annotation.AnnotationNamespaceID(id)).All(ctx)
+	job, err := r.softDeleteNamespace(ctx, id, ns.OwnerID, antCount)
 	if err != nil {
-		logger.Errorw("failed to get annotations", "error", err)
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to delete annotation namespace", "error", err)
 		return nil, ErrInternalServerError
 	}
 
-	antCount := len(annotations)
-	if antCount != 0 {
-		if force {
-			antCount := 0
-			for _, ant := range annotations {
-				// TODO - :bug: - must delete one-by-one to ensure the deleted ID is available when the delete eventhook is triggered
-				// r.client.Annotation.Delete().Where(annotation.AnnotationNamespaceID(id)).Exec(ctx)
-				if err := tx.Annotation.DeleteOneID(ant.ID).Exec(ctx); err != nil {
-					logger.Errorw("failed to delete annotation", "error", err)
-					return nil, ErrInternalServerError
-				}
-				antCount++
-			}
-		} else {
-			return nil, ErrNamespaceInUse
+	return &AnnotationNamespaceDeletePayload{DeletedID: id, NamespaceDeletion: job}, nil
+}
+
+// AnnotationNamespaceRestore is the resolver for the annotationNamespaceRestore field.
+func (r *mutationResolver) AnnotationNamespaceRestore(ctx context.Context, id gidx.PrefixedID) (*AnnotationNamespaceRestorePayload, error) {
+	logger := r.logger.With("annotationNamespaceID", id)
+
+	if id == "" {
+		return nil, NewInvalidFieldError("id", ErrFieldEmpty)
+	}
+
+	if _, err := gidx.Parse(id.String()); err != nil {
+		return nil, NewInvalidFieldError("id", err)
+	}
+
+	ns, err := r.client.AnnotationNamespace.Get(softdelete.IncludeDeleted(ctx), id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
 		}
+
+		logger.Errorw("failed to get annotation namespace", "error", err)
+		return nil, ErrInternalServerError
 	}
 
-	if err := tx.AnnotationNamespace.DeleteOneID(id).Exec(ctx); err != nil {
-		logger.Errorw("failed to delete annotation namespace", "error", err)
+	if err := permissions.CheckAccess(ctx, id, actionMetadataAnnotationNamespaceUpdate); err != nil {
+		return nil, err
+	}
+
+	if ns.DeletedAt == nil {
+		return nil, NewInvalidFieldError("id", ErrNamespaceNotDeleted)
+	}
+
+	if err := r.restoreNamespace(ctx, id); err != nil {
+		if errors.Is(err, ErrNamespaceDeleting) {
+			return nil, err
+		}
+
+		// the name was reused while the namespace was deleted
+		if generated.IsConstraintError(err) {
+			return nil, NewInvalidFieldError("name", ErrUniquenessConstraint)
+		}
+
+		logger.Errorw("failed to restore annotation namespace", "error", err)
 		return nil, ErrInternalServerError
 	}
 
-	if err := tx.Commit(); err != nil {
-		logger.Errorw("failed to commit transaction", "error", err)
+	ns, err = r.client.AnnotationNamespace.Get(ctx, id)
+	if err != nil {
+		logger.Errorw("failed to get restored annotation namespace", "error", err)
 		return nil, ErrInternalServerError
 	}
 
-	return &AnnotationNamespaceDeletePayload{DeletedID: id, AnnotationDeletedCount: antCount}, nil
+	return &AnnotationNamespaceRestorePayload{AnnotationNamespace: ns}, nil
 }
 
 // AnnotationNamespaceUpdate is the resolver for the annotationNamespaceUpdate field.
@@ -137,6 +177,16 @@ func (r *mutationResolver) AnnotationNamespaceUpdate(ctx context.Context, id gid
 		return nil, NewInvalidFieldError("name", ErrFieldEmpty)
 	}
 
+	if input.AppendJSONSchema != nil {
+		return nil, NewInvalidFieldError("appendJSONSchema", ErrFieldNotSupported)
+	}
+
+	if input.JSONSchema != nil {
+		if _, err := compileJSONSchema(input.JSONSchema); err != nil {
+			return nil, NewInvalidFieldError("jsonSchema", err)
+		}
+	}
+
 	ns, err := r.client.AnnotationNamespace.Get(ctx, id)
 	if err != nil {
 		if generated.IsNotFound(err) {
@@ -151,6 +201,10 @@ func (r *mutationResolver) AnnotationNamespaceUpdate(ctx context.Context, id gid
 		return nil, err
 	}
 
+	if ns.Deleting {
+		return nil, ErrNamespaceDeleting
+	}
+
 	ns, err = ns.Update().SetInput(input).Save(ctx)
 	if err != nil {
 		if generated.IsConstraintError(err) {
@@ -161,7 +215,17 @@ func (r *mutationResolver) AnnotationNamespaceUpdate(ctx context.Context, id gid
 		return nil, ErrInternalServerError
 	}
 
-	return &AnnotationNamespaceUpdatePayload{AnnotationNamespace: ns}, nil
+	invalidCount := 0
+
+	if input.JSONSchema != nil {
+		invalidCount, err = r.countInvalidAnnotations(ctx, ns)
+		if err != nil {
+			logger.Errorw("failed to validate annotations against json schema", "error", err)
+			return nil, ErrInternalServerError
+		}
+	}
+
+	return &AnnotationNamespaceUpdatePayload{AnnotationNamespace: ns, InvalidAnnotationCount: invalidCount}, nil
 }
 
 // AnnotationNamespace is the resolver for the annotationNamespace field.
@@ -186,7 +250,45 @@ func (r *queryResolver) AnnotationNamespace(ctx context.Context, id gidx.Prefixe
 		return nil, ErrInternalServerError
 	}
 
-	if err := permissions.CheckAccess(ctx, id, actionMetadataAnnotationNamespaceUpdate); err != nil {
+	if err := permissions.CheckAccess(ctx, id, actionMetadataAnnotationNamespaceGet); err != nil {
+		return nil, err
+	}
+
+	return ns, nil
+}
+
+// AnnotationNamespaceByName is the resolver for the annotationNamespaceByName field.
+func (r *queryResolver) AnnotationNamespaceByName(ctx context.Context, ownerID gidx.PrefixedID, name string) (*generated.AnnotationNamespace, error) {
+	logger := r.logger.With("ownerID", ownerID, "name", name)
+
+	if ownerID == "" {
+		return nil, NewInvalidFieldError("ownerID", ErrFieldEmpty)
+	}
+
+	if _, err := gidx.Parse(ownerID.String()); err != nil {
+		return nil, NewInvalidFieldError("ownerID", err)
+	}
+
+	if name == "" {
+		return nil, NewInvalidFieldError("name", ErrFieldEmpty)
+	}
+
+	ns, err := r.client.AnnotationNamespace.Query().
+		Where(
+			annotationnamespace.OwnerID(ownerID),
+			annotationnamespace.Name(name),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, namespaceNameNotFound(ctx, ownerID, actionMetadataAnnotationNamespaceList, err)
+		}
+
+		logger.Errorw("failed to get annotation namespace", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if err := permissions.CheckAccess(ctx, ns.ID, actionMetadataAnnotationNamespaceGet); err != nil {
 		return nil, err
 	}
 
@@ -2,6 +2,7 @@ package graphapi_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v6"
@@ -51,6 +52,15 @@ func TestAnnotationNamespacesCreate(t *testing.T) {
 			AnnotationNamespaceInput: testclient.CreateAnnotationNamespaceInput{Name: ns1.Name, OwnerID: ""},
 			ErrorMsg:                 "must not be empty",
 		},
+		{
+			TestName:                 "Successful with a json schema",
+			AnnotationNamespaceInput: testclient.CreateAnnotationNamespaceInput{Name: gofakeit.DomainName(), OwnerID: gidx.MustNewID("testing"), JSONSchema: json.RawMessage(`{"type":"object"}`)},
+		},
+		{
+			TestName:                 "Fails when json schema is invalid",
+			AnnotationNamespaceInput: testclient.CreateAnnotationNamespaceInput{Name: gofakeit.DomainName(), OwnerID: gidx.MustNewID("testing"), JSONSchema: json.RawMessage(`{"type":"not-a-type"}`)},
+			ErrorMsg:                 "jsonSchema: invalid json schema",
+		},
 		{
 			TestName:                 "Fails when name is empty",
 			AnnotationNamespaceInput: testclient.CreateAnnotationNamespaceInput{Name: "", OwnerID: ns1.OwnerID},
@@ -154,7 +164,22 @@ func TestAnnotationNamespacesDelete(t *testing.T) {
 			require.NoError(t, err)
 			assert.NotNil(t, resp.AnnotationNamespaceDelete)
 			assert.Equal(t, tt.AnnotationNamespaceID, resp.AnnotationNamespaceDelete.DeletedID)
-			assert.Equal(t, tt.AnnotationDeletedCount, resp.AnnotationNamespaceDelete.AnnotationDeletedCount)
+
+			// deleted namespaces are hidden right away, and purged in the background
+			job := resp.AnnotationNamespaceDelete.NamespaceDeletion
+			require.NotNil(t, job)
+			assert.Equal(t, testclient.NamespaceDeletionStatePending, job.State)
+			assert.Equal(t, tt.AnnotationDeletedCount, job.TotalCount)
+
+			_, err = graphTestClient().GetAnnotationNamespace(ctx, tt.AnnotationNamespaceID)
+			assert.ErrorContains(t, err, "not found")
+
+			runNamespaceDeletions(ctx, t)
+
+			jobResp, err := graphTestClient().GetNamespaceDeletion(ctx, job.ID)
+			require.NoError(t, err)
+			assert.Equal(t, testclient.NamespaceDeletionStateCompleted, jobResp.NamespaceDeletion.State)
+			assert.Equal(t, tt.AnnotationDeletedCount, jobResp.NamespaceDeletion.DeletedCount)
 		})
 	}
 }
@@ -173,12 +198,18 @@ func TestAnnotationNamespacesUpdate(t *testing.T) {
 	ns := AnnotationNamespaceBuilder{}.MustNew(ctx)
 	ns2 := AnnotationNamespaceBuilder{OwnerID: ns.OwnerID}.MustNew(ctx)
 
+	schemaNS := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	AnnotationBuilder{AnnotationNamespace: schemaNS, Data: json.RawMessage(`{"size":1}`)}.MustNew(ctx)
+	AnnotationBuilder{AnnotationNamespace: schemaNS, Data: json.RawMessage(`{"size":"large"}`)}.MustNew(ctx)
+
 	testCases := []struct {
-		TestName   string
-		ID         gidx.PrefixedID
-		NewName    *string
-		NewPrivate *bool
-		ErrorMsg   string
+		TestName      string
+		ID            gidx.PrefixedID
+		NewName       *string
+		NewPrivate    *bool
+		NewJSONSchema json.RawMessage
+		InvalidCount  int
+		ErrorMsg      string
 	}{
 		{
 			TestName: "Successful path to update name",
@@ -199,6 +230,18 @@ func TestAnnotationNamespacesUpdate(t *testing.T) {
 			TestName: "Successful even if name and private is omitted",
 			ID:       ns.ID,
 		},
+		{
+			TestName:      "Successful path to update json schema reports invalid records",
+			ID:            schemaNS.ID,
+			NewJSONSchema: json.RawMessage(`{"type":"object","properties":{"size":{"type":"integer"}}}`),
+			InvalidCount:  1,
+		},
+		{
+			TestName:      "Fails when json schema is invalid",
+			ID:            ns.ID,
+			NewJSONSchema: json.RawMessage(`{"type":"not-a-type"}`),
+			ErrorMsg:      "jsonSchema: invalid json schema",
+		},
 		{
 			TestName: "Failed when name is in use by same tenant",
 			ID:       ns2.ID,
@@ -232,7 +275,7 @@ func TestAnnotationNamespacesUpdate(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.TestName, func(t *testing.T) {
-			resp, err := graphTestClient().AnnotationNamespaceUpdate(ctx, tt.ID, testclient.UpdateAnnotationNamespaceInput{Name: tt.NewName, Private: tt.NewPrivate})
+			resp, err := graphTestClient().AnnotationNamespaceUpdate(ctx, tt.ID, testclient.UpdateAnnotationNamespaceInput{Name: tt.NewName, Private: tt.NewPrivate, JSONSchema: tt.NewJSONSchema})
 
 			if tt.ErrorMsg != "" {
 				assert.Error(t, err)
@@ -249,6 +292,10 @@ func TestAnnotationNamespacesUpdate(t *testing.T) {
 			if tt.NewPrivate != nil {
 				assert.Equal(t, *tt.NewPrivate, resp.AnnotationNamespaceUpdate.AnnotationNamespace.Private)
 			}
+			if tt.NewJSONSchema != nil {
+				assert.JSONEq(t, string(tt.NewJSONSchema), string(resp.AnnotationNamespaceUpdate.AnnotationNamespace.JSONSchema))
+			}
+			assert.Equal(t, int64(tt.InvalidCount), resp.AnnotationNamespaceUpdate.InvalidAnnotationCount)
 		})
 	}
 }
@@ -311,3 +358,182 @@ func TestAnnotationNamespacesGet(t *testing.T) {
 		})
 	}
 }
+
+func TestAnnotationNamespacesGetByName(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns1 := AnnotationNamespaceBuilder{OwnerID: gidx.MustNewID("tnntten")}.MustNew(ctx)
+
+	denyCtx := context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, _ ...permissions.AccessRequest) error {
+		return permissions.ErrPermissionDenied
+	}))
+
+	testCases := []struct {
+		TestName string
+		Ctx      context.Context
+		OwnerID  gidx.PrefixedID
+		Name     string
+		ErrorMsg string
+	}{
+		{
+			TestName: "Successful path",
+			Ctx:      ctx,
+			OwnerID:  ns1.OwnerID,
+			Name:     ns1.Name,
+		},
+		{
+			TestName: "Fails when owner id is empty",
+			Ctx:      ctx,
+			OwnerID:  "",
+			Name:     ns1.Name,
+			ErrorMsg: "must not be empty",
+		},
+		{
+			TestName: "Fails when name is empty",
+			Ctx:      ctx,
+			OwnerID:  ns1.OwnerID,
+			Name:     "",
+			ErrorMsg: "must not be empty",
+		},
+		{
+			TestName: "Fails when the namespace belongs to another owner",
+			Ctx:      ctx,
+			OwnerID:  gidx.MustNewID("tnntten"),
+			Name:     ns1.Name,
+			ErrorMsg: "not found",
+		},
+		{
+			TestName: "Fails without access to the namespace",
+			Ctx:      denyCtx,
+			OwnerID:  ns1.OwnerID,
+			Name:     ns1.Name,
+			ErrorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			resp, err := graphTestClient().GetAnnotationNamespaceByName(tt.Ctx, tt.OwnerID, tt.Name)
+
+			if tt.ErrorMsg != "" {
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, tt.ErrorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, ns1.ID, resp.AnnotationNamespaceByName.ID)
+			assert.Equal(t, ns1.OwnerID, resp.AnnotationNamespaceByName.Owner.ID)
+		})
+	}
+}
+
+func TestAnnotationNamespaceAnnotations(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	privateNS := AnnotationNamespaceBuilder{Private: true}.MustNew(ctx)
+
+	ant1 := AnnotationBuilder{AnnotationNamespace: ns, Data: json.RawMessage(`{"tier":"web"}`)}.MustNew(ctx)
+	ant2 := AnnotationBuilder{AnnotationNamespace: ns, Data: json.RawMessage(`{"tier":"db"}`)}.MustNew(ctx)
+	ant3 := AnnotationBuilder{AnnotationNamespace: ns, Data: json.RawMessage(`{"tier":"web"}`)}.MustNew(ctx)
+	privateAnt := AnnotationBuilder{AnnotationNamespace: privateNS}.MustNew(ctx)
+
+	// Permit reading the namespace, but not the private annotations of its owner
+	denyGetCtx := context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.Action == "metadata_annotationnamespace_get" && req.ResourceID == privateNS.OwnerID {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}))
+
+	testCases := []struct {
+		TestName    string
+		Ctx         context.Context
+		QueryID     gidx.PrefixedID
+		First       *int64
+		Where       *testclient.AnnotationWhereInput
+		ExpectedIDs []gidx.PrefixedID
+		HasNextPage bool
+		TotalCount  int64
+	}{
+		{
+			TestName:    "returns all annotations in the namespace",
+			QueryID:     ns.ID,
+			ExpectedIDs: []gidx.PrefixedID{ant1.ID, ant2.ID, ant3.ID},
+			TotalCount:  3,
+		},
+		{
+			TestName:    "paginates annotations",
+			QueryID:     ns.ID,
+			First:       newInt64(2),
+			ExpectedIDs: []gidx.PrefixedID{ant1.ID, ant2.ID},
+			HasNextPage: true,
+			TotalCount:  3,
+		},
+		{
+			TestName:    "filters annotations",
+			QueryID:     ns.ID,
+			Where:       &testclient.AnnotationWhereInput{DataContains: json.RawMessage(`{"tier":"web"}`)},
+			ExpectedIDs: []gidx.PrefixedID{ant1.ID, ant3.ID},
+			TotalCount:  2,
+		},
+		{
+			TestName:    "returns annotations of a private namespace",
+			QueryID:     privateNS.ID,
+			ExpectedIDs: []gidx.PrefixedID{privateAnt.ID},
+			TotalCount:  1,
+		},
+		{
+			TestName:    "hides annotations of a private namespace without read access",
+			Ctx:         denyGetCtx,
+			QueryID:     privateNS.ID,
+			ExpectedIDs: []gidx.PrefixedID{},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			queryCtx := ctx
+			if tt.Ctx != nil {
+				queryCtx = tt.Ctx
+			}
+
+			orderBy := &testclient.AnnotationOrder{Direction: testclient.OrderDirectionAsc, Field: testclient.AnnotationOrderFieldCreatedAt}
+
+			resp, err := graphTestClient().GetAnnotationNamespaceAnnotations(queryCtx, tt.QueryID, tt.First, nil, orderBy, tt.Where)
+			require.NoError(t, err)
+
+			annotations := resp.AnnotationNamespace.Annotations
+
+			ids := []gidx.PrefixedID{}
+			for _, edge := range annotations.Edges {
+				ids = append(ids, edge.Node.ID)
+			}
+
+			assert.Equal(t, tt.ExpectedIDs, ids)
+			assert.Equal(t, tt.TotalCount, annotations.TotalCount)
+			assert.Equal(t, tt.HasNextPage, annotations.PageInfo.HasNextPage)
+		})
+	}
+}
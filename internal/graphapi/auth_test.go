@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -11,6 +12,7 @@ import (
 	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
 	"go.infratographer.com/x/echojwtx"
+	"go.infratographer.com/x/gidx"
 	"go.infratographer.com/x/testing/auth"
 
 	"go.infratographer.com/metadata-api/internal/testclient"
@@ -95,3 +97,115 @@ func TestJWTAnnotationNSGetWithDefaultClient(t *testing.T) {
 	require.Nil(t, resp)
 	assert.ErrorContains(t, err, `{"networkErrors":{"code":401`)
 }
+
+func TestJWTPrivateNamespaceRecords(t *testing.T) {
+	oauthCLI, issuer, oAuthClose := auth.OAuthTestClient("urn:test:status", "")
+	defer oAuthClose()
+
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	deniedOwnerID := gidx.MustNewID("tnttent")
+
+	// Deny reads of anything owned by the denied owner
+	checker := func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.ResourceID == deniedOwnerID {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}
+
+	srv, err := newTestServer(
+		withAuthConfig(
+			&echojwtx.AuthConfig{
+				Issuer: issuer,
+			},
+		),
+		withPermissions(
+			permissions.WithDefaultChecker(checker),
+		),
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, srv)
+
+	defer srv.Close()
+
+	meta := MetadataBuilder{}.MustNew(ctx)
+
+	publicAnt := AnnotationBuilder{Metadata: meta}.MustNew(ctx)
+	allowedAnt := AnnotationBuilder{Metadata: meta, AnnotationNamespace: AnnotationNamespaceBuilder{Private: true}.MustNew(ctx)}.MustNew(ctx)
+	deniedAnt := AnnotationBuilder{Metadata: meta, AnnotationNamespace: AnnotationNamespaceBuilder{OwnerID: deniedOwnerID, Private: true}.MustNew(ctx)}.MustNew(ctx)
+	// a public namespace with a denied owner isn't filtered
+	publicDeniedAnt := AnnotationBuilder{Metadata: meta, AnnotationNamespace: AnnotationNamespaceBuilder{OwnerID: deniedOwnerID}.MustNew(ctx)}.MustNew(ctx)
+
+	publicSt := StatusBuilder{Metadata: meta}.MustNew(ctx)
+	allowedSt := StatusBuilder{Metadata: meta, StatusNamespace: StatusNamespaceBuilder{Private: true}.MustNew(ctx)}.MustNew(ctx)
+	deniedSt := StatusBuilder{Metadata: meta, StatusNamespace: StatusNamespaceBuilder{ResourceProviderID: deniedOwnerID, Private: true}.MustNew(ctx)}.MustNew(ctx)
+
+	asOf := time.Now()
+
+	graphClient := graphTestClient(
+		withGraphClientHTTPClient(oauthCLI),
+		withGraphClientServerURL(srv.URL+"/query"),
+	)
+
+	for _, tt := range []struct {
+		TestName string
+		AsOf     *time.Time
+	}{
+		{TestName: "current metadata"},
+		{TestName: "metadata as of a time", AsOf: &asOf},
+	} {
+		t.Run(tt.TestName, func(t *testing.T) {
+			resp, err := graphClient.GetNodeMetadataAsOf(ctx, meta.NodeID, tt.AsOf)
+			require.NoError(t, err)
+			require.Len(t, resp.Entities, 1)
+			require.NotNil(t, resp.Entities[0].Metadata)
+
+			md := resp.Entities[0].Metadata
+
+			antIDs := []gidx.PrefixedID{}
+			for _, edge := range md.Annotations.Edges {
+				antIDs = append(antIDs, edge.Node.ID)
+			}
+
+			assert.ElementsMatch(t, []gidx.PrefixedID{publicAnt.ID, allowedAnt.ID, publicDeniedAnt.ID}, antIDs)
+
+			stIDs := []gidx.PrefixedID{}
+			for _, edge := range md.Statuses.Edges {
+				stIDs = append(stIDs, edge.Node.ID)
+			}
+
+			assert.ElementsMatch(t, []gidx.PrefixedID{publicSt.ID, allowedSt.ID}, stIDs)
+		})
+	}
+
+	t.Run("annotation entities", func(t *testing.T) {
+		resp, err := graphClient.GetAnnotationEntities(ctx, []map[string]interface{}{{"__typename": "Annotation", "id": allowedAnt.ID}})
+		require.NoError(t, err)
+		require.Len(t, resp.Entities, 1)
+		assert.Equal(t, allowedAnt.ID, resp.Entities[0].ID)
+
+		_, err = graphClient.GetAnnotationEntities(ctx, []map[string]interface{}{{"__typename": "Annotation", "id": deniedAnt.ID}})
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "annotation not found")
+	})
+
+	t.Run("status entities", func(t *testing.T) {
+		resp, err := graphClient.GetStatusEntities(ctx, []map[string]interface{}{{"__typename": "Status", "id": allowedSt.ID}})
+		require.NoError(t, err)
+		require.Len(t, resp.Entities, 1)
+		assert.Equal(t, allowedSt.ID, resp.Entities[0].ID)
+
+		_, err = graphClient.GetStatusEntities(ctx, []map[string]interface{}{{"__typename": "Status", "id": deniedSt.ID}})
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "status not found")
+	})
+}
@@ -0,0 +1,71 @@
+package graphapi
+
+import (
+	"errors"
+	"fmt"
+)
+
+// runBatch calls apply for each of the n items of a batch. In all-or-nothing mode
+// the batch fails with the error of the first item that fails, in best-effort mode
+// the error of each item is returned instead. Internal errors and races with
+// concurrent transactions always fail the batch, since the transaction can't be
+// relied on after the database failed.
+func runBatch[T any](n int, mode *BatchMode, apply func(i int) (T, error)) ([]T, []error, error) {
+	bestEffort := mode != nil && *mode == BatchModeBestEffort
+
+	results := make([]T, n)
+	errs := make([]error, n)
+
+	for i := 0; i < n; i++ {
+		result, err := apply(i)
+		if err != nil {
+			if errors.Is(err, ErrInternalServerError) || errors.Is(err, errUpsertRace) {
+				return nil, nil, err
+			}
+
+			if !bestEffort {
+				return nil, nil, NewInvalidFieldError(fmt.Sprintf("items[%d]", i), err)
+			}
+
+			errs[i] = err
+
+			continue
+		}
+
+		results[i] = result
+	}
+
+	return results, errs, nil
+}
+
+// memoize returns a function which calls fn once for each distinct key and
+// returns the same result for later calls with the key.
+func memoize[K comparable, V any](fn func(K) (V, error)) func(K) (V, error) {
+	type result struct {
+		value V
+		err   error
+	}
+
+	results := make(map[K]result)
+
+	return func(key K) (V, error) {
+		r, ok := results[key]
+		if !ok {
+			r.value, r.err = fn(key)
+			results[key] = r
+		}
+
+		return r.value, r.err
+	}
+}
+
+// batchError returns the message of a failed batch item, or nil if it succeeded.
+func batchError(err error) *string {
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+
+	return &msg
+}
@@ -0,0 +1,349 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/metadata-api/internal/graphapi"
+	metadata "go.infratographer.com/metadata-api/pkg/client"
+)
+
+func newMetadataClient(options ...metadata.Option) *metadata.Client {
+	options = append([]metadata.Option{metadata.WithHTTPClient(&http.Client{Transport: localRoundTripper{
+		handler: graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithBroker(Broker)).Handler(false).Handler(),
+	}})}, options...)
+
+	return metadata.New("query", options...)
+}
+
+func TestMetadataClient(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	// a small page size makes the iterators fetch several pages
+	cli := newMetadataClient(metadata.WithPageSize(2))
+
+	ownerID := gidx.MustNewID("tstownr")
+	providerID := gidx.MustNewID("rcrspro")
+	nodeID := gidx.MustNewID("testing")
+
+	var (
+		antNS *metadata.AnnotationNamespace
+		stNS  *metadata.StatusNamespace
+	)
+
+	t.Run("namespaces", func(t *testing.T) {
+		antResp, err := cli.AnnotationNamespaceCreate(ctx, &metadata.CreateAnnotationNamespaceInput{
+			Name:    "client-tests",
+			OwnerID: ownerID.String(),
+		})
+		require.NoError(t, err)
+
+		antNS = &antResp.AnnotationNamespaceCreate.AnnotationNamespace
+		assert.Equal(t, "client-tests", antNS.Name)
+		assert.Equal(t, ownerID.String(), antNS.Owner.ID)
+
+		stResp, err := cli.StatusNamespaceCreate(ctx, &metadata.CreateStatusNamespaceInput{
+			Name:               "client-tests",
+			ResourceProviderID: providerID.String(),
+			DefaultTTL:         newInt64(3600),
+		})
+		require.NoError(t, err)
+
+		stNS = &stResp.StatusNamespaceCreate.StatusNamespace
+		assert.Equal(t, providerID.String(), stNS.Owner.ID)
+		require.NotNil(t, stNS.DefaultTTL)
+		assert.Equal(t, int64(3600), *stNS.DefaultTTL)
+
+		antUpdate, err := cli.AnnotationNamespaceUpdate(ctx, antNS.ID, &metadata.UpdateAnnotationNamespaceInput{
+			Private:    newBool(true),
+			JSONSchema: json.RawMessage(`{"type":"object"}`),
+		})
+		require.NoError(t, err)
+		assert.True(t, antUpdate.AnnotationNamespaceUpdate.AnnotationNamespace.Private)
+		assert.JSONEq(t, `{"type":"object"}`, string(antUpdate.AnnotationNamespaceUpdate.AnnotationNamespace.JSONSchema))
+
+		stUpdate, err := cli.StatusNamespaceUpdate(ctx, stNS.ID, &metadata.UpdateStatusNamespaceInput{
+			Name:            newString("client-tests-renamed"),
+			ClearDefaultTTL: newBool(true),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "client-tests-renamed", stUpdate.StatusNamespaceUpdate.StatusNamespace.Name)
+		assert.Nil(t, stUpdate.StatusNamespaceUpdate.StatusNamespace.DefaultTTL)
+
+		gotAnt, err := cli.AnnotationNamespace(ctx, antNS.ID)
+		require.NoError(t, err)
+		assert.Equal(t, antNS.ID, gotAnt.ID)
+		assert.True(t, gotAnt.Private)
+
+		gotSt, err := cli.StatusNamespace(ctx, stNS.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "client-tests-renamed", gotSt.Name)
+
+		for i := 0; i < 2; i++ {
+			_, err := cli.AnnotationNamespaceCreate(ctx, &metadata.CreateAnnotationNamespaceInput{Name: gidx.MustNewID("testing").String(), OwnerID: ownerID.String()})
+			require.NoError(t, err)
+
+			_, err = cli.StatusNamespaceCreate(ctx, &metadata.CreateStatusNamespaceInput{Name: gidx.MustNewID("testing").String(), ResourceProviderID: providerID.String()})
+			require.NoError(t, err)
+		}
+
+		antNamespaces, err := cli.AnnotationNamespaces(ctx, ownerID.String()).All()
+		require.NoError(t, err)
+		assert.Len(t, antNamespaces, 3)
+
+		stNamespaces, err := cli.StatusNamespaces(ctx, providerID.String()).All()
+		require.NoError(t, err)
+		assert.Len(t, stNamespaces, 3)
+	})
+
+	t.Run("statuses and annotations", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+		for _, source := range []string{"source-a", "source-b", "source-c"} {
+			resp, err := cli.StatusUpdate(ctx, &metadata.StatusUpdateInput{
+				NodeID:      nodeID.String(),
+				NamespaceID: stNS.ID,
+				Source:      source,
+				Data:        json.RawMessage(`{"state":"ACTIVE"}`),
+				ExpiresAt:   &expiresAt,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, source, resp.StatusUpdate.Status.Source)
+			assert.Equal(t, nodeID.String(), resp.StatusUpdate.Status.Metadata.NodeID)
+			require.NotNil(t, resp.StatusUpdate.Status.ExpiresAt)
+			assert.True(t, expiresAt.Equal(*resp.StatusUpdate.Status.ExpiresAt))
+		}
+
+		mergePatch := metadata.DataUpdateModeMergePatch
+
+		antResp, err := cli.AnnotationUpdate(ctx, &metadata.AnnotationUpdateInput{
+			NodeID:      nodeID.String(),
+			NamespaceID: antNS.ID,
+			Data:        json.RawMessage(`{"owner":"team-a"}`),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, antNS.ID, antResp.AnnotationUpdate.Annotation.Namespace.ID)
+
+		antResp, err = cli.AnnotationUpdate(ctx, &metadata.AnnotationUpdateInput{
+			NodeID:      nodeID.String(),
+			NamespaceID: antNS.ID,
+			Data:        json.RawMessage(`{"tier":"web"}`),
+			Mode:        &mergePatch,
+		})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"owner":"team-a","tier":"web"}`, string(antResp.AnnotationUpdate.Annotation.Data))
+
+		md, err := cli.Metadata(ctx, nodeID.String())
+		require.NoError(t, err)
+		assert.Equal(t, nodeID.String(), md.NodeID)
+		assert.Len(t, md.Statuses, 3)
+		assert.Len(t, md.Annotations, 1)
+
+		statuses, err := cli.Statuses(ctx, nodeID.String()).All()
+		require.NoError(t, err)
+		require.Len(t, statuses, 3)
+
+		var deletedID string
+
+		for _, st := range statuses {
+			if st.Source == "source-a" {
+				deletedID = st.ID
+			}
+		}
+
+		stDelete, err := cli.StatusDelete(ctx, &metadata.StatusDeleteInput{NodeID: nodeID.String(), NamespaceID: stNS.ID, Source: "source-a"})
+		require.NoError(t, err)
+		assert.Equal(t, deletedID, stDelete.StatusDelete.DeletedID)
+
+		antDelete, err := cli.AnnotationDelete(ctx, &metadata.AnnotationDeleteInput{NodeID: nodeID.String(), NamespaceID: antNS.ID})
+		require.NoError(t, err)
+		assert.Equal(t, antResp.AnnotationUpdate.Annotation.ID, antDelete.AnnotationDelete.DeletedID)
+
+		annotations, err := cli.Annotations(ctx, nodeID.String()).All()
+		require.NoError(t, err)
+		assert.Empty(t, annotations)
+	})
+
+	t.Run("metadata not found", func(t *testing.T) {
+		_, err := cli.Metadata(ctx, gidx.MustNewID("testing").String())
+		assert.ErrorIs(t, err, metadata.ErrMetadataNotFound)
+
+		statuses, err := cli.Statuses(ctx, gidx.MustNewID("testing").String()).All()
+		require.NoError(t, err)
+		assert.Empty(t, statuses)
+	})
+
+	t.Run("delete namespaces", func(t *testing.T) {
+		_, err := cli.StatusNamespaceDelete(ctx, stNS.ID, false)
+		require.Error(t, err)
+
+		stResp, err := cli.StatusNamespaceDelete(ctx, stNS.ID, true)
+		require.NoError(t, err)
+		assert.Equal(t, stNS.ID, stResp.StatusNamespaceDelete.DeletedID)
+		require.NotNil(t, stResp.StatusNamespaceDelete.NamespaceDeletion)
+		assert.Equal(t, 2, stResp.StatusNamespaceDelete.NamespaceDeletion.TotalCount)
+
+		runNamespaceDeletions(ctx, t)
+
+		job, err := cli.NamespaceDeletion(ctx, stResp.StatusNamespaceDelete.NamespaceDeletion.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "COMPLETED", job.State)
+		assert.Equal(t, 2, job.DeletedCount)
+
+		antResp, err := cli.AnnotationNamespaceDelete(ctx, antNS.ID, false)
+		require.NoError(t, err)
+		assert.Equal(t, antNS.ID, antResp.AnnotationNamespaceDelete.DeletedID)
+		assert.Equal(t, 0, antResp.AnnotationNamespaceDelete.AnnotationDeletedCount)
+	})
+}
+
+func TestMetadataClientErrors(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	deniedOwner := gidx.MustNewID("tstownr")
+
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.ResourceID == deniedOwner {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}))
+
+	cli := newMetadataClient()
+
+	ns := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	AnnotationBuilder{AnnotationNamespace: ns}.MustNew(ctx)
+
+	testCases := []struct {
+		TestName  string
+		Call      func() error
+		Code      string
+		Field     string
+		ErrorIs   error
+		ErrorText string
+		InvalidID bool
+	}{
+		{
+			TestName: "not found",
+			Call: func() error {
+				_, err := cli.AnnotationNamespace(ctx, gidx.MustNewID("metamns").String())
+				return err
+			},
+			Code:    metadata.CodeNotFound,
+			ErrorIs: metadata.ErrNotFound,
+		},
+		{
+			TestName: "invalid field",
+			Call: func() error {
+				_, err := cli.StatusDelete(ctx, &metadata.StatusDeleteInput{NodeID: gidx.MustNewID("testing").String(), NamespaceID: gidx.MustNewID("metasns").String()})
+				return err
+			},
+			Code:      metadata.CodeInvalidField,
+			Field:     "source",
+			ErrorIs:   metadata.ErrInvalidField,
+			ErrorText: "source: must not be empty",
+		},
+		{
+			TestName: "invalid id argument",
+			Call: func() error {
+				_, err := cli.AnnotationNamespace(ctx, "invalid")
+				return err
+			},
+			Code:      metadata.CodeInvalidField,
+			Field:     "id",
+			ErrorIs:   metadata.ErrInvalidField,
+			ErrorText: "invalid id",
+			InvalidID: true,
+		},
+		{
+			TestName: "conflict",
+			Call: func() error {
+				_, err := cli.AnnotationNamespaceCreate(ctx, &metadata.CreateAnnotationNamespaceInput{Name: ns.Name, OwnerID: ns.OwnerID.String()})
+				return err
+			},
+			Code:      metadata.CodeConflict,
+			Field:     "name",
+			ErrorIs:   metadata.ErrConflict,
+			ErrorText: "must be unique",
+		},
+		{
+			TestName: "version conflict",
+			Call: func() error {
+				_, err := cli.AnnotationUpdate(ctx, &metadata.AnnotationUpdateInput{NodeID: gidx.MustNewID("testing").String(), NamespaceID: ns.ID.String(), Data: json.RawMessage(`{}`), ExpectedVersion: newInt64(1)})
+				return err
+			},
+			Code:      metadata.CodeConflict,
+			Field:     "expectedVersion",
+			ErrorIs:   metadata.ErrConflict,
+			ErrorText: "doesn't match the current version",
+		},
+		{
+			TestName: "namespace in use",
+			Call: func() error {
+				_, err := cli.AnnotationNamespaceDelete(ctx, ns.ID.String(), false)
+				return err
+			},
+			Code:    metadata.CodeNamespaceInUse,
+			ErrorIs: metadata.ErrNamespaceInUse,
+		},
+		{
+			TestName: "forbidden",
+			Call: func() error {
+				_, err := cli.AnnotationNamespaceCreate(ctx, &metadata.CreateAnnotationNamespaceInput{Name: "forbidden", OwnerID: deniedOwner.String()})
+				return err
+			},
+			Code:    metadata.CodeForbidden,
+			ErrorIs: metadata.ErrPermissionDenied,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			err := tt.Call()
+			require.Error(t, err)
+
+			assert.ErrorIs(t, err, tt.ErrorIs)
+
+			var apiErr *metadata.Error
+
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.Code, apiErr.Code)
+			assert.Equal(t, tt.Field, apiErr.Field)
+
+			if tt.ErrorText != "" {
+				assert.ErrorContains(t, err, tt.ErrorText)
+			}
+
+			// only invalid ids match ErrInvalidID
+			var idErr *metadata.ErrInvalidID
+
+			assert.Equal(t, tt.InvalidID, errors.As(err, &idErr))
+		})
+	}
+}
@@ -4,6 +4,103 @@ package graphapi
 // will be copied through when generating and any unknown code will be moved to the end.
 // Code generated by github.com/99designs/gqlgen version v0.17.38
 
+import (
+	"context"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+)
+
+// Namespace is the resolver for the namespace field.
+func (r *annotationResolver) Namespace(ctx context.Context, obj *generated.Annotation) (*generated.AnnotationNamespace, error) {
+	// connections load the namespaces with the annotations
+	if ns, err := obj.Edges.NamespaceOrErr(); !generated.IsNotLoaded(err) {
+		return ns, err
+	}
+
+	ns, err := r.loaders(ctx).annotationNamespaceByID.Load(ctx, obj.AnnotationNamespaceID)()
+	if err != nil || ns != nil {
+		return ns, err
+	}
+
+	// the edge query returns the not found error
+	return obj.Namespace(ctx)
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *annotationNamespaceResolver) Annotations(ctx context.Context, obj *generated.AnnotationNamespace, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AnnotationOrder, where *generated.AnnotationWhereInput) (*generated.AnnotationConnection, error) {
+	if err := permissions.CheckAccess(ctx, obj.ID, actionMetadataAnnotationNamespaceGet); err != nil {
+		return nil, err
+	}
+
+	return obj.Annotations(ctx, after, first, before, last, orderBy, where)
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *metadataResolver) Annotations(ctx context.Context, obj *generated.Metadata, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AnnotationOrder, where *generated.AnnotationWhereInput, asOf *time.Time) (*generated.AnnotationConnection, error) {
+	if err := r.loaders(ctx).checkNodeAccess(ctx, obj.NodeID, actionMetadataAnnotationList); err != nil {
+		return nil, err
+	}
+
+	if asOf == nil {
+		return obj.Annotations(ctx, after, first, before, last, orderBy, where)
+	}
+
+	return r.client.Annotation.Query().Where(annotationAsOf(*asOf), annotation.MetadataID(obj.ID)).Paginate(ctx, after, first, before, last, generated.WithAnnotationOrder(orderBy), generated.WithAnnotationFilter(where.Filter))
+}
+
+// Statuses is the resolver for the statuses field.
+func (r *metadataResolver) Statuses(ctx context.Context, obj *generated.Metadata, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.StatusOrder, where *generated.StatusWhereInput, asOf *time.Time) (*generated.StatusConnection, error) {
+	if err := r.loaders(ctx).checkNodeAccess(ctx, obj.NodeID, actionMetadataStatusList); err != nil {
+		return nil, err
+	}
+
+	preds := []predicate.Status{status.MetadataID(obj.ID)}
+
+	if asOf == nil {
+		preds = append(preds, statusNotExpired(time.Now()))
+	} else {
+		preds = append(preds, statusAsOf(*asOf), statusNotExpired(*asOf))
+	}
+
+	return r.client.Status.Query().Where(preds...).Paginate(ctx, after, first, before, last, generated.WithStatusOrder(orderBy), generated.WithStatusFilter(where.Filter))
+}
+
+// Namespace is the resolver for the namespace field.
+func (r *statusResolver) Namespace(ctx context.Context, obj *generated.Status) (*generated.StatusNamespace, error) {
+	// connections load the namespaces with the statuses
+	if ns, err := obj.Edges.NamespaceOrErr(); !generated.IsNotLoaded(err) {
+		return ns, err
+	}
+
+	ns, err := r.loaders(ctx).statusNamespaceByID.Load(ctx, obj.StatusNamespaceID)()
+	if err != nil || ns != nil {
+		return ns, err
+	}
+
+	// the edge query returns the not found error
+	return obj.Namespace(ctx)
+}
+
+// Statuses is the resolver for the statuses field.
+func (r *statusNamespaceResolver) Statuses(ctx context.Context, obj *generated.StatusNamespace, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.StatusOrder, where *generated.StatusWhereInput) (*generated.StatusConnection, error) {
+	if err := permissions.CheckAccess(ctx, obj.ID, actionMetadataStatusNamespaceGet); err != nil {
+		return nil, err
+	}
+
+	return r.client.Status.Query().Where(status.StatusNamespaceID(obj.ID), statusNotExpired(time.Now())).Paginate(ctx, after, first, before, last, generated.WithStatusOrder(orderBy), generated.WithStatusFilter(where.Filter))
+}
+
+// Annotation returns AnnotationResolver implementation.
+func (r *Resolver) Annotation() AnnotationResolver { return &annotationResolver{r} }
+
 // AnnotationNamespace returns AnnotationNamespaceResolver implementation.
 func (r *Resolver) AnnotationNamespace() AnnotationNamespaceResolver {
 	return &annotationNamespaceResolver{r}
@@ -15,10 +112,25 @@ func (r *Resolver) Metadata() MetadataResolver { return &metadataResolver{r} }
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Status returns StatusResolver implementation.
+func (r *Resolver) Status() StatusResolver { return &statusResolver{r} }
+
 // StatusNamespace returns StatusNamespaceResolver implementation.
 func (r *Resolver) StatusNamespace() StatusNamespaceResolver { return &statusNamespaceResolver{r} }
 
+// AnnotationWhereInput returns AnnotationWhereInputResolver implementation.
+func (r *Resolver) AnnotationWhereInput() AnnotationWhereInputResolver {
+	return &annotationWhereInputResolver{r}
+}
+
+// StatusWhereInput returns StatusWhereInputResolver implementation.
+func (r *Resolver) StatusWhereInput() StatusWhereInputResolver { return &statusWhereInputResolver{r} }
+
+type annotationResolver struct{ *Resolver }
 type annotationNamespaceResolver struct{ *Resolver }
 type metadataResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type statusResolver struct{ *Resolver }
 type statusNamespaceResolver struct{ *Resolver }
+type annotationWhereInputResolver struct{ *Resolver }
+type statusWhereInputResolver struct{ *Resolver }
@@ -6,30 +6,54 @@ package graphapi
 
 import (
 	"context"
-	"fmt"
+	"time"
 
-	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
 )
 
 // FindAnnotationByID is the resolver for the findAnnotationByID field.
 func (r *entityResolver) FindAnnotationByID(ctx context.Context, id gidx.PrefixedID) (*generated.Annotation, error) {
-	return r.client.Annotation.Get(ctx, id)
+	a, err := r.client.Annotation.Query().Where(annotation.ID(id)).WithMetadata().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loaders(ctx).checkNodeAccess(ctx, a.Edges.Metadata.NodeID, actionMetadataAnnotationGet); err != nil {
+		return nil, err
+	}
+
+	return a, nil
 }
 
 // FindAnnotationNamespaceByID is the resolver for the findAnnotationNamespaceByID field.
 func (r *entityResolver) FindAnnotationNamespaceByID(ctx context.Context, id gidx.PrefixedID) (*generated.AnnotationNamespace, error) {
-	return r.client.AnnotationNamespace.Get(ctx, id)
+	ns, err := r.client.AnnotationNamespace.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := permissions.CheckAccess(ctx, id, actionMetadataAnnotationNamespaceGet); err != nil {
+		return nil, err
+	}
+
+	return ns, nil
 }
 
 // FindMetadataByID is the resolver for the findMetadataByID field.
 func (r *entityResolver) FindMetadataByID(ctx context.Context, id gidx.PrefixedID) (*generated.Metadata, error) {
-	panic(fmt.Errorf("not implemented: FindMetadataByID - findMetadataByID"))
+	// Representations are resolved concurrently, the loader batches them into a single query
+	return r.loaders(ctx).metadataByID.Load(ctx, id)()
 }
 
 // FindMetadataByNodeID is the resolver for the findMetadataByNodeID field.
 func (r *entityResolver) FindMetadataByNodeID(ctx context.Context, nodeID gidx.PrefixedID) (*generated.Metadata, error) {
-	panic(fmt.Errorf("not implemented: FindMetadataByNodeID - findMetadataByNodeID"))
+	// Don't return an error if it isn't found, metadata is optional
+	return r.loaders(ctx).metadataByNodeID.Load(ctx, nodeID)()
 }
 
 // FindMetadataNodeByID is the resolver for the findMetadataNodeByID field.
@@ -44,12 +68,30 @@ func (r *entityResolver) FindResourceOwnerByID(ctx context.Context, id gidx.Pref
 
 // FindStatusByID is the resolver for the findStatusByID field.
 func (r *entityResolver) FindStatusByID(ctx context.Context, id gidx.PrefixedID) (*generated.Status, error) {
-	return r.client.Status.Get(ctx, id)
+	st, err := r.client.Status.Query().Where(status.ID(id), statusNotExpired(time.Now())).WithMetadata().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loaders(ctx).checkNodeAccess(ctx, st.Edges.Metadata.NodeID, actionMetadataStatusGet); err != nil {
+		return nil, err
+	}
+
+	return st, nil
 }
 
 // FindStatusNamespaceByID is the resolver for the findStatusNamespaceByID field.
 func (r *entityResolver) FindStatusNamespaceByID(ctx context.Context, id gidx.PrefixedID) (*generated.StatusNamespace, error) {
-	return r.client.StatusNamespace.Get(ctx, id)
+	ns, err := r.client.StatusNamespace.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := permissions.CheckAccess(ctx, id, actionMetadataStatusNamespaceGet); err != nil {
+		return nil, err
+	}
+
+	return ns, nil
 }
 
 // FindStatusOwnerByID is the resolver for the findStatusOwnerByID field.
@@ -1,8 +1,57 @@
 package graphapi
 
 import (
+	"context"
 	"errors"
 	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+)
+
+// Error codes set in the code extension of graphql errors.
+const (
+	// CodeNotFound is used when the requested record doesn't exist.
+	CodeNotFound = "NOT_FOUND"
+
+	// CodeInvalidField is used when an input field is invalid, the field is set in the field extension.
+	CodeInvalidField = "INVALID_FIELD"
+
+	// CodeConflict is used when a change conflicts with a stored record.
+	CodeConflict = "CONFLICT"
+
+	// CodeNamespaceInUse is used when a namespace is in use and can't be deleted.
+	CodeNamespaceInUse = "NAMESPACE_IN_USE"
+
+	// CodeNamespaceDeleting is used when a namespace is being deleted and can't be written to.
+	CodeNamespaceDeleting = "NAMESPACE_DELETING"
+
+	// CodeForbidden is used when the subject doesn't have access.
+	CodeForbidden = "FORBIDDEN"
+
+	// CodeUnauthenticated is used when the request isn't authenticated.
+	CodeUnauthenticated = "UNAUTHENTICATED"
+
+	// CodeDepthLimitExceeded is used when a query is nested deeper than the max depth.
+	CodeDepthLimitExceeded = "DEPTH_LIMIT_EXCEEDED"
+
+	// CodeComplexityLimitExceeded is used when a query is more complex than the max complexity.
+	CodeComplexityLimitExceeded = "COMPLEXITY_LIMIT_EXCEEDED"
+
+	// CodeOperationNotAllowed is used when an operation isn't in the allow list.
+	CodeOperationNotAllowed = "OPERATION_NOT_ALLOWED"
+)
+
+// Reasons set in the reason extension of graphql errors, which tell apart the
+// errors of a code clients may want to handle differently.
+const (
+	// ReasonInvalidID is used with CodeInvalidField when the field isn't a valid id.
+	ReasonInvalidID = "INVALID_ID"
 )
 
 var (
@@ -20,6 +69,42 @@ var (
 
 	// ErrNamespaceInUse is returned when a namespace is in use and can't be deleted.
 	ErrNamespaceInUse = errors.New("namespace is in use and can't be deleted")
+
+	// ErrNamespaceDeleting is returned when a namespace is being deleted and can't be written to.
+	ErrNamespaceDeleting = errors.New("namespace is being deleted")
+
+	// ErrNamespaceRefConflict is returned when a namespace is named by both its id and its name.
+	ErrNamespaceRefConflict = errors.New("can't be set together with ownerID and namespaceName")
+
+	// ErrNamespaceNotDeleted is returned when restoring a namespace which isn't deleted.
+	ErrNamespaceNotDeleted = errors.New("namespace is not deleted")
+
+	// ErrInvalidJSONSchema is returned when a namespace json schema can't be compiled.
+	ErrInvalidJSONSchema = errors.New("invalid json schema")
+
+	// ErrJSONSchemaRemoteRef is returned when a json schema references a remote document.
+	ErrJSONSchemaRemoteRef = errors.New("remote references are not supported")
+
+	// ErrJSONSchemaValidation is returned when data doesn't validate against the namespace json schema.
+	ErrJSONSchemaValidation = errors.New("does not match namespace json schema")
+
+	// ErrInvalidPatch is returned when a patch can't be applied to the stored data.
+	ErrInvalidPatch = errors.New("invalid patch")
+
+	// ErrFieldNotSupported is returned when an input field is not supported.
+	ErrFieldNotSupported = errors.New("field is not supported")
+
+	// ErrExpiryConflict is returned when both an expiry time and a ttl are provided.
+	ErrExpiryConflict = errors.New("can't be set together with expiresAt")
+
+	// ErrSubscriptionsDisabled is returned when subscribing without a change broker.
+	ErrSubscriptionsDisabled = errors.New("subscriptions are not enabled")
+
+	// ErrInvalidTTL is returned when a ttl isn't a positive number of seconds.
+	ErrInvalidTTL = errors.New("must be a positive number of seconds")
+
+	// ErrVersionConflict is returned when a record isn't at the expected version.
+	ErrVersionConflict = errors.New("doesn't match the current version")
 )
 
 // ErrInvalidField is returned when an invalid input is provided.
@@ -33,6 +118,100 @@ func (e *ErrInvalidField) Error() string {
 	return fmt.Sprintf("%s: %v", e.field, e.err)
 }
 
+// Unwrap returns the underlying error.
+func (e *ErrInvalidField) Unwrap() error {
+	return e.err
+}
+
 func NewInvalidFieldError(field string, err error) *ErrInvalidField {
 	return &ErrInvalidField{field: field, err: err}
 }
+
+// errorPresenter sets the code extension, the field extension for invalid
+// fields, and the reason extension for invalid ids, of the errors clients are
+// expected to handle.
+func errorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	code, field := errorCode(err)
+	if code == "" {
+		return gqlErr
+	}
+
+	invalidID := code == CodeInvalidField && isInvalidID(err)
+
+	// ids that fail to unmarshal don't know their field, it's taken from the path
+	if field == "" && invalidID {
+		field = lastPathName(gqlErr.Path)
+	}
+
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = make(map[string]interface{})
+	}
+
+	gqlErr.Extensions["code"] = code
+
+	if invalidID {
+		gqlErr.Extensions["reason"] = ReasonInvalidID
+	}
+
+	if field != "" {
+		gqlErr.Extensions["field"] = field
+	}
+
+	return gqlErr
+}
+
+func errorCode(err error) (string, string) {
+	var (
+		fieldErr      *ErrInvalidField
+		validationErr *generated.ValidationError
+	)
+
+	switch {
+	case errors.Is(err, ErrUniquenessConstraint), errors.Is(err, ErrVersionConflict):
+		if errors.As(err, &fieldErr) {
+			return CodeConflict, fieldErr.field
+		}
+
+		return CodeConflict, ""
+	case generated.IsConstraintError(err):
+		return CodeConflict, ""
+	case errors.As(err, &fieldErr):
+		return CodeInvalidField, fieldErr.field
+	case errors.As(err, &validationErr):
+		return CodeInvalidField, validationErr.Name
+	case isInvalidID(err):
+		return CodeInvalidField, ""
+	case generated.IsNotFound(err):
+		return CodeNotFound, ""
+	case errors.Is(err, ErrNamespaceInUse):
+		return CodeNamespaceInUse, ""
+	case errors.Is(err, ErrNamespaceDeleting):
+		return CodeNamespaceDeleting, ""
+	case errors.Is(err, permissions.ErrPermissionDenied):
+		return CodeForbidden, ""
+	case errors.Is(err, permissions.ErrNoAuthToken), errors.Is(err, permissions.ErrInvalidAuthToken):
+		return CodeUnauthenticated, ""
+	}
+
+	return "", ""
+}
+
+func isInvalidID(err error) bool {
+	var idErr *gidx.ErrInvalidID
+
+	return errors.As(err, &idErr)
+}
+
+// lastPathName returns the name of the last field in path, which is the input
+// field for errors unmarshaling input values.
+func lastPathName(path ast.Path) string {
+	for i := len(path) - 1; i >= 0; i-- {
+		if name, ok := path[i].(ast.PathName); ok {
+			return string(name)
+		}
+	}
+
+	return ""
+}
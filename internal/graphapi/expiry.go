@@ -0,0 +1,62 @@
+package graphapi
+
+import (
+	"time"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+)
+
+// validateStatusExpiry checks the expiry fields of a status update.
+func validateStatusExpiry(input StatusUpdateInput) error {
+	if input.TTL == nil {
+		return nil
+	}
+
+	if input.ExpiresAt != nil {
+		return NewInvalidFieldError("ttl", ErrExpiryConflict)
+	}
+
+	if *input.TTL <= 0 {
+		return NewInvalidFieldError("ttl", ErrInvalidTTL)
+	}
+
+	return nil
+}
+
+// statusExpiresAt returns the time a status updated at now expires. The expiry of
+// the input takes precedence over the default TTL of the namespace. Nil is
+// returned when the status doesn't expire.
+func statusExpiresAt(ns *generated.StatusNamespace, input StatusUpdateInput, now time.Time) *time.Time {
+	var ttl time.Duration
+
+	switch {
+	case input.ExpiresAt != nil:
+		return input.ExpiresAt
+	case input.TTL != nil:
+		ttl = time.Duration(*input.TTL) * time.Second
+	case ns.DefaultTTL != nil:
+		ttl = time.Duration(*ns.DefaultTTL) * time.Second
+	default:
+		return nil
+	}
+
+	expiresAt := now.Add(ttl)
+
+	return &expiresAt
+}
+
+// statusExpired reports whether the status has expired at t.
+func statusExpired(st *generated.Status, t time.Time) bool {
+	return st.ExpiresAt != nil && !st.ExpiresAt.After(t)
+}
+
+// statusNotExpired returns a predicate which filters out statuses that have
+// expired at t. Expired statuses are hidden until the reaper deletes them.
+func statusNotExpired(t time.Time) predicate.Status {
+	return status.Or(
+		status.ExpiresAtIsNil(),
+		status.ExpiresAtGT(t),
+	)
+}
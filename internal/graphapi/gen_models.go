@@ -4,17 +4,37 @@ package graphapi
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
 
 	"go.infratographer.com/metadata-api/internal/ent/generated"
 	"go.infratographer.com/x/gidx"
 )
 
+// A change made to a annotation.
+type AnnotationChange struct {
+	// The kind of change made.
+	Operation ChangeOperation `json:"operation"`
+	// The ID of the changed annotation.
+	AnnotationID gidx.PrefixedID `json:"annotationID"`
+	// The annotation as it is now, empty when it was deleted.
+	Annotation *generated.Annotation `json:"annotation,omitempty"`
+}
+
 // Input information to delete an annotation.
 type AnnotationDeleteInput struct {
 	// The node ID for this annotation.
 	NodeID gidx.PrefixedID `json:"nodeID"`
-	// The namespace ID for this annotation.
-	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	// The namespace ID for this annotation. Either namespaceID, or ownerID and namespaceName, must be set.
+	NamespaceID *gidx.PrefixedID `json:"namespaceID,omitempty"`
+	// The ID of the owner of the namespace, to name the namespace by namespaceName instead of namespaceID.
+	OwnerID *gidx.PrefixedID `json:"ownerID,omitempty"`
+	// The name of the namespace, used with ownerID instead of namespaceID.
+	NamespaceName *string `json:"namespaceName,omitempty"`
+	// The version the annotation must be at for it to be deleted.
+	ExpectedVersion *int `json:"expectedVersion,omitempty"`
 }
 
 // Return response from annotationDelete
@@ -35,22 +55,62 @@ type AnnotationNamespaceDeletePayload struct {
 	DeletedID gidx.PrefixedID `json:"deletedID"`
 	// The count of annotations deleted
 	AnnotationDeletedCount int `json:"annotationDeletedCount"`
+	// The deletion of the namespace, which purges it with its annotations in the background.
+	NamespaceDeletion *generated.NamespaceDeletion `json:"namespaceDeletion,omitempty"`
+}
+
+// Return response from annotationNamespaceRestore
+type AnnotationNamespaceRestorePayload struct {
+	// The restored annotation namespace.
+	AnnotationNamespace *generated.AnnotationNamespace `json:"annotationNamespace"`
 }
 
 // Return response from annotationNamespaceUpdate
 type AnnotationNamespaceUpdatePayload struct {
 	// The updated annotation namespace.
 	AnnotationNamespace *generated.AnnotationNamespace `json:"annotationNamespace"`
+	// The count of existing annotations that don't validate against the updated JSON schema
+	InvalidAnnotationCount int `json:"invalidAnnotationCount"`
+}
+
+// Input information to update many annotations.
+type AnnotationUpdateBatchInput struct {
+	// The annotations to update.
+	Items []*AnnotationUpdateInput `json:"items"`
+	// How items that fail are handled, defaults to applying all the items or none of them.
+	Mode *BatchMode `json:"mode,omitempty"`
+}
+
+// Return response from annotationUpdateBatch
+type AnnotationUpdateBatchResponse struct {
+	// The result of each item, in the order of the input items.
+	Results []*AnnotationUpdateBatchResult `json:"results"`
+}
+
+// The result of an item of annotationUpdateBatch.
+type AnnotationUpdateBatchResult struct {
+	// The set annotation, when the item succeeded.
+	Annotation *generated.Annotation `json:"annotation,omitempty"`
+	// The reason the item failed.
+	Error *string `json:"error,omitempty"`
 }
 
 // Input information to update an annotation.
 type AnnotationUpdateInput struct {
 	// The node ID for this annotation.
 	NodeID gidx.PrefixedID `json:"nodeID"`
-	// The namespace ID for this annotation.
-	NamespaceID gidx.PrefixedID `json:"namespaceID"`
-	// The data to save in this annotation.
+	// The namespace ID for this annotation. Either namespaceID, or ownerID and namespaceName, must be set.
+	NamespaceID *gidx.PrefixedID `json:"namespaceID,omitempty"`
+	// The ID of the owner of the namespace, to name the namespace by namespaceName instead of namespaceID.
+	OwnerID *gidx.PrefixedID `json:"ownerID,omitempty"`
+	// The name of the namespace, used with ownerID instead of namespaceID.
+	NamespaceName *string `json:"namespaceName,omitempty"`
+	// The data to save in this annotation. When a patch mode is used, this is the patch to apply to the stored data.
 	Data json.RawMessage `json:"data"`
+	// How the data is applied to the stored data, defaults to replacing it.
+	Mode *DataUpdateMode `json:"mode,omitempty"`
+	// The version the annotation must be at for the update to be applied, 0 when it must not exist yet.
+	ExpectedVersion *int `json:"expectedVersion,omitempty"`
 }
 
 // Return response from annotationUpdate
@@ -59,6 +119,14 @@ type AnnotationUpdateResponse struct {
 	Annotation *generated.Annotation `json:"annotation"`
 }
 
+// DataPathPredicate compares the value at a path in the JSON data to the given value.
+type DataPathPredicate struct {
+	// Path to the value, each element is an object key or an array index, e.g. ["conditions", "0", "state"].
+	Path []string `json:"path"`
+	// The value to compare the value at the path with.
+	Value json.RawMessage `json:"value"`
+}
+
 // MetadataNode provides an interface for any Node in the graph that can store metadata.
 type MetadataNode struct {
 	ID gidx.PrefixedID `json:"id"`
@@ -77,14 +145,30 @@ type ResourceOwner struct {
 
 func (ResourceOwner) IsEntity() {}
 
+// A change made to a status.
+type StatusChange struct {
+	// The kind of change made.
+	Operation ChangeOperation `json:"operation"`
+	// The ID of the changed status.
+	StatusID gidx.PrefixedID `json:"statusID"`
+	// The status as it is now, empty when it was deleted.
+	Status *generated.Status `json:"status,omitempty"`
+}
+
 // Input information to delete an status.
 type StatusDeleteInput struct {
 	// The node ID for this status.
 	NodeID gidx.PrefixedID `json:"nodeID"`
-	// The namespace ID for this status.
-	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	// The namespace ID for this status. Either namespaceID, or ownerID and namespaceName, must be set.
+	NamespaceID *gidx.PrefixedID `json:"namespaceID,omitempty"`
+	// The ID of the resource provider of the namespace, to name the namespace by namespaceName instead of namespaceID.
+	OwnerID *gidx.PrefixedID `json:"ownerID,omitempty"`
+	// The name of the namespace, used with ownerID instead of namespaceID.
+	NamespaceName *string `json:"namespaceName,omitempty"`
 	// The source for this status.
 	Source string `json:"source"`
+	// The version the status must be at for it to be deleted.
+	ExpectedVersion *int `json:"expectedVersion,omitempty"`
 }
 
 // Return response from statusDelete
@@ -105,12 +189,22 @@ type StatusNamespaceDeletePayload struct {
 	DeletedID gidx.PrefixedID `json:"deletedID"`
 	// The count of statuss deleted
 	StatusDeletedCount int `json:"statusDeletedCount"`
+	// The deletion of the namespace, which purges it with its statuses in the background.
+	NamespaceDeletion *generated.NamespaceDeletion `json:"namespaceDeletion,omitempty"`
+}
+
+// Return response from statusNamespaceRestore
+type StatusNamespaceRestorePayload struct {
+	// The restored status namespace.
+	StatusNamespace *generated.StatusNamespace `json:"statusNamespace"`
 }
 
 // Return response from statusNamespaceUpdate
 type StatusNamespaceUpdatePayload struct {
 	// The updated status namespace.
 	StatusNamespace *generated.StatusNamespace `json:"statusNamespace"`
+	// The count of existing statuses that don't validate against the updated JSON schema
+	InvalidStatusCount int `json:"invalidStatusCount"`
 }
 
 type StatusOwner struct {
@@ -122,16 +216,50 @@ type StatusOwner struct {
 
 func (StatusOwner) IsEntity() {}
 
+// Input information to update many statuses.
+type StatusUpdateBatchInput struct {
+	// The statuses to update.
+	Items []*StatusUpdateInput `json:"items"`
+	// How items that fail are handled, defaults to applying all the items or none of them.
+	Mode *BatchMode `json:"mode,omitempty"`
+}
+
+// Return response from statusUpdateBatch
+type StatusUpdateBatchResponse struct {
+	// The result of each item, in the order of the input items.
+	Results []*StatusUpdateBatchResult `json:"results"`
+}
+
+// The result of an item of statusUpdateBatch.
+type StatusUpdateBatchResult struct {
+	// The set status, when the item succeeded.
+	Status *generated.Status `json:"status,omitempty"`
+	// The reason the item failed.
+	Error *string `json:"error,omitempty"`
+}
+
 // Input information to update an status.
 type StatusUpdateInput struct {
 	// The node ID for this status.
 	NodeID gidx.PrefixedID `json:"nodeID"`
-	// The namespace ID for this status.
-	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	// The namespace ID for this status. Either namespaceID, or ownerID and namespaceName, must be set.
+	NamespaceID *gidx.PrefixedID `json:"namespaceID,omitempty"`
+	// The ID of the resource provider of the namespace, to name the namespace by namespaceName instead of namespaceID.
+	OwnerID *gidx.PrefixedID `json:"ownerID,omitempty"`
+	// The name of the namespace, used with ownerID instead of namespaceID.
+	NamespaceName *string `json:"namespaceName,omitempty"`
 	// The source for this status.
 	Source string `json:"source"`
-	// The data to save in this status.
+	// The data to save in this status. When a patch mode is used, this is the patch to apply to the stored data.
 	Data json.RawMessage `json:"data"`
+	// How the data is applied to the stored data, defaults to replacing it.
+	Mode *DataUpdateMode `json:"mode,omitempty"`
+	// The time the status expires. Can't be set together with ttl.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// The number of seconds until the status expires. Can't be set together with expiresAt. When neither is set, the default TTL of the namespace is used.
+	TTL *int `json:"ttl,omitempty"`
+	// The version the status must be at for the update to be applied, 0 when it must not exist yet.
+	ExpectedVersion *int `json:"expectedVersion,omitempty"`
 }
 
 // Return response from statusUpdate
@@ -139,3 +267,142 @@ type StatusUpdateResponse struct {
 	// The set status.
 	Status *generated.Status `json:"status"`
 }
+
+// BatchMode defines how a batch mutation handles items that fail.
+type BatchMode string
+
+const (
+	// Apply all the items or none of them. The batch fails with the error of the first item that fails.
+	BatchModeAllOrNothing BatchMode = "ALL_OR_NOTHING"
+	// Apply every item that succeeds and return the error of each item that fails. The batch still fails
+	// when the database returns an error, since the state of the transaction is unknown then.
+	BatchModeBestEffort BatchMode = "BEST_EFFORT"
+)
+
+var AllBatchMode = []BatchMode{
+	BatchModeAllOrNothing,
+	BatchModeBestEffort,
+}
+
+func (e BatchMode) IsValid() bool {
+	switch e {
+	case BatchModeAllOrNothing, BatchModeBestEffort:
+		return true
+	}
+	return false
+}
+
+func (e BatchMode) String() string {
+	return string(e)
+}
+
+func (e *BatchMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BatchMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BatchMode", str)
+	}
+	return nil
+}
+
+func (e BatchMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// ChangeOperation is the kind of change made to a status or annotation.
+type ChangeOperation string
+
+const (
+	// The record was created.
+	ChangeOperationCreate ChangeOperation = "CREATE"
+	// The record was updated.
+	ChangeOperationUpdate ChangeOperation = "UPDATE"
+	// The record was deleted.
+	ChangeOperationDelete ChangeOperation = "DELETE"
+)
+
+var AllChangeOperation = []ChangeOperation{
+	ChangeOperationCreate,
+	ChangeOperationUpdate,
+	ChangeOperationDelete,
+}
+
+func (e ChangeOperation) IsValid() bool {
+	switch e {
+	case ChangeOperationCreate, ChangeOperationUpdate, ChangeOperationDelete:
+		return true
+	}
+	return false
+}
+
+func (e ChangeOperation) String() string {
+	return string(e)
+}
+
+func (e *ChangeOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeOperation", str)
+	}
+	return nil
+}
+
+func (e ChangeOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// DataUpdateMode defines how the data of an update is applied to the stored data.
+type DataUpdateMode string
+
+const (
+	// Replace the stored data with the given data.
+	DataUpdateModeReplace DataUpdateMode = "REPLACE"
+	// Apply the given data to the stored data as an RFC 7396 JSON merge patch.
+	DataUpdateModeMergePatch DataUpdateMode = "MERGE_PATCH"
+	// Apply the given data to the stored data as an RFC 6902 JSON Patch document.
+	DataUpdateModeJSONPatch DataUpdateMode = "JSON_PATCH"
+)
+
+var AllDataUpdateMode = []DataUpdateMode{
+	DataUpdateModeReplace,
+	DataUpdateModeMergePatch,
+	DataUpdateModeJSONPatch,
+}
+
+func (e DataUpdateMode) IsValid() bool {
+	switch e {
+	case DataUpdateModeReplace, DataUpdateModeMergePatch, DataUpdateModeJSONPatch:
+		return true
+	}
+	return false
+}
+
+func (e DataUpdateMode) String() string {
+	return string(e)
+}
+
+func (e *DataUpdateMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DataUpdateMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DataUpdateMode", str)
+	}
+	return nil
+}
+
+func (e DataUpdateMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,152 @@
+package graphapi
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationhistory"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
+)
+
+// statusAsOf returns a predicate which replaces the status table in the query with
+// the statuses as they were at the given time. A status that hasn't changed since
+// then is read from the status table, otherwise the state is read from the first
+// history record after the time, which holds the values from before that change.
+// Since the replacement has the same name and columns as the status table, the
+// query can still be filtered, ordered and paginated as usual. The status table
+// in the replacement must not be aliased, as the selector qualifies the columns of
+// the query with the name of the first table of the replacement.
+func statusAsOf(t time.Time) predicate.Status {
+	return func(s *sql.Selector) {
+		b := sql.Dialect(s.Dialect())
+
+		st := b.Table(status.Table)
+		h := b.Table(statushistory.Table).As("h")
+
+		current := b.Select(
+			st.C(status.FieldID),
+			st.C(status.FieldCreatedAt),
+			st.C(status.FieldUpdatedAt),
+			st.C(status.FieldMetadataID),
+			st.C(status.FieldStatusNamespaceID),
+			st.C(status.FieldSource),
+			st.C(status.FieldData),
+			st.C(status.FieldVersion),
+			st.C(status.FieldExpiresAt),
+		).From(st).Where(sql.And(
+			sql.LTE(st.C(status.FieldCreatedAt), t),
+			sql.NotExists(statusChangesAfter(b, t, st.C(status.FieldID), nil)),
+		))
+
+		previous := b.Select(
+			h.C(statushistory.FieldStatusID),
+			h.C(statushistory.FieldStatusCreatedAt),
+			h.C(statushistory.FieldStatusUpdatedAt),
+			h.C(statushistory.FieldMetadataID),
+			h.C(statushistory.FieldStatusNamespaceID),
+			h.C(statushistory.FieldSource),
+			h.C(statushistory.FieldData),
+			h.C(statushistory.FieldStatusVersion),
+			h.C(statushistory.FieldStatusExpiresAt),
+		).From(h).Where(sql.And(
+			sql.GT(h.C(statushistory.FieldCreatedAt), t),
+			sql.NEQ(h.C(statushistory.FieldOperation), statushistory.OperationCREATE.String()),
+			sql.LTE(h.C(statushistory.FieldStatusCreatedAt), t),
+			sql.NotExists(statusChangesAfter(b, t, h.C(statushistory.FieldStatusID), h)),
+		))
+
+		s.From(current.UnionAll(previous).As(status.Table))
+	}
+}
+
+// statusChangesAfter selects the history of the status with the given id column
+// that was recorded after t. If first is set, only changes made before that
+// history record are selected.
+func statusChangesAfter(b *sql.DialectBuilder, t time.Time, idColumn string, first *sql.SelectTable) *sql.Selector {
+	l := b.Table(statushistory.Table).As("l")
+
+	preds := []*sql.Predicate{
+		sql.ColumnsEQ(l.C(statushistory.FieldStatusID), idColumn),
+		sql.GT(l.C(statushistory.FieldCreatedAt), t),
+	}
+
+	if first != nil {
+		preds = append(preds, sql.Or(
+			sql.ColumnsLT(l.C(statushistory.FieldCreatedAt), first.C(statushistory.FieldCreatedAt)),
+			sql.And(
+				sql.ColumnsEQ(l.C(statushistory.FieldCreatedAt), first.C(statushistory.FieldCreatedAt)),
+				sql.ColumnsLT(l.C(statushistory.FieldID), first.C(statushistory.FieldID)),
+			),
+		))
+	}
+
+	return b.Select(l.C(statushistory.FieldID)).From(l).Where(sql.And(preds...))
+}
+
+// annotationAsOf returns a predicate which replaces the annotations table in the
+// query with the annotations as they were at the given time. See statusAsOf.
+func annotationAsOf(t time.Time) predicate.Annotation {
+	return func(s *sql.Selector) {
+		b := sql.Dialect(s.Dialect())
+
+		ant := b.Table(annotation.Table)
+		h := b.Table(annotationhistory.Table).As("h")
+
+		current := b.Select(
+			ant.C(annotation.FieldID),
+			ant.C(annotation.FieldCreatedAt),
+			ant.C(annotation.FieldUpdatedAt),
+			ant.C(annotation.FieldMetadataID),
+			ant.C(annotation.FieldAnnotationNamespaceID),
+			ant.C(annotation.FieldData),
+			ant.C(annotation.FieldVersion),
+		).From(ant).Where(sql.And(
+			sql.LTE(ant.C(annotation.FieldCreatedAt), t),
+			sql.NotExists(annotationChangesAfter(b, t, ant.C(annotation.FieldID), nil)),
+		))
+
+		previous := b.Select(
+			h.C(annotationhistory.FieldAnnotationID),
+			h.C(annotationhistory.FieldAnnotationCreatedAt),
+			h.C(annotationhistory.FieldAnnotationUpdatedAt),
+			h.C(annotationhistory.FieldMetadataID),
+			h.C(annotationhistory.FieldAnnotationNamespaceID),
+			h.C(annotationhistory.FieldData),
+			h.C(annotationhistory.FieldAnnotationVersion),
+		).From(h).Where(sql.And(
+			sql.GT(h.C(annotationhistory.FieldCreatedAt), t),
+			sql.NEQ(h.C(annotationhistory.FieldOperation), annotationhistory.OperationCREATE.String()),
+			sql.LTE(h.C(annotationhistory.FieldAnnotationCreatedAt), t),
+			sql.NotExists(annotationChangesAfter(b, t, h.C(annotationhistory.FieldAnnotationID), h)),
+		))
+
+		s.From(current.UnionAll(previous).As(annotation.Table))
+	}
+}
+
+// annotationChangesAfter selects the history of the annotation with the given id
+// column that was recorded after t. See statusChangesAfter.
+func annotationChangesAfter(b *sql.DialectBuilder, t time.Time, idColumn string, first *sql.SelectTable) *sql.Selector {
+	l := b.Table(annotationhistory.Table).As("l")
+
+	preds := []*sql.Predicate{
+		sql.ColumnsEQ(l.C(annotationhistory.FieldAnnotationID), idColumn),
+		sql.GT(l.C(annotationhistory.FieldCreatedAt), t),
+	}
+
+	if first != nil {
+		preds = append(preds, sql.Or(
+			sql.ColumnsLT(l.C(annotationhistory.FieldCreatedAt), first.C(annotationhistory.FieldCreatedAt)),
+			sql.And(
+				sql.ColumnsEQ(l.C(annotationhistory.FieldCreatedAt), first.C(annotationhistory.FieldCreatedAt)),
+				sql.ColumnsLT(l.C(annotationhistory.FieldID), first.C(annotationhistory.FieldID)),
+			),
+		))
+	}
+
+	return b.Select(l.C(annotationhistory.FieldID)).From(l).Where(sql.And(preds...))
+}
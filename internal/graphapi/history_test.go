@@ -0,0 +1,174 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/echojwtx"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
+	"go.infratographer.com/metadata-api/internal/testclient"
+)
+
+func TestMetadataAsOf(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	nodeID := gidx.MustNewID("testing")
+	statusNS := StatusNamespaceBuilder{}.MustNew(ctx)
+	annotationNS := AnnotationNamespaceBuilder{}.MustNew(ctx)
+
+	// timestamps between each change, times[i] is the state after change i
+	times := []time.Time{time.Now()}
+
+	changes := []func() error{
+		func() error {
+			_, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{NodeID: nodeID, NamespaceID: newID(statusNS.ID), Source: "tests", Data: json.RawMessage(`{"v":1}`)})
+			if err != nil {
+				return err
+			}
+
+			_, err = graphTestClient().AnnotationUpdate(ctx, testclient.AnnotationUpdateInput{NodeID: nodeID, NamespaceID: newID(annotationNS.ID), Data: json.RawMessage(`{"v":1}`)})
+
+			return err
+		},
+		func() error {
+			_, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{NodeID: nodeID, NamespaceID: newID(statusNS.ID), Source: "tests", Data: json.RawMessage(`{"v":2}`)})
+			if err != nil {
+				return err
+			}
+
+			_, err = graphTestClient().AnnotationUpdate(ctx, testclient.AnnotationUpdateInput{NodeID: nodeID, NamespaceID: newID(annotationNS.ID), Data: json.RawMessage(`{"v":2}`)})
+
+			return err
+		},
+		func() error {
+			_, err := graphTestClient().StatusDelete(ctx, testclient.StatusDeleteInput{NodeID: nodeID, NamespaceID: newID(statusNS.ID), Source: "tests"})
+			if err != nil {
+				return err
+			}
+
+			_, err = graphTestClient().AnnotationDelete(ctx, testclient.AnnotationDeleteInput{NodeID: nodeID, NamespaceID: newID(annotationNS.ID)})
+
+			return err
+		},
+	}
+
+	for _, change := range changes {
+		require.NoError(t, change())
+
+		times = append(times, time.Now())
+	}
+
+	testCases := []struct {
+		TestName     string
+		AsOf         *time.Time
+		ExpectedData json.RawMessage
+	}{
+		{
+			TestName: "before the statuses and annotations were created",
+			AsOf:     &times[0],
+		},
+		{
+			TestName:     "after they were created",
+			AsOf:         &times[1],
+			ExpectedData: json.RawMessage(`{"v":1}`),
+		},
+		{
+			TestName:     "after they were updated",
+			AsOf:         &times[2],
+			ExpectedData: json.RawMessage(`{"v":2}`),
+		},
+		{
+			TestName: "after they were deleted",
+			AsOf:     &times[3],
+		},
+		{
+			TestName: "current state",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			resp, err := graphTestClient().GetNodeMetadataAsOf(ctx, nodeID, tt.AsOf)
+			require.NoError(t, err)
+			require.Len(t, resp.Entities, 1)
+			require.NotNil(t, resp.Entities[0].Metadata)
+
+			md := resp.Entities[0].Metadata
+
+			if tt.ExpectedData == nil {
+				assert.Empty(t, md.Statuses.Edges)
+				assert.Empty(t, md.Annotations.Edges)
+
+				return
+			}
+
+			require.Len(t, md.Statuses.Edges, 1)
+			st := md.Statuses.Edges[0].Node
+			assert.Equal(t, statusNS.ID, st.Namespace.ID)
+			assert.Equal(t, "tests", st.Source)
+			assert.JSONEq(t, string(tt.ExpectedData), string(st.Data))
+
+			// history of a past status is still available after it is deleted
+			require.Len(t, st.History.Edges, 3)
+			assert.Equal(t, testclient.StatusHistoryOperationCreate, st.History.Edges[0].Node.Operation)
+			assert.JSONEq(t, `null`, string(st.History.Edges[0].Node.Data))
+			assert.Equal(t, testclient.StatusHistoryOperationUpdate, st.History.Edges[1].Node.Operation)
+			assert.JSONEq(t, `{"v":1}`, string(st.History.Edges[1].Node.Data))
+			assert.Equal(t, testclient.StatusHistoryOperationDelete, st.History.Edges[2].Node.Operation)
+			assert.JSONEq(t, `{"v":2}`, string(st.History.Edges[2].Node.Data))
+
+			require.Len(t, md.Annotations.Edges, 1)
+			ant := md.Annotations.Edges[0].Node
+			assert.Equal(t, annotationNS.ID, ant.Namespace.ID)
+			assert.JSONEq(t, string(tt.ExpectedData), string(ant.Data))
+
+			require.Len(t, ant.History.Edges, 3)
+			assert.Equal(t, testclient.AnnotationHistoryOperationCreate, ant.History.Edges[0].Node.Operation)
+			assert.JSONEq(t, `{"v":1}`, string(ant.History.Edges[1].Node.Data))
+			assert.JSONEq(t, `{"v":2}`, string(ant.History.Edges[2].Node.Data))
+		})
+	}
+}
+
+func TestStatusHistoryActor(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	st := StatusBuilder{}.MustNew(ctx)
+
+	actorCtx := context.WithValue(ctx, echojwtx.ActorCtxKey, "idntusr-tester")
+
+	_, err := EntClient.Status.UpdateOne(st).SetData(json.RawMessage(`{"updated":true}`)).Save(actorCtx)
+	require.NoError(t, err)
+
+	history, err := EntClient.StatusHistory.Query().Where(statushistory.StatusID(st.ID)).Order(statushistory.ByCreatedAt()).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	assert.Equal(t, statushistory.OperationCREATE, history[0].Operation)
+	assert.Empty(t, history[0].Actor)
+
+	assert.Equal(t, statushistory.OperationUPDATE, history[1].Operation)
+	assert.Equal(t, "idntusr-tester", history[1].Actor)
+	assert.Equal(t, st.Source, history[1].Source)
+	assert.JSONEq(t, string(st.Data), string(history[1].Data))
+}
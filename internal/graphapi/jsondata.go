@@ -0,0 +1,169 @@
+package graphapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// dataContains returns a predicate which matches rows whose JSON data in column
+// contains the given document, using the Postgres @> operator.
+//
+// SQLite has no containment operator, so there every value in the document's
+// objects is compared with the value at the same path in the data. Arrays and
+// scalars are compared by equality, empty objects match any object.
+func dataContains(column string, doc json.RawMessage) (func(*sql.Selector), error) {
+	leaves, err := documentLeaves(nil, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(s *sql.Selector) {
+		// the column is qualified now, the selector may be aliased when it's
+		// used as a subquery before the predicate is built
+		col := s.C(column)
+
+		if s.Dialect() == dialect.Postgres {
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.Ident(col).WriteString(" @> ").Arg(string(doc)).WriteString("::jsonb")
+			}))
+
+			return
+		}
+
+		preds := make([]*sql.Predicate, len(leaves))
+
+		for i, leaf := range leaves {
+			if leaf.object {
+				path := sqlitePath(leaf.path)
+
+				preds[i] = sql.P(func(b *sql.Builder) {
+					b.WriteString("json_type(").Ident(col).Comma().Arg(path).WriteString(") = 'object'")
+				})
+
+				continue
+			}
+
+			preds[i] = dataValueEQ(col, leaf.path, leaf.value)
+		}
+
+		s.Where(sql.And(preds...))
+	}, nil
+}
+
+// dataPathEQ returns a predicate which matches rows where the value at the path in
+// the JSON data in column is equal to the given value.
+func dataPathEQ(column string, path []string, value json.RawMessage) (func(*sql.Selector), error) {
+	if len(path) == 0 {
+		return nil, NewInvalidFieldError("path", ErrFieldEmpty)
+	}
+
+	if !json.Valid(value) {
+		return nil, NewInvalidFieldError("value", ErrInvalidJSON)
+	}
+
+	return func(s *sql.Selector) {
+		s.Where(dataValueEQ(s.C(column), path, value))
+	}, nil
+}
+
+// dataValueEQ compares the JSON value at the path in the column with the given
+// value. The path elements are passed as arguments, so they are never written to
+// the query itself.
+func dataValueEQ(column string, path []string, value json.RawMessage) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		if b.Dialect() == dialect.Postgres {
+			b.WriteString("jsonb_extract_path(").Ident(column)
+
+			for _, p := range path {
+				b.Comma().Arg(p)
+			}
+
+			b.WriteString(") = ").Arg(string(value)).WriteString("::jsonb")
+
+			return
+		}
+
+		b.Ident(column).WriteString(" -> ").Arg(sqlitePath(path)).WriteString(" = json(").Arg(string(value)).WriteByte(')')
+	})
+}
+
+// sqlitePath returns the SQLite JSON path for the path elements. Elements that
+// are numbers select array elements, like they do in Postgres when the value at
+// the path is an array.
+func sqlitePath(path []string) string {
+	var sb strings.Builder
+
+	sb.WriteByte('$')
+
+	for _, p := range path {
+		if isArrayIndex(p) {
+			sb.WriteString("[" + p + "]")
+		} else {
+			sb.WriteString(`."` + p + `"`)
+		}
+	}
+
+	return sb.String()
+}
+
+func isArrayIndex(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+type documentLeaf struct {
+	path   []string
+	value  json.RawMessage
+	object bool
+}
+
+// documentLeaves returns the values in the document which aren't objects, along
+// with their path. Empty objects are returned as leaves themselves.
+func documentLeaves(path []string, doc json.RawMessage) ([]documentLeaf, error) {
+	if !json.Valid(doc) {
+		return nil, ErrInvalidJSON
+	}
+
+	if trimmed := bytes.TrimSpace(doc); len(trimmed) == 0 || trimmed[0] != '{' {
+		return []documentLeaf{{path: path, value: doc}}, nil
+	}
+
+	var obj map[string]json.RawMessage
+
+	if err := json.Unmarshal(doc, &obj); err != nil {
+		return nil, ErrInvalidJSON
+	}
+
+	if len(obj) == 0 {
+		return []documentLeaf{{path: path, value: doc, object: true}}, nil
+	}
+
+	var leaves []documentLeaf
+
+	for key, value := range obj {
+		// copy the path, so the leaves don't share the backing array
+		keyPath := append(append([]string{}, path...), key)
+
+		l, err := documentLeaves(keyPath, value)
+		if err != nil {
+			return nil, err
+		}
+
+		leaves = append(leaves, l...)
+	}
+
+	return leaves, nil
+}
@@ -0,0 +1,240 @@
+package graphapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+)
+
+const (
+	// jsonSchemaURL is the resource name used when compiling namespace JSON schemas.
+	jsonSchemaURL = "namespace.schema.json"
+
+	// schemaCheckPageSize is the number of records loaded at once when checking a
+	// namespace's data against a new schema.
+	schemaCheckPageSize = 500
+)
+
+// compileJSONSchema compiles the given JSON schema document. Remote references are
+// not resolved so a schema can't be used to load resources from the server.
+func compileJSONSchema(raw json.RawMessage) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.LoadURL = func(s string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("%w: %s", ErrJSONSchemaRemoteRef, s)
+	}
+
+	if err := c.AddResource(jsonSchemaURL, bytes.NewReader(raw)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSONSchema, err)
+	}
+
+	schema, err := c.Compile(jsonSchemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidJSONSchema, err)
+	}
+
+	return schema, nil
+}
+
+// schemaKey identifies the version of a namespace's JSON schema.
+type schemaKey struct {
+	namespaceID gidx.PrefixedID
+	updatedAt   time.Time
+}
+
+type compiledSchema struct {
+	schema *jsonschema.Schema
+	err    error
+}
+
+// schemaCache holds the JSON schemas compiled in a request, so the schema of a
+// namespace is compiled once however many records are written to it. Schemas are
+// keyed by when their namespace was last updated, a changed schema is compiled
+// again.
+type schemaCache struct {
+	mu      sync.Mutex
+	schemas map[schemaKey]compiledSchema
+}
+
+func newSchemaCache() *schemaCache {
+	return &schemaCache{schemas: make(map[schemaKey]compiledSchema)}
+}
+
+// validate validates data against the JSON schema of the namespace. A nil or
+// empty schema accepts any data. The returned error lists each violation with the
+// path of the value that failed to validate.
+func (c *schemaCache) validate(namespaceID gidx.PrefixedID, updatedAt time.Time, raw json.RawMessage, data json.RawMessage) error {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	key := schemaKey{namespaceID: namespaceID, updatedAt: updatedAt}
+
+	c.mu.Lock()
+
+	compiled, ok := c.schemas[key]
+	if !ok {
+		compiled.schema, compiled.err = compileJSONSchema(raw)
+		c.schemas[key] = compiled
+	}
+
+	c.mu.Unlock()
+
+	if compiled.err != nil {
+		return compiled.err
+	}
+
+	return validateWithSchema(compiled.schema, data)
+}
+
+// validateWithSchema validates data against an already compiled JSON schema.
+func validateWithSchema(schema *jsonschema.Schema, data json.RawMessage) error {
+	var v interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if err := dec.Decode(&v); err != nil {
+		return ErrInvalidJSON
+	}
+
+	err := schema.Validate(v)
+	if err == nil {
+		return nil
+	}
+
+	var ve *jsonschema.ValidationError
+	if !errors.As(err, &ve) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %s", ErrJSONSchemaValidation, strings.Join(schemaViolations(ve), "; "))
+}
+
+// schemaViolations flattens a validation error into a list of "path: message" strings,
+// one for each leaf error.
+func schemaViolations(ve *jsonschema.ValidationError) []string {
+	if len(ve.Causes) == 0 {
+		loc := ve.InstanceLocation
+		if loc == "" {
+			loc = "/"
+		}
+
+		return []string{fmt.Sprintf("%s: %s", loc, ve.Message)}
+	}
+
+	var violations []string
+
+	for _, cause := range ve.Causes {
+		violations = append(violations, schemaViolations(cause)...)
+	}
+
+	return violations
+}
+
+// countInvalidAnnotations returns the number of annotations in the namespace whose
+// data doesn't validate against the namespace json schema.
+func (r *Resolver) countInvalidAnnotations(ctx context.Context, ns *generated.AnnotationNamespace) (int, error) {
+	if len(ns.JSONSchema) == 0 {
+		return 0, nil
+	}
+
+	schema, err := compileJSONSchema(ns.JSONSchema)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		count  int
+		lastID gidx.PrefixedID
+	)
+
+	// page through the namespace by id so large namespaces aren't loaded at once
+	for {
+		query := r.client.Annotation.Query().Where(annotation.AnnotationNamespaceID(ns.ID))
+
+		if lastID != "" {
+			query.Where(annotation.IDGT(lastID))
+		}
+
+		annotations, err := query.
+			Order(generated.Asc(annotation.FieldID)).
+			Limit(schemaCheckPageSize).
+			Select(annotation.FieldID, annotation.FieldData).
+			All(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, ant := range annotations {
+			if err := validateWithSchema(schema, ant.Data); err != nil {
+				count++
+			}
+		}
+
+		if len(annotations) < schemaCheckPageSize {
+			return count, nil
+		}
+
+		lastID = annotations[len(annotations)-1].ID
+	}
+}
+
+// countInvalidStatuses returns the number of statuses in the namespace whose
+// data doesn't validate against the namespace json schema.
+func (r *Resolver) countInvalidStatuses(ctx context.Context, ns *generated.StatusNamespace) (int, error) {
+	if len(ns.JSONSchema) == 0 {
+		return 0, nil
+	}
+
+	schema, err := compileJSONSchema(ns.JSONSchema)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		count  int
+		lastID gidx.PrefixedID
+	)
+
+	for {
+		query := r.client.Status.Query().Where(status.StatusNamespaceID(ns.ID))
+
+		if lastID != "" {
+			query.Where(status.IDGT(lastID))
+		}
+
+		statuses, err := query.
+			Order(generated.Asc(status.FieldID)).
+			Limit(schemaCheckPageSize).
+			Select(status.FieldID, status.FieldData).
+			All(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, st := range statuses {
+			if err := validateWithSchema(schema, st.Data); err != nil {
+				count++
+			}
+		}
+
+		if len(statuses) < schemaCheckPageSize {
+			return count, nil
+		}
+
+		lastID = statuses[len(statuses)-1].ID
+	}
+}
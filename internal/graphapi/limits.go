@@ -0,0 +1,228 @@
+package graphapi
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+)
+
+const (
+	// DefaultMaxDepth is the default maximum depth of queries.
+	DefaultMaxDepth = 15
+
+	// DefaultMaxComplexity is the default maximum complexity of queries.
+	DefaultMaxComplexity = 10000
+
+	// DefaultMaxPageSize is the default maximum number of records requested from a connection.
+	DefaultMaxPageSize = 1000
+
+	// unboundedConnectionSize is the number of records a connection requested
+	// without first or last is counted as in the complexity of a query.
+	unboundedConnectionSize = 10
+
+	limitsExtension = "QueryLimits"
+)
+
+// Limits configures the limits queries are rejected by. A limit of 0 disables it.
+type Limits struct {
+	// MaxDepth is the maximum number of nested fields in a query.
+	MaxDepth int
+
+	// MaxComplexity is the maximum complexity of a query. Each field costs 1 and
+	// the fields of connections are multiplied by the number of records
+	// requested with first or last.
+	MaxComplexity int
+
+	// MaxPageSize is the maximum value of first and last on connections.
+	MaxPageSize int
+}
+
+// WithLimits sets the limits of the queries the handler runs.
+func WithLimits(l Limits) Option {
+	return func(r *Resolver) {
+		r.limits = l
+	}
+}
+
+// complexityRoot returns the complexity functions of the fields, the fields of
+// connections cost as much as the number of records requested.
+func complexityRoot() ComplexityRoot {
+	var c ComplexityRoot
+
+	c.Annotation.History = func(childComplexity int, _ *entgql.Cursor[gidx.PrefixedID], first *int, _ *entgql.Cursor[gidx.PrefixedID], last *int, _ *generated.AnnotationHistoryOrder, _ *generated.AnnotationHistoryWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	c.AnnotationNamespace.Annotations = func(childComplexity int, _ *entgql.Cursor[gidx.PrefixedID], first *int, _ *entgql.Cursor[gidx.PrefixedID], last *int, _ *generated.AnnotationOrder, _ *generated.AnnotationWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	c.Metadata.Annotations = func(childComplexity int, _ *entgql.Cursor[gidx.PrefixedID], first *int, _ *entgql.Cursor[gidx.PrefixedID], last *int, _ *generated.AnnotationOrder, _ *generated.AnnotationWhereInput, _ *time.Time) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	c.Metadata.Statuses = func(childComplexity int, _ *entgql.Cursor[gidx.PrefixedID], first *int, _ *entgql.Cursor[gidx.PrefixedID], last *int, _ *generated.StatusOrder, _ *generated.StatusWhereInput, _ *time.Time) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	c.ResourceOwner.AnnotationNamespaces = func(childComplexity int, _ *entgql.Cursor[gidx.PrefixedID], first *int, _ *entgql.Cursor[gidx.PrefixedID], last *int, _ *generated.AnnotationNamespaceOrder, _ *generated.AnnotationNamespaceWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	c.Status.History = func(childComplexity int, _ *entgql.Cursor[gidx.PrefixedID], first *int, _ *entgql.Cursor[gidx.PrefixedID], last *int, _ *generated.StatusHistoryOrder, _ *generated.StatusHistoryWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	c.StatusNamespace.Statuses = func(childComplexity int, _ *entgql.Cursor[gidx.PrefixedID], first *int, _ *entgql.Cursor[gidx.PrefixedID], last *int, _ *generated.StatusOrder, _ *generated.StatusWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+	c.StatusOwner.StatusNamespaces = func(childComplexity int, _ *entgql.Cursor[gidx.PrefixedID], first *int, _ *entgql.Cursor[gidx.PrefixedID], last *int, _ *generated.StatusNamespaceOrder, _ *generated.StatusNamespaceWhereInput) int {
+		return connectionComplexity(childComplexity, first, last)
+	}
+
+	return c
+}
+
+func connectionComplexity(childComplexity int, first, last *int) int {
+	size := unboundedConnectionSize
+
+	switch {
+	case first != nil:
+		size = *first
+	case last != nil:
+		size = *last
+	}
+
+	return 1 + childComplexity*max(size, 1)
+}
+
+// queryLimits rejects operations nested deeper than the max depth, or which
+// request more records than the max page size from a connection.
+type queryLimits struct {
+	Limits
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = queryLimits{}
+
+// ExtensionName implements graphql.HandlerExtension.
+func (queryLimits) ExtensionName() string {
+	return limitsExtension
+}
+
+// Validate implements graphql.HandlerExtension.
+func (queryLimits) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext implements graphql.OperationContextMutator.
+func (l queryLimits) MutateOperationContext(_ context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	depth, err := l.check(op.SelectionSet, rc.Variables, 1)
+	if err != nil {
+		return err
+	}
+
+	if l.MaxDepth > 0 && depth > l.MaxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, l.MaxDepth)
+		errcode.Set(err, CodeDepthLimitExceeded)
+
+		return err
+	}
+
+	return nil
+}
+
+// check checks the page size of the connections in the selection set and returns
+// its depth. Introspection fields are skipped.
+func (l queryLimits) check(set ast.SelectionSet, vars map[string]interface{}, depth int) (int, *gqlerror.Error) {
+	maxDepth := depth - 1
+
+	for _, sel := range set {
+		var (
+			d   int
+			err *gqlerror.Error
+		)
+
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name, "__") {
+				continue
+			}
+
+			if err := l.checkPageSize(sel, vars); err != nil {
+				return 0, err
+			}
+
+			d, err = l.check(sel.SelectionSet, vars, depth+1)
+		case *ast.InlineFragment:
+			d, err = l.check(sel.SelectionSet, vars, depth)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				d, err = l.check(sel.Definition.SelectionSet, vars, depth)
+			}
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		maxDepth = max(maxDepth, d)
+	}
+
+	return maxDepth, nil
+}
+
+func (l queryLimits) checkPageSize(field *ast.Field, vars map[string]interface{}) *gqlerror.Error {
+	if l.MaxPageSize <= 0 || field.Definition == nil {
+		return nil
+	}
+
+	args := field.ArgumentMap(vars)
+
+	for _, name := range []string{"first", "last"} {
+		if field.Definition.Arguments.ForName(name) == nil {
+			continue
+		}
+
+		if size, ok := intValue(args[name]); ok && size > int64(l.MaxPageSize) {
+			err := gqlerror.ErrorPosf(field.Position, "%s: must not be more than the max page size of %d", name, l.MaxPageSize)
+			err.Extensions = map[string]interface{}{
+				"code":  CodeInvalidField,
+				"field": name,
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// intValue returns the value of an Int argument, which is decoded differently
+// when given inline or as a variable.
+func intValue(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case json.Number:
+		i, err := v.Int64()
+		return i, err == nil
+	case float64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,149 @@
+package graphapi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/metadata-api/internal/graphapi"
+)
+
+func TestQueryLimits(t *testing.T) {
+	const nodeMetadataQuery = `query($id: ID!, $first: Int) {
+		_entities(representations: [{ __typename: "MetadataNode", id: $id }]) {
+			... on MetadataNode {
+				metadata {
+					annotations(first: $first) {
+						edges { node { id data } }
+					}
+				}
+			}
+		}
+	}`
+
+	limits := graphapi.Limits{
+		MaxDepth:      graphapi.DefaultMaxDepth,
+		MaxComplexity: graphapi.DefaultMaxComplexity,
+		MaxPageSize:   graphapi.DefaultMaxPageSize,
+	}
+
+	testCases := []struct {
+		TestName  string
+		Limits    graphapi.Limits
+		Query     string
+		First     int
+		errorCode string
+		errorMsg  string
+		field     string
+	}{
+		{
+			TestName: "query within the limits",
+			Limits:   limits,
+			Query:    nodeMetadataQuery,
+			First:    10,
+		},
+		{
+			TestName: "no limits",
+			Query:    nodeMetadataQuery,
+			First:    100000,
+		},
+		{
+			TestName:  "query deeper than the max depth",
+			Limits:    graphapi.Limits{MaxDepth: 5},
+			Query:     nodeMetadataQuery,
+			errorCode: graphapi.CodeDepthLimitExceeded,
+			errorMsg:  "operation has depth 6, which exceeds the limit of 5",
+		},
+		{
+			TestName: "depth of fragments",
+			Limits:   graphapi.Limits{MaxDepth: 3},
+			Query: `query($id: ID!) {
+				_entities(representations: [{ __typename: "MetadataNode", id: $id }]) { ...node }
+			}
+			fragment node on MetadataNode { metadata { ...metadata } }
+			fragment metadata on Metadata { annotations { edges { node { id } } } }`,
+			errorCode: graphapi.CodeDepthLimitExceeded,
+			errorMsg:  "operation has depth 6, which exceeds the limit of 3",
+		},
+		{
+			TestName: "introspection doesn't count towards the depth",
+			Limits:   graphapi.Limits{MaxDepth: 2},
+			Query:    `query { __schema { types { fields { type { ofType { name } } } } } }`,
+		},
+		{
+			TestName:  "page size over the max page size",
+			Limits:    limits,
+			Query:     nodeMetadataQuery,
+			First:     graphapi.DefaultMaxPageSize + 1,
+			errorCode: graphapi.CodeInvalidField,
+			errorMsg:  "first: must not be more than the max page size of 1000",
+			field:     "first",
+		},
+		{
+			TestName: "inline page size over the max page size",
+			Limits:   graphapi.Limits{MaxPageSize: 10},
+			Query: `query($id: ID!) {
+				_entities(representations: [{ __typename: "MetadataNode", id: $id }]) {
+					... on MetadataNode { metadata { statuses(last: 11) { edges { node { id } } } } }
+				}
+			}`,
+			errorCode: graphapi.CodeInvalidField,
+			errorMsg:  "last: must not be more than the max page size of 10",
+			field:     "last",
+		},
+		{
+			TestName:  "connections are counted once per requested record",
+			Limits:    graphapi.Limits{MaxComplexity: 300},
+			Query:     nodeMetadataQuery,
+			First:     100,
+			errorCode: graphapi.CodeComplexityLimitExceeded,
+			errorMsg:  "operation has complexity 403, which exceeds the limit of 300",
+		},
+		{
+			TestName: "nested connections",
+			Limits:   graphapi.Limits{MaxComplexity: 10000},
+			Query: `query($id: ID!) {
+				_entities(representations: [{ __typename: "MetadataNode", id: $id }]) {
+					... on MetadataNode {
+						metadata {
+							annotations(first: 100) {
+								edges { node { history(first: 100) { edges { node { id } } } } }
+							}
+						}
+					}
+				}
+			}`,
+			errorCode: graphapi.CodeComplexityLimitExceeded,
+			errorMsg:  "which exceeds the limit of 10000",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			vars := map[string]any{"id": "testing-limits"}
+			if tt.First != 0 {
+				vars["first"] = tt.First
+			}
+
+			handler := graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithLimits(tt.Limits)).Handler(false).Handler()
+
+			resp := postGraphQuery(t, handler, map[string]any{"query": tt.Query, "variables": vars})
+
+			if tt.errorCode == "" {
+				assert.Empty(t, resp.Errors)
+
+				return
+			}
+
+			require.Len(t, resp.Errors, 1)
+			assert.Contains(t, resp.Errors[0].Message, tt.errorMsg)
+			assert.Equal(t, tt.errorCode, resp.Errors[0].Extensions["code"])
+
+			if tt.field != "" {
+				assert.Equal(t, tt.field, resp.Errors[0].Extensions["field"])
+			}
+		})
+	}
+}
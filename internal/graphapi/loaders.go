@@ -0,0 +1,176 @@
+package graphapi
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader/v7"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+)
+
+type loadersCtxKey struct{}
+
+// loaders holds the request scoped dataloaders used to batch lookups that are
+// resolved concurrently, such as federation entity representations, the
+// namespaces of lists of statuses and annotations, and the permission checks of
+// the nodes their metadata belongs to.
+//
+// The loaders only batch the lookups made at the same time, they don't cache the
+// results. Mutations and the events of subscriptions, which share the loaders of
+// their operation, always see the current data. Only the compiled JSON schemas of
+// namespaces are kept, by the version of the namespace they belong to.
+type loaders struct {
+	client *generated.Client
+
+	metadataByID            *dataloader.Loader[gidx.PrefixedID, *generated.Metadata]
+	metadataByNodeID        *dataloader.Loader[gidx.PrefixedID, *generated.Metadata]
+	annotationNamespaceByID *dataloader.Loader[gidx.PrefixedID, *generated.AnnotationNamespace]
+	statusNamespaceByID     *dataloader.Loader[gidx.PrefixedID, *generated.StatusNamespace]
+
+	// nodeAccess batches the permission checks of nodes by action
+	nodeAccess map[string]*dataloader.Loader[gidx.PrefixedID, bool]
+
+	schemas *schemaCache
+}
+
+func newLoaders(client *generated.Client) *loaders {
+	l := &loaders{client: client, schemas: newSchemaCache()}
+
+	l.metadataByID = newLoader(l.loadMetadataByID)
+	l.metadataByNodeID = newLoader(l.loadMetadataByNodeID)
+	l.annotationNamespaceByID = newLoader(l.loadAnnotationNamespaceByID)
+	l.statusNamespaceByID = newLoader(l.loadStatusNamespaceByID)
+
+	l.nodeAccess = make(map[string]*dataloader.Loader[gidx.PrefixedID, bool])
+
+	for _, action := range []string{
+		actionMetadataAnnotationGet,
+		actionMetadataAnnotationList,
+		actionMetadataStatusGet,
+		actionMetadataStatusList,
+	} {
+		l.nodeAccess[action] = newLoader(loadAccess(action))
+	}
+
+	return l
+}
+
+func newLoader[V any](batchFn dataloader.BatchFunc[gidx.PrefixedID, V]) *dataloader.Loader[gidx.PrefixedID, V] {
+	return dataloader.NewBatchedLoader(batchFn, dataloader.WithCache[gidx.PrefixedID, V](&dataloader.NoCache[gidx.PrefixedID, V]{}))
+}
+
+// withLoaders returns a copy of the context with a new set of dataloaders.
+func (r *Resolver) withLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersCtxKey{}, newLoaders(r.client))
+}
+
+// loaders returns the dataloaders for the request. If the context doesn't have
+// any, a new set is returned which will not be shared with other resolvers.
+func (r *Resolver) loaders(ctx context.Context) *loaders {
+	if l, ok := ctx.Value(loadersCtxKey{}).(*loaders); ok {
+		return l
+	}
+
+	return newLoaders(r.client)
+}
+
+func (l *loaders) loadMetadataByID(ctx context.Context, ids []gidx.PrefixedID) []*dataloader.Result[*generated.Metadata] {
+	mds, err := l.client.Metadata.Query().Where(metadata.IDIn(uniqueIDs(ids)...)).All(ctx)
+
+	return loaderResults(ids, mds, err, func(md *generated.Metadata) gidx.PrefixedID { return md.ID })
+}
+
+func (l *loaders) loadMetadataByNodeID(ctx context.Context, nodeIDs []gidx.PrefixedID) []*dataloader.Result[*generated.Metadata] {
+	mds, err := l.client.Metadata.Query().Where(metadata.NodeIDIn(uniqueIDs(nodeIDs)...)).All(ctx)
+
+	return loaderResults(nodeIDs, mds, err, func(md *generated.Metadata) gidx.PrefixedID { return md.NodeID })
+}
+
+func (l *loaders) loadAnnotationNamespaceByID(ctx context.Context, ids []gidx.PrefixedID) []*dataloader.Result[*generated.AnnotationNamespace] {
+	nss, err := l.client.AnnotationNamespace.Query().Where(annotationnamespace.IDIn(uniqueIDs(ids)...)).All(ctx)
+
+	return loaderResults(ids, nss, err, func(ns *generated.AnnotationNamespace) gidx.PrefixedID { return ns.ID })
+}
+
+func (l *loaders) loadStatusNamespaceByID(ctx context.Context, ids []gidx.PrefixedID) []*dataloader.Result[*generated.StatusNamespace] {
+	nss, err := l.client.StatusNamespace.Query().Where(statusnamespace.IDIn(uniqueIDs(ids)...)).All(ctx)
+
+	return loaderResults(ids, nss, err, func(ns *generated.StatusNamespace) gidx.PrefixedID { return ns.ID })
+}
+
+// checkNodeAccess checks whether the caller is permitted the action on the node.
+// The checks of the nodes resolved at the same time are made together.
+func (l *loaders) checkNodeAccess(ctx context.Context, nodeID gidx.PrefixedID, action string) error {
+	permitted, err := l.nodeAccess[action].Load(ctx, nodeID)()
+	if err != nil {
+		return err
+	}
+
+	if !permitted {
+		return permissions.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func loadAccess(action string) dataloader.BatchFunc[gidx.PrefixedID, bool] {
+	return func(ctx context.Context, ids []gidx.PrefixedID) []*dataloader.Result[bool] {
+		permitted, err := permittedResources(ctx, uniqueIDs(ids), action)
+
+		results := make([]*dataloader.Result[bool], len(ids))
+
+		for i, id := range ids {
+			results[i] = &dataloader.Result[bool]{Data: permitted[id], Error: err}
+		}
+
+		return results
+	}
+}
+
+// uniqueIDs returns the IDs without duplicates, the loaders don't cache so the
+// same key can be requested more than once in a batch.
+func uniqueIDs(ids []gidx.PrefixedID) []gidx.PrefixedID {
+	seen := make(map[gidx.PrefixedID]struct{}, len(ids))
+	unique := make([]gidx.PrefixedID, 0, len(ids))
+
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	return unique
+}
+
+// loaderResults orders the loaded values to match the requested keys. Keys
+// without a value get a nil result, since metadata is optional for a node.
+func loaderResults[V any](keys []gidx.PrefixedID, values []V, err error, keyFn func(V) gidx.PrefixedID) []*dataloader.Result[V] {
+	results := make([]*dataloader.Result[V], len(keys))
+
+	if err != nil {
+		for i := range results {
+			results[i] = &dataloader.Result[V]{Error: err}
+		}
+
+		return results
+	}
+
+	byKey := make(map[gidx.PrefixedID]V, len(values))
+	for _, v := range values {
+		byKey[keyFn(v)] = v
+	}
+
+	for i, key := range keys {
+		results[i] = &dataloader.Result[V]{Data: byKey[key]}
+	}
+
+	return results
+}
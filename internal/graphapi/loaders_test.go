@@ -0,0 +1,198 @@
+package graphapi_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.uber.org/zap"
+
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/graphapi"
+)
+
+// queryLog records the queries run by a client.
+type queryLog struct {
+	mu      sync.Mutex
+	queries []string
+}
+
+func (l *queryLog) log(_ context.Context, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.queries = append(l.queries, fmt.Sprint(args...))
+}
+
+func (l *queryLog) reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.queries = nil
+}
+
+// count returns the number of queries containing all of the substrings.
+func (l *queryLog) count(substrs ...string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	n := 0
+
+QUERIES:
+	for _, q := range l.queries {
+		for _, s := range substrs {
+			if !strings.Contains(q, s) {
+				continue QUERIES
+			}
+		}
+
+		n++
+	}
+
+	return n
+}
+
+func TestLoadersBatchEntityQueries(t *testing.T) {
+	ctx := context.Background()
+
+	perms := new(mockpermissions.MockPermissions)
+	ctx = perms.ContextWithHandler(ctx)
+
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	drv, err := entsql.Open(DBDialect, DBURI)
+	require.NoError(t, err)
+
+	queries := new(queryLog)
+	entClient := ent.NewClient(ent.Driver(dialect.DebugWithContext(drv, queries.log)))
+
+	t.Cleanup(func() { _ = entClient.Close() })
+
+	handler := graphapi.NewResolver(entClient, zap.NewNop().Sugar()).Handler(false).Handler()
+	gqlClient := client.New(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r.WithContext(ctx))
+	}))
+
+	const nodes = 5
+
+	statusNS := StatusNamespaceBuilder{}.MustNew(ctx)
+	annotationNS := AnnotationNamespaceBuilder{}.MustNew(ctx)
+
+	var (
+		nodeReps       []map[string]any
+		statusReps     []map[string]any
+		annotationReps []map[string]any
+	)
+
+	for i := 0; i < nodes; i++ {
+		md := MetadataBuilder{}.MustNew(ctx)
+		st := StatusBuilder{Metadata: md, StatusNamespace: statusNS}.MustNew(ctx)
+		ant := AnnotationBuilder{Metadata: md, AnnotationNamespace: annotationNS}.MustNew(ctx)
+
+		nodeReps = append(nodeReps,
+			map[string]any{"__typename": "MetadataNode", "id": md.NodeID},
+			map[string]any{"__typename": "ResourceOwner", "id": md.NodeID},
+			map[string]any{"__typename": "StatusOwner", "id": md.NodeID},
+		)
+		statusReps = append(statusReps, map[string]any{"__typename": "Status", "id": st.ID})
+		annotationReps = append(annotationReps, map[string]any{"__typename": "Annotation", "id": ant.ID})
+	}
+
+	t.Run("metadata of nodes", func(t *testing.T) {
+		var resp struct {
+			Entities []struct {
+				Metadata *struct {
+					ID string
+				}
+			} `json:"_entities"`
+		}
+
+		queries.reset()
+
+		err := gqlClient.Post(`query($representations: [_Any!]!) {
+			_entities(representations: $representations) {
+				... on MetadataNode { metadata { id } }
+				... on ResourceOwner { metadata { id } }
+				... on StatusOwner { metadata { id } }
+			}
+		}`, &resp, client.Var("representations", nodeReps))
+		require.NoError(t, err)
+
+		require.Len(t, resp.Entities, len(nodeReps))
+
+		for _, e := range resp.Entities {
+			assert.NotNil(t, e.Metadata)
+		}
+
+		assert.Equal(t, 1, queries.count("metadata", "node_id"), "the metadata of all nodes is loaded with one query")
+	})
+
+	t.Run("namespaces of statuses", func(t *testing.T) {
+		var resp struct {
+			Entities []struct {
+				Namespace struct {
+					ID string
+				}
+			} `json:"_entities"`
+		}
+
+		queries.reset()
+
+		err := gqlClient.Post(`query($representations: [_Any!]!) {
+			_entities(representations: $representations) {
+				... on Status { namespace { id } }
+			}
+		}`, &resp, client.Var("representations", statusReps))
+		require.NoError(t, err)
+
+		require.Len(t, resp.Entities, nodes)
+
+		for _, e := range resp.Entities {
+			assert.EqualValues(t, statusNS.ID, e.Namespace.ID)
+		}
+
+		// the representations check the visibility of their namespaces, which only selects the owner
+		assert.Equal(t, 1, queries.count("status_namespaces", "json_schema"), "the namespaces of all statuses are loaded with one query")
+	})
+
+	t.Run("namespaces of annotations", func(t *testing.T) {
+		var resp struct {
+			Entities []struct {
+				Namespace struct {
+					ID string
+				}
+			} `json:"_entities"`
+		}
+
+		queries.reset()
+
+		err := gqlClient.Post(`query($representations: [_Any!]!) {
+			_entities(representations: $representations) {
+				... on Annotation { namespace { id } }
+			}
+		}`, &resp, client.Var("representations", annotationReps))
+		require.NoError(t, err)
+
+		require.Len(t, resp.Entities, nodes)
+
+		for _, e := range resp.Entities {
+			assert.EqualValues(t, annotationNS.ID, e.Namespace.ID)
+		}
+
+		assert.Equal(t, 1, queries.count("annotation_namespaces", "json_schema"), "the namespaces of all annotations are loaded with one query")
+	})
+}
@@ -8,7 +8,6 @@ import (
 	"context"
 
 	"go.infratographer.com/metadata-api/internal/ent/generated"
-	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
 )
 
 // Node is the resolver for the node field.
@@ -18,10 +17,8 @@ func (r *metadataResolver) Node(ctx context.Context, obj *generated.Metadata) (*
 
 // Metadata is the resolver for the metadata field.
 func (r *metadataNodeResolver) Metadata(ctx context.Context, obj *MetadataNode) (*generated.Metadata, error) {
-	m, err := r.client.Metadata.Query().Where(metadata.NodeID(obj.ID)).First(ctx)
 	// Don't return an error if it isn't found, metadata is optional
-	err = generated.MaskNotFound(err)
-	return m, err
+	return r.loaders(ctx).metadataByNodeID.Load(ctx, obj.ID)()
 }
 
 // MetadataNode returns MetadataNodeResolver implementation.
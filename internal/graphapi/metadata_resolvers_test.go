@@ -2,6 +2,7 @@ package graphapi_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -76,3 +77,197 @@ func TestLoadingMetadataNodeMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestFindMetadataEntities(t *testing.T) {
+	ctx := context.Background()
+
+	perms := new(mockpermissions.MockPermissions)
+	ctx = perms.ContextWithHandler(ctx)
+
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	meta1 := MetadataBuilder{}.MustNew(ctx)
+	meta2 := MetadataBuilder{}.MustNew(ctx)
+
+	testCases := []struct {
+		TestName        string
+		Representations []map[string]interface{}
+		Expected        []*ent.Metadata
+	}{
+		{
+			TestName: "resolves metadata by id",
+			Representations: []map[string]interface{}{
+				{"__typename": "Metadata", "id": meta1.ID},
+				{"__typename": "Metadata", "id": meta2.ID},
+			},
+			Expected: []*ent.Metadata{meta1, meta2},
+		},
+		{
+			TestName: "resolves metadata by node id",
+			Representations: []map[string]interface{}{
+				{"__typename": "Metadata", "nodeID": meta2.NodeID},
+				{"__typename": "Metadata", "nodeID": meta1.NodeID},
+			},
+			Expected: []*ent.Metadata{meta2, meta1},
+		},
+		{
+			TestName: "resolves metadata by a mix of id and node id",
+			Representations: []map[string]interface{}{
+				{"__typename": "Metadata", "nodeID": meta1.NodeID},
+				{"__typename": "Metadata", "id": meta2.ID},
+			},
+			Expected: []*ent.Metadata{meta1, meta2},
+		},
+		{
+			TestName: "null is returned when there is no metadata for a node id",
+			Representations: []map[string]interface{}{
+				{"__typename": "Metadata", "nodeID": gidx.MustNewID("testing")},
+				{"__typename": "Metadata", "nodeID": meta1.NodeID},
+			},
+			Expected: []*ent.Metadata{nil, meta1},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			resp, err := graphTestClient().GetMetadataEntities(ctx, tt.Representations)
+			require.NoError(t, err)
+			require.Len(t, resp.Entities, len(tt.Expected))
+
+			for i, expected := range tt.Expected {
+				if expected == nil {
+					assert.Nil(t, resp.Entities[i])
+
+					continue
+				}
+
+				require.NotNil(t, resp.Entities[i])
+				assert.Equal(t, expected.ID, resp.Entities[i].ID)
+				assert.Equal(t, expected.NodeID, resp.Entities[i].NodeID)
+			}
+		})
+	}
+}
+
+func TestMetadataDataFilters(t *testing.T) {
+	ctx := context.Background()
+
+	perms := new(mockpermissions.MockPermissions)
+	ctx = perms.ContextWithHandler(ctx)
+
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	meta := MetadataBuilder{}.MustNew(ctx)
+
+	failed := json.RawMessage(`{"state":"FAILED","conditions":[{"type":"ready","status":false}],"labels":{"env":"prod","tier":"web"}}`)
+	healthy := json.RawMessage(`{"state":"HEALTHY","conditions":[{"type":"ready","status":true}],"labels":{"env":"prod"}}`)
+
+	failedSt := StatusBuilder{Metadata: meta, Data: failed}.MustNew(ctx)
+	healthySt := StatusBuilder{Metadata: meta, Data: healthy}.MustNew(ctx)
+	failedAnt := AnnotationBuilder{Metadata: meta, Data: failed}.MustNew(ctx)
+	healthyAnt := AnnotationBuilder{Metadata: meta, Data: healthy}.MustNew(ctx)
+
+	testCases := []struct {
+		TestName     string
+		DataContains json.RawMessage
+		DataPath     *testclient.DataPathPredicate
+		Expected     []int
+		ErrorMsg     string
+	}{
+		{
+			TestName:     "contains a value",
+			DataContains: json.RawMessage(`{"state":"FAILED"}`),
+			Expected:     []int{0},
+		},
+		{
+			TestName:     "contains a nested value",
+			DataContains: json.RawMessage(`{"labels":{"env":"prod"}}`),
+			Expected:     []int{0, 1},
+		},
+		{
+			TestName:     "contains an empty object",
+			DataContains: json.RawMessage(`{"labels":{}}`),
+			Expected:     []int{0, 1},
+		},
+		{
+			TestName:     "doesn't contain",
+			DataContains: json.RawMessage(`{"labels":{"tier":"db"}}`),
+			Expected:     []int{},
+		},
+		{
+			TestName: "value at path",
+			DataPath: &testclient.DataPathPredicate{Path: []string{"state"}, Value: json.RawMessage(`"HEALTHY"`)},
+			Expected: []int{1},
+		},
+		{
+			TestName: "value at path with array index",
+			DataPath: &testclient.DataPathPredicate{Path: []string{"conditions", "0", "status"}, Value: json.RawMessage(`false`)},
+			Expected: []int{0},
+		},
+		{
+			TestName: "object at path",
+			DataPath: &testclient.DataPathPredicate{Path: []string{"labels"}, Value: json.RawMessage(`{"env":"prod"}`)},
+			Expected: []int{1},
+		},
+		{
+			TestName: "missing path",
+			DataPath: &testclient.DataPathPredicate{Path: []string{"missing"}, Value: json.RawMessage(`"FAILED"`)},
+			Expected: []int{},
+		},
+		{
+			TestName: "empty path",
+			DataPath: &testclient.DataPathPredicate{Path: []string{}, Value: json.RawMessage(`"FAILED"`)},
+			ErrorMsg: "dataPath: path: must not be empty",
+		},
+	}
+
+	statusIDs := []gidx.PrefixedID{failedSt.ID, healthySt.ID}
+	annotationIDs := []gidx.PrefixedID{failedAnt.ID, healthyAnt.ID}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			resp, err := graphTestClient().GetNodeMetadataWhere(ctx, meta.NodeID,
+				&testclient.AnnotationWhereInput{DataContains: tt.DataContains, DataPath: tt.DataPath},
+				&testclient.StatusWhereInput{DataContains: tt.DataContains, DataPath: tt.DataPath},
+			)
+
+			if tt.ErrorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.ErrorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Entities, 1)
+			require.NotNil(t, resp.Entities[0].Metadata)
+
+			expectedStatuses := []gidx.PrefixedID{}
+			expectedAnnotations := []gidx.PrefixedID{}
+
+			for _, i := range tt.Expected {
+				expectedStatuses = append(expectedStatuses, statusIDs[i])
+				expectedAnnotations = append(expectedAnnotations, annotationIDs[i])
+			}
+
+			stIDs := []gidx.PrefixedID{}
+			for _, edge := range resp.Entities[0].Metadata.Statuses.Edges {
+				stIDs = append(stIDs, edge.Node.ID)
+			}
+
+			antIDs := []gidx.PrefixedID{}
+			for _, edge := range resp.Entities[0].Metadata.Annotations.Edges {
+				antIDs = append(antIDs, edge.Node.ID)
+			}
+
+			assert.ElementsMatch(t, expectedStatuses, stIDs)
+			assert.ElementsMatch(t, expectedAnnotations, antIDs)
+		})
+	}
+}
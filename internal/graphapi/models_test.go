@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"go.infratographer.com/x/gidx"
@@ -12,9 +13,10 @@ import (
 )
 
 type AnnotationNamespaceBuilder struct {
-	Name    string
-	OwnerID gidx.PrefixedID
-	Private bool
+	Name       string
+	OwnerID    gidx.PrefixedID
+	Private    bool
+	JSONSchema json.RawMessage
 }
 
 func (b AnnotationNamespaceBuilder) MustNew(ctx context.Context) *ent.AnnotationNamespace {
@@ -26,7 +28,13 @@ func (b AnnotationNamespaceBuilder) MustNew(ctx context.Context) *ent.Annotation
 		b.OwnerID = gidx.MustNewID("tstownr")
 	}
 
-	return EntClient.AnnotationNamespace.Create().SetName(b.Name).SetOwnerID(b.OwnerID).SetPrivate(b.Private).SaveX(ctx)
+	create := EntClient.AnnotationNamespace.Create().SetName(b.Name).SetOwnerID(b.OwnerID).SetPrivate(b.Private)
+
+	if b.JSONSchema != nil {
+		create.SetJSONSchema(b.JSONSchema)
+	}
+
+	return create.SaveX(ctx)
 }
 
 type AnnotationBuilder struct {
@@ -70,6 +78,8 @@ type StatusNamespaceBuilder struct {
 	Name               string
 	ResourceProviderID gidx.PrefixedID
 	Private            bool
+	JSONSchema         json.RawMessage
+	DefaultTTL         *int64
 }
 
 func (b StatusNamespaceBuilder) MustNew(ctx context.Context) *ent.StatusNamespace {
@@ -81,7 +91,13 @@ func (b StatusNamespaceBuilder) MustNew(ctx context.Context) *ent.StatusNamespac
 		b.ResourceProviderID = gidx.MustNewID("rcrspro")
 	}
 
-	return EntClient.StatusNamespace.Create().SetName(b.Name).SetResourceProviderID(b.ResourceProviderID).SetPrivate(b.Private).SaveX(ctx)
+	create := EntClient.StatusNamespace.Create().SetName(b.Name).SetResourceProviderID(b.ResourceProviderID).SetPrivate(b.Private)
+
+	if b.JSONSchema != nil {
+		create.SetJSONSchema(b.JSONSchema)
+	}
+
+	return create.SetNillableDefaultTTL(b.DefaultTTL).SaveX(ctx)
 }
 
 type StatusBuilder struct {
@@ -89,6 +105,7 @@ type StatusBuilder struct {
 	StatusNamespace *ent.StatusNamespace
 	Source          string
 	Data            json.RawMessage
+	ExpiresAt       *time.Time
 }
 
 func (b StatusBuilder) MustNew(ctx context.Context) *ent.Status {
@@ -111,5 +128,5 @@ func (b StatusBuilder) MustNew(ctx context.Context) *ent.Status {
 		b.Data = json.RawMessage(jsonData)
 	}
 
-	return EntClient.Status.Create().SetMetadata(b.Metadata).SetNamespace(b.StatusNamespace).SetSource(b.Source).SetData(b.Data).SaveX(ctx)
+	return EntClient.Status.Create().SetMetadata(b.Metadata).SetNamespace(b.StatusNamespace).SetSource(b.Source).SetData(b.Data).SetNillableExpiresAt(b.ExpiresAt).SaveX(ctx)
 }
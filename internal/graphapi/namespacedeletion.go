@@ -0,0 +1,108 @@
+package graphapi
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/schema"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
+)
+
+// softDeleteNamespace hides the namespace, along with its statuses or annotations,
+// and creates the deletion which purges it in the background once the purge delay
+// has passed. A namespace which was deleted concurrently isn't found.
+func (r *Resolver) softDeleteNamespace(ctx context.Context, nsID, ownerID gidx.PrefixedID, count int) (*generated.NamespaceDeletion, error) {
+	tx, err := r.client.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	now := time.Now()
+
+	// only one delete can mark the namespace, concurrent deletes don't find it
+	if nsID.Prefix() == schema.StatusNamespacePrefix {
+		err = tx.StatusNamespace.UpdateOneID(nsID).
+			Where(statusnamespace.DeletedAtIsNil()).
+			SetDeletedAt(now).
+			SetDeleting(true).
+			Exec(ctx)
+	} else {
+		err = tx.AnnotationNamespace.UpdateOneID(nsID).
+			Where(annotationnamespace.DeletedAtIsNil()).
+			SetDeletedAt(now).
+			SetDeleting(true).
+			Exec(ctx)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := tx.NamespaceDeletion.Create().
+		SetCreatedAt(now).
+		SetNamespaceID(nsID).
+		SetOwnerID(ownerID).
+		SetTotalCount(count).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// return the deletion with the client, rather than the committed transaction
+	return job.Unwrap(), nil
+}
+
+// restoreNamespace cancels the pending deletion of the soft deleted namespace and
+// shows it again. ErrNamespaceDeleting is returned once the namespace is being
+// purged.
+func (r *Resolver) restoreNamespace(ctx context.Context, nsID gidx.PrefixedID) error {
+	ctx = softdelete.IncludeDeleted(ctx)
+
+	tx, err := r.client.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	// the deleter can't start the purge once the deletion is canceled
+	canceled, err := tx.NamespaceDeletion.Update().
+		Where(
+			namespacedeletion.NamespaceID(nsID),
+			namespacedeletion.StateEQ(namespacedeletion.StatePENDING),
+		).
+		SetState(namespacedeletion.StateCANCELED).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if canceled == 0 {
+		return ErrNamespaceDeleting
+	}
+
+	if nsID.Prefix() == schema.StatusNamespacePrefix {
+		err = tx.StatusNamespace.UpdateOneID(nsID).ClearDeletedAt().SetDeleting(false).Exec(ctx)
+	} else {
+		err = tx.AnnotationNamespace.UpdateOneID(nsID).ClearDeletedAt().SetDeleting(false).Exec(ctx)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
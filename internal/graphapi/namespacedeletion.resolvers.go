@@ -0,0 +1,49 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.38
+
+import (
+	"context"
+
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/schema"
+)
+
+// NamespaceDeletion is the resolver for the namespaceDeletion field.
+func (r *queryResolver) NamespaceDeletion(ctx context.Context, id gidx.PrefixedID) (*generated.NamespaceDeletion, error) {
+	logger := r.logger.With("namespaceDeletionID", id)
+
+	if id == "" {
+		return nil, NewInvalidFieldError("id", ErrFieldEmpty)
+	}
+
+	if _, err := gidx.Parse(id.String()); err != nil {
+		return nil, NewInvalidFieldError("id", err)
+	}
+
+	job, err := r.client.NamespaceDeletion.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to get namespace deletion", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	action := actionMetadataAnnotationNamespaceUpdate
+	if job.NamespaceID.Prefix() == schema.StatusNamespacePrefix {
+		action = actionMetadataStatusNamespaceUpdate
+	}
+
+	if err := permissions.CheckAccess(ctx, job.OwnerID, action); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
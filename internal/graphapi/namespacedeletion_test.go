@@ -0,0 +1,342 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated/namespacedeletion"
+	"go.infratographer.com/metadata-api/internal/testclient"
+)
+
+func TestNamespaceDeletedRejectsWrites(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	meta := MetadataBuilder{}.MustNew(ctx)
+	stNS := StatusNamespaceBuilder{}.MustNew(ctx)
+	antNS := AnnotationNamespaceBuilder{}.MustNew(ctx)
+
+	StatusBuilder{Metadata: meta, StatusNamespace: stNS}.MustNew(ctx)
+	AnnotationBuilder{Metadata: meta, AnnotationNamespace: antNS}.MustNew(ctx)
+
+	stResp, err := graphTestClient().StatusNamespaceDelete(ctx, stNS.ID, true)
+	require.NoError(t, err)
+	require.NotNil(t, stResp.StatusNamespaceDelete.NamespaceDeletion)
+
+	antResp, err := graphTestClient().AnnotationNamespaceDelete(ctx, antNS.ID, true)
+	require.NoError(t, err)
+	require.NotNil(t, antResp.AnnotationNamespaceDelete.NamespaceDeletion)
+
+	data := json.RawMessage(`{"hello":"world"}`)
+
+	testCases := []struct {
+		TestName string
+		Write    func(ctx context.Context, c testclient.TestClient) error
+	}{
+		{
+			TestName: "status update",
+			Write: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.StatusUpdate(ctx, testclient.StatusUpdateInput{NodeID: meta.NodeID, NamespaceID: newID(stNS.ID), Source: "tests", Data: data})
+				return err
+			},
+		},
+		{
+			TestName: "annotation update",
+			Write: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.AnnotationUpdate(ctx, testclient.AnnotationUpdateInput{NodeID: meta.NodeID, NamespaceID: newID(antNS.ID), Data: data})
+				return err
+			},
+		},
+		{
+			TestName: "status namespace update",
+			Write: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.StatusNamespaceUpdate(ctx, stNS.ID, testclient.UpdateStatusNamespaceInput{Name: newString("renamed")})
+				return err
+			},
+		},
+		{
+			TestName: "annotation namespace update",
+			Write: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.AnnotationNamespaceUpdate(ctx, antNS.ID, testclient.UpdateAnnotationNamespaceInput{Name: newString("renamed")})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			err := tt.Write(ctx, graphTestClient())
+			require.Error(t, err)
+			assert.ErrorContains(t, err, "not found")
+		})
+	}
+
+	t.Run("deleting again fails", func(t *testing.T) {
+		_, err := graphTestClient().StatusNamespaceDelete(ctx, stNS.ID, true)
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	runNamespaceDeletions(ctx, t)
+
+	_, err = graphTestClient().StatusNamespaceUpdate(ctx, stNS.ID, testclient.UpdateStatusNamespaceInput{Name: newString("renamed")})
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestNamespaceRestore(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	meta := MetadataBuilder{}.MustNew(ctx)
+	antNS := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	stNS := StatusNamespaceBuilder{}.MustNew(ctx)
+	purgingNS := StatusNamespaceBuilder{}.MustNew(ctx)
+	activeNS := AnnotationNamespaceBuilder{}.MustNew(ctx)
+
+	AnnotationBuilder{Metadata: meta, AnnotationNamespace: antNS}.MustNew(ctx)
+	StatusBuilder{Metadata: meta, StatusNamespace: stNS}.MustNew(ctx)
+
+	antResp, err := graphTestClient().AnnotationNamespaceDelete(ctx, antNS.ID, true)
+	require.NoError(t, err)
+
+	_, err = graphTestClient().StatusNamespaceDelete(ctx, stNS.ID, true)
+	require.NoError(t, err)
+
+	purgingResp, err := graphTestClient().StatusNamespaceDelete(ctx, purgingNS.ID, false)
+	require.NoError(t, err)
+
+	// the purge was started by the deleter
+	EntClient.NamespaceDeletion.UpdateOneID(purgingResp.StatusNamespaceDelete.NamespaceDeletion.ID).
+		SetState(namespacedeletion.StateRUNNING).
+		ExecX(ctx)
+
+	t.Run("deleted annotations are hidden from the node", func(t *testing.T) {
+		resp, err := graphTestClient().GetNodeMetadata(ctx, meta.NodeID)
+		require.NoError(t, err)
+		assert.Empty(t, resp.Entities[0].Metadata.Annotations.Edges)
+	})
+
+	t.Run("annotation namespace is restored with its annotations", func(t *testing.T) {
+		resp, err := graphTestClient().AnnotationNamespaceRestore(ctx, antNS.ID)
+		require.NoError(t, err)
+		assert.Equal(t, antNS.ID, resp.AnnotationNamespaceRestore.AnnotationNamespace.ID)
+		assert.Nil(t, resp.AnnotationNamespaceRestore.AnnotationNamespace.DeletedAt)
+
+		nsResp, err := graphTestClient().GetAnnotationNamespace(ctx, antNS.ID)
+		require.NoError(t, err)
+		assert.Len(t, nsResp.AnnotationNamespace.Annotations.Edges, 1)
+
+		mdResp, err := graphTestClient().GetNodeMetadata(ctx, meta.NodeID)
+		require.NoError(t, err)
+		assert.Len(t, mdResp.Entities[0].Metadata.Annotations.Edges, 1)
+
+		jobResp, err := graphTestClient().GetNamespaceDeletion(ctx, antResp.AnnotationNamespaceDelete.NamespaceDeletion.ID)
+		require.NoError(t, err)
+		assert.Equal(t, testclient.NamespaceDeletionStateCanceled, jobResp.NamespaceDeletion.State)
+	})
+
+	t.Run("status namespace is restored with its statuses", func(t *testing.T) {
+		resp, err := graphTestClient().StatusNamespaceRestore(ctx, stNS.ID)
+		require.NoError(t, err)
+		assert.Equal(t, stNS.ID, resp.StatusNamespaceRestore.StatusNamespace.ID)
+
+		nsResp, err := graphTestClient().GetStatusNamespaceStatuses(ctx, stNS.ID, nil, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Len(t, nsResp.StatusNamespace.Statuses.Edges, 1)
+	})
+
+	denyCtx := context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, _ ...permissions.AccessRequest) error {
+		return permissions.ErrPermissionDenied
+	}))
+
+	testCases := []struct {
+		TestName string
+		Ctx      context.Context
+		ID       gidx.PrefixedID
+		ErrorMsg string
+	}{
+		{
+			TestName: "Fails when id is empty",
+			Ctx:      ctx,
+			ID:       "",
+			ErrorMsg: "must not be empty",
+		},
+		{
+			TestName: "Fails when id is not found",
+			Ctx:      ctx,
+			ID:       gidx.MustNewID("metamns"),
+			ErrorMsg: "not found",
+		},
+		{
+			TestName: "Fails when the namespace isn't deleted",
+			Ctx:      ctx,
+			ID:       activeNS.ID,
+			ErrorMsg: "namespace is not deleted",
+		},
+		{
+			TestName: "Fails once the namespace is being purged",
+			Ctx:      ctx,
+			ID:       purgingNS.ID,
+			ErrorMsg: "namespace is being deleted",
+		},
+		{
+			TestName: "Fails without access to the namespace",
+			Ctx:      denyCtx,
+			ID:       purgingNS.ID,
+			ErrorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			var err error
+
+			if tt.ID.Prefix() == "metasns" {
+				_, err = graphTestClient().StatusNamespaceRestore(tt.Ctx, tt.ID)
+			} else {
+				_, err = graphTestClient().AnnotationNamespaceRestore(tt.Ctx, tt.ID)
+			}
+
+			assert.Error(t, err)
+			assert.ErrorContains(t, err, tt.ErrorMsg)
+		})
+	}
+}
+
+func TestNamespaceDeletionGet(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	AnnotationBuilder{AnnotationNamespace: ns}.MustNew(ctx)
+
+	resp, err := graphTestClient().AnnotationNamespaceDelete(ctx, ns.ID, true)
+	require.NoError(t, err)
+	require.NotNil(t, resp.AnnotationNamespaceDelete.NamespaceDeletion)
+
+	jobID := resp.AnnotationNamespaceDelete.NamespaceDeletion.ID
+
+	denyOwnerCtx := context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.ResourceID == ns.OwnerID {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}))
+
+	testCases := []struct {
+		TestName string
+		Ctx      context.Context
+		ID       gidx.PrefixedID
+		ErrorMsg string
+	}{
+		{
+			TestName: "Successful path",
+			Ctx:      ctx,
+			ID:       jobID,
+		},
+		{
+			TestName: "Fails when id is empty",
+			Ctx:      ctx,
+			ID:       "",
+			ErrorMsg: "must not be empty",
+		},
+		{
+			TestName: "Fails when id is not found",
+			Ctx:      ctx,
+			ID:       gidx.MustNewID("metandl"),
+			ErrorMsg: "not found",
+		},
+		{
+			TestName: "Fails without access to the owner of the namespace",
+			Ctx:      denyOwnerCtx,
+			ID:       jobID,
+			ErrorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			resp, err := graphTestClient().GetNamespaceDeletion(tt.Ctx, tt.ID)
+
+			if tt.ErrorMsg != "" {
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, tt.ErrorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, jobID, resp.NamespaceDeletion.ID)
+			assert.Equal(t, ns.ID, resp.NamespaceDeletion.NamespaceID)
+			assert.Equal(t, ns.OwnerID, resp.NamespaceDeletion.OwnerID)
+			assert.Equal(t, int64(1), resp.NamespaceDeletion.TotalCount)
+		})
+	}
+}
+
+func TestNamespaceNameReusedAfterDelete(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	deletedNS := AnnotationNamespaceBuilder{}.MustNew(ctx)
+
+	_, err := graphTestClient().AnnotationNamespaceDelete(ctx, deletedNS.ID, false)
+	require.NoError(t, err)
+
+	resp, err := graphTestClient().AnnotationNamespaceCreate(ctx, testclient.CreateAnnotationNamespaceInput{
+		Name:    deletedNS.Name,
+		OwnerID: deletedNS.OwnerID,
+	})
+	require.NoError(t, err)
+
+	reusedNS := resp.AnnotationNamespaceCreate.AnnotationNamespace
+	assert.NotEqual(t, deletedNS.ID, reusedNS.ID)
+
+	// the deleted namespace can't be restored while its name is in use
+	_, err = graphTestClient().AnnotationNamespaceRestore(ctx, deletedNS.ID)
+	assert.ErrorContains(t, err, "name: must be unique")
+
+	_, err = graphTestClient().AnnotationNamespaceDelete(ctx, reusedNS.ID, false)
+	require.NoError(t, err)
+
+	restoreResp, err := graphTestClient().AnnotationNamespaceRestore(ctx, deletedNS.ID)
+	require.NoError(t, err)
+	assert.Equal(t, deletedNS.Name, restoreResp.AnnotationNamespaceRestore.AnnotationNamespace.Name)
+}
@@ -0,0 +1,121 @@
+package graphapi
+
+import (
+	"context"
+
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+)
+
+// namespaceRef is the namespace an input writes to, named either by its id, or by
+// the id of its owner, or resource provider, and its name. Names don't change with
+// the environment, so clients can be configured with them rather than the ids.
+type namespaceRef struct {
+	id      gidx.PrefixedID
+	ownerID gidx.PrefixedID
+	name    string
+}
+
+// newNamespaceRef checks the input names its namespace either by namespaceID, or
+// by ownerID and namespaceName, and returns the reference.
+func newNamespaceRef(id, ownerID *gidx.PrefixedID, name *string) (namespaceRef, error) {
+	if id != nil {
+		if ownerID != nil || name != nil {
+			return namespaceRef{}, NewInvalidFieldError("namespaceID", ErrNamespaceRefConflict)
+		}
+
+		if *id == "" {
+			return namespaceRef{}, NewInvalidFieldError("namespaceID", ErrFieldEmpty)
+		}
+
+		if _, err := gidx.Parse(id.String()); err != nil {
+			return namespaceRef{}, NewInvalidFieldError("namespaceID", err)
+		}
+
+		return namespaceRef{id: *id}, nil
+	}
+
+	if ownerID == nil && name == nil {
+		return namespaceRef{}, NewInvalidFieldError("namespaceID", ErrFieldEmpty)
+	}
+
+	if ownerID == nil || *ownerID == "" {
+		return namespaceRef{}, NewInvalidFieldError("ownerID", ErrFieldEmpty)
+	}
+
+	if _, err := gidx.Parse(ownerID.String()); err != nil {
+		return namespaceRef{}, NewInvalidFieldError("ownerID", err)
+	}
+
+	if name == nil || *name == "" {
+		return namespaceRef{}, NewInvalidFieldError("namespaceName", ErrFieldEmpty)
+	}
+
+	return namespaceRef{ownerID: *ownerID, name: *name}, nil
+}
+
+// annotationNamespaceID returns the id of the annotation namespace, looking it up
+// by its owner and name when the reference doesn't have the id.
+func (r *Resolver) annotationNamespaceID(ctx context.Context, ref namespaceRef) (gidx.PrefixedID, error) {
+	if ref.id != "" {
+		return ref.id, nil
+	}
+
+	id, err := r.client.AnnotationNamespace.Query().
+		Where(
+			annotationnamespace.OwnerID(ref.ownerID),
+			annotationnamespace.Name(ref.name),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return "", namespaceNameNotFound(ctx, ref.ownerID, actionMetadataAnnotationNamespaceList, err)
+		}
+
+		r.logger.Errorw("failed to look up annotation namespace", "ownerID", ref.ownerID, "namespaceName", ref.name, "error", err)
+		return "", ErrInternalServerError
+	}
+
+	return id, nil
+}
+
+// statusNamespaceID returns the id of the status namespace, looking it up by its
+// resource provider and name when the reference doesn't have the id.
+func (r *Resolver) statusNamespaceID(ctx context.Context, ref namespaceRef) (gidx.PrefixedID, error) {
+	if ref.id != "" {
+		return ref.id, nil
+	}
+
+	id, err := r.client.StatusNamespace.Query().
+		Where(
+			statusnamespace.ResourceProviderID(ref.ownerID),
+			statusnamespace.Name(ref.name),
+		).
+		OnlyID(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return "", namespaceNameNotFound(ctx, ref.ownerID, actionMetadataStatusNamespaceList, err)
+		}
+
+		r.logger.Errorw("failed to look up status namespace", "ownerID", ref.ownerID, "namespaceName", ref.name, "error", err)
+		return "", ErrInternalServerError
+	}
+
+	return id, nil
+}
+
+// namespaceNameNotFound returns the not found error of a namespace looked up by
+// its owner and name to callers who can list the namespaces of the owner, and
+// permission denied to others. Callers without access to a namespace that exists
+// are denied too, so they can't tell which names are in use.
+func namespaceNameNotFound(ctx context.Context, ownerID gidx.PrefixedID, listAction string, notFound error) error {
+	if err := permissions.CheckAccess(ctx, ownerID, listAction); err != nil {
+		return err
+	}
+
+	return notFound
+}
@@ -0,0 +1,213 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/testclient"
+)
+
+func TestStatusWritesByNamespaceName(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns := StatusNamespaceBuilder{}.MustNew(ctx)
+
+	testCases := []struct {
+		TestName      string
+		NamespaceID   *gidx.PrefixedID
+		OwnerID       *gidx.PrefixedID
+		NamespaceName *string
+		ErrorMsg      string
+	}{
+		{
+			TestName:      "Successful path",
+			OwnerID:       newID(ns.ResourceProviderID),
+			NamespaceName: newString(ns.Name),
+		},
+		{
+			TestName:      "Fails when namespace id is also set",
+			NamespaceID:   newID(ns.ID),
+			OwnerID:       newID(ns.ResourceProviderID),
+			NamespaceName: newString(ns.Name),
+			ErrorMsg:      "can't be set together with ownerID and namespaceName",
+		},
+		{
+			TestName:      "Fails when owner id is missing",
+			NamespaceName: newString(ns.Name),
+			ErrorMsg:      "ownerID: must not be empty",
+		},
+		{
+			TestName: "Fails when namespace name is missing",
+			OwnerID:  newID(ns.ResourceProviderID),
+			ErrorMsg: "namespaceName: must not be empty",
+		},
+		{
+			TestName: "Fails when nothing names the namespace",
+			ErrorMsg: "namespaceID: must not be empty",
+		},
+		{
+			TestName:      "Fails when the resource provider doesn't have the namespace",
+			OwnerID:       newID(gidx.MustNewID("rcrspro")),
+			NamespaceName: newString(ns.Name),
+			ErrorMsg:      "not found",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			nodeID := gidx.MustNewID("testing")
+
+			resp, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{
+				NodeID:        nodeID,
+				NamespaceID:   tt.NamespaceID,
+				OwnerID:       tt.OwnerID,
+				NamespaceName: tt.NamespaceName,
+				Source:        "go-tests",
+				Data:          json.RawMessage(`{"state":"ACTIVE"}`),
+			})
+
+			if tt.ErrorMsg != "" {
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, tt.ErrorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, ns.ID, resp.StatusUpdate.Status.Namespace.ID)
+
+			_, err = graphTestClient().StatusDelete(ctx, testclient.StatusDeleteInput{
+				NodeID:        nodeID,
+				OwnerID:       tt.OwnerID,
+				NamespaceName: tt.NamespaceName,
+				Source:        "go-tests",
+			})
+			require.NoError(t, err)
+
+			stCount := EntClient.Status.Query().Where(status.StatusNamespaceID(ns.ID), status.HasMetadataWith(metadata.NodeID(nodeID))).CountX(ctx)
+			assert.Equal(t, 0, stCount)
+		})
+	}
+}
+
+func TestAnnotationWritesByNamespaceName(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns1 := AnnotationNamespaceBuilder{OwnerID: gidx.MustNewID("tnntten")}.MustNew(ctx)
+	ns2 := AnnotationNamespaceBuilder{OwnerID: gidx.MustNewID("tnntten")}.MustNew(ctx)
+
+	nodeID := gidx.MustNewID("testing")
+
+	// names and ids can be mixed in a batch
+	resp, err := graphTestClient().AnnotationUpdateBatch(ctx, testclient.AnnotationUpdateBatchInput{
+		Items: []*testclient.AnnotationUpdateInput{
+			{NodeID: nodeID, OwnerID: newID(ns1.OwnerID), NamespaceName: newString(ns1.Name), Data: json.RawMessage(`{"tier":"web"}`)},
+			{NodeID: nodeID, NamespaceID: newID(ns2.ID), Data: json.RawMessage(`{"tier":"db"}`)},
+		},
+	})
+	require.NoError(t, err)
+
+	results := resp.AnnotationUpdateBatch.Results
+	require.Len(t, results, 2)
+	require.NotNil(t, results[0].Annotation)
+	assert.Equal(t, ns1.ID, results[0].Annotation.Namespace.ID)
+	require.NotNil(t, results[1].Annotation)
+	assert.Equal(t, ns2.ID, results[1].Annotation.Namespace.ID)
+
+	// a name which matches another owner's namespace isn't found
+	_, err = graphTestClient().AnnotationUpdate(ctx, testclient.AnnotationUpdateInput{
+		NodeID:        nodeID,
+		OwnerID:       newID(gidx.MustNewID("tnntten")),
+		NamespaceName: newString(ns1.Name),
+		Data:          json.RawMessage(`{"tier":"web"}`),
+	})
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = graphTestClient().AnnotationDelete(ctx, testclient.AnnotationDeleteInput{
+		NodeID:        nodeID,
+		OwnerID:       newID(ns1.OwnerID),
+		NamespaceName: newString(ns1.Name),
+	})
+	require.NoError(t, err)
+
+	antCount := EntClient.Annotation.Query().Where(annotation.HasMetadataWith(metadata.NodeID(nodeID))).CountX(ctx)
+	assert.Equal(t, 1, antCount)
+}
+
+func TestNamespaceNamesCheckAccessFirst(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	antNS := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	stNS := StatusNamespaceBuilder{}.MustNew(ctx)
+
+	denyCtx := context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, _ ...permissions.AccessRequest) error {
+		return permissions.ErrPermissionDenied
+	}))
+
+	// callers without access can't tell whether a namespace has the name
+	for _, name := range []string{antNS.Name, "missing-namespace"} {
+		_, err := graphTestClient().AnnotationUpdate(denyCtx, testclient.AnnotationUpdateInput{
+			NodeID:        gidx.MustNewID("testing"),
+			OwnerID:       newID(antNS.OwnerID),
+			NamespaceName: newString(name),
+			Data:          json.RawMessage(`{"tier":"web"}`),
+		})
+		assert.ErrorContains(t, err, permissions.ErrPermissionDenied.Error())
+
+		_, err = graphTestClient().GetAnnotationNamespaceByName(denyCtx, antNS.OwnerID, name)
+		assert.ErrorContains(t, err, permissions.ErrPermissionDenied.Error())
+	}
+
+	for _, name := range []string{stNS.Name, "missing-namespace"} {
+		_, err := graphTestClient().StatusUpdate(denyCtx, testclient.StatusUpdateInput{
+			NodeID:        gidx.MustNewID("testing"),
+			OwnerID:       newID(stNS.ResourceProviderID),
+			NamespaceName: newString(name),
+			Source:        "go-tests",
+			Data:          json.RawMessage(`{"state":"ACTIVE"}`),
+		})
+		assert.ErrorContains(t, err, permissions.ErrPermissionDenied.Error())
+
+		_, err = graphTestClient().GetStatusNamespaceByName(denyCtx, stNS.ResourceProviderID, name)
+		assert.ErrorContains(t, err, permissions.ErrPermissionDenied.Error())
+	}
+
+	// callers who can list the namespaces of the owner are told the name isn't found
+	_, err := graphTestClient().GetAnnotationNamespaceByName(ctx, antNS.OwnerID, "missing-namespace")
+	assert.ErrorContains(t, err, "not found")
+
+	_, err = graphTestClient().GetStatusNamespaceByName(ctx, stNS.ResourceProviderID, "missing-namespace")
+	assert.ErrorContains(t, err, "not found")
+}
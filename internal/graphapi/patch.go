@@ -0,0 +1,61 @@
+package graphapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+)
+
+// emptyDocument is used as the stored data when a patch is applied to data that
+// doesn't exist yet.
+var emptyDocument = json.RawMessage(`{}`)
+
+// applyDataUpdate returns the data that results from applying the update data to the
+// stored data using the given mode. A nil mode replaces the stored data.
+func applyDataUpdate(mode *DataUpdateMode, stored json.RawMessage, data json.RawMessage) (json.RawMessage, error) {
+	if mode == nil || *mode == DataUpdateModeReplace {
+		return data, nil
+	}
+
+	if len(stored) == 0 {
+		stored = emptyDocument
+	}
+
+	switch *mode {
+	case DataUpdateModeMergePatch:
+		patched, err := jsonpatch.MergePatch(stored, data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+		}
+
+		return patched, nil
+	case DataUpdateModeJSONPatch:
+		patch, err := jsonpatch.DecodePatch(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+		}
+
+		patched, err := patch.Apply(stored)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+		}
+
+		return patched, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPatch, *mode)
+	}
+}
+
+// forUpdate returns a predicate which locks the selected rows until the end of the
+// transaction. SQLite doesn't support row locks, since it only allows a single writer
+// the predicate does nothing there.
+func forUpdate[P ~func(*sql.Selector)]() P {
+	return func(s *sql.Selector) {
+		if s.Dialect() != dialect.SQLite {
+			s.ForUpdate()
+		}
+	}
+}
@@ -1,9 +1,21 @@
 package graphapi
 
 const (
-	// metadata annotations owner access
+	// metadata annotations owner access, get is checked on namespaces and on the
+	// owners of private namespaces, list on owners
 	actionMetadataAnnotationNamespaceUpdate = "metadata_annotationnamespace_update"
+	actionMetadataAnnotationNamespaceGet    = "metadata_annotationnamespace_get"
+	actionMetadataAnnotationNamespaceList   = "metadata_annotationnamespace_list"
 
-	// metadata status resource provider access
+	// metadata status resource provider access, get is checked on namespaces and
+	// on the resource providers of private namespaces, list on resource providers
 	actionMetadataStatusNamespaceUpdate = "metadata_statusnamespace_update"
+	actionMetadataStatusNamespaceGet    = "metadata_statusnamespace_get"
+	actionMetadataStatusNamespaceList   = "metadata_statusnamespace_list"
+
+	// metadata of nodes read access, checked on the nodes
+	actionMetadataAnnotationGet  = "metadata_annotation_get"
+	actionMetadataAnnotationList = "metadata_annotation_list"
+	actionMetadataStatusGet      = "metadata_status_get"
+	actionMetadataStatusList     = "metadata_status_list"
 )
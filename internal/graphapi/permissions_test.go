@@ -0,0 +1,130 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+
+	"go.infratographer.com/metadata-api/internal/testclient"
+)
+
+func TestReadPermissions(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	meta := MetadataBuilder{}.MustNew(ctx)
+	antNS := AnnotationNamespaceBuilder{}.MustNew(ctx)
+	stNS := StatusNamespaceBuilder{}.MustNew(ctx)
+	ant := AnnotationBuilder{Metadata: meta, AnnotationNamespace: antNS}.MustNew(ctx)
+	st := StatusBuilder{Metadata: meta, StatusNamespace: stNS}.MustNew(ctx)
+
+	testCases := []struct {
+		TestName string
+		Action   string
+		Query    func(ctx context.Context, c testclient.TestClient) error
+	}{
+		{
+			TestName: "annotation namespace",
+			Action:   "metadata_annotationnamespace_get",
+			Query: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.GetAnnotationNamespace(ctx, antNS.ID)
+				return err
+			},
+		},
+		{
+			TestName: "annotations of an annotation namespace",
+			Action:   "metadata_annotationnamespace_get",
+			Query: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.GetAnnotationNamespaceAnnotations(ctx, antNS.ID, nil, nil, nil, nil)
+				return err
+			},
+		},
+		{
+			TestName: "statuses of a status namespace",
+			Action:   "metadata_statusnamespace_get",
+			Query: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.GetStatusNamespaceStatuses(ctx, stNS.ID, nil, nil, nil, nil)
+				return err
+			},
+		},
+		{
+			TestName: "annotation namespaces of an owner",
+			Action:   "metadata_annotationnamespace_list",
+			Query: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.GetResourceOwnerAnnotationNamespaces(ctx, antNS.OwnerID, nil)
+				return err
+			},
+		},
+		{
+			TestName: "status namespaces of a resource provider",
+			Action:   "metadata_statusnamespace_list",
+			Query: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.GetResourceProviderStatusNamespaces(ctx, stNS.ResourceProviderID, nil)
+				return err
+			},
+		},
+		{
+			TestName: "annotations of a node",
+			Action:   "metadata_annotation_list",
+			Query: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.GetNodeMetadata(ctx, meta.NodeID)
+				return err
+			},
+		},
+		{
+			TestName: "statuses of a node",
+			Action:   "metadata_status_list",
+			Query: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.GetNodeMetadataAsOf(ctx, meta.NodeID, nil)
+				return err
+			},
+		},
+		{
+			TestName: "annotation entity",
+			Action:   "metadata_annotation_get",
+			Query: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.GetAnnotationEntities(ctx, []map[string]interface{}{{"__typename": "Annotation", "id": ant.ID}})
+				return err
+			},
+		},
+		{
+			TestName: "status entity",
+			Action:   "metadata_status_get",
+			Query: func(ctx context.Context, c testclient.TestClient) error {
+				_, err := c.GetStatusEntities(ctx, []map[string]interface{}{{"__typename": "Status", "id": st.ID}})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			require.NoError(t, tt.Query(ctx, graphTestClient()))
+
+			denyCtx := context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+				for _, req := range requests {
+					if req.Action == tt.Action {
+						return permissions.ErrPermissionDenied
+					}
+				}
+
+				return nil
+			}))
+
+			err := tt.Query(denyCtx, graphTestClient())
+			require.Error(t, err)
+			assert.ErrorContains(t, err, permissions.ErrPermissionDenied.Error())
+		})
+	}
+}
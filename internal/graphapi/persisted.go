@@ -0,0 +1,170 @@
+package graphapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// DefaultAPQCacheSize is the default number of automatic persisted queries kept in memory.
+	DefaultAPQCacheSize = 1000
+
+	allowListExtension = "AllowList"
+)
+
+// PersistedQueries configures automatic persisted queries and the allow list of operations.
+type PersistedQueries struct {
+	// CacheSize is the number of automatic persisted queries kept in memory,
+	// automatic persisted queries are disabled when 0.
+	CacheSize int
+
+	// AllowListDir is a directory of .graphql documents with the operations
+	// clients may run. When set, all other operations are rejected.
+	AllowListDir string
+}
+
+// WithAPQCacheSize sets the number of automatic persisted queries kept in
+// memory, automatic persisted queries are disabled when 0.
+func WithAPQCacheSize(size int) Option {
+	return func(r *Resolver) {
+		r.apqCacheSize = size
+	}
+}
+
+// WithAllowList only allows the operations of the allow list to run.
+func WithAllowList(l *AllowList) Option {
+	return func(r *Resolver) {
+		r.allowList = l
+	}
+}
+
+// AllowList is the list of operation documents clients may run. Clients send
+// the documents as they are, or only their sha256 hash as an automatic
+// persisted query.
+type AllowList struct {
+	documents map[string]string
+}
+
+// LoadAllowList loads the .graphql documents in dir and its subdirectories.
+// Documents which aren't valid against the schema fail to load.
+func LoadAllowList(dir string) (*AllowList, error) {
+	schema := NewExecutableSchema(Config{}).Schema()
+
+	l := &AllowList{documents: make(map[string]string)}
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".graphql" {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if _, errs := gqlparser.LoadQuery(schema, string(data)); len(errs) != 0 {
+			return fmt.Errorf("invalid operation document %s: %w", path, errs)
+		}
+
+		l.documents[queryHash(string(data))] = string(data)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Len returns the number of documents in the allow list.
+func (l *AllowList) Len() int {
+	return len(l.documents)
+}
+
+func (l *AllowList) document(hash string) (string, bool) {
+	if l == nil {
+		return "", false
+	}
+
+	doc, ok := l.documents[hash]
+
+	return doc, ok
+}
+
+// persistedQueryCache is the cache of automatic persisted queries. The documents
+// of the allow list are always found, and only they are when the cache is nil.
+type persistedQueryCache struct {
+	allowList *AllowList
+	cache     graphql.Cache
+}
+
+// Get implements graphql.Cache.
+func (c persistedQueryCache) Get(ctx context.Context, hash string) (interface{}, bool) {
+	if doc, ok := c.allowList.document(hash); ok {
+		return doc, true
+	}
+
+	if c.cache == nil {
+		return nil, false
+	}
+
+	return c.cache.Get(ctx, hash)
+}
+
+// Add implements graphql.Cache.
+func (c persistedQueryCache) Add(ctx context.Context, hash string, query interface{}) {
+	if c.cache != nil {
+		c.cache.Add(ctx, hash, query)
+	}
+}
+
+// allowListCheck rejects the operations which aren't in the allow list. It runs
+// after the automatic persisted queries are looked up.
+type allowListCheck struct {
+	allowList *AllowList
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = allowListCheck{}
+
+// ExtensionName implements graphql.HandlerExtension.
+func (allowListCheck) ExtensionName() string {
+	return allowListExtension
+}
+
+// Validate implements graphql.HandlerExtension.
+func (allowListCheck) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationParameters implements graphql.OperationParameterMutator.
+func (c allowListCheck) MutateOperationParameters(_ context.Context, params *graphql.RawParams) *gqlerror.Error {
+	if _, ok := c.allowList.document(queryHash(params.Query)); ok {
+		return nil
+	}
+
+	err := gqlerror.Errorf("operation is not in the allow list")
+	errcode.Set(err, CodeOperationNotAllowed)
+
+	return err
+}
+
+// queryHash returns the hash automatic persisted queries are looked up by.
+func queryHash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+
+	return hex.EncodeToString(sum[:])
+}
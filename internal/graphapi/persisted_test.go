@@ -0,0 +1,139 @@
+package graphapi_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/metadata-api/internal/graphapi"
+)
+
+const (
+	allowedQuery = `query AllowedNamespace($id: ID!) {
+  statusNamespace(id: $id) {
+    id
+  }
+}
+`
+
+	otherQuery = `query OtherNamespace($id: ID!) { annotationNamespace(id: $id) { id } }`
+)
+
+func persistedQueryParams(query string, withQuery bool) map[string]any {
+	sum := sha256.Sum256([]byte(query))
+
+	params := map[string]any{
+		"variables": map[string]any{"id": "testing-persisted"},
+		"extensions": map[string]any{
+			"persistedQuery": map[string]any{
+				"version":    1,
+				"sha256Hash": hex.EncodeToString(sum[:]),
+			},
+		},
+	}
+
+	if withQuery {
+		params["query"] = query
+	}
+
+	return params
+}
+
+func errorCodes(resp graphQueryResponse) []any {
+	codes := []any{}
+
+	for _, err := range resp.Errors {
+		codes = append(codes, err.Extensions["code"])
+	}
+
+	return codes
+}
+
+func TestAutomaticPersistedQueries(t *testing.T) {
+	handler := graphapi.NewResolver(EntClient, zap.NewNop().Sugar()).Handler(false).Handler()
+
+	resp := postGraphQuery(t, handler, persistedQueryParams(otherQuery, false))
+	assert.Equal(t, []any{"PERSISTED_QUERY_NOT_FOUND"}, errorCodes(resp), "unknown hashes are not found")
+
+	resp = postGraphQuery(t, handler, persistedQueryParams(otherQuery, true))
+	assert.Equal(t, []any{graphapi.CodeNotFound}, errorCodes(resp), "the query is run and kept")
+
+	resp = postGraphQuery(t, handler, persistedQueryParams(otherQuery, false))
+	assert.Equal(t, []any{graphapi.CodeNotFound}, errorCodes(resp), "the kept query is run by its hash")
+
+	handler = graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithAPQCacheSize(0)).Handler(false).Handler()
+
+	resp = postGraphQuery(t, handler, persistedQueryParams(otherQuery, true))
+	assert.Equal(t, []any{graphapi.CodeNotFound}, errorCodes(resp), "the extension is ignored when disabled")
+}
+
+func TestAllowList(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "ops"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ops", "allowed.graphql"), []byte(allowedQuery), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not an operation"), 0o600))
+
+	allowList, err := graphapi.LoadAllowList(dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, allowList.Len())
+
+	handler := graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithAllowList(allowList)).Handler(false).Handler()
+
+	testCases := []struct {
+		TestName string
+		Params   map[string]any
+		Codes    []any
+	}{
+		{
+			TestName: "allowed operation",
+			Params:   map[string]any{"query": allowedQuery, "variables": map[string]any{"id": "testing-persisted"}},
+			Codes:    []any{graphapi.CodeNotFound},
+		},
+		{
+			TestName: "allowed operation by its hash",
+			Params:   persistedQueryParams(allowedQuery, false),
+			Codes:    []any{graphapi.CodeNotFound},
+		},
+		{
+			TestName: "other operation",
+			Params:   map[string]any{"query": otherQuery, "variables": map[string]any{"id": "testing-persisted"}},
+			Codes:    []any{graphapi.CodeOperationNotAllowed},
+		},
+		{
+			TestName: "other operation as a persisted query",
+			Params:   persistedQueryParams(otherQuery, true),
+			Codes:    []any{graphapi.CodeOperationNotAllowed},
+		},
+		{
+			TestName: "other operation isn't kept as a persisted query",
+			Params:   persistedQueryParams(otherQuery, false),
+			Codes:    []any{"PERSISTED_QUERY_NOT_FOUND"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			resp := postGraphQuery(t, handler, tt.Params)
+
+			assert.Equal(t, tt.Codes, errorCodes(resp))
+		})
+	}
+}
+
+func TestLoadAllowListInvalidDocument(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.graphql"), []byte(`query { unknownField }`), 0o600))
+
+	_, err := graphapi.LoadAllowList(dir)
+	assert.ErrorContains(t, err, "invalid.graphql")
+	assert.ErrorContains(t, err, "unknownField")
+}
@@ -0,0 +1,223 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/generated/intercept"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+)
+
+// permittedResources reports which of the resources the caller is permitted the
+// action on. The permissions api only reports whether all the requests of a check
+// are permitted, so the resources are checked together first and only checked
+// one at a time when some of them are denied.
+func permittedResources(ctx context.Context, ids []gidx.PrefixedID, action string) (map[gidx.PrefixedID]bool, error) {
+	permitted := make(map[gidx.PrefixedID]bool, len(ids))
+
+	if len(ids) == 0 {
+		return permitted, nil
+	}
+
+	requests := make([]permissions.AccessRequest, len(ids))
+
+	for i, id := range ids {
+		requests[i] = permissions.AccessRequest{ResourceID: id, Action: action}
+	}
+
+	err := permissions.CheckAll(ctx, requests...)
+
+	switch {
+	case err == nil:
+		for _, id := range ids {
+			permitted[id] = true
+		}
+
+		return permitted, nil
+	case !errors.Is(err, permissions.ErrPermissionDenied):
+		return nil, err
+	case len(ids) == 1:
+		return permitted, nil
+	}
+
+	for _, id := range ids {
+		err := permissions.CheckAccess(ctx, id, action)
+
+		switch {
+		case err == nil:
+			permitted[id] = true
+		case !errors.Is(err, permissions.ErrPermissionDenied):
+			return nil, err
+		}
+	}
+
+	return permitted, nil
+}
+
+type (
+	visibilityCtxKey struct{}
+	reachCtxKey      struct{}
+	unfilteredCtxKey struct{}
+)
+
+// visibility caches whether the caller can read the private namespaces of owners,
+// so the permissions of an owner are only checked once in a request however many
+// connections reach its namespaces.
+type visibility struct {
+	mu        sync.Mutex
+	decisions map[permissions.AccessRequest]bool
+}
+
+// withVisibility returns a copy of the context the visibility interceptors filter
+// the annotation and status queries of.
+func (r *Resolver) withVisibility(ctx context.Context) context.Context {
+	v := &visibility{decisions: make(map[permissions.AccessRequest]bool)}
+
+	return context.WithValue(ctx, visibilityCtxKey{}, v)
+}
+
+// readable returns the namespaces the caller can read of the given private
+// namespaces, keyed by their owners. The owners which weren't decided earlier in
+// the request are checked together.
+func (v *visibility) readable(ctx context.Context, owners map[gidx.PrefixedID]gidx.PrefixedID, action string) ([]gidx.PrefixedID, bool, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	var undecided []gidx.PrefixedID
+
+	for _, ownerID := range owners {
+		if _, ok := v.decisions[permissions.AccessRequest{ResourceID: ownerID, Action: action}]; !ok {
+			undecided = append(undecided, ownerID)
+		}
+	}
+
+	permitted, err := permittedResources(ctx, uniqueIDs(undecided), action)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, ownerID := range undecided {
+		v.decisions[permissions.AccessRequest{ResourceID: ownerID, Action: action}] = permitted[ownerID]
+	}
+
+	readable := make([]gidx.PrefixedID, 0, len(owners))
+
+	for nsID, ownerID := range owners {
+		if v.decisions[permissions.AccessRequest{ResourceID: ownerID, Action: action}] {
+			readable = append(readable, nsID)
+		}
+	}
+
+	return readable, len(readable) == len(owners), nil
+}
+
+// visibilityFieldMiddleware leaves the queries of the root fields of mutations and
+// subscriptions unfiltered, so they see the records they change or stream, which
+// they check the permissions of themselves. The fields of their payloads are
+// filtered like the fields of queries. The decisions of a subscription are kept
+// for as long as it's open.
+func visibilityFieldMiddleware(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+
+	switch {
+	case fc.Object == "Mutation" || fc.Object == "Subscription":
+		ctx = context.WithValue(ctx, unfilteredCtxKey{}, true)
+	case unfiltered(ctx):
+		// the payloads of mutations are resolved with the context of the root field
+		ctx = context.WithValue(ctx, unfilteredCtxKey{}, false)
+	}
+
+	return next(ctx)
+}
+
+func unfiltered(ctx context.Context) bool {
+	u, _ := ctx.Value(unfilteredCtxKey{}).(bool)
+
+	return u
+}
+
+// VisibilityInterceptors registers the interceptors which filter out the
+// annotations and statuses in private namespaces the caller can't read from the
+// queries of graph api operations. Other queries aren't filtered.
+//
+// Only the private namespaces a query reaches, ignoring its limit and offset, are
+// checked. The query is limited to the public namespaces and the private ones the
+// caller can read when some of them can't be read.
+func VisibilityInterceptors(c *generated.Client) {
+	c.Annotation.Intercept(intercept.TraverseAnnotation(func(ctx context.Context, q *generated.AnnotationQuery) error {
+		v, ok := ctx.Value(visibilityCtxKey{}).(*visibility)
+		if !ok || unfiltered(ctx) || ctx.Value(reachCtxKey{}) != nil {
+			return nil
+		}
+
+		// the copy of the query which finds the namespaces is intercepted too
+		nss, err := q.Clone().Limit(math.MaxInt).Offset(0).QueryNamespace().
+			Where(annotationnamespace.Private(true)).
+			Select(annotationnamespace.FieldID, annotationnamespace.FieldOwnerID).
+			All(context.WithValue(ctx, reachCtxKey{}, true))
+		if err != nil {
+			return err
+		}
+
+		owners := make(map[gidx.PrefixedID]gidx.PrefixedID, len(nss))
+
+		for _, ns := range nss {
+			owners[ns.ID] = ns.OwnerID
+		}
+
+		readable, all, err := v.readable(ctx, owners, actionMetadataAnnotationNamespaceGet)
+		if err != nil || all {
+			return err
+		}
+
+		q.Where(annotation.Or(
+			annotation.HasNamespaceWith(annotationnamespace.Private(false)),
+			annotation.AnnotationNamespaceIDIn(readable...),
+		))
+
+		return nil
+	}))
+
+	c.Status.Intercept(intercept.TraverseStatus(func(ctx context.Context, q *generated.StatusQuery) error {
+		v, ok := ctx.Value(visibilityCtxKey{}).(*visibility)
+		if !ok || unfiltered(ctx) || ctx.Value(reachCtxKey{}) != nil {
+			return nil
+		}
+
+		nss, err := q.Clone().Limit(math.MaxInt).Offset(0).QueryNamespace().
+			Where(statusnamespace.Private(true)).
+			Select(statusnamespace.FieldID, statusnamespace.FieldResourceProviderID).
+			All(context.WithValue(ctx, reachCtxKey{}, true))
+		if err != nil {
+			return err
+		}
+
+		owners := make(map[gidx.PrefixedID]gidx.PrefixedID, len(nss))
+
+		for _, ns := range nss {
+			owners[ns.ID] = ns.ResourceProviderID
+		}
+
+		readable, all, err := v.readable(ctx, owners, actionMetadataStatusNamespaceGet)
+		if err != nil || all {
+			return err
+		}
+
+		q.Where(status.Or(
+			status.HasNamespaceWith(statusnamespace.Private(false)),
+			status.StatusNamespaceIDIn(readable...),
+		))
+
+		return nil
+	}))
+}
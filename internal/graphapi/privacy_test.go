@@ -0,0 +1,409 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/metadata-api/internal/graphapi"
+	"go.infratographer.com/metadata-api/internal/testclient"
+)
+
+func TestVisibilityBatchesPermissionChecks(t *testing.T) {
+	const nodeMetadataQuery = `query($id: ID!, $first: Int, $orderBy: AnnotationOrder) {
+		_entities(representations: [{ __typename: "MetadataNode", id: $id }]) {
+			... on MetadataNode {
+				metadata {
+					annotations(first: $first, orderBy: $orderBy) { totalCount edges { node { id } } }
+					statuses { totalCount edges { node { id } } }
+				}
+			}
+		}
+	}`
+
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	meta := MetadataBuilder{}.MustNew(ctx)
+
+	antIDs := []gidx.PrefixedID{AnnotationBuilder{Metadata: meta}.MustNew(ctx).ID}
+	owners := []gidx.PrefixedID{}
+
+	for i := 0; i < 4; i++ {
+		ns := AnnotationNamespaceBuilder{Private: true}.MustNew(ctx)
+		antIDs = append(antIDs, AnnotationBuilder{Metadata: meta, AnnotationNamespace: ns}.MustNew(ctx).ID)
+		owners = append(owners, ns.OwnerID)
+	}
+
+	stIDs := []gidx.PrefixedID{}
+
+	for i := 0; i < 2; i++ {
+		ns := StatusNamespaceBuilder{Private: true}.MustNew(ctx)
+		stIDs = append(stIDs, StatusBuilder{Metadata: meta, StatusNamespace: ns}.MustNew(ctx).ID)
+	}
+
+	// the namespaces of other nodes' metadata aren't reached by the query
+	unreached := AnnotationNamespaceBuilder{Private: true}.MustNew(ctx)
+	AnnotationBuilder{AnnotationNamespace: unreached}.MustNew(ctx)
+
+	handler := graphapi.NewResolver(EntClient, zap.NewNop().Sugar()).Handler(false).Handler()
+
+	testCases := []struct {
+		TestName         string
+		DeniedOwners     []gidx.PrefixedID
+		First            *int
+		AnnotationIDs    []gidx.PrefixedID
+		AnnotationCount  int
+		AnnotationChecks int
+		StatusChecks     int
+	}{
+		{
+			TestName:         "all namespaces are checked at once",
+			AnnotationIDs:    antIDs,
+			AnnotationCount:  len(antIDs),
+			AnnotationChecks: 1,
+			StatusChecks:     1,
+		},
+		{
+			TestName:        "namespaces of denied owners are filtered out",
+			DeniedOwners:    []gidx.PrefixedID{owners[1]},
+			AnnotationIDs:   append(append([]gidx.PrefixedID{}, antIDs[:2]...), antIDs[3:]...),
+			AnnotationCount: len(antIDs) - 1,
+			// the owners are checked on their own once the check of all is denied
+			AnnotationChecks: 1 + len(owners),
+			StatusChecks:     1,
+		},
+		{
+			// the newest annotations are in the namespaces of the denied owners
+			TestName:         "pages are filtered before they are limited",
+			DeniedOwners:     []gidx.PrefixedID{owners[3], owners[2]},
+			First:            newInt(2),
+			AnnotationIDs:    []gidx.PrefixedID{antIDs[2], antIDs[1]},
+			AnnotationCount:  len(antIDs) - 2,
+			AnnotationChecks: 1 + len(owners),
+			StatusChecks:     1,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			var (
+				mu      sync.Mutex
+				checks  = map[string]int{}
+				checked = map[gidx.PrefixedID]bool{}
+			)
+
+			checker := permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				actions := map[string]bool{}
+
+				for _, req := range requests {
+					actions[req.Action] = true
+					checked[req.ResourceID] = true
+				}
+
+				for action := range actions {
+					checks[action]++
+				}
+
+				for _, req := range requests {
+					for _, denied := range tt.DeniedOwners {
+						if req.ResourceID == denied {
+							return permissions.ErrPermissionDenied
+						}
+					}
+				}
+
+				return nil
+			})
+
+			vars := map[string]any{
+				"id":      meta.NodeID,
+				"first":   tt.First,
+				"orderBy": map[string]any{"field": "CREATED_AT", "direction": "DESC"},
+			}
+
+			resp := postGraphQuery(t, func(w http.ResponseWriter, r *http.Request) {
+				handler(w, r.WithContext(context.WithValue(r.Context(), permissions.CheckerCtxKey, checker)))
+			}, map[string]any{"query": nodeMetadataQuery, "variables": vars})
+			require.Empty(t, resp.Errors)
+
+			var data struct {
+				Entities []struct {
+					Metadata struct {
+						Annotations connectionIDs
+						Statuses    connectionIDs
+					}
+				} `json:"_entities"`
+			}
+
+			require.NoError(t, json.Unmarshal(resp.Data, &data))
+			require.Len(t, data.Entities, 1)
+
+			md := data.Entities[0].Metadata
+
+			assert.ElementsMatch(t, tt.AnnotationIDs, md.Annotations.ids())
+			assert.Equal(t, tt.AnnotationCount, md.Annotations.TotalCount)
+			assert.ElementsMatch(t, stIDs, md.Statuses.ids())
+			assert.Equal(t, len(stIDs), md.Statuses.TotalCount)
+
+			// the decisions are cached for the request, so the count and the
+			// page of a connection don't check the owners again
+			assert.Equal(t, tt.AnnotationChecks, checks["metadata_annotationnamespace_get"])
+			assert.Equal(t, tt.StatusChecks, checks["metadata_statusnamespace_get"])
+			assert.False(t, checked[unreached.OwnerID])
+		})
+	}
+}
+
+func TestMetadataConnectionsBatchPermissionChecks(t *testing.T) {
+	const nodesMetadataQuery = `query($representations: [_Any!]!) {
+		_entities(representations: $representations) {
+			... on MetadataNode {
+				metadata {
+					annotations { totalCount }
+					statuses { totalCount }
+				}
+			}
+		}
+	}`
+
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	representations := []map[string]any{}
+	nodeIDs := []gidx.PrefixedID{}
+
+	for i := 0; i < 3; i++ {
+		meta := MetadataBuilder{}.MustNew(ctx)
+		AnnotationBuilder{Metadata: meta}.MustNew(ctx)
+		StatusBuilder{Metadata: meta}.MustNew(ctx)
+
+		representations = append(representations, map[string]any{"__typename": "MetadataNode", "id": meta.NodeID})
+		nodeIDs = append(nodeIDs, meta.NodeID)
+	}
+
+	handler := graphapi.NewResolver(EntClient, zap.NewNop().Sugar()).Handler(false).Handler()
+
+	testCases := []struct {
+		TestName   string
+		DeniedNode gidx.PrefixedID
+		ListChecks int
+		Errors     int
+	}{
+		{
+			TestName:   "the nodes are checked at once",
+			ListChecks: 1,
+		},
+		{
+			TestName:   "the denied node is checked on its own",
+			DeniedNode: nodeIDs[1],
+			ListChecks: 1 + len(nodeIDs),
+			Errors:     2,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			var (
+				mu     sync.Mutex
+				checks = map[string]int{}
+			)
+
+			checker := permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				checks[requests[0].Action]++
+
+				for _, req := range requests {
+					if req.ResourceID == tt.DeniedNode {
+						return permissions.ErrPermissionDenied
+					}
+				}
+
+				return nil
+			})
+
+			resp := postGraphQuery(t, func(w http.ResponseWriter, r *http.Request) {
+				handler(w, r.WithContext(context.WithValue(r.Context(), permissions.CheckerCtxKey, checker)))
+			}, map[string]any{"query": nodesMetadataQuery, "variables": map[string]any{"representations": representations}})
+
+			// the annotations and statuses of the denied node can't be listed
+			assert.Len(t, resp.Errors, tt.Errors)
+			assert.Equal(t, tt.ListChecks, checks["metadata_annotation_list"])
+			assert.Equal(t, tt.ListChecks, checks["metadata_status_list"])
+		})
+	}
+}
+
+type connectionIDs struct {
+	TotalCount int
+	Edges      []struct {
+		Node struct {
+			ID gidx.PrefixedID
+		}
+	}
+}
+
+func (c connectionIDs) ids() []gidx.PrefixedID {
+	ids := []gidx.PrefixedID{}
+
+	for _, edge := range c.Edges {
+		ids = append(ids, edge.Node.ID)
+	}
+
+	return ids
+}
+
+func TestVisibilityOfMutationAndSubscriptionPayloads(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	deniedOwner := gidx.MustNewID("tnntten")
+
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.ResourceID == deniedOwner {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}))
+
+	meta := MetadataBuilder{}.MustNew(ctx)
+	publicAnt := AnnotationBuilder{Metadata: meta}.MustNew(ctx)
+	privateNS := AnnotationNamespaceBuilder{OwnerID: deniedOwner, Private: true}.MustNew(ctx)
+	privateAnt := AnnotationBuilder{Metadata: meta, AnnotationNamespace: privateNS}.MustNew(ctx)
+
+	stNS := StatusNamespaceBuilder{}.MustNew(ctx)
+
+	input := map[string]any{
+		"nodeID":      meta.NodeID,
+		"namespaceID": stNS.ID,
+		"source":      "go-tests",
+		"data":        map[string]any{"state": "ACTIVE"},
+	}
+
+	type payloadStatus struct {
+		Metadata struct {
+			Annotations connectionIDs
+		}
+	}
+
+	t.Run("mutation payloads are filtered", func(t *testing.T) {
+		const statusUpdateMutation = `mutation($input: StatusUpdateInput!) {
+			statusUpdate(input: $input) {
+				status { metadata { annotations { totalCount edges { node { id } } } } }
+			}
+		}`
+
+		handler := graphapi.NewResolver(EntClient, zap.NewNop().Sugar()).Handler(false).Handler()
+
+		resp := postGraphQuery(t, func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r.WithContext(ctx))
+		}, map[string]any{"query": statusUpdateMutation, "variables": map[string]any{"input": input}})
+		require.Empty(t, resp.Errors)
+
+		var data struct {
+			StatusUpdate struct {
+				Status payloadStatus
+			}
+		}
+
+		require.NoError(t, json.Unmarshal(resp.Data, &data))
+
+		annotations := data.StatusUpdate.Status.Metadata.Annotations
+
+		assert.Equal(t, []gidx.PrefixedID{publicAnt.ID}, annotations.ids())
+		assert.Equal(t, 1, annotations.TotalCount)
+	})
+
+	t.Run("mutations find the records they change", func(t *testing.T) {
+		// the namespace can be updated, but its owner's private namespaces can't be read
+		resp, err := graphTestClient().AnnotationUpdate(ctx, testclient.AnnotationUpdateInput{
+			NodeID:      meta.NodeID,
+			NamespaceID: newID(privateNS.ID),
+			Data:        json.RawMessage(`{"tier":"web"}`),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, privateAnt.ID, resp.AnnotationUpdate.Annotation.ID)
+	})
+
+	t.Run("subscription payloads are filtered", func(t *testing.T) {
+		const statusChangedSubscription = `subscription($nodeID: ID!, $namespaceID: ID) {
+			statusChanged(nodeID: $nodeID, namespaceID: $namespaceID) {
+				status { metadata { annotations { totalCount edges { node { id } } } } }
+			}
+		}`
+
+		type statusChanged struct {
+			StatusChanged struct {
+				Status *payloadStatus
+			}
+		}
+
+		responses := graphTestSubscription[statusChanged](t, ctx, statusChangedSubscription, client.Var("nodeID", meta.NodeID), client.Var("namespaceID", stNS.ID))
+
+		var resp statusChanged
+
+		// the subscription is started in the background, so update until it receives a change
+		require.Eventually(t, func() bool {
+			_, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{
+				NodeID:      meta.NodeID,
+				NamespaceID: newID(stNS.ID),
+				Source:      "go-tests",
+				Data:        json.RawMessage(`{"state":"ACTIVE"}`),
+			})
+			require.NoError(t, err)
+
+			select {
+			case r := <-responses:
+				require.NoError(t, r.err)
+				resp = r.resp
+
+				return true
+			case <-time.After(100 * time.Millisecond):
+				return false
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+
+		require.NotNil(t, resp.StatusChanged.Status)
+
+		annotations := resp.StatusChanged.Status.Metadata.Annotations
+
+		assert.Equal(t, []gidx.PrefixedID{publicAnt.ID}, annotations.ids())
+		assert.Equal(t, 1, annotations.TotalCount)
+	})
+}
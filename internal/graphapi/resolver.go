@@ -1,16 +1,23 @@
 package graphapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/labstack/echo/v4"
 	"github.com/wundergraph/graphql-go-tools/pkg/playground"
 	"go.infratographer.com/x/gqlgenx/oteltracing"
 	"go.uber.org/zap"
 
 	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/pubsub"
 )
 
 // This file will not be regenerated automatically.
@@ -24,18 +31,47 @@ var (
 	graphFullPath = fmt.Sprintf("/%s", graphPath)
 )
 
+const (
+	queryCacheSize     = 1000
+	websocketKeepAlive = 10 * time.Second
+)
+
 // Resolver provides a graph response resolver
 type Resolver struct {
 	client *ent.Client
 	logger *zap.SugaredLogger
+	pubsub *pubsub.Broker
+	limits Limits
+
+	apqCacheSize int
+	allowList    *AllowList
+}
+
+// Option configures a Resolver
+type Option func(*Resolver)
+
+// WithBroker sets the broker that subscriptions receive changes from. The
+// change hooks must publish to the same broker. Subscriptions are disabled
+// without a broker.
+func WithBroker(b *pubsub.Broker) Option {
+	return func(r *Resolver) {
+		r.pubsub = b
+	}
 }
 
 // NewResolver returns a resolver configured with the given ent client
-func NewResolver(client *ent.Client, logger *zap.SugaredLogger) *Resolver {
-	return &Resolver{
-		client: client,
-		logger: logger,
+func NewResolver(client *ent.Client, logger *zap.SugaredLogger, opts ...Option) *Resolver {
+	r := &Resolver{
+		client:       client,
+		logger:       logger,
+		apqCacheSize: DefaultAPQCacheSize,
+	}
+
+	for _, opt := range opts {
+		opt(r)
 	}
+
+	return r
 }
 
 // Handler is an http handler wrapping a Resolver
@@ -48,16 +84,62 @@ type Handler struct {
 
 // Handler returns an http handler for a graph resolver
 func (r *Resolver) Handler(withPlayground bool, middleware ...echo.MiddlewareFunc) *Handler {
-	srv := handler.NewDefaultServer(
+	srv := handler.New(
 		NewExecutableSchema(
 			Config{
-				Resolvers: r,
+				Resolvers:  r,
+				Complexity: complexityRoot(),
 			},
 		),
 	)
 
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: websocketKeepAlive,
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+
+	srv.SetQueryCache(lru.New(queryCacheSize))
+
+	srv.Use(extension.Introspection{})
+
+	// the documents of the allow list are found as automatic persisted queries,
+	// which are only kept for other queries without an allow list
+	apqCache := persistedQueryCache{allowList: r.allowList}
+
+	if r.allowList == nil && r.apqCacheSize > 0 {
+		apqCache.cache = lru.New(r.apqCacheSize)
+	}
+
+	if r.allowList != nil || apqCache.cache != nil {
+		srv.Use(extension.AutomaticPersistedQuery{Cache: apqCache})
+	}
+
+	if r.allowList != nil {
+		srv.Use(allowListCheck{r.allowList})
+	}
+
 	srv.Use(oteltracing.Tracer{})
 
+	srv.Use(queryLimits{r.limits})
+
+	if r.limits.MaxComplexity > 0 {
+		srv.Use(extension.FixedComplexityLimit(r.limits.MaxComplexity))
+	}
+
+	srv.SetErrorPresenter(errorPresenter)
+
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		ctx = r.withLoaders(ctx)
+		ctx = r.withVisibility(ctx)
+
+		return next(ctx)
+	})
+
+	srv.AroundFields(visibilityFieldMiddleware)
+
 	h := &Handler{
 		r:              r,
 		middleware:     middleware,
@@ -89,6 +171,12 @@ func (h *Handler) Routes(e *echo.Group) {
 		return nil
 	})
 
+	// subscriptions are served over websockets, which are opened with a GET request
+	e.GET("/"+graphPath, func(c echo.Context) error {
+		h.graphqlHandler.ServeHTTP(c.Response(), c.Request())
+		return nil
+	})
+
 	if h.playground != nil {
 		handlers, err := h.playground.Handlers()
 		if err != nil {
@@ -10,26 +10,28 @@ import (
 	"entgo.io/contrib/entgql"
 	"go.infratographer.com/metadata-api/internal/ent/generated"
 	"go.infratographer.com/metadata-api/internal/ent/generated/annotationnamespace"
-	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
 )
 
 // Owner is the resolver for the owner field.
 func (r *annotationNamespaceResolver) Owner(ctx context.Context, obj *generated.AnnotationNamespace) (*ResourceOwner, error) {
-	return &ResourceOwner{ID: obj.ID}, nil
+	return &ResourceOwner{ID: obj.OwnerID}, nil
 }
 
 // AnnotationNamespaces is the resolver for the annotationNamespaces field.
 func (r *resourceOwnerResolver) AnnotationNamespaces(ctx context.Context, obj *ResourceOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.AnnotationNamespaceOrder, where *generated.AnnotationNamespaceWhereInput) (*generated.AnnotationNamespaceConnection, error) {
+	if err := permissions.CheckAccess(ctx, obj.ID, actionMetadataAnnotationNamespaceList); err != nil {
+		return nil, err
+	}
+
 	return r.client.AnnotationNamespace.Query().Where(annotationnamespace.OwnerID(obj.ID)).Paginate(ctx, after, first, before, last, generated.WithAnnotationNamespaceOrder(orderBy), generated.WithAnnotationNamespaceFilter(where.Filter))
 }
 
 // Metadata is the resolver for the metadata field.
 func (r *resourceOwnerResolver) Metadata(ctx context.Context, obj *ResourceOwner) (*generated.Metadata, error) {
-	m, err := r.client.Metadata.Query().Where(metadata.NodeID(obj.ID)).First(ctx)
 	// Don't return an error if it isn't found, metadata is optional
-	err = generated.MaskNotFound(err)
-	return m, err
+	return r.loaders(ctx).metadataByNodeID.Load(ctx, obj.ID)()
 }
 
 // ResourceOwner returns ResourceOwnerResolver implementation.
@@ -7,7 +7,9 @@ package graphapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
+	"entgo.io/contrib/entgql"
 	"go.infratographer.com/x/gidx"
 
 	"go.infratographer.com/permissions-api/pkg/permissions"
@@ -15,106 +17,103 @@ import (
 	"go.infratographer.com/metadata-api/internal/ent/generated"
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
+	"go.infratographer.com/metadata-api/internal/pubsub"
 )
 
 // StatusUpdate is the resolver for the statusUpdate field.
 func (r *mutationResolver) StatusUpdate(ctx context.Context, input StatusUpdateInput) (*StatusUpdateResponse, error) {
-	logger := r.logger.With("nodeID", input.NodeID, "namespaceID", input.NamespaceID, "source", input.Source)
-
-	if input.NamespaceID == "" {
-		return nil, NewInvalidFieldError("namespaceID", ErrFieldEmpty)
+	ref, err := validateStatusUpdateInput(input)
+	if err != nil {
+		return nil, err
 	}
 
-	if input.NodeID == "" {
-		return nil, NewInvalidFieldError("nodeID", ErrFieldEmpty)
+	nsID, err := r.statusNamespaceID(ctx, ref)
+	if err != nil {
+		return nil, err
 	}
 
-	if input.Source == "" {
-		return nil, NewInvalidFieldError("source", ErrFieldEmpty)
+	ns, err := r.statusNamespaceForUpdate(ctx, nsID)
+	if err != nil {
+		return nil, err
 	}
 
-	if _, err := gidx.Parse(input.NodeID.String()); err != nil {
-		return nil, NewInvalidFieldError("nodeID", err)
-	}
+	var st *generated.Status
 
-	if _, err := gidx.Parse(input.NamespaceID.String()); err != nil {
-		return nil, NewInvalidFieldError("namespaceID", err)
-	}
+	err = r.upsertTx(ctx, func(tx *generated.Tx) error {
+		st, err = r.upsertStatus(ctx, tx, ns, input)
 
-	if !json.Valid(input.Data) {
-		return nil, NewInvalidFieldError("data", ErrInvalidJSON)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if err := permissions.CheckAccess(ctx, input.NamespaceID, actionMetadataStatusNamespaceUpdate); err != nil {
-		return nil, err
+	return &StatusUpdateResponse{Status: st.Unwrap()}, nil
+}
+
+// StatusUpdateBatch is the resolver for the statusUpdateBatch field.
+func (r *mutationResolver) StatusUpdateBatch(ctx context.Context, input StatusUpdateBatchInput) (*StatusUpdateBatchResponse, error) {
+	if len(input.Items) == 0 {
+		return nil, NewInvalidFieldError("items", ErrFieldEmpty)
 	}
 
-	if _, err := r.client.StatusNamespace.Get(ctx, input.NamespaceID); err != nil {
-		if generated.IsNotFound(err) {
+	// each namespace is only looked up and checked once
+	namespace := memoize(func(ref namespaceRef) (*generated.StatusNamespace, error) {
+		id, err := r.statusNamespaceID(ctx, ref)
+		if err != nil {
 			return nil, err
 		}
 
-		if generated.IsValidationError(err) {
-			return nil, err
-		}
+		return r.statusNamespaceForUpdate(ctx, id)
+	})
 
-		logger.Errorw("failed to get status namespace", "error", err)
-		return nil, ErrInternalServerError
-	}
+	var (
+		statuses []*generated.Status
+		errs     []error
+	)
 
-	status, err := r.client.Status.Query().Where(
-		status.HasMetadataWith(metadata.NodeID(input.NodeID)),
-		status.StatusNamespaceID(input.NamespaceID),
-		status.Source(input.Source),
-	).First(ctx)
-	if err != nil {
-		md, err := r.client.Metadata.Query().Where(metadata.NodeID(input.NodeID)).First(ctx)
-		if err != nil {
-			if generated.IsNotFound(err) {
-				md, err = r.client.Metadata.Create().SetNodeID(input.NodeID).Save(ctx)
-				if err != nil {
-					if generated.IsValidationError(err) {
-						return nil, err
-					}
-
-					logger.Errorw("failed to create metadata", "error", err)
-					return nil, ErrInternalServerError
-				}
-			} else {
-				logger.Errorw("failed to get metadata", "error", err)
-				return nil, ErrInternalServerError
+	err := r.upsertTx(ctx, func(tx *generated.Tx) (err error) {
+		statuses, errs, err = runBatch(len(input.Items), input.Mode, func(i int) (*generated.Status, error) {
+			item := *input.Items[i]
+
+			ref, err := validateStatusUpdateInput(item)
+			if err != nil {
+				return nil, err
 			}
-		}
 
-		status, err = r.client.Status.Create().SetInput(generated.CreateStatusInput{
-			MetadataID:  md.ID,
-			NamespaceID: input.NamespaceID,
-			Source:      input.Source,
-			Data:        input.Data,
-		}).Save(ctx)
-		if err != nil {
-			logger.Errorw("failed to create status", "error", err)
-			return nil, ErrInternalServerError
-		}
+			ns, err := namespace(ref)
+			if err != nil {
+				return nil, err
+			}
 
-		return &StatusUpdateResponse{Status: status}, nil
-	}
+			return r.upsertStatus(ctx, tx, ns, item)
+		})
 
-	status, err = status.Update().SetData(input.Data).Save(ctx)
+		return err
+	})
 	if err != nil {
-		logger.Errorw("failed to update status", "error", err)
-		return nil, ErrInternalServerError
+		return nil, err
 	}
 
-	return &StatusUpdateResponse{Status: status}, nil
+	results := make([]*StatusUpdateBatchResult, len(statuses))
+
+	for i, st := range statuses {
+		results[i] = &StatusUpdateBatchResult{Error: batchError(errs[i])}
+
+		if st != nil {
+			results[i].Status = st.Unwrap()
+		}
+	}
+
+	return &StatusUpdateBatchResponse{Results: results}, nil
 }
 
 // StatusDelete is the resolver for the statusDelete field.
 func (r *mutationResolver) StatusDelete(ctx context.Context, input StatusDeleteInput) (*StatusDeleteResponse, error) {
-	logger := r.logger.With("nodeID", input.NodeID, "namespaceID", input.NamespaceID, "source", input.Source)
-
-	if input.NamespaceID == "" {
-		return nil, NewInvalidFieldError("namespaceID", ErrFieldEmpty)
+	ref, err := newNamespaceRef(input.NamespaceID, input.OwnerID, input.NamespaceName)
+	if err != nil {
+		return nil, err
 	}
 
 	if input.NodeID == "" {
@@ -129,17 +128,20 @@ func (r *mutationResolver) StatusDelete(ctx context.Context, input StatusDeleteI
 		return nil, NewInvalidFieldError("nodeID", err)
 	}
 
-	if _, err := gidx.Parse(input.NamespaceID.String()); err != nil {
-		return nil, NewInvalidFieldError("namespaceID", err)
+	nsID, err := r.statusNamespaceID(ctx, ref)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := permissions.CheckAccess(ctx, input.NamespaceID, actionMetadataStatusNamespaceUpdate); err != nil {
+	logger := r.logger.With("nodeID", input.NodeID, "namespaceID", nsID, "source", input.Source)
+
+	if err := permissions.CheckAccess(ctx, nsID, actionMetadataStatusNamespaceUpdate); err != nil {
 		return nil, err
 	}
 
 	st, err := r.client.Status.Query().Where(
 		status.HasMetadataWith(metadata.NodeID(input.NodeID)),
-		status.StatusNamespaceID(input.NamespaceID),
+		status.StatusNamespaceID(nsID),
 		status.Source(input.Source),
 	).First(ctx)
 	if err != nil {
@@ -151,10 +153,117 @@ func (r *mutationResolver) StatusDelete(ctx context.Context, input StatusDeleteI
 		return nil, ErrInternalServerError
 	}
 
-	if err := r.client.Status.DeleteOne(st).Exec(ctx); err != nil {
+	if input.ExpectedVersion != nil {
+		if err := checkVersion(input.ExpectedVersion, st.Version); err != nil {
+			return nil, err
+		}
+	}
+
+	// delete in a transaction so the history of the status is recorded with the delete
+	err = r.runTx(ctx, func(tx *generated.Tx) error {
+		del := tx.Status.DeleteOneID(st.ID)
+
+		if input.ExpectedVersion != nil {
+			// only delete the version checked, in case the status changed since
+			del.Where(status.Version(st.Version))
+		}
+
+		return del.Exec(ctx)
+	})
+	if err != nil {
+		if errors.Is(err, ErrInternalServerError) {
+			return nil, err
+		}
+
+		if generated.IsNotFound(err) && input.ExpectedVersion != nil {
+			return nil, NewInvalidFieldError("expectedVersion", ErrVersionConflict)
+		}
+
 		logger.Errorw("failed to delete status", "error", err)
 		return nil, ErrInternalServerError
 	}
 
 	return &StatusDeleteResponse{DeletedID: st.ID}, nil
 }
+
+// History is the resolver for the history field.
+func (r *statusResolver) History(ctx context.Context, obj *generated.Status, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.StatusHistoryOrder, where *generated.StatusHistoryWhereInput) (*generated.StatusHistoryConnection, error) {
+	// history is listed in the order the changes were made unless asked otherwise
+	if orderBy == nil {
+		orderBy = &generated.StatusHistoryOrder{Direction: entgql.OrderDirectionAsc, Field: generated.StatusHistoryOrderFieldCreatedAt}
+	}
+
+	return r.client.StatusHistory.Query().Where(statushistory.StatusID(obj.ID)).Paginate(ctx, after, first, before, last, generated.WithStatusHistoryOrder(orderBy), generated.WithStatusHistoryFilter(where.Filter))
+}
+
+// StatusChanged is the resolver for the statusChanged field.
+func (r *subscriptionResolver) StatusChanged(ctx context.Context, nodeID gidx.PrefixedID, namespaceID *gidx.PrefixedID) (<-chan *StatusChange, error) {
+	if err := validateSubscription(nodeID, namespaceID); err != nil {
+		return nil, err
+	}
+
+	if err := permissions.CheckAccess(ctx, nodeID, actionMetadataStatusList); err != nil {
+		return nil, err
+	}
+
+	changes, err := r.subscribeChanges(ctx, pubsub.TopicStatus, nodeID, namespaceID, actionMetadataStatusNamespaceGet, r.statusNamespaceHidden)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *StatusChange)
+
+	go func() {
+		defer close(out)
+
+		for change := range changes {
+			sc := &StatusChange{Operation: ChangeOperation(change.Operation), StatusID: change.ID}
+
+			if change.Operation != pubsub.OperationDelete {
+				st, err := r.client.Status.Get(ctx, change.ID)
+				if err != nil && !generated.IsNotFound(err) {
+					r.logger.Errorw("failed to get changed status", "statusID", change.ID, "error", err)
+					continue
+				}
+
+				sc.Status = st
+			}
+
+			select {
+			case out <- sc:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// DataContains is the resolver for the dataContains field.
+func (r *statusWhereInputResolver) DataContains(ctx context.Context, obj *generated.StatusWhereInput, data json.RawMessage) error {
+	p, err := dataContains(status.FieldData, data)
+	if err != nil {
+		return NewInvalidFieldError("dataContains", err)
+	}
+
+	obj.AddPredicates(p)
+
+	return nil
+}
+
+// DataPath is the resolver for the dataPath field.
+func (r *statusWhereInputResolver) DataPath(ctx context.Context, obj *generated.StatusWhereInput, data *DataPathPredicate) error {
+	if data == nil {
+		return nil
+	}
+
+	p, err := dataPathEQ(status.FieldData, data.Path, data.Value)
+	if err != nil {
+		return NewInvalidFieldError("dataPath", err)
+	}
+
+	obj.AddPredicates(p)
+
+	return nil
+}
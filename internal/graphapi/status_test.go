@@ -3,8 +3,12 @@ package graphapi_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/99designs/gqlgen/client"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -12,9 +16,12 @@ import (
 	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/permissions-api/pkg/permissions/mockpermissions"
 	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
 
 	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statushistory"
+	"go.infratographer.com/metadata-api/internal/reaper"
 	"go.infratographer.com/metadata-api/internal/testclient"
 )
 
@@ -30,14 +37,22 @@ func TestStatusUpdate(t *testing.T) {
 
 	meta1 := MetadataBuilder{}.MustNew(ctx)
 	st1 := StatusBuilder{Metadata: meta1}.MustNew(ctx)
+	schemaNS := StatusNamespaceBuilder{JSONSchema: json.RawMessage(`{"type":"object","properties":{"state":{"enum":["ACTIVE","FAILED"]}},"required":["state"]}`)}.MustNew(ctx)
+	patchSt := StatusBuilder{Metadata: meta1, Data: json.RawMessage(`{"state":"ACTIVE","checks":{"http":"ok","tcp":"ok"}}`)}.MustNew(ctx)
+	schemaSt := StatusBuilder{Metadata: meta1, StatusNamespace: schemaNS, Data: json.RawMessage(`{"state":"ACTIVE"}`)}.MustNew(ctx)
+
+	mergePatch := testclient.DataUpdateModeMergePatch
+	jsonPatch := testclient.DataUpdateModeJSONPatch
 
 	testCases := []struct {
-		TestName    string
-		NodeID      gidx.PrefixedID
-		NamespaceID gidx.PrefixedID
-		JSONData    json.RawMessage // optional, otherwise generated
-		Source      string
-		ErrorMsg    string
+		TestName     string
+		NodeID       gidx.PrefixedID
+		NamespaceID  gidx.PrefixedID
+		JSONData     json.RawMessage // optional, otherwise generated
+		Mode         *testclient.DataUpdateMode
+		ExpectedData json.RawMessage // optional, otherwise JSONData
+		Source       string
+		ErrorMsg     string
 	}{
 		{
 			TestName:    "Will create status for a node we don't have metadata for",
@@ -113,6 +128,75 @@ func TestStatusUpdate(t *testing.T) {
 			Source:      "go-tests",
 			ErrorMsg:    "error calling MarshalJSON",
 		},
+		{
+			TestName:    "Will create status when data matches the namespace json schema",
+			NodeID:      gidx.MustNewID("testing"),
+			NamespaceID: schemaNS.ID,
+			JSONData:    json.RawMessage(`{"state":"ACTIVE"}`),
+			Source:      "go-tests",
+		},
+		{
+			TestName:    "Fails when data doesn't match the namespace json schema",
+			NodeID:      gidx.MustNewID("testing"),
+			NamespaceID: schemaNS.ID,
+			JSONData:    json.RawMessage(`{"state":"UNKNOWN"}`),
+			Source:      "go-tests",
+			ErrorMsg:    "data: does not match namespace json schema: /state:",
+		},
+		{
+			TestName:     "Will merge patch the stored status data",
+			NodeID:       meta1.NodeID,
+			NamespaceID:  patchSt.StatusNamespaceID,
+			JSONData:     json.RawMessage(`{"checks":{"tcp":null,"icmp":"failed"}}`),
+			Mode:         &mergePatch,
+			ExpectedData: json.RawMessage(`{"state":"ACTIVE","checks":{"http":"ok","icmp":"failed"}}`),
+			Source:       patchSt.Source,
+		},
+		{
+			TestName:     "Will apply a json patch to the stored status data",
+			NodeID:       meta1.NodeID,
+			NamespaceID:  patchSt.StatusNamespaceID,
+			JSONData:     json.RawMessage(`[{"op":"replace","path":"/state","value":"FAILED"},{"op":"remove","path":"/checks"}]`),
+			Mode:         &jsonPatch,
+			ExpectedData: json.RawMessage(`{"state":"FAILED"}`),
+			Source:       patchSt.Source,
+		},
+		{
+			TestName:     "Will create status from a merge patch when it doesn't exist",
+			NodeID:       gidx.MustNewID("testing"),
+			NamespaceID:  patchSt.StatusNamespaceID,
+			JSONData:     json.RawMessage(`{"state":"ACTIVE","error":null}`),
+			Mode:         &mergePatch,
+			ExpectedData: json.RawMessage(`{"state":"ACTIVE"}`),
+			Source:       "go-tests",
+		},
+		{
+			TestName:    "Fails when the json patch can't be applied",
+			NodeID:      meta1.NodeID,
+			NamespaceID: patchSt.StatusNamespaceID,
+			JSONData:    json.RawMessage(`[{"op":"remove","path":"/missing"}]`),
+			Mode:        &jsonPatch,
+			Source:      patchSt.Source,
+			ErrorMsg:    "data: invalid patch",
+		},
+		{
+			TestName:    "Fails when the json patch isn't a list of operations",
+			NodeID:      meta1.NodeID,
+			NamespaceID: patchSt.StatusNamespaceID,
+			JSONData:    json.RawMessage(`{"state":"FAILED"}`),
+			Mode:        &jsonPatch,
+			Source:      patchSt.Source,
+			ErrorMsg:    "data: invalid patch",
+		},
+		{
+			TestName:    "Fails when the patched data doesn't match the namespace json schema",
+			NodeID:      meta1.NodeID,
+			NamespaceID: schemaNS.ID,
+			JSONData:    json.RawMessage(`{"state":null}`),
+			Mode:        &mergePatch,
+			Source:      schemaSt.Source,
+			ErrorMsg:    "data: does not match namespace json schema",
+		},
 	}
 
 	for _, tt := range testCases {
@@ -123,7 +207,7 @@ func TestStatusUpdate(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			resp, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{NodeID: tt.NodeID, NamespaceID: tt.NamespaceID, Source: tt.Source, Data: tt.JSONData})
+			resp, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{NodeID: tt.NodeID, NamespaceID: newID(tt.NamespaceID), Source: tt.Source, Data: tt.JSONData, Mode: tt.Mode})
 
 			if tt.ErrorMsg != "" {
 				assert.Error(t, err)
@@ -132,9 +216,13 @@ func TestStatusUpdate(t *testing.T) {
 				return
 			}
 
+			if tt.ExpectedData == nil {
+				tt.ExpectedData = tt.JSONData
+			}
+
 			require.NoError(t, err)
 			assert.NotNil(t, resp.StatusUpdate.Status)
-			assert.JSONEq(t, string(tt.JSONData), string(resp.StatusUpdate.Status.Data))
+			assert.JSONEq(t, string(tt.ExpectedData), string(resp.StatusUpdate.Status.Data))
 
 			stCount := EntClient.Status.Query().Where(status.StatusNamespaceID(tt.NamespaceID), status.Source(tt.Source), status.HasMetadataWith(metadata.NodeID(tt.NodeID))).CountX(ctx)
 			assert.Equal(t, 1, stCount)
@@ -208,7 +296,7 @@ func TestStatusDelete(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.TestName, func(t *testing.T) {
-			resp, err := graphTestClient().StatusDelete(ctx, testclient.StatusDeleteInput{NodeID: tt.NodeID, NamespaceID: tt.NamespaceID, Source: tt.Source})
+			resp, err := graphTestClient().StatusDelete(ctx, testclient.StatusDeleteInput{NodeID: tt.NodeID, NamespaceID: newID(tt.NamespaceID), Source: tt.Source})
 
 			if tt.ErrorMsg != "" {
 				assert.Error(t, err)
@@ -226,3 +314,802 @@ func TestStatusDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusUpdateBatch(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	deniedNS := StatusNamespaceBuilder{}.MustNew(ctx)
+
+	// Permit requests, except on the denied namespace, and count the checks per namespace
+	var checksMu sync.Mutex
+
+	checks := map[gidx.PrefixedID]int{}
+
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+		checksMu.Lock()
+		defer checksMu.Unlock()
+
+		for _, req := range requests {
+			checks[req.ResourceID]++
+
+			if req.ResourceID == deniedNS.ID {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}))
+
+	ns1 := StatusNamespaceBuilder{}.MustNew(ctx)
+	ns2 := StatusNamespaceBuilder{JSONSchema: json.RawMessage(`{"type":"object","required":["state"]}`)}.MustNew(ctx)
+
+	existingMeta := MetadataBuilder{}.MustNew(ctx)
+	StatusBuilder{Metadata: existingMeta, StatusNamespace: ns1, Source: "batch", Data: json.RawMessage(`{"state":"ACTIVE","count":1}`)}.MustNew(ctx)
+
+	mergePatch := testclient.DataUpdateModeMergePatch
+	bestEffort := testclient.BatchModeBestEffort
+
+	testCases := []struct {
+		TestName       string
+		Mode           *testclient.BatchMode
+		Items          []*testclient.StatusUpdateInput
+		ExpectedData   []json.RawMessage
+		ExpectedErrors []string
+		ExpectedChecks map[gidx.PrefixedID]int
+		ErrorMsg       string
+	}{
+		{
+			TestName: "updates and creates statuses",
+			Items: []*testclient.StatusUpdateInput{
+				{NodeID: existingMeta.NodeID, NamespaceID: newID(ns1.ID), Source: "batch", Data: json.RawMessage(`{"count":2}`), Mode: &mergePatch},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns1.ID), Source: "batch", Data: json.RawMessage(`{"state":"ACTIVE"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns2.ID), Source: "batch", Data: json.RawMessage(`{"state":"FAILED"}`)},
+			},
+			ExpectedData:   []json.RawMessage{json.RawMessage(`{"state":"ACTIVE","count":2}`), json.RawMessage(`{"state":"ACTIVE"}`), json.RawMessage(`{"state":"FAILED"}`)},
+			ExpectedErrors: []string{"", "", ""},
+			ExpectedChecks: map[gidx.PrefixedID]int{ns1.ID: 1, ns2.ID: 1},
+		},
+		{
+			TestName: "fails all items when one fails",
+			Items: []*testclient.StatusUpdateInput{
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns1.ID), Source: "batch", Data: json.RawMessage(`{"state":"ACTIVE"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns2.ID), Source: "batch", Data: json.RawMessage(`{"count":1}`)},
+			},
+			ErrorMsg: "items[1]: data: does not match namespace json schema",
+		},
+		{
+			TestName: "fails all items when access to a namespace is denied",
+			Items: []*testclient.StatusUpdateInput{
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns1.ID), Source: "batch", Data: json.RawMessage(`{"state":"ACTIVE"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(deniedNS.ID), Source: "batch", Data: json.RawMessage(`{"state":"ACTIVE"}`)},
+			},
+			ErrorMsg: "items[1]: " + permissions.ErrPermissionDenied.Error(),
+		},
+		{
+			TestName: "returns the error of each failed item in best effort mode",
+			Mode:     &bestEffort,
+			Items: []*testclient.StatusUpdateInput{
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns1.ID), Source: "batch", Data: json.RawMessage(`{"state":"ACTIVE"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns2.ID), Source: "batch", Data: json.RawMessage(`{"count":1}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(deniedNS.ID), Source: "batch", Data: json.RawMessage(`{"state":"ACTIVE"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(deniedNS.ID), Source: "batch", Data: json.RawMessage(`{"state":"ACTIVE"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns1.ID), Source: "", Data: json.RawMessage(`{"state":"ACTIVE"}`)},
+				{NodeID: gidx.MustNewID("testing"), NamespaceID: newID(ns2.ID), Source: "batch", Data: json.RawMessage(`{"state":"ACTIVE"}`)},
+			},
+			ExpectedData: []json.RawMessage{json.RawMessage(`{"state":"ACTIVE"}`), nil, nil, nil, nil, json.RawMessage(`{"state":"ACTIVE"}`)},
+			ExpectedErrors: []string{
+				"",
+				"data: does not match namespace json schema",
+				permissions.ErrPermissionDenied.Error(),
+				permissions.ErrPermissionDenied.Error(),
+				"source: must not be empty",
+				"",
+			},
+			ExpectedChecks: map[gidx.PrefixedID]int{ns1.ID: 1, ns2.ID: 1, deniedNS.ID: 1},
+		},
+		{
+			TestName: "fails when there are no items",
+			Items:    []*testclient.StatusUpdateInput{},
+			ErrorMsg: "items: must not be empty",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			checksMu.Lock()
+			checks = map[gidx.PrefixedID]int{}
+			checksMu.Unlock()
+
+			resp, err := graphTestClient().StatusUpdateBatch(ctx, testclient.StatusUpdateBatchInput{Items: tt.Items, Mode: tt.Mode})
+
+			if tt.ErrorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.ErrorMsg)
+
+				// nothing in a failed batch is stored
+				for _, item := range tt.Items {
+					exists, err := EntClient.Status.Query().Where(status.HasMetadataWith(metadata.NodeID(item.NodeID))).Exist(ctx)
+					require.NoError(t, err)
+					assert.False(t, exists)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			results := resp.StatusUpdateBatch.Results
+			require.Len(t, results, len(tt.Items))
+
+			for i, result := range results {
+				if tt.ExpectedErrors[i] != "" {
+					require.NotNil(t, result.Error)
+					assert.Contains(t, *result.Error, tt.ExpectedErrors[i])
+					assert.Nil(t, result.Status)
+
+					continue
+				}
+
+				require.Nil(t, result.Error)
+				require.NotNil(t, result.Status)
+				assert.Equal(t, tt.Items[i].NodeID, result.Status.Metadata.NodeID)
+				assert.Equal(t, *tt.Items[i].NamespaceID, result.Status.Namespace.ID)
+				assert.JSONEq(t, string(tt.ExpectedData[i]), string(result.Status.Data))
+
+				stored, err := EntClient.Status.Get(ctx, result.Status.ID)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(tt.ExpectedData[i]), string(stored.Data))
+			}
+
+			checksMu.Lock()
+			defer checksMu.Unlock()
+
+			assert.Equal(t, tt.ExpectedChecks, checks)
+		})
+	}
+}
+
+func TestStatusUpdateVersion(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns := StatusNamespaceBuilder{}.MustNew(ctx)
+	nodeID := gidx.MustNewID("testing")
+
+	// the steps are run in order against the same status
+	testCases := []struct {
+		TestName        string
+		ExpectedVersion *int64
+		Delete          bool
+		Version         int64
+		errorMsg        string
+	}{
+		{
+			TestName:        "Fails to update a status that doesn't exist yet",
+			ExpectedVersion: newInt64(1),
+			errorMsg:        "expectedVersion: doesn't match the current version",
+		},
+		{
+			TestName:        "Creates a status that must not exist yet",
+			ExpectedVersion: newInt64(0),
+			Version:         1,
+		},
+		{
+			TestName:        "Fails to create a status that exists",
+			ExpectedVersion: newInt64(0),
+			errorMsg:        "expectedVersion: doesn't match the current version",
+		},
+		{
+			TestName: "Increments the version without an expected version",
+			Version:  2,
+		},
+		{
+			TestName:        "Fails to update an outdated version",
+			ExpectedVersion: newInt64(1),
+			errorMsg:        "expectedVersion: doesn't match the current version",
+		},
+		{
+			TestName:        "Updates the current version",
+			ExpectedVersion: newInt64(2),
+			Version:         3,
+		},
+		{
+			TestName:        "Fails to delete an outdated version",
+			ExpectedVersion: newInt64(2),
+			Delete:          true,
+			errorMsg:        "expectedVersion: doesn't match the current version",
+		},
+		{
+			TestName:        "Deletes the current version",
+			ExpectedVersion: newInt64(3),
+			Delete:          true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			if tt.Delete {
+				_, err := graphTestClient().StatusDelete(ctx, testclient.StatusDeleteInput{
+					NodeID:          nodeID,
+					NamespaceID:     newID(ns.ID),
+					Source:          "go-tests",
+					ExpectedVersion: tt.ExpectedVersion,
+				})
+
+				if tt.errorMsg != "" {
+					require.Error(t, err)
+					assert.ErrorContains(t, err, tt.errorMsg)
+
+					return
+				}
+
+				require.NoError(t, err)
+
+				return
+			}
+
+			resp, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{
+				NodeID:          nodeID,
+				NamespaceID:     newID(ns.ID),
+				Source:          "go-tests",
+				Data:            json.RawMessage(`{"state":"ACTIVE"}`),
+				ExpectedVersion: tt.ExpectedVersion,
+			})
+
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.errorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tt.Version, resp.StatusUpdate.Status.Version)
+		})
+	}
+}
+
+func TestStatusUpdateVersionExpired(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns := StatusNamespaceBuilder{}.MustNew(ctx)
+	meta := MetadataBuilder{}.MustNew(ctx)
+	st := StatusBuilder{Metadata: meta, StatusNamespace: ns, ExpiresAt: newTime(time.Now().Add(-time.Hour))}.MustNew(ctx)
+
+	EntClient.Status.UpdateOneID(st.ID).AddVersion(2).ExecX(ctx)
+
+	// an expired status is replaced as if it doesn't exist, so its version starts again
+	for _, version := range []int64{0, 1} {
+		resp, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{
+			NodeID:          meta.NodeID,
+			NamespaceID:     newID(ns.ID),
+			Source:          st.Source,
+			Data:            json.RawMessage(`{"state":"ACTIVE"}`),
+			ExpectedVersion: newInt64(version),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, st.ID, resp.StatusUpdate.Status.ID)
+		assert.Equal(t, version+1, resp.StatusUpdate.Status.Version)
+	}
+}
+
+func TestStatusUpdateConcurrent(t *testing.T) {
+	skipUnlessPostgres(t)
+
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns := StatusNamespaceBuilder{}.MustNew(ctx)
+
+	const writers = 10
+
+	testCases := []struct {
+		TestName string
+		Source   func(i int) string
+	}{
+		{
+			TestName: "First writes of the same status",
+			Source:   func(int) string { return "go-tests" },
+		},
+		{
+			TestName: "First writes of different statuses of a node",
+			Source:   func(i int) string { return fmt.Sprintf("go-tests-%d", i) },
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			nodeID := gidx.MustNewID("testing")
+
+			errs := runConcurrently(writers, func(i int) error {
+				_, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{
+					NodeID:      nodeID,
+					NamespaceID: newID(ns.ID),
+					Source:      tt.Source(i),
+					Data:        json.RawMessage(fmt.Sprintf(`{"writer":%d}`, i)),
+				})
+
+				return err
+			})
+
+			for _, err := range errs {
+				require.NoError(t, err)
+			}
+
+			mds, err := EntClient.Metadata.Query().Where(metadata.NodeID(nodeID)).All(ctx)
+			require.NoError(t, err)
+			require.Len(t, mds, 1)
+
+			statuses, err := EntClient.Status.Query().Where(status.MetadataID(mds[0].ID)).All(ctx)
+			require.NoError(t, err)
+
+			var updates int64
+
+			for _, st := range statuses {
+				updates += st.Version
+			}
+
+			// every write is applied exactly once
+			assert.Equal(t, int64(writers), updates)
+		})
+	}
+}
+
+func TestStatusUpdateExpiry(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns := StatusNamespaceBuilder{}.MustNew(ctx)
+	ttlNS := StatusNamespaceBuilder{DefaultTTL: newInt64(3600)}.MustNew(ctx)
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	expired := StatusBuilder{
+		StatusNamespace: ns,
+		Data:            json.RawMessage(`{"state":"ACTIVE","checks":{"http":"ok"}}`),
+		ExpiresAt:       newTime(time.Now().Add(-time.Minute)),
+	}.MustNew(ctx)
+	expiredMeta := EntClient.Metadata.GetX(ctx, expired.MetadataID)
+
+	mergePatch := testclient.DataUpdateModeMergePatch
+
+	testCases := []struct {
+		TestName        string
+		NodeID          gidx.PrefixedID
+		NamespaceID     gidx.PrefixedID
+		Source          string
+		Data            json.RawMessage
+		Mode            *testclient.DataUpdateMode
+		ExpiresAt       *time.Time
+		TTL             *int64
+		ExpectedData    json.RawMessage // optional, otherwise Data
+		ExpectedExpires *time.Duration  // expected time until expiry, nil when it doesn't expire
+		ErrorMsg        string
+	}{
+		{
+			TestName:    "Doesn't expire without an expiry or a namespace default",
+			NodeID:      gidx.MustNewID("testing"),
+			NamespaceID: ns.ID,
+		},
+		{
+			TestName:    "Expires at the given time",
+			NodeID:      gidx.MustNewID("testing"),
+			NamespaceID: ns.ID,
+			ExpiresAt:   &expiresAt,
+		},
+		{
+			TestName:        "Expires after the given ttl",
+			NodeID:          gidx.MustNewID("testing"),
+			NamespaceID:     ns.ID,
+			TTL:             newInt64(60),
+			ExpectedExpires: newDuration(time.Minute),
+		},
+		{
+			TestName:        "Expires after the namespace default ttl",
+			NodeID:          gidx.MustNewID("testing"),
+			NamespaceID:     ttlNS.ID,
+			ExpectedExpires: newDuration(time.Hour),
+		},
+		{
+			TestName:        "Given ttl overrides the namespace default ttl",
+			NodeID:          gidx.MustNewID("testing"),
+			NamespaceID:     ttlNS.ID,
+			TTL:             newInt64(60),
+			ExpectedExpires: newDuration(time.Minute),
+		},
+		{
+			TestName:     "Patches an expired status as if it doesn't exist",
+			NodeID:       expiredMeta.NodeID,
+			NamespaceID:  ns.ID,
+			Source:       expired.Source,
+			Data:         json.RawMessage(`{"checks":{"tcp":"ok"}}`),
+			Mode:         &mergePatch,
+			ExpectedData: json.RawMessage(`{"checks":{"tcp":"ok"}}`),
+		},
+		{
+			TestName:    "Fails when both expiresAt and ttl are set",
+			NodeID:      gidx.MustNewID("testing"),
+			NamespaceID: ns.ID,
+			ExpiresAt:   &expiresAt,
+			TTL:         newInt64(60),
+			ErrorMsg:    "ttl: can't be set together with expiresAt",
+		},
+		{
+			TestName:    "Fails when ttl isn't positive",
+			NodeID:      gidx.MustNewID("testing"),
+			NamespaceID: ns.ID,
+			TTL:         newInt64(0),
+			ErrorMsg:    "ttl: must be a positive number of seconds",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			if tt.Source == "" {
+				tt.Source = "go-tests"
+			}
+
+			if tt.Data == nil {
+				tt.Data = json.RawMessage(`{"state":"ACTIVE"}`)
+			}
+
+			if tt.ExpectedData == nil {
+				tt.ExpectedData = tt.Data
+			}
+
+			updatedAt := time.Now()
+
+			resp, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{
+				NodeID:      tt.NodeID,
+				NamespaceID: newID(tt.NamespaceID),
+				Source:      tt.Source,
+				Data:        tt.Data,
+				Mode:        tt.Mode,
+				ExpiresAt:   tt.ExpiresAt,
+				TTL:         tt.TTL,
+			})
+
+			if tt.ErrorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.ErrorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			st := resp.StatusUpdate.Status
+			assert.JSONEq(t, string(tt.ExpectedData), string(st.Data))
+
+			switch {
+			case tt.ExpiresAt != nil:
+				require.NotNil(t, st.ExpiresAt)
+				assert.WithinDuration(t, *tt.ExpiresAt, *st.ExpiresAt, time.Second)
+			case tt.ExpectedExpires != nil:
+				require.NotNil(t, st.ExpiresAt)
+				assert.WithinDuration(t, updatedAt.Add(*tt.ExpectedExpires), *st.ExpiresAt, 5*time.Second)
+			default:
+				assert.Nil(t, st.ExpiresAt)
+			}
+		})
+	}
+}
+
+func TestStatusExpiryHidden(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	meta := MetadataBuilder{}.MustNew(ctx)
+	ns := StatusNamespaceBuilder{}.MustNew(ctx)
+
+	current := StatusBuilder{Metadata: meta, StatusNamespace: ns, Source: "current", ExpiresAt: newTime(time.Now().Add(time.Hour))}.MustNew(ctx)
+	permanent := StatusBuilder{Metadata: meta, StatusNamespace: ns, Source: "permanent"}.MustNew(ctx)
+	expired := StatusBuilder{Metadata: meta, StatusNamespace: ns, Source: "expired", ExpiresAt: newTime(time.Now().Add(-time.Minute))}.MustNew(ctx)
+
+	visible := []gidx.PrefixedID{current.ID, permanent.ID}
+
+	t.Run("metadata statuses", func(t *testing.T) {
+		resp, err := graphTestClient().GetNodeMetadataWhere(ctx, meta.NodeID, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, resp.Entities, 1)
+
+		var ids []gidx.PrefixedID
+		for _, edge := range resp.Entities[0].Metadata.Statuses.Edges {
+			ids = append(ids, edge.Node.ID)
+		}
+
+		assert.ElementsMatch(t, visible, ids)
+	})
+
+	t.Run("metadata statuses as of a time", func(t *testing.T) {
+		resp, err := graphTestClient().GetNodeMetadataAsOf(ctx, meta.NodeID, newTime(time.Now()))
+		require.NoError(t, err)
+		require.Len(t, resp.Entities, 1)
+
+		var ids []gidx.PrefixedID
+		for _, edge := range resp.Entities[0].Metadata.Statuses.Edges {
+			ids = append(ids, edge.Node.ID)
+		}
+
+		assert.ElementsMatch(t, visible, ids)
+
+		// statuses that will have expired by then are hidden too
+		resp, err = graphTestClient().GetNodeMetadataAsOf(ctx, meta.NodeID, newTime(time.Now().Add(2*time.Hour)))
+		require.NoError(t, err)
+		require.Len(t, resp.Entities, 1)
+
+		ids = nil
+		for _, edge := range resp.Entities[0].Metadata.Statuses.Edges {
+			ids = append(ids, edge.Node.ID)
+		}
+
+		assert.ElementsMatch(t, []gidx.PrefixedID{permanent.ID}, ids)
+	})
+
+	t.Run("namespace statuses", func(t *testing.T) {
+		resp, err := graphTestClient().GetStatusNamespaceStatuses(ctx, ns.ID, nil, nil, nil, nil)
+		require.NoError(t, err)
+
+		var ids []gidx.PrefixedID
+		for _, edge := range resp.StatusNamespace.Statuses.Edges {
+			ids = append(ids, edge.Node.ID)
+		}
+
+		assert.ElementsMatch(t, visible, ids)
+	})
+
+	t.Run("status entities", func(t *testing.T) {
+		resp, err := graphTestClient().GetStatusEntities(ctx, []map[string]interface{}{{"__typename": "Status", "id": current.ID}})
+		require.NoError(t, err)
+		require.Len(t, resp.Entities, 1)
+		assert.Equal(t, current.ID, resp.Entities[0].ID)
+
+		_, err = graphTestClient().GetStatusEntities(ctx, []map[string]interface{}{{"__typename": "Status", "id": expired.ID}})
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "status not found")
+	})
+}
+
+func TestStatusReaper(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	now := time.Now()
+	ns := StatusNamespaceBuilder{}.MustNew(ctx)
+
+	var expired []gidx.PrefixedID
+
+	for i := 0; i < 3; i++ {
+		st := StatusBuilder{StatusNamespace: ns, ExpiresAt: newTime(now.Add(-time.Minute))}.MustNew(ctx)
+		expired = append(expired, st.ID)
+	}
+
+	current := StatusBuilder{StatusNamespace: ns, ExpiresAt: newTime(now.Add(time.Hour))}.MustNew(ctx)
+	permanent := StatusBuilder{StatusNamespace: ns}.MustNew(ctx)
+
+	// a small batch size makes the reaper look up the expired statuses in more than one batch
+	count, err := reaper.New(EntClient, zap.NewNop().Sugar(), reaper.Config{BatchSize: 2}).Reap(ctx, now)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, count, len(expired))
+
+	remaining := EntClient.Status.Query().Where(status.StatusNamespaceID(ns.ID)).IDsX(ctx)
+	assert.ElementsMatch(t, []gidx.PrefixedID{current.ID, permanent.ID}, remaining)
+
+	// each status is deleted on its own, so its deletion is recorded
+	deleted := EntClient.StatusHistory.Query().Where(
+		statushistory.StatusIDIn(expired...),
+		statushistory.OperationEQ(statushistory.OperationDELETE),
+	).CountX(ctx)
+	assert.Equal(t, len(expired), deleted)
+}
+
+func TestStatusChangedSubscription(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	perms.On("DeleteAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	deniedOwner := gidx.MustNewID("rcrspro")
+
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.ResourceID == deniedOwner {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}))
+
+	nodeID := gidx.MustNewID("testing")
+	ns := StatusNamespaceBuilder{}.MustNew(ctx)
+	otherNS := StatusNamespaceBuilder{}.MustNew(ctx)
+	privateNS := StatusNamespaceBuilder{Private: true, ResourceProviderID: deniedOwner}.MustNew(ctx)
+
+	type statusChanged struct {
+		StatusChanged struct {
+			Operation string `json:"operation"`
+			StatusID  string `json:"statusID"`
+			Status    *struct {
+				Source string                 `json:"source"`
+				Data   map[string]interface{} `json:"data"`
+			} `json:"status"`
+		} `json:"statusChanged"`
+	}
+
+	query := `subscription($nodeID: ID!, $namespaceID: ID) {
+		statusChanged(nodeID: $nodeID, namespaceID: $namespaceID) { operation statusID status { source data } }
+	}`
+
+	update := func(nodeID, namespaceID gidx.PrefixedID, source string) gidx.PrefixedID {
+		resp, err := graphTestClient().StatusUpdate(ctx, testclient.StatusUpdateInput{
+			NodeID:      nodeID,
+			NamespaceID: newID(namespaceID),
+			Source:      source,
+			Data:        json.RawMessage(`{"state":"ACTIVE"}`),
+		})
+		require.NoError(t, err)
+
+		return resp.StatusUpdate.Status.ID
+	}
+
+	t.Run("receives changes to the statuses of the node", func(t *testing.T) {
+		responses := graphTestSubscription[statusChanged](t, ctx, query, client.Var("nodeID", nodeID), client.Var("namespaceID", ns.ID))
+
+		// the subscription is started in the background, so update until it receives a change
+		require.Eventually(t, func() bool {
+			update(nodeID, ns.ID, "sync")
+
+			select {
+			case r := <-responses:
+				require.NoError(t, r.err)
+				assert.Equal(t, "sync", r.resp.StatusChanged.Status.Source)
+
+				return true
+			case <-time.After(100 * time.Millisecond):
+				return false
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+
+		update(gidx.MustNewID("testing"), ns.ID, "other-node")
+		update(nodeID, otherNS.ID, "other-namespace")
+		noResponse(t, responses)
+
+		id := update(nodeID, ns.ID, "go-tests")
+
+		resp, err := nextResponse(t, responses)
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE", resp.StatusChanged.Operation)
+		assert.Equal(t, id.String(), resp.StatusChanged.StatusID)
+		require.NotNil(t, resp.StatusChanged.Status)
+		assert.Equal(t, "go-tests", resp.StatusChanged.Status.Source)
+		assert.Equal(t, map[string]interface{}{"state": "ACTIVE"}, resp.StatusChanged.Status.Data)
+
+		update(nodeID, ns.ID, "go-tests")
+
+		resp, err = nextResponse(t, responses)
+		require.NoError(t, err)
+		assert.Equal(t, "UPDATE", resp.StatusChanged.Operation)
+		assert.Equal(t, id.String(), resp.StatusChanged.StatusID)
+
+		_, err = graphTestClient().StatusDelete(ctx, testclient.StatusDeleteInput{NodeID: nodeID, NamespaceID: newID(ns.ID), Source: "go-tests"})
+		require.NoError(t, err)
+
+		resp, err = nextResponse(t, responses)
+		require.NoError(t, err)
+		assert.Equal(t, "DELETE", resp.StatusChanged.Operation)
+		assert.Equal(t, id.String(), resp.StatusChanged.StatusID)
+		assert.Nil(t, resp.StatusChanged.Status)
+	})
+
+	t.Run("leaves out changes in namespaces that can't be read", func(t *testing.T) {
+		responses := graphTestSubscription[statusChanged](t, ctx, query, client.Var("nodeID", nodeID))
+
+		require.Eventually(t, func() bool {
+			update(nodeID, ns.ID, "sync")
+
+			select {
+			case r := <-responses:
+				require.NoError(t, r.err)
+				return true
+			case <-time.After(100 * time.Millisecond):
+				return false
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+
+		update(nodeID, privateNS.ID, "private")
+		noResponse(t, responses)
+
+		id := update(nodeID, otherNS.ID, "visible")
+
+		resp, err := nextResponse(t, responses)
+		require.NoError(t, err)
+		assert.Equal(t, id.String(), resp.StatusChanged.StatusID)
+	})
+
+	t.Run("fails when the namespace can't be read", func(t *testing.T) {
+		responses := graphTestSubscription[statusChanged](t, ctx, query, client.Var("nodeID", nodeID), client.Var("namespaceID", privateNS.ID))
+
+		_, err := nextResponse(t, responses)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "subject doesn't have access")
+	})
+
+	t.Run("doesn't reveal whether the namespace exists without access to it", func(t *testing.T) {
+		denyCtx := context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+			for _, req := range requests {
+				if req.ResourceID.Prefix() == "metasns" {
+					return permissions.ErrPermissionDenied
+				}
+			}
+
+			return nil
+		}))
+
+		for _, nsID := range []gidx.PrefixedID{ns.ID, gidx.MustNewID("metasns")} {
+			responses := graphTestSubscription[statusChanged](t, denyCtx, query, client.Var("nodeID", nodeID), client.Var("namespaceID", nsID))
+
+			_, err := nextResponse(t, responses)
+			require.Error(t, err)
+			assert.ErrorContains(t, err, "subject doesn't have access")
+		}
+	})
+
+	t.Run("fails when the namespace doesn't exist", func(t *testing.T) {
+		responses := graphTestSubscription[statusChanged](t, ctx, query, client.Var("nodeID", nodeID), client.Var("namespaceID", gidx.MustNewID("metasns")))
+
+		_, err := nextResponse(t, responses)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "status_namespace not found")
+	})
+
+	t.Run("fails when the node id is invalid", func(t *testing.T) {
+		responses := graphTestSubscription[statusChanged](t, ctx, query, client.Var("nodeID", "invalid"))
+
+		_, err := nextResponse(t, responses)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "nodeID: invalid id")
+	})
+}
@@ -6,13 +6,15 @@ package graphapi
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 
 	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
 
 	"go.infratographer.com/metadata-api/internal/ent/generated"
 	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
 )
 
 // StatusNamespaceCreate is the resolver for the statusNamespaceCreate field.
@@ -29,6 +31,12 @@ func (r *mutationResolver) StatusNamespaceCreate(ctx context.Context, input gene
 		return nil, NewInvalidFieldError("resourceProviderID", err)
 	}
 
+	if input.JSONSchema != nil {
+		if _, err := compileJSONSchema(input.JSONSchema); err != nil {
+			return nil, NewInvalidFieldError("jsonSchema", err)
+		}
+	}
+
 	if err := permissions.CheckAccess(ctx, input.ResourceProviderID, actionMetadataStatusNamespaceUpdate); err != nil {
 		return nil, err
 	}
@@ -72,49 +80,80 @@ func (r *mutationResolver) StatusNamespaceDelete(ctx context.Context, id gidx.Pr
 		return nil, err
 	}
 
-	tx, err := r.client.BeginTx(ctx, &sql.TxOptions{})
+	statusCount, err := r.client.Status.Query().Where(status.StatusNamespaceID(id)).Count(ctx)
 	if err != nil {
-		logger.Errorw("failed to begin transaction", "error", err)
+		logger.Errorw("failed to count statuses", "error", err)
 		return nil, ErrInternalServerError
 	}
 
-	defer tx.Rollback()
+	if statusCount != 0 && !force {
+		return nil, ErrNamespaceInUse
+	}
 
-	statuses, err := tx.Status.Query().Where(status.StatusNamespaceID(id)).All(ctx)
+	job, err := r.softDeleteNamespace(ctx, id, sns.ResourceProviderID, statusCount)
 	if err != nil {
-		logger.Errorw("failed to get statuses", "error", err)
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to delete status namespace", "error", err)
 		return nil, ErrInternalServerError
 	}
 
-	statusCount := len(statuses)
-	if statusCount != 0 {
-		if force {
-			statusCount = 0
-			for _, status := range statuses {
-				// TODO - :bug: - must delete one-by-one to ensure the deleted ID is available when the delete eventhook is triggered
-				// statusCount, err = r.client.Status.Delete().Where(status.StatusNamespaceID(id)).Exec(ctx)
-				if err := tx.Status.DeleteOneID(status.ID).Exec(ctx); err != nil {
-					logger.Errorw("failed to delete status", "error", err, "statusID", status.ID)
-					return nil, ErrInternalServerError
-				}
-				statusCount++
-			}
-		} else {
-			return nil, ErrNamespaceInUse
+	return &StatusNamespaceDeletePayload{DeletedID: id, NamespaceDeletion: job}, nil
+}
+
+// StatusNamespaceRestore is the resolver for the statusNamespaceRestore field.
+func (r *mutationResolver) StatusNamespaceRestore(ctx context.Context, id gidx.PrefixedID) (*StatusNamespaceRestorePayload, error) {
+	logger := r.logger.With("statusNamespaceID", id)
+
+	if id == "" {
+		return nil, NewInvalidFieldError("id", ErrFieldEmpty)
+	}
+
+	if _, err := gidx.Parse(id.String()); err != nil {
+		return nil, NewInvalidFieldError("id", err)
+	}
+
+	sns, err := r.client.StatusNamespace.Get(softdelete.IncludeDeleted(ctx), id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
 		}
+
+		logger.Errorw("failed to get status namespace", "error", err)
+		return nil, ErrInternalServerError
 	}
 
-	if err := tx.StatusNamespace.DeleteOneID(id).Exec(ctx); err != nil {
-		logger.Errorw("failed to delete status namespace", "error", err)
+	if err := permissions.CheckAccess(ctx, sns.ResourceProviderID, actionMetadataStatusNamespaceUpdate); err != nil {
+		return nil, err
+	}
+
+	if sns.DeletedAt == nil {
+		return nil, NewInvalidFieldError("id", ErrNamespaceNotDeleted)
+	}
+
+	if err := r.restoreNamespace(ctx, id); err != nil {
+		if errors.Is(err, ErrNamespaceDeleting) {
+			return nil, err
+		}
+
+		// the name was reused while the namespace was deleted
+		if generated.IsConstraintError(err) {
+			return nil, NewInvalidFieldError("name", ErrUniquenessConstraint)
+		}
+
+		logger.Errorw("failed to restore status namespace", "error", err)
 		return nil, ErrInternalServerError
 	}
 
-	if err := tx.Commit(); err != nil {
-		logger.Errorw("failed to commit transaction", "error", err)
+	ns, err := r.client.StatusNamespace.Get(ctx, id)
+	if err != nil {
+		logger.Errorw("failed to get restored status namespace", "error", err)
 		return nil, ErrInternalServerError
 	}
 
-	return &StatusNamespaceDeletePayload{DeletedID: id, StatusDeletedCount: statusCount}, nil
+	return &StatusNamespaceRestorePayload{StatusNamespace: ns}, nil
 }
 
 // StatusNamespaceUpdate is the resolver for the statusNamespaceUpdate field.
@@ -133,6 +172,16 @@ func (r *mutationResolver) StatusNamespaceUpdate(ctx context.Context, id gidx.Pr
 		return nil, NewInvalidFieldError("name", ErrFieldEmpty)
 	}
 
+	if input.AppendJSONSchema != nil {
+		return nil, NewInvalidFieldError("appendJSONSchema", ErrFieldNotSupported)
+	}
+
+	if input.JSONSchema != nil {
+		if _, err := compileJSONSchema(input.JSONSchema); err != nil {
+			return nil, NewInvalidFieldError("jsonSchema", err)
+		}
+	}
+
 	sns, err := r.client.StatusNamespace.Get(ctx, id)
 	if err != nil {
 		if generated.IsNotFound(err) {
@@ -147,6 +196,10 @@ func (r *mutationResolver) StatusNamespaceUpdate(ctx context.Context, id gidx.Pr
 		return nil, err
 	}
 
+	if sns.Deleting {
+		return nil, ErrNamespaceDeleting
+	}
+
 	ns, err := sns.Update().SetInput(input).Save(ctx)
 	if err != nil {
 		if generated.IsConstraintError(err) {
@@ -157,5 +210,82 @@ func (r *mutationResolver) StatusNamespaceUpdate(ctx context.Context, id gidx.Pr
 		return nil, ErrInternalServerError
 	}
 
-	return &StatusNamespaceUpdatePayload{StatusNamespace: ns}, nil
+	invalidCount := 0
+
+	if input.JSONSchema != nil {
+		invalidCount, err = r.countInvalidStatuses(ctx, ns)
+		if err != nil {
+			logger.Errorw("failed to validate statuses against json schema", "error", err)
+			return nil, ErrInternalServerError
+		}
+	}
+
+	return &StatusNamespaceUpdatePayload{StatusNamespace: ns, InvalidStatusCount: invalidCount}, nil
+}
+
+// StatusNamespace is the resolver for the statusNamespace field.
+func (r *queryResolver) StatusNamespace(ctx context.Context, id gidx.PrefixedID) (*generated.StatusNamespace, error) {
+	logger := r.logger.With("statusNamespaceID", id)
+
+	if id == "" {
+		return nil, NewInvalidFieldError("id", ErrFieldEmpty)
+	}
+
+	if _, err := gidx.Parse(id.String()); err != nil {
+		return nil, NewInvalidFieldError("id", err)
+	}
+
+	ns, err := r.client.StatusNamespace.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		logger.Errorw("failed to get status namespace", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if err := permissions.CheckAccess(ctx, id, actionMetadataStatusNamespaceGet); err != nil {
+		return nil, err
+	}
+
+	return ns, nil
+}
+
+// StatusNamespaceByName is the resolver for the statusNamespaceByName field.
+func (r *queryResolver) StatusNamespaceByName(ctx context.Context, ownerID gidx.PrefixedID, name string) (*generated.StatusNamespace, error) {
+	logger := r.logger.With("ownerID", ownerID, "name", name)
+
+	if ownerID == "" {
+		return nil, NewInvalidFieldError("ownerID", ErrFieldEmpty)
+	}
+
+	if _, err := gidx.Parse(ownerID.String()); err != nil {
+		return nil, NewInvalidFieldError("ownerID", err)
+	}
+
+	if name == "" {
+		return nil, NewInvalidFieldError("name", ErrFieldEmpty)
+	}
+
+	ns, err := r.client.StatusNamespace.Query().
+		Where(
+			statusnamespace.ResourceProviderID(ownerID),
+			statusnamespace.Name(name),
+		).
+		Only(ctx)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, namespaceNameNotFound(ctx, ownerID, actionMetadataStatusNamespaceList, err)
+		}
+
+		logger.Errorw("failed to get status namespace", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if err := permissions.CheckAccess(ctx, ns.ID, actionMetadataStatusNamespaceGet); err != nil {
+		return nil, err
+	}
+
+	return ns, nil
 }
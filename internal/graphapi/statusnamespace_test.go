@@ -2,6 +2,7 @@ package graphapi_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v6"
@@ -55,6 +56,15 @@ func TestStatusNamespacesCreate(t *testing.T) {
 			StatusNamespaceInput: testclient.CreateStatusNamespaceInput{Name: ns1.Name, ResourceProviderID: "test-invalid-id"},
 			ErrorMsg:             "invalid id",
 		},
+		{
+			TestName:             "Successful with a json schema",
+			StatusNamespaceInput: testclient.CreateStatusNamespaceInput{Name: gofakeit.DomainName(), ResourceProviderID: gidx.MustNewID("testing"), JSONSchema: json.RawMessage(`{"type":"object"}`)},
+		},
+		{
+			TestName:             "Fails when json schema is invalid",
+			StatusNamespaceInput: testclient.CreateStatusNamespaceInput{Name: gofakeit.DomainName(), ResourceProviderID: gidx.MustNewID("testing"), JSONSchema: json.RawMessage(`{"type":"not-a-type"}`)},
+			ErrorMsg:             "jsonSchema: invalid json schema",
+		},
 		{
 			TestName:             "Fails when name is empty",
 			StatusNamespaceInput: testclient.CreateStatusNamespaceInput{Name: "", ResourceProviderID: ns1.ResourceProviderID},
@@ -152,7 +162,22 @@ func TestStatusNamespacesDelete(t *testing.T) {
 			require.NoError(t, err)
 			assert.NotNil(t, resp.StatusNamespaceDelete)
 			assert.Equal(t, tt.StatusNamespaceID, resp.StatusNamespaceDelete.DeletedID)
-			assert.Equal(t, tt.StatusDeletedCount, resp.StatusNamespaceDelete.StatusDeletedCount)
+
+			// deleted namespaces are hidden right away, and purged in the background
+			job := resp.StatusNamespaceDelete.NamespaceDeletion
+			require.NotNil(t, job)
+			assert.Equal(t, testclient.NamespaceDeletionStatePending, job.State)
+			assert.Equal(t, tt.StatusDeletedCount, job.TotalCount)
+
+			_, err = graphTestClient().GetStatusNamespaceStatuses(ctx, tt.StatusNamespaceID, nil, nil, nil, nil)
+			assert.ErrorContains(t, err, "not found")
+
+			runNamespaceDeletions(ctx, t)
+
+			jobResp, err := graphTestClient().GetNamespaceDeletion(ctx, job.ID)
+			require.NoError(t, err)
+			assert.Equal(t, testclient.NamespaceDeletionStateCompleted, jobResp.NamespaceDeletion.State)
+			assert.Equal(t, tt.StatusDeletedCount, jobResp.NamespaceDeletion.DeletedCount)
 		})
 	}
 }
@@ -171,12 +196,18 @@ func TestStatusNamespacesUpdate(t *testing.T) {
 	ns := StatusNamespaceBuilder{}.MustNew(ctx)
 	ns2 := StatusNamespaceBuilder{ResourceProviderID: ns.ResourceProviderID}.MustNew(ctx)
 
+	schemaNS := StatusNamespaceBuilder{}.MustNew(ctx)
+	StatusBuilder{StatusNamespace: schemaNS, Data: json.RawMessage(`{"size":1}`)}.MustNew(ctx)
+	StatusBuilder{StatusNamespace: schemaNS, Data: json.RawMessage(`{"size":"large"}`)}.MustNew(ctx)
+
 	testCases := []struct {
-		TestName   string
-		ID         gidx.PrefixedID
-		NewName    *string
-		NewPrivate *bool
-		ErrorMsg   string
+		TestName      string
+		ID            gidx.PrefixedID
+		NewName       *string
+		NewPrivate    *bool
+		NewJSONSchema json.RawMessage
+		InvalidCount  int
+		ErrorMsg      string
 	}{
 		{
 			TestName: "Successful path to update name",
@@ -197,6 +228,18 @@ func TestStatusNamespacesUpdate(t *testing.T) {
 			TestName: "Successful even if name and private is omitted",
 			ID:       ns.ID,
 		},
+		{
+			TestName:      "Successful path to update json schema reports invalid records",
+			ID:            schemaNS.ID,
+			NewJSONSchema: json.RawMessage(`{"type":"object","properties":{"size":{"type":"integer"}}}`),
+			InvalidCount:  1,
+		},
+		{
+			TestName:      "Fails when json schema is invalid",
+			ID:            ns.ID,
+			NewJSONSchema: json.RawMessage(`{"type":"not-a-type"}`),
+			ErrorMsg:      "jsonSchema: invalid json schema",
+		},
 		{
 			TestName: "Fails when name is empty",
 			ID:       ns.ID,
@@ -237,7 +280,7 @@ func TestStatusNamespacesUpdate(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.TestName, func(t *testing.T) {
-			resp, err := graphTestClient().StatusNamespaceUpdate(ctx, tt.ID, testclient.UpdateStatusNamespaceInput{Name: tt.NewName, Private: tt.NewPrivate})
+			resp, err := graphTestClient().StatusNamespaceUpdate(ctx, tt.ID, testclient.UpdateStatusNamespaceInput{Name: tt.NewName, Private: tt.NewPrivate, JSONSchema: tt.NewJSONSchema})
 
 			if tt.ErrorMsg != "" {
 				assert.Error(t, err)
@@ -254,6 +297,175 @@ func TestStatusNamespacesUpdate(t *testing.T) {
 			if tt.NewPrivate != nil {
 				assert.Equal(t, *tt.NewPrivate, resp.StatusNamespaceUpdate.StatusNamespace.Private)
 			}
+			if tt.NewJSONSchema != nil {
+				assert.JSONEq(t, string(tt.NewJSONSchema), string(resp.StatusNamespaceUpdate.StatusNamespace.JSONSchema))
+			}
+			assert.Equal(t, int64(tt.InvalidCount), resp.StatusNamespaceUpdate.InvalidStatusCount)
+		})
+	}
+}
+
+func TestStatusNamespacesGetByName(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns1 := StatusNamespaceBuilder{}.MustNew(ctx)
+
+	testCases := []struct {
+		TestName           string
+		ResourceProviderID gidx.PrefixedID
+		Name               string
+		ErrorMsg           string
+	}{
+		{
+			TestName:           "Successful path",
+			ResourceProviderID: ns1.ResourceProviderID,
+			Name:               ns1.Name,
+		},
+		{
+			TestName:           "Fails when resource provider id is an invalid gidx",
+			ResourceProviderID: "test-invalid-id",
+			Name:               ns1.Name,
+			ErrorMsg:           "invalid id",
+		},
+		{
+			TestName:           "Fails when name is not found",
+			ResourceProviderID: ns1.ResourceProviderID,
+			Name:               "not-a-namespace",
+			ErrorMsg:           "not found",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			resp, err := graphTestClient().GetStatusNamespaceByName(ctx, tt.ResourceProviderID, tt.Name)
+
+			if tt.ErrorMsg != "" {
+				assert.Error(t, err)
+				assert.ErrorContains(t, err, tt.ErrorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, ns1.ID, resp.StatusNamespaceByName.ID)
+			assert.Equal(t, ns1.ResourceProviderID, resp.StatusNamespaceByName.Owner.ID)
+		})
+	}
+}
+
+func TestStatusNamespaceStatuses(t *testing.T) {
+	ctx := context.Background()
+	perms := new(mockpermissions.MockPermissions)
+	perms.On("CreateAuthRelationships", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ctx = perms.ContextWithHandler(ctx)
+
+	// Permit request
+	ctx = context.WithValue(ctx, permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	ns := StatusNamespaceBuilder{}.MustNew(ctx)
+	otherNS := StatusNamespaceBuilder{}.MustNew(ctx)
+
+	st1 := StatusBuilder{StatusNamespace: ns, Data: json.RawMessage(`{"state":"FAILED"}`)}.MustNew(ctx)
+	st2 := StatusBuilder{StatusNamespace: ns, Data: json.RawMessage(`{"state":"HEALTHY"}`)}.MustNew(ctx)
+	st3 := StatusBuilder{StatusNamespace: ns, Data: json.RawMessage(`{"state":"FAILED"}`)}.MustNew(ctx)
+	StatusBuilder{StatusNamespace: otherNS, Data: json.RawMessage(`{"state":"FAILED"}`)}.MustNew(ctx)
+
+	// Only deny reading the statuses of the namespace
+	denyGetCtx := context.WithValue(ctx, permissions.CheckerCtxKey, permissions.Checker(func(_ context.Context, requests ...permissions.AccessRequest) error {
+		for _, req := range requests {
+			if req.Action == "metadata_statusnamespace_get" {
+				return permissions.ErrPermissionDenied
+			}
+		}
+
+		return nil
+	}))
+
+	testCases := []struct {
+		TestName    string
+		Ctx         context.Context
+		QueryID     gidx.PrefixedID
+		First       *int64
+		Where       *testclient.StatusWhereInput
+		ExpectedIDs []gidx.PrefixedID
+		HasNextPage bool
+		TotalCount  int64
+		ErrorMsg    string
+	}{
+		{
+			TestName:    "returns all statuses in the namespace",
+			QueryID:     ns.ID,
+			ExpectedIDs: []gidx.PrefixedID{st1.ID, st2.ID, st3.ID},
+			TotalCount:  3,
+		},
+		{
+			TestName:    "paginates statuses",
+			QueryID:     ns.ID,
+			First:       newInt64(2),
+			ExpectedIDs: []gidx.PrefixedID{st1.ID, st2.ID},
+			HasNextPage: true,
+			TotalCount:  3,
+		},
+		{
+			TestName:    "filters statuses",
+			QueryID:     ns.ID,
+			Where:       &testclient.StatusWhereInput{DataContains: json.RawMessage(`{"state":"FAILED"}`)},
+			ExpectedIDs: []gidx.PrefixedID{st1.ID, st3.ID},
+			TotalCount:  2,
+		},
+		{
+			TestName: "fails without access to the namespace",
+			Ctx:      denyGetCtx,
+			QueryID:  ns.ID,
+			ErrorMsg: permissions.ErrPermissionDenied.Error(),
+		},
+		{
+			TestName: "fails when the namespace is not found",
+			QueryID:  gidx.MustNewID("testing"),
+			ErrorMsg: "not found",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.TestName, func(t *testing.T) {
+			queryCtx := ctx
+			if tt.Ctx != nil {
+				queryCtx = tt.Ctx
+			}
+
+			orderBy := &testclient.StatusOrder{Direction: testclient.OrderDirectionAsc, Field: testclient.StatusOrderFieldCreatedAt}
+
+			resp, err := graphTestClient().GetStatusNamespaceStatuses(queryCtx, tt.QueryID, tt.First, nil, orderBy, tt.Where)
+
+			if tt.ErrorMsg != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.ErrorMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			statuses := resp.StatusNamespace.Statuses
+
+			ids := []gidx.PrefixedID{}
+			for _, edge := range statuses.Edges {
+				ids = append(ids, edge.Node.ID)
+			}
+
+			assert.Equal(t, tt.ExpectedIDs, ids)
+			assert.Equal(t, tt.TotalCount, statuses.TotalCount)
+			assert.Equal(t, tt.HasNextPage, statuses.PageInfo.HasNextPage)
 		})
 	}
 }
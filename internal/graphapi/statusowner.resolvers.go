@@ -9,8 +9,8 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"go.infratographer.com/metadata-api/internal/ent/generated"
-	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
 	"go.infratographer.com/metadata-api/internal/ent/generated/statusnamespace"
+	"go.infratographer.com/permissions-api/pkg/permissions"
 	"go.infratographer.com/x/gidx"
 )
 
@@ -21,15 +21,17 @@ func (r *statusNamespaceResolver) Owner(ctx context.Context, obj *generated.Stat
 
 // StatusNamespaces is the resolver for the statusNamespaces field.
 func (r *statusOwnerResolver) StatusNamespaces(ctx context.Context, obj *StatusOwner, after *entgql.Cursor[gidx.PrefixedID], first *int, before *entgql.Cursor[gidx.PrefixedID], last *int, orderBy *generated.StatusNamespaceOrder, where *generated.StatusNamespaceWhereInput) (*generated.StatusNamespaceConnection, error) {
+	if err := permissions.CheckAccess(ctx, obj.ID, actionMetadataStatusNamespaceList); err != nil {
+		return nil, err
+	}
+
 	return r.client.StatusNamespace.Query().Where(statusnamespace.ResourceProviderID(obj.ID)).Paginate(ctx, after, first, before, last, generated.WithStatusNamespaceOrder(orderBy), generated.WithStatusNamespaceFilter(where.Filter))
 }
 
 // Metadata is the resolver for the metadata field.
 func (r *statusOwnerResolver) Metadata(ctx context.Context, obj *StatusOwner) (*generated.Metadata, error) {
-	m, err := r.client.Metadata.Query().Where(metadata.NodeID(obj.ID)).First(ctx)
 	// Don't return an error if it isn't found, metadata is optional
-	err = generated.MaskNotFound(err)
-	return m, err
+	return r.loaders(ctx).metadataByNodeID.Load(ctx, obj.ID)()
 }
 
 // StatusOwner returns StatusOwnerResolver implementation.
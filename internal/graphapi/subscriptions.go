@@ -0,0 +1,145 @@
+package graphapi
+
+import (
+	"context"
+
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/pubsub"
+)
+
+// validateSubscription checks the arguments of a change subscription.
+func validateSubscription(nodeID gidx.PrefixedID, namespaceID *gidx.PrefixedID) error {
+	if nodeID == "" {
+		return NewInvalidFieldError("nodeID", ErrFieldEmpty)
+	}
+
+	if _, err := gidx.Parse(nodeID.String()); err != nil {
+		return NewInvalidFieldError("nodeID", err)
+	}
+
+	if namespaceID != nil {
+		if _, err := gidx.Parse(namespaceID.String()); err != nil {
+			return NewInvalidFieldError("namespaceID", err)
+		}
+	}
+
+	return nil
+}
+
+// subscribeChanges returns the changes published to the topic for the node,
+// and the namespace if it's set, until the context is canceled. Changes in
+// namespaces the caller can't read are left out. Whether a namespace can be read
+// is checked once for each subscriber. Access to the namespace is checked with the
+// get action before it's looked up, so whether it exists isn't revealed to callers
+// who can't get it.
+func (r *Resolver) subscribeChanges(ctx context.Context, topic string, nodeID gidx.PrefixedID, namespaceID *gidx.PrefixedID, getAction string, hidden func(context.Context, gidx.PrefixedID) (bool, error)) (<-chan pubsub.Change, error) {
+	if r.pubsub == nil {
+		return nil, ErrSubscriptionsDisabled
+	}
+
+	if namespaceID != nil {
+		if err := permissions.CheckAccess(ctx, *namespaceID, getAction); err != nil {
+			return nil, err
+		}
+
+		hide, err := hidden(ctx, *namespaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if hide {
+			return nil, permissions.ErrPermissionDenied
+		}
+	}
+
+	isHidden := memoize(func(id gidx.PrefixedID) (bool, error) {
+		return hidden(ctx, id)
+	})
+
+	changes := r.pubsub.Subscribe(ctx, topic)
+	out := make(chan pubsub.Change)
+
+	go func() {
+		defer close(out)
+
+		for change := range changes {
+			if change.NodeID != nodeID || (namespaceID != nil && change.NamespaceID != *namespaceID) {
+				continue
+			}
+
+			hide, err := isHidden(change.NamespaceID)
+			if err != nil {
+				r.logger.Errorw("failed to check access to namespace", "namespaceID", change.NamespaceID, "error", err)
+				continue
+			}
+
+			if hide {
+				continue
+			}
+
+			select {
+			case out <- change:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// statusNamespaceHidden reports whether the caller can't read the statuses in the
+// namespace. Not found is returned if the namespace doesn't exist, so access to
+// the namespace must be checked first by the caller.
+func (r *Resolver) statusNamespaceHidden(ctx context.Context, id gidx.PrefixedID) (bool, error) {
+	ns, err := r.client.StatusNamespace.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return false, err
+		}
+
+		r.logger.Errorw("failed to get status namespace", "namespaceID", id, "error", err)
+
+		return false, ErrInternalServerError
+	}
+
+	if !ns.Private {
+		return false, nil
+	}
+
+	permitted, err := permittedResources(ctx, []gidx.PrefixedID{ns.ResourceProviderID}, actionMetadataStatusNamespaceGet)
+	if err != nil {
+		return false, err
+	}
+
+	return !permitted[ns.ResourceProviderID], nil
+}
+
+// annotationNamespaceHidden reports whether the caller can't read the annotations
+// in the namespace. See statusNamespaceHidden.
+func (r *Resolver) annotationNamespaceHidden(ctx context.Context, id gidx.PrefixedID) (bool, error) {
+	ns, err := r.client.AnnotationNamespace.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return false, err
+		}
+
+		r.logger.Errorw("failed to get annotation namespace", "namespaceID", id, "error", err)
+
+		return false, ErrInternalServerError
+	}
+
+	if !ns.Private {
+		return false, nil
+	}
+
+	permitted, err := permittedResources(ctx, []gidx.PrefixedID{ns.OwnerID}, actionMetadataAnnotationNamespaceGet)
+	if err != nil {
+		return false, err
+	}
+
+	return !permitted[ns.OwnerID], nil
+}
@@ -1,20 +1,25 @@
 package graphapi_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
-	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/client"
 	"github.com/labstack/echo/v4"
 	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 	"go.uber.org/zap"
 
@@ -22,21 +27,30 @@ import (
 	"go.infratographer.com/x/echojwtx"
 	"go.infratographer.com/x/echox"
 	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
 	"go.infratographer.com/x/goosex"
 	"go.infratographer.com/x/testing/eventtools"
 
 	"go.infratographer.com/metadata-api/db"
+	"go.infratographer.com/metadata-api/internal/deleter"
+	"go.infratographer.com/metadata-api/internal/ent/bulkhooks"
+	"go.infratographer.com/metadata-api/internal/ent/changehooks"
 	ent "go.infratographer.com/metadata-api/internal/ent/generated"
-	"go.infratographer.com/metadata-api/internal/ent/generated/eventhooks"
+	"go.infratographer.com/metadata-api/internal/ent/historyhooks"
+	"go.infratographer.com/metadata-api/internal/ent/softdelete"
 	"go.infratographer.com/metadata-api/internal/graphapi"
+	"go.infratographer.com/metadata-api/internal/pubsub"
 	"go.infratographer.com/metadata-api/internal/testclient"
 	"go.infratographer.com/metadata-api/x/testcontainersx"
 )
 
 var (
 	TestDBURI   = os.Getenv("METADATAAPI_TESTDB_URI")
+	DBDialect   string
+	DBURI       string
 	EntClient   *ent.Client
 	DBContainer *testcontainersx.DBContainer
+	Broker      *pubsub.Broker
 )
 
 func TestMain(m *testing.M) {
@@ -93,6 +107,7 @@ func setupDB() {
 	ctx := context.Background()
 
 	dia, uri, cntr := parseDBURI(ctx)
+	DBDialect, DBURI = dia, uri
 
 	nats, err := eventtools.NewNatsServer()
 	if err != nil {
@@ -119,7 +134,13 @@ func setupDB() {
 		goosex.MigrateUp(uri, db.Migrations)
 	}
 
-	eventhooks.EventHooks(c)
+	Broker = pubsub.NewBroker(zap.NewNop().Sugar())
+
+	bulkhooks.EventHooks(c)
+	historyhooks.HistoryHooks(c)
+	changehooks.ChangeHooks(c, Broker)
+	softdelete.Interceptors(c)
+	graphapi.VisibilityInterceptors(c)
 
 	EntClient = c
 }
@@ -136,6 +157,43 @@ func teardownDB() {
 	}
 }
 
+// skipUnlessPostgres skips tests which need a database allowing concurrent writers,
+// the in memory sqlite database used by default doesn't.
+func skipUnlessPostgres(t *testing.T) {
+	t.Helper()
+
+	if DBDialect != dialect.Postgres {
+		t.Skip("requires postgres or cockroachdb, e.g. METADATAAPI_TESTDB_URI=docker://postgres:15")
+	}
+}
+
+// runConcurrently calls fn n times in parallel and returns the errors of the calls.
+func runConcurrently(n int, fn func(i int) error) []error {
+	var wg sync.WaitGroup
+
+	errs := make([]error, n)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			errs[i] = fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+// runNamespaceDeletions runs the pending namespace deletions like the deleter of the api.
+func runNamespaceDeletions(ctx context.Context, t *testing.T) {
+	_, err := deleter.New(EntClient, zap.NewNop().Sugar(), deleter.Config{}).RunPending(ctx)
+	require.NoError(t, err)
+}
+
 func errPanic(msg string, err error) {
 	if err != nil {
 		log.Panicf("%s err: %s", msg, err.Error())
@@ -164,10 +222,9 @@ func withGraphClientHTTPClient(httpcli *http.Client) graphClientOptions {
 func graphTestClient(options ...graphClientOptions) testclient.TestClient {
 	g := &graphClient{
 		srvURL: "graph",
-		httpClient: &http.Client{Transport: localRoundTripper{handler: handler.NewDefaultServer(
-			graphapi.NewExecutableSchema(
-				graphapi.Config{Resolvers: graphapi.NewResolver(EntClient, zap.NewNop().Sugar())},
-			))}},
+		httpClient: &http.Client{Transport: localRoundTripper{
+			handler: graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithBroker(Broker)).Handler(false).Handler(),
+		}},
 	}
 
 	for _, opt := range options {
@@ -190,6 +247,37 @@ func (l localRoundTripper) RoundTrip(req *http.Request) (*http.Response, error)
 	return w.Result(), nil
 }
 
+type graphQueryResponse struct {
+	Data   json.RawMessage
+	Errors []struct {
+		Message    string
+		Extensions map[string]any
+	}
+}
+
+// postGraphQuery posts the params of a query, such as the query and its variables,
+// to the handler and returns the response. All actions are permitted.
+func postGraphQuery(t *testing.T, handler http.HandlerFunc, params map[string]any) graphQueryResponse {
+	t.Helper()
+
+	ctx := context.WithValue(context.Background(), permissions.CheckerCtxKey, permissions.DefaultAllowChecker)
+
+	body, err := json.Marshal(params)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(body)).WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	handler(w, req)
+
+	var resp graphQueryResponse
+
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	return resp
+}
+
 type testServerConfig struct {
 	echoConfig        echox.Config
 	handlerMiddleware []echo.MiddlewareFunc
@@ -237,12 +325,88 @@ func newTestServer(options ...testServerOption) (*httptest.Server, error) {
 		return nil, err
 	}
 
-	r := graphapi.NewResolver(EntClient, zap.NewNop().Sugar())
+	r := graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithBroker(Broker))
 	srv.AddHandler(r.Handler(false, tsc.handlerMiddleware...))
 
 	return httptest.NewServer(srv.Handler()), nil
 }
 
+type subscriptionResponse[T any] struct {
+	resp T
+	err  error
+}
+
+// graphTestSubscription starts a subscription over a websocket with the values of
+// ctx and returns the responses it receives until the test ends.
+func graphTestSubscription[T any](t *testing.T, ctx context.Context, query string, options ...client.Option) <-chan subscriptionResponse[T] {
+	handler := graphapi.NewResolver(EntClient, zap.NewNop().Sugar(), graphapi.WithBroker(Broker)).Handler(false).Handler()
+
+	sub := client.New(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r.WithContext(ctx))
+	})).Websocket(query, options...)
+
+	done := make(chan struct{})
+
+	t.Cleanup(func() {
+		close(done)
+		_ = sub.Close()
+	})
+
+	responses := make(chan subscriptionResponse[T])
+
+	go func() {
+		for {
+			var resp T
+
+			err := sub.Next(&resp)
+
+			select {
+			case responses <- subscriptionResponse[T]{resp: resp, err: err}:
+			case <-done:
+				return
+			}
+
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return responses
+}
+
+// nextResponse returns the next response of a subscription, failing the test if
+// none is received in time.
+func nextResponse[T any](t *testing.T, responses <-chan subscriptionResponse[T]) (T, error) {
+	t.Helper()
+
+	select {
+	case r := <-responses:
+		return r.resp, r.err
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for subscription response")
+	}
+
+	var resp T
+
+	return resp, nil
+}
+
+// noResponse checks a subscription doesn't receive a response within a short time.
+func noResponse[T any](t *testing.T, responses <-chan subscriptionResponse[T]) {
+	t.Helper()
+
+	select {
+	case r := <-responses:
+		t.Fatalf("unexpected subscription response: %+v", r)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func newID(id gidx.PrefixedID) *gidx.PrefixedID {
+	return &id
+}
+
 func newString(s string) *string {
 	return &s
 }
@@ -250,3 +414,19 @@ func newString(s string) *string {
 func newBool(b bool) *bool {
 	return &b
 }
+
+func newInt(i int) *int {
+	return &i
+}
+
+func newInt64(i int64) *int64 {
+	return &i
+}
+
+func newTime(t time.Time) *time.Time {
+	return &t
+}
+
+func newDuration(d time.Duration) *time.Duration {
+	return &d
+}
@@ -0,0 +1,418 @@
+package graphapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"go.infratographer.com/permissions-api/pkg/permissions"
+	"go.infratographer.com/x/gidx"
+
+	"go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/annotation"
+	"go.infratographer.com/metadata-api/internal/ent/generated/metadata"
+	"go.infratographer.com/metadata-api/internal/ent/generated/predicate"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+)
+
+// maxUpsertAttempts limits how often an upsert is tried when it keeps racing with
+// concurrent transactions.
+const maxUpsertAttempts = 5
+
+// errUpsertRace is returned by the upserts when a concurrent transaction created or
+// changed the same records first. The upsert succeeds when it's run again.
+var errUpsertRace = errors.New("upsert raced with a concurrent transaction")
+
+// isUpsertRace reports whether err is caused by a concurrent transaction, either a
+// constraint error from creating a record that was just created by another
+// transaction, or a serialization failure as returned by CockroachDB.
+func isUpsertRace(err error) bool {
+	if generated.IsConstraintError(err) {
+		return true
+	}
+
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == "40001"
+}
+
+// upsertTx runs fn in a transaction and commits it. Two first writes for a node can
+// both find no records and race to create them, the loser then fails on the unique
+// indexes with errUpsertRace. Its transaction is rolled back and fn runs again in a
+// new one, which finds the records created by the winner.
+func (r *Resolver) upsertTx(ctx context.Context, fn func(tx *generated.Tx) error) error {
+	for attempt := 1; ; attempt++ {
+		err := r.runTx(ctx, fn)
+		if !errors.Is(err, errUpsertRace) {
+			return err
+		}
+
+		if attempt == maxUpsertAttempts {
+			r.logger.Errorw("upsert still racing with concurrent transactions", "attempts", attempt)
+			return ErrInternalServerError
+		}
+	}
+}
+
+// runTx runs fn in a transaction and commits it if fn succeeds.
+func (r *Resolver) runTx(ctx context.Context, fn func(tx *generated.Tx) error) error {
+	tx, err := r.client.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		r.logger.Errorw("failed to begin transaction", "error", err)
+		return ErrInternalServerError
+	}
+
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		if isUpsertRace(err) {
+			return errUpsertRace
+		}
+
+		r.logger.Errorw("failed to commit transaction", "error", err)
+		return ErrInternalServerError
+	}
+
+	return nil
+}
+
+// validateStatusUpdateInput checks the fields of a status update and returns the
+// namespace it names.
+func validateStatusUpdateInput(input StatusUpdateInput) (namespaceRef, error) {
+	ref, err := newNamespaceRef(input.NamespaceID, input.OwnerID, input.NamespaceName)
+	if err != nil {
+		return namespaceRef{}, err
+	}
+
+	if input.NodeID == "" {
+		return namespaceRef{}, NewInvalidFieldError("nodeID", ErrFieldEmpty)
+	}
+
+	if input.Source == "" {
+		return namespaceRef{}, NewInvalidFieldError("source", ErrFieldEmpty)
+	}
+
+	if _, err := gidx.Parse(input.NodeID.String()); err != nil {
+		return namespaceRef{}, NewInvalidFieldError("nodeID", err)
+	}
+
+	if !json.Valid(input.Data) {
+		return namespaceRef{}, NewInvalidFieldError("data", ErrInvalidJSON)
+	}
+
+	return ref, validateStatusExpiry(input)
+}
+
+// statusNamespaceForUpdate checks the caller can update statuses in the namespace
+// and returns it.
+func (r *Resolver) statusNamespaceForUpdate(ctx context.Context, id gidx.PrefixedID) (*generated.StatusNamespace, error) {
+	if err := permissions.CheckAccess(ctx, id, actionMetadataStatusNamespaceUpdate); err != nil {
+		return nil, err
+	}
+
+	ns, err := r.client.StatusNamespace.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		if generated.IsValidationError(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get status namespace", "namespaceID", id, "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if ns.Deleting {
+		return nil, ErrNamespaceDeleting
+	}
+
+	return ns, nil
+}
+
+// upsertStatus sets the data of the status for the node, namespace and source of
+// the input within the transaction. The status and the metadata of the node are
+// created when they don't exist yet. Access to the namespace must be checked by
+// the caller.
+func (r *Resolver) upsertStatus(ctx context.Context, tx *generated.Tx, ns *generated.StatusNamespace, input StatusUpdateInput) (*generated.Status, error) {
+	logger := r.logger.With("nodeID", input.NodeID, "namespaceID", ns.ID, "source", input.Source)
+	now := time.Now()
+
+	// lock the status so concurrent patches are applied one after another
+	st, err := tx.Status.Query().Where(
+		status.HasMetadataWith(metadata.NodeID(input.NodeID)),
+		status.StatusNamespaceID(ns.ID),
+		status.Source(input.Source),
+		forUpdate[predicate.Status](),
+	).First(ctx)
+	if err != nil && !generated.IsNotFound(err) {
+		if isUpsertRace(err) {
+			return nil, errUpsertRace
+		}
+
+		logger.Errorw("failed to get status", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	// an expired status is patched as if it no longer exists
+	var (
+		stored  json.RawMessage
+		version int64
+	)
+
+	if st != nil && !statusExpired(st, now) {
+		stored = st.Data
+		version = st.Version
+	}
+
+	if err := checkVersion(input.ExpectedVersion, version); err != nil {
+		return nil, err
+	}
+
+	data, err := applyDataUpdate(input.Mode, stored, input.Data)
+	if err != nil {
+		return nil, NewInvalidFieldError("data", err)
+	}
+
+	if err := r.loaders(ctx).schemas.validate(ns.ID, ns.UpdatedAt, ns.JSONSchema, data); err != nil {
+		return nil, NewInvalidFieldError("data", err)
+	}
+
+	expiresAt := statusExpiresAt(ns, input, now)
+
+	if st != nil {
+		// the update only applies to the version read, in case the status changed since
+		upd := tx.Status.UpdateOneID(st.ID).Where(status.Version(st.Version)).SetData(data)
+
+		// an expired status is replaced as if it was created again
+		if version == 0 {
+			upd.SetVersion(1)
+		} else {
+			upd.AddVersion(1)
+		}
+
+		if expiresAt != nil {
+			upd.SetExpiresAt(*expiresAt)
+		} else {
+			upd.ClearExpiresAt()
+		}
+
+		st, err = upd.Save(ctx)
+		if err != nil {
+			if generated.IsNotFound(err) {
+				return nil, NewInvalidFieldError("expectedVersion", ErrVersionConflict)
+			}
+
+			if isUpsertRace(err) {
+				return nil, errUpsertRace
+			}
+
+			logger.Errorw("failed to update status", "error", err)
+			return nil, ErrInternalServerError
+		}
+
+		return st, nil
+	}
+
+	md, err := r.upsertMetadata(ctx, tx, input.NodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err = tx.Status.Create().SetInput(generated.CreateStatusInput{
+		MetadataID:  md.ID,
+		NamespaceID: ns.ID,
+		Source:      input.Source,
+		Data:        data,
+	}).SetNillableExpiresAt(expiresAt).Save(ctx)
+	if err != nil {
+		if isUpsertRace(err) {
+			return nil, errUpsertRace
+		}
+
+		logger.Errorw("failed to create status", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return st, nil
+}
+
+// validateAnnotationUpdateInput checks the fields of an annotation update and
+// returns the namespace it names.
+func validateAnnotationUpdateInput(input AnnotationUpdateInput) (namespaceRef, error) {
+	ref, err := newNamespaceRef(input.NamespaceID, input.OwnerID, input.NamespaceName)
+	if err != nil {
+		return namespaceRef{}, err
+	}
+
+	if input.NodeID == "" {
+		return namespaceRef{}, NewInvalidFieldError("nodeID", ErrFieldEmpty)
+	}
+
+	if _, err := gidx.Parse(input.NodeID.String()); err != nil {
+		return namespaceRef{}, NewInvalidFieldError("nodeID", err)
+	}
+
+	if !json.Valid(input.Data) {
+		return namespaceRef{}, NewInvalidFieldError("data", ErrInvalidJSON)
+	}
+
+	return ref, nil
+}
+
+// annotationNamespaceForUpdate returns the namespace once the caller is known to be
+// able to update annotations in it.
+func (r *Resolver) annotationNamespaceForUpdate(ctx context.Context, id gidx.PrefixedID) (*generated.AnnotationNamespace, error) {
+	if err := permissions.CheckAccess(ctx, id, actionMetadataAnnotationNamespaceUpdate); err != nil {
+		return nil, err
+	}
+
+	ns, err := r.client.AnnotationNamespace.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil, err
+		}
+
+		r.logger.Errorw("failed to get annotation namespace", "namespaceID", id, "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	if ns.Deleting {
+		return nil, ErrNamespaceDeleting
+	}
+
+	return ns, nil
+}
+
+// upsertAnnotation sets the data of the annotation for the node and namespace of
+// the input within the transaction. See upsertStatus.
+func (r *Resolver) upsertAnnotation(ctx context.Context, tx *generated.Tx, ns *generated.AnnotationNamespace, input AnnotationUpdateInput) (*generated.Annotation, error) {
+	logger := r.logger.With("nodeID", input.NodeID, "namespaceID", ns.ID)
+
+	// lock the annotation so concurrent patches are applied one after another
+	ant, err := tx.Annotation.Query().Where(
+		annotation.AnnotationNamespaceID(ns.ID),
+		annotation.HasMetadataWith(metadata.NodeID(input.NodeID)),
+		forUpdate[predicate.Annotation](),
+	).First(ctx)
+	if err != nil && !generated.IsNotFound(err) {
+		if isUpsertRace(err) {
+			return nil, errUpsertRace
+		}
+
+		logger.Errorw("failed to get annotation", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	var (
+		stored  json.RawMessage
+		version int64
+	)
+
+	if ant != nil {
+		stored = ant.Data
+		version = ant.Version
+	}
+
+	if err := checkVersion(input.ExpectedVersion, version); err != nil {
+		return nil, err
+	}
+
+	data, err := applyDataUpdate(input.Mode, stored, input.Data)
+	if err != nil {
+		return nil, NewInvalidFieldError("data", err)
+	}
+
+	if err := r.loaders(ctx).schemas.validate(ns.ID, ns.UpdatedAt, ns.JSONSchema, data); err != nil {
+		return nil, NewInvalidFieldError("data", err)
+	}
+
+	if ant != nil {
+		// the update only applies to the version read, in case the annotation changed since
+		ant, err = tx.Annotation.UpdateOneID(ant.ID).Where(annotation.Version(ant.Version)).SetData(data).AddVersion(1).Save(ctx)
+		if err != nil {
+			if generated.IsNotFound(err) {
+				return nil, NewInvalidFieldError("expectedVersion", ErrVersionConflict)
+			}
+
+			if isUpsertRace(err) {
+				return nil, errUpsertRace
+			}
+
+			logger.Errorw("failed to update annotation", "error", err)
+			return nil, ErrInternalServerError
+		}
+
+		return ant, nil
+	}
+
+	// The annotation doesn't exist, create it
+	md, err := r.upsertMetadata(ctx, tx, input.NodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	ant, err = tx.Annotation.Create().SetMetadata(md).SetAnnotationNamespaceID(ns.ID).SetData(data).Save(ctx)
+	if err != nil {
+		if isUpsertRace(err) {
+			return nil, errUpsertRace
+		}
+
+		logger.Errorw("failed to create annotation", "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return ant, nil
+}
+
+// checkVersion returns a conflict error when an expected version is given and
+// the record isn't at that version. The version of a missing record is 0.
+func checkVersion(expected *int, version int64) error {
+	if expected != nil && int64(*expected) != version {
+		return NewInvalidFieldError("expectedVersion", ErrVersionConflict)
+	}
+
+	return nil
+}
+
+// upsertMetadata returns the metadata of the node, creating it if it doesn't exist.
+func (r *Resolver) upsertMetadata(ctx context.Context, tx *generated.Tx, nodeID gidx.PrefixedID) (*generated.Metadata, error) {
+	md, err := tx.Metadata.Query().Where(metadata.NodeID(nodeID)).First(ctx)
+	if err == nil {
+		return md, nil
+	}
+
+	if !generated.IsNotFound(err) {
+		if isUpsertRace(err) {
+			return nil, errUpsertRace
+		}
+
+		r.logger.Errorw("failed to get metadata", "nodeID", nodeID, "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	// metadata doesn't exist, create it
+	md, err = tx.Metadata.Create().SetNodeID(nodeID).Save(ctx)
+	if err != nil {
+		if generated.IsValidationError(err) {
+			return nil, err
+		}
+
+		if isUpsertRace(err) {
+			return nil, errUpsertRace
+		}
+
+		r.logger.Errorw("failed to create metadata", "nodeID", nodeID, "error", err)
+		return nil, ErrInternalServerError
+	}
+
+	return md, nil
+}
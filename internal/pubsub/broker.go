@@ -0,0 +1,162 @@
+// Package pubsub fans out changes to statuses and annotations to the
+// subscribers on every replica of the service.
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+)
+
+const (
+	// TopicStatus is the topic changes to statuses are published to.
+	TopicStatus = "status"
+
+	// TopicAnnotation is the topic changes to annotations are published to.
+	TopicAnnotation = "annotation"
+
+	// subscriberBuffer is the number of changes buffered for each subscriber.
+	// Changes are dropped for subscribers that fall further behind.
+	subscriberBuffer = 64
+
+	// subjectPrefix is the nats subject changes are published under.
+	subjectPrefix = "metadata-api.subscriptions"
+)
+
+// Operation is the kind of change made.
+type Operation string
+
+const (
+	// OperationCreate is used when a record was created.
+	OperationCreate Operation = "CREATE"
+	// OperationUpdate is used when a record was updated.
+	OperationUpdate Operation = "UPDATE"
+	// OperationDelete is used when a record was deleted.
+	OperationDelete Operation = "DELETE"
+)
+
+// Change describes a change made to a status or annotation.
+type Change struct {
+	Operation   Operation       `json:"operation"`
+	ID          gidx.PrefixedID `json:"id"`
+	NodeID      gidx.PrefixedID `json:"nodeID"`
+	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+}
+
+// Broker delivers published changes to the subscribers of their topic. Without
+// nats, changes are only delivered to the subscribers of this replica.
+type Broker struct {
+	logger *zap.SugaredLogger
+
+	mu   sync.RWMutex
+	subs map[string]map[chan Change]struct{}
+
+	conn    *nats.Conn
+	subject string
+}
+
+// NewBroker returns a broker which delivers changes to the subscribers of this replica.
+func NewBroker(logger *zap.SugaredLogger) *Broker {
+	return &Broker{
+		logger: logger,
+		subs:   make(map[string]map[chan Change]struct{}),
+	}
+}
+
+// ConnectNATS publishes changes through nats, so they are delivered to the
+// subscribers of every replica connected to it. Subjects are prefixed with
+// prefix when it's set.
+func (b *Broker) ConnectNATS(conn *nats.Conn, prefix string) error {
+	subject := subjectPrefix
+	if prefix != "" {
+		subject = prefix + "." + subject
+	}
+
+	// every replica must receive every change, so no queue group is used
+	if _, err := conn.Subscribe(subject+".>", b.receive); err != nil {
+		return err
+	}
+
+	b.conn = conn
+	b.subject = subject
+
+	return nil
+}
+
+// Publish sends the change to the subscribers of the topic.
+func (b *Broker) Publish(topic string, change Change) {
+	if b.conn == nil {
+		b.deliver(topic, change)
+
+		return
+	}
+
+	data, err := json.Marshal(change)
+	if err != nil {
+		b.logger.Errorw("failed to encode change", "topic", topic, "error", err)
+
+		return
+	}
+
+	if err := b.conn.Publish(b.subject+"."+topic, data); err != nil {
+		b.logger.Errorw("failed to publish change", "topic", topic, "id", change.ID, "error", err)
+	}
+}
+
+// Subscribe returns a channel which receives the changes published to the topic
+// until the context is canceled.
+func (b *Broker) Subscribe(ctx context.Context, topic string) <-chan Change {
+	ch := make(chan Change, subscriberBuffer)
+
+	b.mu.Lock()
+
+	if b.subs[topic] == nil {
+		b.subs[topic] = make(map[chan Change]struct{})
+	}
+
+	b.subs[topic][ch] = struct{}{}
+
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		delete(b.subs[topic], ch)
+		b.mu.Unlock()
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+func (b *Broker) receive(msg *nats.Msg) {
+	var change Change
+
+	if err := json.Unmarshal(msg.Data, &change); err != nil {
+		b.logger.Errorw("failed to decode change", "subject", msg.Subject, "error", err)
+
+		return
+	}
+
+	b.deliver(strings.TrimPrefix(msg.Subject, b.subject+"."), change)
+}
+
+func (b *Broker) deliver(topic string, change Change) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subs[topic] {
+		select {
+		case ch <- change:
+		default:
+			b.logger.Warnw("dropped change for slow subscriber", "topic", topic, "id", change.ID)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.infratographer.com/x/events"
+	"go.infratographer.com/x/gidx"
+	"go.infratographer.com/x/testing/eventtools"
+	"go.uber.org/zap"
+
+	"go.infratographer.com/metadata-api/internal/pubsub"
+)
+
+func TestBrokerLocal(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := pubsub.NewBroker(zap.NewNop().Sugar())
+
+	statuses := broker.Subscribe(ctx, pubsub.TopicStatus)
+	annotations := broker.Subscribe(ctx, pubsub.TopicAnnotation)
+
+	change := pubsub.Change{
+		Operation:   pubsub.OperationCreate,
+		ID:          gidx.MustNewID("metasts"),
+		NodeID:      gidx.MustNewID("testing"),
+		NamespaceID: gidx.MustNewID("metasns"),
+	}
+
+	broker.Publish(pubsub.TopicStatus, change)
+
+	assert.Equal(t, change, receive(t, statuses))
+
+	select {
+	case c := <-annotations:
+		t.Fatalf("unexpected change on annotation topic: %+v", c)
+	default:
+	}
+
+	cancel()
+
+	// the channel is closed once the context is canceled
+	require.Eventually(t, func() bool {
+		_, ok := <-statuses
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestBrokerNATS(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv, err := eventtools.NewNatsServer()
+	require.NoError(t, err)
+
+	defer srv.Close()
+
+	// each broker acts as a separate replica of the service
+	brokers := make([]*pubsub.Broker, 2)
+	changes := make([]<-chan pubsub.Change, 2)
+
+	for i := range brokers {
+		conn, err := events.NewConnection(srv.Config)
+		require.NoError(t, err)
+
+		defer conn.Shutdown(ctx) //nolint:errcheck // only used in tests
+
+		natsConn, ok := conn.Source().(*nats.Conn)
+		require.True(t, ok)
+
+		brokers[i] = pubsub.NewBroker(zap.NewNop().Sugar())
+		require.NoError(t, brokers[i].ConnectNATS(natsConn, srv.Config.NATS.PublishPrefix))
+
+		changes[i] = brokers[i].Subscribe(ctx, pubsub.TopicAnnotation)
+	}
+
+	change := pubsub.Change{
+		Operation:   pubsub.OperationDelete,
+		ID:          gidx.MustNewID("metaant"),
+		NodeID:      gidx.MustNewID("testing"),
+		NamespaceID: gidx.MustNewID("metaans"),
+	}
+
+	brokers[0].Publish(pubsub.TopicAnnotation, change)
+
+	for _, ch := range changes {
+		assert.Equal(t, change, receive(t, ch))
+	}
+}
+
+func receive(t *testing.T, ch <-chan pubsub.Change) pubsub.Change {
+	t.Helper()
+
+	select {
+	case c := <-ch:
+		return c
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for change")
+	}
+
+	return pubsub.Change{}
+}
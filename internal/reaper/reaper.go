@@ -0,0 +1,136 @@
+// Package reaper deletes statuses once they have expired.
+package reaper
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go.infratographer.com/x/gidx"
+	"go.uber.org/zap"
+
+	ent "go.infratographer.com/metadata-api/internal/ent/generated"
+	"go.infratographer.com/metadata-api/internal/ent/generated/status"
+)
+
+const (
+	// DefaultInterval is the default time between runs of the reaper.
+	DefaultInterval = time.Minute
+
+	// DefaultBatchSize is the default number of statuses looked up at once.
+	DefaultBatchSize = 100
+)
+
+// Config defines the reaper configuration structure
+type Config struct {
+	// Interval is the time between runs of the reaper. The reaper is disabled when it isn't positive.
+	Interval time.Duration
+
+	// BatchSize is the number of expired statuses looked up at once.
+	BatchSize int
+}
+
+// Reaper periodically deletes expired statuses.
+type Reaper struct {
+	client    *ent.Client
+	logger    *zap.SugaredLogger
+	interval  time.Duration
+	batchSize int
+}
+
+// New returns a reaper which deletes the expired statuses with the client.
+func New(client *ent.Client, logger *zap.SugaredLogger, cfg Config) *Reaper {
+	r := &Reaper{
+		client:    client,
+		logger:    logger,
+		interval:  cfg.Interval,
+		batchSize: cfg.BatchSize,
+	}
+
+	if r.batchSize <= 0 {
+		r.batchSize = DefaultBatchSize
+	}
+
+	return r
+}
+
+// Run deletes expired statuses every interval until the context is canceled.
+func (r *Reaper) Run(ctx context.Context) {
+	if r.interval <= 0 {
+		r.logger.Info("status reaper disabled")
+
+		return
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := r.Reap(ctx, time.Now())
+			if err != nil {
+				r.logger.Errorw("failed to reap expired statuses", "deleted", count, "error", err)
+
+				continue
+			}
+
+			if count > 0 {
+				r.logger.Infow("reaped expired statuses", "deleted", count)
+			}
+		}
+	}
+}
+
+// Reap deletes the statuses that have expired at t and returns the number of
+// statuses deleted. Statuses are deleted one at a time so the hooks of the client
+// publish a delete event and record the history of each.
+func (r *Reaper) Reap(ctx context.Context, t time.Time) (int, error) {
+	var count int
+
+	for {
+		ids, err := r.client.Status.Query().
+			Where(status.ExpiresAtLTE(t)).
+			Limit(r.batchSize).
+			IDs(ctx)
+		if err != nil {
+			return count, err
+		}
+
+		for _, id := range ids {
+			if err := r.deleteExpired(ctx, id, t); err != nil {
+				// the status was deleted or its expiry was extended since it was looked up
+				if ent.IsNotFound(err) {
+					continue
+				}
+
+				return count, err
+			}
+
+			count++
+		}
+
+		if len(ids) < r.batchSize {
+			return count, nil
+		}
+	}
+}
+
+// deleteExpired deletes the status if it has expired at t. The status is deleted
+// in a transaction so its history is recorded with the delete.
+func (r *Reaper) deleteExpired(ctx context.Context, id gidx.PrefixedID, t time.Time) error {
+	tx, err := r.client.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	if err := tx.Status.DeleteOneID(id).Where(status.ExpiresAtLTE(t)).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -16,17 +16,31 @@ type TestClient interface {
 	AnnotationDelete(ctx context.Context, input AnnotationDeleteInput, httpRequestOptions ...client.HTTPRequestOption) (*AnnotationDelete, error)
 	AnnotationNamespaceCreate(ctx context.Context, input CreateAnnotationNamespaceInput, httpRequestOptions ...client.HTTPRequestOption) (*AnnotationNamespaceCreate, error)
 	AnnotationNamespaceDelete(ctx context.Context, id gidx.PrefixedID, force bool, httpRequestOptions ...client.HTTPRequestOption) (*AnnotationNamespaceDelete, error)
+	AnnotationNamespaceRestore(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*AnnotationNamespaceRestore, error)
 	AnnotationNamespaceUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateAnnotationNamespaceInput, httpRequestOptions ...client.HTTPRequestOption) (*AnnotationNamespaceUpdate, error)
 	AnnotationUpdate(ctx context.Context, input AnnotationUpdateInput, httpRequestOptions ...client.HTTPRequestOption) (*AnnotationUpdate, error)
+	AnnotationUpdateBatch(ctx context.Context, input AnnotationUpdateBatchInput, httpRequestOptions ...client.HTTPRequestOption) (*AnnotationUpdateBatch, error)
+	GetAnnotationEntities(ctx context.Context, representations []map[string]interface{}, httpRequestOptions ...client.HTTPRequestOption) (*GetAnnotationEntities, error)
 	GetAnnotationNamespace(ctx context.Context, annotationNamespaceID gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetAnnotationNamespace, error)
+	GetAnnotationNamespaceAnnotations(ctx context.Context, annotationNamespaceID gidx.PrefixedID, first *int64, after *string, orderBy *AnnotationOrder, where *AnnotationWhereInput, httpRequestOptions ...client.HTTPRequestOption) (*GetAnnotationNamespaceAnnotations, error)
+	GetAnnotationNamespaceByName(ctx context.Context, ownerID gidx.PrefixedID, name string, httpRequestOptions ...client.HTTPRequestOption) (*GetAnnotationNamespaceByName, error)
+	GetMetadataEntities(ctx context.Context, representations []map[string]interface{}, httpRequestOptions ...client.HTTPRequestOption) (*GetMetadataEntities, error)
+	GetNamespaceDeletion(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetNamespaceDeletion, error)
 	GetNodeMetadata(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetNodeMetadata, error)
+	GetNodeMetadataAsOf(ctx context.Context, id gidx.PrefixedID, asOf *time.Time, httpRequestOptions ...client.HTTPRequestOption) (*GetNodeMetadataAsOf, error)
+	GetNodeMetadataWhere(ctx context.Context, id gidx.PrefixedID, annotationsWhere *AnnotationWhereInput, statusesWhere *StatusWhereInput, httpRequestOptions ...client.HTTPRequestOption) (*GetNodeMetadataWhere, error)
 	GetResourceOwnerAnnotationNamespaces(ctx context.Context, id gidx.PrefixedID, orderBy *AnnotationNamespaceOrder, httpRequestOptions ...client.HTTPRequestOption) (*GetResourceOwnerAnnotationNamespaces, error)
 	GetResourceProviderStatusNamespaces(ctx context.Context, id gidx.PrefixedID, orderBy *StatusNamespaceOrder, httpRequestOptions ...client.HTTPRequestOption) (*GetResourceProviderStatusNamespaces, error)
+	GetStatusEntities(ctx context.Context, representations []map[string]interface{}, httpRequestOptions ...client.HTTPRequestOption) (*GetStatusEntities, error)
+	GetStatusNamespaceByName(ctx context.Context, ownerID gidx.PrefixedID, name string, httpRequestOptions ...client.HTTPRequestOption) (*GetStatusNamespaceByName, error)
+	GetStatusNamespaceStatuses(ctx context.Context, statusNamespaceID gidx.PrefixedID, first *int64, after *string, orderBy *StatusOrder, where *StatusWhereInput, httpRequestOptions ...client.HTTPRequestOption) (*GetStatusNamespaceStatuses, error)
 	StatusDelete(ctx context.Context, input StatusDeleteInput, httpRequestOptions ...client.HTTPRequestOption) (*StatusDelete, error)
 	StatusNamespaceCreate(ctx context.Context, input CreateStatusNamespaceInput, httpRequestOptions ...client.HTTPRequestOption) (*StatusNamespaceCreate, error)
 	StatusNamespaceDelete(ctx context.Context, id gidx.PrefixedID, force bool, httpRequestOptions ...client.HTTPRequestOption) (*StatusNamespaceDelete, error)
+	StatusNamespaceRestore(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*StatusNamespaceRestore, error)
 	StatusNamespaceUpdate(ctx context.Context, id gidx.PrefixedID, input UpdateStatusNamespaceInput, httpRequestOptions ...client.HTTPRequestOption) (*StatusNamespaceUpdate, error)
 	StatusUpdate(ctx context.Context, input StatusUpdateInput, httpRequestOptions ...client.HTTPRequestOption) (*StatusUpdate, error)
+	StatusUpdateBatch(ctx context.Context, input StatusUpdateBatchInput, httpRequestOptions ...client.HTTPRequestOption) (*StatusUpdateBatch, error)
 }
 
 type Client struct {
@@ -38,21 +52,29 @@ func NewClient(cli *http.Client, baseURL string, options ...client.HTTPRequestOp
 }
 
 type Query struct {
-	AnnotationNamespace AnnotationNamespace "json:\"annotationNamespace\" graphql:\"annotationNamespace\""
-	Entities            []Entity            "json:\"_entities\" graphql:\"_entities\""
-	Service             Service             "json:\"_service\" graphql:\"_service\""
+	AnnotationNamespace       AnnotationNamespace "json:\"annotationNamespace\" graphql:\"annotationNamespace\""
+	AnnotationNamespaceByName AnnotationNamespace "json:\"annotationNamespaceByName\" graphql:\"annotationNamespaceByName\""
+	NamespaceDeletion         NamespaceDeletion   "json:\"namespaceDeletion\" graphql:\"namespaceDeletion\""
+	StatusNamespace           StatusNamespace     "json:\"statusNamespace\" graphql:\"statusNamespace\""
+	StatusNamespaceByName     StatusNamespace     "json:\"statusNamespaceByName\" graphql:\"statusNamespaceByName\""
+	Entities                  []Entity            "json:\"_entities\" graphql:\"_entities\""
+	Service                   Service             "json:\"_service\" graphql:\"_service\""
 }
 type Mutation struct {
-	AnnotationUpdate          AnnotationUpdateResponse         "json:\"annotationUpdate\" graphql:\"annotationUpdate\""
-	AnnotationDelete          AnnotationDeleteResponse         "json:\"annotationDelete\" graphql:\"annotationDelete\""
-	AnnotationNamespaceCreate AnnotationNamespaceCreatePayload "json:\"annotationNamespaceCreate\" graphql:\"annotationNamespaceCreate\""
-	AnnotationNamespaceDelete AnnotationNamespaceDeletePayload "json:\"annotationNamespaceDelete\" graphql:\"annotationNamespaceDelete\""
-	AnnotationNamespaceUpdate AnnotationNamespaceUpdatePayload "json:\"annotationNamespaceUpdate\" graphql:\"annotationNamespaceUpdate\""
-	StatusUpdate              StatusUpdateResponse             "json:\"statusUpdate\" graphql:\"statusUpdate\""
-	StatusDelete              StatusDeleteResponse             "json:\"statusDelete\" graphql:\"statusDelete\""
-	StatusNamespaceCreate     StatusNamespaceCreatePayload     "json:\"statusNamespaceCreate\" graphql:\"statusNamespaceCreate\""
-	StatusNamespaceDelete     StatusNamespaceDeletePayload     "json:\"statusNamespaceDelete\" graphql:\"statusNamespaceDelete\""
-	StatusNamespaceUpdate     StatusNamespaceUpdatePayload     "json:\"statusNamespaceUpdate\" graphql:\"statusNamespaceUpdate\""
+	AnnotationUpdate           AnnotationUpdateResponse          "json:\"annotationUpdate\" graphql:\"annotationUpdate\""
+	AnnotationUpdateBatch      AnnotationUpdateBatchResponse     "json:\"annotationUpdateBatch\" graphql:\"annotationUpdateBatch\""
+	AnnotationDelete           AnnotationDeleteResponse          "json:\"annotationDelete\" graphql:\"annotationDelete\""
+	AnnotationNamespaceCreate  AnnotationNamespaceCreatePayload  "json:\"annotationNamespaceCreate\" graphql:\"annotationNamespaceCreate\""
+	AnnotationNamespaceDelete  AnnotationNamespaceDeletePayload  "json:\"annotationNamespaceDelete\" graphql:\"annotationNamespaceDelete\""
+	AnnotationNamespaceRestore AnnotationNamespaceRestorePayload "json:\"annotationNamespaceRestore\" graphql:\"annotationNamespaceRestore\""
+	AnnotationNamespaceUpdate  AnnotationNamespaceUpdatePayload  "json:\"annotationNamespaceUpdate\" graphql:\"annotationNamespaceUpdate\""
+	StatusUpdate               StatusUpdateResponse              "json:\"statusUpdate\" graphql:\"statusUpdate\""
+	StatusUpdateBatch          StatusUpdateBatchResponse         "json:\"statusUpdateBatch\" graphql:\"statusUpdateBatch\""
+	StatusDelete               StatusDeleteResponse              "json:\"statusDelete\" graphql:\"statusDelete\""
+	StatusNamespaceCreate      StatusNamespaceCreatePayload      "json:\"statusNamespaceCreate\" graphql:\"statusNamespaceCreate\""
+	StatusNamespaceDelete      StatusNamespaceDeletePayload      "json:\"statusNamespaceDelete\" graphql:\"statusNamespaceDelete\""
+	StatusNamespaceRestore     StatusNamespaceRestorePayload     "json:\"statusNamespaceRestore\" graphql:\"statusNamespaceRestore\""
+	StatusNamespaceUpdate      StatusNamespaceUpdatePayload      "json:\"statusNamespaceUpdate\" graphql:\"statusNamespaceUpdate\""
 }
 type AnnotationDelete struct {
 	AnnotationDelete struct {
@@ -62,12 +84,13 @@ type AnnotationDelete struct {
 type AnnotationNamespaceCreate struct {
 	AnnotationNamespaceCreate struct {
 		AnnotationNamespace struct {
-			ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
-			Name      string          "json:\"name\" graphql:\"name\""
-			Private   bool            "json:\"private\" graphql:\"private\""
-			CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
-			UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
-			Owner     struct {
+			ID         gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			Name       string          "json:\"name\" graphql:\"name\""
+			Private    bool            "json:\"private\" graphql:\"private\""
+			JSONSchema json.RawMessage "json:\"jsonSchema\" graphql:\"jsonSchema\""
+			CreatedAt  time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+			UpdatedAt  time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
+			Owner      struct {
 				ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
 			} "json:\"owner\" graphql:\"owner\""
 		} "json:\"annotationNamespace\" graphql:\"annotationNamespace\""
@@ -77,20 +100,38 @@ type AnnotationNamespaceDelete struct {
 	AnnotationNamespaceDelete struct {
 		DeletedID              gidx.PrefixedID "json:\"deletedID\" graphql:\"deletedID\""
 		AnnotationDeletedCount int64           "json:\"annotationDeletedCount\" graphql:\"annotationDeletedCount\""
+		NamespaceDeletion      *struct {
+			ID           gidx.PrefixedID        "json:\"id\" graphql:\"id\""
+			NamespaceID  gidx.PrefixedID        "json:\"namespaceID\" graphql:\"namespaceID\""
+			State        NamespaceDeletionState "json:\"state\" graphql:\"state\""
+			TotalCount   int64                  "json:\"totalCount\" graphql:\"totalCount\""
+			DeletedCount int64                  "json:\"deletedCount\" graphql:\"deletedCount\""
+		} "json:\"namespaceDeletion\" graphql:\"namespaceDeletion\""
 	} "json:\"annotationNamespaceDelete\" graphql:\"annotationNamespaceDelete\""
 }
-type AnnotationNamespaceUpdate struct {
-	AnnotationNamespaceUpdate struct {
+type AnnotationNamespaceRestore struct {
+	AnnotationNamespaceRestore struct {
 		AnnotationNamespace struct {
 			ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
 			Name      string          "json:\"name\" graphql:\"name\""
-			Private   bool            "json:\"private\" graphql:\"private\""
-			CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
-			UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
-			Owner     struct {
+			DeletedAt *time.Time      "json:\"deletedAt\" graphql:\"deletedAt\""
+		} "json:\"annotationNamespace\" graphql:\"annotationNamespace\""
+	} "json:\"annotationNamespaceRestore\" graphql:\"annotationNamespaceRestore\""
+}
+type AnnotationNamespaceUpdate struct {
+	AnnotationNamespaceUpdate struct {
+		AnnotationNamespace struct {
+			ID         gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			Name       string          "json:\"name\" graphql:\"name\""
+			Private    bool            "json:\"private\" graphql:\"private\""
+			JSONSchema json.RawMessage "json:\"jsonSchema\" graphql:\"jsonSchema\""
+			CreatedAt  time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+			UpdatedAt  time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
+			Owner      struct {
 				ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
 			} "json:\"owner\" graphql:\"owner\""
 		} "json:\"annotationNamespace\" graphql:\"annotationNamespace\""
+		InvalidAnnotationCount int64 "json:\"invalidAnnotationCount\" graphql:\"invalidAnnotationCount\""
 	} "json:\"annotationNamespaceUpdate\" graphql:\"annotationNamespaceUpdate\""
 }
 type AnnotationUpdate struct {
@@ -109,11 +150,35 @@ type AnnotationUpdate struct {
 				Private bool            "json:\"private\" graphql:\"private\""
 			} "json:\"namespace\" graphql:\"namespace\""
 			Data      json.RawMessage "json:\"data\" graphql:\"data\""
+			Version   int64           "json:\"version\" graphql:\"version\""
 			CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
 			UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
 		} "json:\"annotation\" graphql:\"annotation\""
 	} "json:\"annotationUpdate\" graphql:\"annotationUpdate\""
 }
+type AnnotationUpdateBatch struct {
+	AnnotationUpdateBatch struct {
+		Results []*struct {
+			Annotation *struct {
+				ID       gidx.PrefixedID "json:\"id\" graphql:\"id\""
+				Metadata struct {
+					NodeID gidx.PrefixedID "json:\"nodeID\" graphql:\"nodeID\""
+				} "json:\"metadata\" graphql:\"metadata\""
+				Namespace struct {
+					ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
+				} "json:\"namespace\" graphql:\"namespace\""
+				Data json.RawMessage "json:\"data\" graphql:\"data\""
+			} "json:\"annotation\" graphql:\"annotation\""
+			Error *string "json:\"error\" graphql:\"error\""
+		} "json:\"results\" graphql:\"results\""
+	} "json:\"annotationUpdateBatch\" graphql:\"annotationUpdateBatch\""
+}
+type GetAnnotationEntities struct {
+	Entities []*struct {
+		ID   gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Data json.RawMessage "json:\"data\" graphql:\"data\""
+	} "json:\"_entities\" graphql:\"_entities\""
+}
 type GetAnnotationNamespace struct {
 	AnnotationNamespace struct {
 		ID      gidx.PrefixedID "json:\"id\" graphql:\"id\""
@@ -122,16 +187,65 @@ type GetAnnotationNamespace struct {
 		Owner   struct {
 			ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
 		} "json:\"owner\" graphql:\"owner\""
-		Annotations []*struct {
-			ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
-			Data      json.RawMessage "json:\"data\" graphql:\"data\""
-			CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
-			UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
+		Annotations struct {
+			Edges []*struct {
+				Node *struct {
+					ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					Data      json.RawMessage "json:\"data\" graphql:\"data\""
+					CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+					UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
+				} "json:\"node\" graphql:\"node\""
+			} "json:\"edges\" graphql:\"edges\""
 		} "json:\"annotations\" graphql:\"annotations\""
 		CreatedAt time.Time "json:\"createdAt\" graphql:\"createdAt\""
 		UpdatedAt time.Time "json:\"updatedAt\" graphql:\"updatedAt\""
 	} "json:\"annotationNamespace\" graphql:\"annotationNamespace\""
 }
+type GetAnnotationNamespaceAnnotations struct {
+	AnnotationNamespace struct {
+		ID          gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Annotations struct {
+			TotalCount int64 "json:\"totalCount\" graphql:\"totalCount\""
+			PageInfo   struct {
+				HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+				EndCursor   *string "json:\"endCursor\" graphql:\"endCursor\""
+			} "json:\"pageInfo\" graphql:\"pageInfo\""
+			Edges []*struct {
+				Node *struct {
+					ID   gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					Data json.RawMessage "json:\"data\" graphql:\"data\""
+				} "json:\"node\" graphql:\"node\""
+			} "json:\"edges\" graphql:\"edges\""
+		} "json:\"annotations\" graphql:\"annotations\""
+	} "json:\"annotationNamespace\" graphql:\"annotationNamespace\""
+}
+type GetAnnotationNamespaceByName struct {
+	AnnotationNamespaceByName struct {
+		ID      gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Name    string          "json:\"name\" graphql:\"name\""
+		Private bool            "json:\"private\" graphql:\"private\""
+		Owner   struct {
+			ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		} "json:\"owner\" graphql:\"owner\""
+	} "json:\"annotationNamespaceByName\" graphql:\"annotationNamespaceByName\""
+}
+type GetMetadataEntities struct {
+	Entities []*struct {
+		ID     gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		NodeID gidx.PrefixedID "json:\"nodeID\" graphql:\"nodeID\""
+	} "json:\"_entities\" graphql:\"_entities\""
+}
+type GetNamespaceDeletion struct {
+	NamespaceDeletion struct {
+		ID           gidx.PrefixedID        "json:\"id\" graphql:\"id\""
+		NamespaceID  gidx.PrefixedID        "json:\"namespaceID\" graphql:\"namespaceID\""
+		OwnerID      gidx.PrefixedID        "json:\"ownerID\" graphql:\"ownerID\""
+		State        NamespaceDeletionState "json:\"state\" graphql:\"state\""
+		TotalCount   int64                  "json:\"totalCount\" graphql:\"totalCount\""
+		DeletedCount int64                  "json:\"deletedCount\" graphql:\"deletedCount\""
+		CompletedAt  *time.Time             "json:\"completedAt\" graphql:\"completedAt\""
+	} "json:\"namespaceDeletion\" graphql:\"namespaceDeletion\""
+}
 type GetNodeMetadata struct {
 	Entities []*struct {
 		Metadata *struct {
@@ -148,6 +262,72 @@ type GetNodeMetadata struct {
 		} "json:\"metadata\" graphql:\"metadata\""
 	} "json:\"_entities\" graphql:\"_entities\""
 }
+type GetNodeMetadataAsOf struct {
+	Entities []*struct {
+		Metadata *struct {
+			Annotations struct {
+				Edges []*struct {
+					Node *struct {
+						ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+						Namespace struct {
+							ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
+						} "json:\"namespace\" graphql:\"namespace\""
+						Data    json.RawMessage "json:\"data\" graphql:\"data\""
+						History struct {
+							Edges []*struct {
+								Node *struct {
+									Operation AnnotationHistoryOperation "json:\"operation\" graphql:\"operation\""
+									Data      json.RawMessage            "json:\"data\" graphql:\"data\""
+								} "json:\"node\" graphql:\"node\""
+							} "json:\"edges\" graphql:\"edges\""
+						} "json:\"history\" graphql:\"history\""
+					} "json:\"node\" graphql:\"node\""
+				} "json:\"edges\" graphql:\"edges\""
+			} "json:\"annotations\" graphql:\"annotations\""
+			Statuses struct {
+				Edges []*struct {
+					Node *struct {
+						ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
+						Namespace struct {
+							ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
+						} "json:\"namespace\" graphql:\"namespace\""
+						Source  string          "json:\"source\" graphql:\"source\""
+						Data    json.RawMessage "json:\"data\" graphql:\"data\""
+						History struct {
+							Edges []*struct {
+								Node *struct {
+									Operation StatusHistoryOperation "json:\"operation\" graphql:\"operation\""
+									Source    string                 "json:\"source\" graphql:\"source\""
+									Data      json.RawMessage        "json:\"data\" graphql:\"data\""
+								} "json:\"node\" graphql:\"node\""
+							} "json:\"edges\" graphql:\"edges\""
+						} "json:\"history\" graphql:\"history\""
+					} "json:\"node\" graphql:\"node\""
+				} "json:\"edges\" graphql:\"edges\""
+			} "json:\"statuses\" graphql:\"statuses\""
+		} "json:\"metadata\" graphql:\"metadata\""
+	} "json:\"_entities\" graphql:\"_entities\""
+}
+type GetNodeMetadataWhere struct {
+	Entities []*struct {
+		Metadata *struct {
+			Annotations struct {
+				Edges []*struct {
+					Node *struct {
+						ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					} "json:\"node\" graphql:\"node\""
+				} "json:\"edges\" graphql:\"edges\""
+			} "json:\"annotations\" graphql:\"annotations\""
+			Statuses struct {
+				Edges []*struct {
+					Node *struct {
+						ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					} "json:\"node\" graphql:\"node\""
+				} "json:\"edges\" graphql:\"edges\""
+			} "json:\"statuses\" graphql:\"statuses\""
+		} "json:\"metadata\" graphql:\"metadata\""
+	} "json:\"_entities\" graphql:\"_entities\""
+}
 type GetResourceOwnerAnnotationNamespaces struct {
 	Entities []*struct {
 		AnnotationNamespaces struct {
@@ -178,6 +358,46 @@ type GetResourceProviderStatusNamespaces struct {
 		} "json:\"statusNamespaces\" graphql:\"statusNamespaces\""
 	} "json:\"_entities\" graphql:\"_entities\""
 }
+type GetStatusEntities struct {
+	Entities []*struct {
+		ID     gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Source string          "json:\"source\" graphql:\"source\""
+		Data   json.RawMessage "json:\"data\" graphql:\"data\""
+	} "json:\"_entities\" graphql:\"_entities\""
+}
+type GetStatusNamespaceByName struct {
+	StatusNamespaceByName struct {
+		ID      gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Name    string          "json:\"name\" graphql:\"name\""
+		Private bool            "json:\"private\" graphql:\"private\""
+		Owner   struct {
+			ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		} "json:\"owner\" graphql:\"owner\""
+	} "json:\"statusNamespaceByName\" graphql:\"statusNamespaceByName\""
+}
+type GetStatusNamespaceStatuses struct {
+	StatusNamespace struct {
+		ID       gidx.PrefixedID "json:\"id\" graphql:\"id\""
+		Name     string          "json:\"name\" graphql:\"name\""
+		Statuses struct {
+			TotalCount int64 "json:\"totalCount\" graphql:\"totalCount\""
+			PageInfo   struct {
+				HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+				EndCursor   *string "json:\"endCursor\" graphql:\"endCursor\""
+			} "json:\"pageInfo\" graphql:\"pageInfo\""
+			Edges []*struct {
+				Node *struct {
+					ID       gidx.PrefixedID "json:\"id\" graphql:\"id\""
+					Source   string          "json:\"source\" graphql:\"source\""
+					Data     json.RawMessage "json:\"data\" graphql:\"data\""
+					Metadata struct {
+						NodeID gidx.PrefixedID "json:\"nodeID\" graphql:\"nodeID\""
+					} "json:\"metadata\" graphql:\"metadata\""
+				} "json:\"node\" graphql:\"node\""
+			} "json:\"edges\" graphql:\"edges\""
+		} "json:\"statuses\" graphql:\"statuses\""
+	} "json:\"statusNamespace\" graphql:\"statusNamespace\""
+}
 type StatusDelete struct {
 	StatusDelete struct {
 		DeletedID gidx.PrefixedID "json:\"deletedID\" graphql:\"deletedID\""
@@ -186,12 +406,13 @@ type StatusDelete struct {
 type StatusNamespaceCreate struct {
 	StatusNamespaceCreate struct {
 		StatusNamespace struct {
-			ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
-			Name      string          "json:\"name\" graphql:\"name\""
-			Private   bool            "json:\"private\" graphql:\"private\""
-			CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
-			UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
-			Owner     struct {
+			ID         gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			Name       string          "json:\"name\" graphql:\"name\""
+			Private    bool            "json:\"private\" graphql:\"private\""
+			JSONSchema json.RawMessage "json:\"jsonSchema\" graphql:\"jsonSchema\""
+			CreatedAt  time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+			UpdatedAt  time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
+			Owner      struct {
 				ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
 			} "json:\"owner\" graphql:\"owner\""
 		} "json:\"statusNamespace\" graphql:\"statusNamespace\""
@@ -201,20 +422,38 @@ type StatusNamespaceDelete struct {
 	StatusNamespaceDelete struct {
 		DeletedID          gidx.PrefixedID "json:\"deletedID\" graphql:\"deletedID\""
 		StatusDeletedCount int64           "json:\"statusDeletedCount\" graphql:\"statusDeletedCount\""
+		NamespaceDeletion  *struct {
+			ID           gidx.PrefixedID        "json:\"id\" graphql:\"id\""
+			NamespaceID  gidx.PrefixedID        "json:\"namespaceID\" graphql:\"namespaceID\""
+			State        NamespaceDeletionState "json:\"state\" graphql:\"state\""
+			TotalCount   int64                  "json:\"totalCount\" graphql:\"totalCount\""
+			DeletedCount int64                  "json:\"deletedCount\" graphql:\"deletedCount\""
+		} "json:\"namespaceDeletion\" graphql:\"namespaceDeletion\""
 	} "json:\"statusNamespaceDelete\" graphql:\"statusNamespaceDelete\""
 }
-type StatusNamespaceUpdate struct {
-	StatusNamespaceUpdate struct {
+type StatusNamespaceRestore struct {
+	StatusNamespaceRestore struct {
 		StatusNamespace struct {
 			ID        gidx.PrefixedID "json:\"id\" graphql:\"id\""
 			Name      string          "json:\"name\" graphql:\"name\""
-			Private   bool            "json:\"private\" graphql:\"private\""
-			CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
-			UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
-			Owner     struct {
+			DeletedAt *time.Time      "json:\"deletedAt\" graphql:\"deletedAt\""
+		} "json:\"statusNamespace\" graphql:\"statusNamespace\""
+	} "json:\"statusNamespaceRestore\" graphql:\"statusNamespaceRestore\""
+}
+type StatusNamespaceUpdate struct {
+	StatusNamespaceUpdate struct {
+		StatusNamespace struct {
+			ID         gidx.PrefixedID "json:\"id\" graphql:\"id\""
+			Name       string          "json:\"name\" graphql:\"name\""
+			Private    bool            "json:\"private\" graphql:\"private\""
+			JSONSchema json.RawMessage "json:\"jsonSchema\" graphql:\"jsonSchema\""
+			CreatedAt  time.Time       "json:\"createdAt\" graphql:\"createdAt\""
+			UpdatedAt  time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
+			Owner      struct {
 				ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
 			} "json:\"owner\" graphql:\"owner\""
 		} "json:\"statusNamespace\" graphql:\"statusNamespace\""
+		InvalidStatusCount int64 "json:\"invalidStatusCount\" graphql:\"invalidStatusCount\""
 	} "json:\"statusNamespaceUpdate\" graphql:\"statusNamespaceUpdate\""
 }
 type StatusUpdate struct {
@@ -234,11 +473,31 @@ type StatusUpdate struct {
 			} "json:\"namespace\" graphql:\"namespace\""
 			Source    string          "json:\"source\" graphql:\"source\""
 			Data      json.RawMessage "json:\"data\" graphql:\"data\""
+			Version   int64           "json:\"version\" graphql:\"version\""
+			ExpiresAt *time.Time      "json:\"expiresAt\" graphql:\"expiresAt\""
 			CreatedAt time.Time       "json:\"createdAt\" graphql:\"createdAt\""
 			UpdatedAt time.Time       "json:\"updatedAt\" graphql:\"updatedAt\""
 		} "json:\"status\" graphql:\"status\""
 	} "json:\"statusUpdate\" graphql:\"statusUpdate\""
 }
+type StatusUpdateBatch struct {
+	StatusUpdateBatch struct {
+		Results []*struct {
+			Status *struct {
+				ID       gidx.PrefixedID "json:\"id\" graphql:\"id\""
+				Metadata struct {
+					NodeID gidx.PrefixedID "json:\"nodeID\" graphql:\"nodeID\""
+				} "json:\"metadata\" graphql:\"metadata\""
+				Namespace struct {
+					ID gidx.PrefixedID "json:\"id\" graphql:\"id\""
+				} "json:\"namespace\" graphql:\"namespace\""
+				Source string          "json:\"source\" graphql:\"source\""
+				Data   json.RawMessage "json:\"data\" graphql:\"data\""
+			} "json:\"status\" graphql:\"status\""
+			Error *string "json:\"error\" graphql:\"error\""
+		} "json:\"results\" graphql:\"results\""
+	} "json:\"statusUpdateBatch\" graphql:\"statusUpdateBatch\""
+}
 
 const AnnotationDeleteDocument = `mutation AnnotationDelete ($input: AnnotationDeleteInput!) {
 	annotationDelete(input: $input) {
@@ -266,6 +525,7 @@ const AnnotationNamespaceCreateDocument = `mutation AnnotationNamespaceCreate ($
 			id
 			name
 			private
+			jsonSchema
 			createdAt
 			updatedAt
 			owner {
@@ -293,6 +553,13 @@ const AnnotationNamespaceDeleteDocument = `mutation AnnotationNamespaceDelete ($
 	annotationNamespaceDelete(id: $id, force: $force) {
 		deletedID
 		annotationDeletedCount
+		namespaceDeletion {
+			id
+			namespaceID
+			state
+			totalCount
+			deletedCount
+		}
 	}
 }
 `
@@ -311,18 +578,44 @@ func (c *Client) AnnotationNamespaceDelete(ctx context.Context, id gidx.Prefixed
 	return &res, nil
 }
 
+const AnnotationNamespaceRestoreDocument = `mutation AnnotationNamespaceRestore ($id: ID!) {
+	annotationNamespaceRestore(id: $id) {
+		annotationNamespace {
+			id
+			name
+			deletedAt
+		}
+	}
+}
+`
+
+func (c *Client) AnnotationNamespaceRestore(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*AnnotationNamespaceRestore, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var res AnnotationNamespaceRestore
+	if err := c.Client.Post(ctx, "AnnotationNamespaceRestore", AnnotationNamespaceRestoreDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const AnnotationNamespaceUpdateDocument = `mutation AnnotationNamespaceUpdate ($id: ID!, $input: UpdateAnnotationNamespaceInput!) {
 	annotationNamespaceUpdate(id: $id, input: $input) {
 		annotationNamespace {
 			id
 			name
 			private
+			jsonSchema
 			createdAt
 			updatedAt
 			owner {
 				id
 			}
 		}
+		invalidAnnotationCount
 	}
 }
 `
@@ -357,6 +650,7 @@ const AnnotationUpdateDocument = `mutation AnnotationUpdate ($input: AnnotationU
 				private
 			}
 			data
+			version
 			createdAt
 			updatedAt
 		}
@@ -377,6 +671,61 @@ func (c *Client) AnnotationUpdate(ctx context.Context, input AnnotationUpdateInp
 	return &res, nil
 }
 
+const AnnotationUpdateBatchDocument = `mutation AnnotationUpdateBatch ($input: AnnotationUpdateBatchInput!) {
+	annotationUpdateBatch(input: $input) {
+		results {
+			annotation {
+				id
+				metadata {
+					nodeID
+				}
+				namespace {
+					id
+				}
+				data
+			}
+			error
+		}
+	}
+}
+`
+
+func (c *Client) AnnotationUpdateBatch(ctx context.Context, input AnnotationUpdateBatchInput, httpRequestOptions ...client.HTTPRequestOption) (*AnnotationUpdateBatch, error) {
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var res AnnotationUpdateBatch
+	if err := c.Client.Post(ctx, "AnnotationUpdateBatch", AnnotationUpdateBatchDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetAnnotationEntitiesDocument = `query GetAnnotationEntities ($representations: [_Any!]!) {
+	_entities(representations: $representations) {
+		... on Annotation {
+			id
+			data
+		}
+	}
+}
+`
+
+func (c *Client) GetAnnotationEntities(ctx context.Context, representations []map[string]interface{}, httpRequestOptions ...client.HTTPRequestOption) (*GetAnnotationEntities, error) {
+	vars := map[string]interface{}{
+		"representations": representations,
+	}
+
+	var res GetAnnotationEntities
+	if err := c.Client.Post(ctx, "GetAnnotationEntities", GetAnnotationEntitiesDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetAnnotationNamespaceDocument = `query GetAnnotationNamespace ($annotationNamespaceId: ID!) {
 	annotationNamespace(id: $annotationNamespaceId) {
 		id
@@ -386,10 +735,14 @@ const GetAnnotationNamespaceDocument = `query GetAnnotationNamespace ($annotatio
 			id
 		}
 		annotations {
-			id
-			data
-			createdAt
-			updatedAt
+			edges {
+				node {
+					id
+					data
+					createdAt
+					updatedAt
+				}
+			}
 		}
 		createdAt
 		updatedAt
@@ -410,6 +763,118 @@ func (c *Client) GetAnnotationNamespace(ctx context.Context, annotationNamespace
 	return &res, nil
 }
 
+const GetAnnotationNamespaceAnnotationsDocument = `query GetAnnotationNamespaceAnnotations ($annotationNamespaceId: ID!, $first: Int, $after: Cursor, $orderBy: AnnotationOrder, $where: AnnotationWhereInput) {
+	annotationNamespace(id: $annotationNamespaceId) {
+		id
+		annotations(first: $first, after: $after, orderBy: $orderBy, where: $where) {
+			totalCount
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				node {
+					id
+					data
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetAnnotationNamespaceAnnotations(ctx context.Context, annotationNamespaceID gidx.PrefixedID, first *int64, after *string, orderBy *AnnotationOrder, where *AnnotationWhereInput, httpRequestOptions ...client.HTTPRequestOption) (*GetAnnotationNamespaceAnnotations, error) {
+	vars := map[string]interface{}{
+		"annotationNamespaceId": annotationNamespaceID,
+		"first":                 first,
+		"after":                 after,
+		"orderBy":               orderBy,
+		"where":                 where,
+	}
+
+	var res GetAnnotationNamespaceAnnotations
+	if err := c.Client.Post(ctx, "GetAnnotationNamespaceAnnotations", GetAnnotationNamespaceAnnotationsDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetAnnotationNamespaceByNameDocument = `query GetAnnotationNamespaceByName ($ownerID: ID!, $name: String!) {
+	annotationNamespaceByName(ownerID: $ownerID, name: $name) {
+		id
+		name
+		private
+		owner {
+			id
+		}
+	}
+}
+`
+
+func (c *Client) GetAnnotationNamespaceByName(ctx context.Context, ownerID gidx.PrefixedID, name string, httpRequestOptions ...client.HTTPRequestOption) (*GetAnnotationNamespaceByName, error) {
+	vars := map[string]interface{}{
+		"ownerID": ownerID,
+		"name":    name,
+	}
+
+	var res GetAnnotationNamespaceByName
+	if err := c.Client.Post(ctx, "GetAnnotationNamespaceByName", GetAnnotationNamespaceByNameDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetMetadataEntitiesDocument = `query GetMetadataEntities ($representations: [_Any!]!) {
+	_entities(representations: $representations) {
+		... on Metadata {
+			id
+			nodeID
+		}
+	}
+}
+`
+
+func (c *Client) GetMetadataEntities(ctx context.Context, representations []map[string]interface{}, httpRequestOptions ...client.HTTPRequestOption) (*GetMetadataEntities, error) {
+	vars := map[string]interface{}{
+		"representations": representations,
+	}
+
+	var res GetMetadataEntities
+	if err := c.Client.Post(ctx, "GetMetadataEntities", GetMetadataEntitiesDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetNamespaceDeletionDocument = `query GetNamespaceDeletion ($id: ID!) {
+	namespaceDeletion(id: $id) {
+		id
+		namespaceID
+		ownerID
+		state
+		totalCount
+		deletedCount
+		completedAt
+	}
+}
+`
+
+func (c *Client) GetNamespaceDeletion(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*GetNamespaceDeletion, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var res GetNamespaceDeletion
+	if err := c.Client.Post(ctx, "GetNamespaceDeletion", GetNamespaceDeletionDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetNodeMetadataDocument = `query GetNodeMetadata ($id: ID!) {
 	_entities(representations: {__typename:"MetadataNode",id:$id}) {
 		... on MetadataNode {
@@ -443,6 +908,109 @@ func (c *Client) GetNodeMetadata(ctx context.Context, id gidx.PrefixedID, httpRe
 	return &res, nil
 }
 
+const GetNodeMetadataAsOfDocument = `query GetNodeMetadataAsOf ($id: ID!, $asOf: Time) {
+	_entities(representations: {__typename:"MetadataNode",id:$id}) {
+		... on MetadataNode {
+			metadata {
+				annotations(asOf: $asOf) {
+					edges {
+						node {
+							id
+							namespace {
+								id
+							}
+							data
+							history {
+								edges {
+									node {
+										operation
+										data
+									}
+								}
+							}
+						}
+					}
+				}
+				statuses(asOf: $asOf) {
+					edges {
+						node {
+							id
+							namespace {
+								id
+							}
+							source
+							data
+							history {
+								edges {
+									node {
+										operation
+										source
+										data
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetNodeMetadataAsOf(ctx context.Context, id gidx.PrefixedID, asOf *time.Time, httpRequestOptions ...client.HTTPRequestOption) (*GetNodeMetadataAsOf, error) {
+	vars := map[string]interface{}{
+		"id":   id,
+		"asOf": asOf,
+	}
+
+	var res GetNodeMetadataAsOf
+	if err := c.Client.Post(ctx, "GetNodeMetadataAsOf", GetNodeMetadataAsOfDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetNodeMetadataWhereDocument = `query GetNodeMetadataWhere ($id: ID!, $annotationsWhere: AnnotationWhereInput, $statusesWhere: StatusWhereInput) {
+	_entities(representations: {__typename:"MetadataNode",id:$id}) {
+		... on MetadataNode {
+			metadata {
+				annotations(where: $annotationsWhere) {
+					edges {
+						node {
+							id
+						}
+					}
+				}
+				statuses(where: $statusesWhere) {
+					edges {
+						node {
+							id
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetNodeMetadataWhere(ctx context.Context, id gidx.PrefixedID, annotationsWhere *AnnotationWhereInput, statusesWhere *StatusWhereInput, httpRequestOptions ...client.HTTPRequestOption) (*GetNodeMetadataWhere, error) {
+	vars := map[string]interface{}{
+		"id":               id,
+		"annotationsWhere": annotationsWhere,
+		"statusesWhere":    statusesWhere,
+	}
+
+	var res GetNodeMetadataWhere
+	if err := c.Client.Post(ctx, "GetNodeMetadataWhere", GetNodeMetadataWhereDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const GetResourceOwnerAnnotationNamespacesDocument = `query GetResourceOwnerAnnotationNamespaces ($id: ID!, $orderBy: AnnotationNamespaceOrder) {
 	_entities(representations: {__typename:"ResourceOwner",id:$id}) {
 		... on ResourceOwner {
@@ -509,6 +1077,98 @@ func (c *Client) GetResourceProviderStatusNamespaces(ctx context.Context, id gid
 	return &res, nil
 }
 
+const GetStatusEntitiesDocument = `query GetStatusEntities ($representations: [_Any!]!) {
+	_entities(representations: $representations) {
+		... on Status {
+			id
+			source
+			data
+		}
+	}
+}
+`
+
+func (c *Client) GetStatusEntities(ctx context.Context, representations []map[string]interface{}, httpRequestOptions ...client.HTTPRequestOption) (*GetStatusEntities, error) {
+	vars := map[string]interface{}{
+		"representations": representations,
+	}
+
+	var res GetStatusEntities
+	if err := c.Client.Post(ctx, "GetStatusEntities", GetStatusEntitiesDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetStatusNamespaceByNameDocument = `query GetStatusNamespaceByName ($ownerID: ID!, $name: String!) {
+	statusNamespaceByName(ownerID: $ownerID, name: $name) {
+		id
+		name
+		private
+		owner {
+			id
+		}
+	}
+}
+`
+
+func (c *Client) GetStatusNamespaceByName(ctx context.Context, ownerID gidx.PrefixedID, name string, httpRequestOptions ...client.HTTPRequestOption) (*GetStatusNamespaceByName, error) {
+	vars := map[string]interface{}{
+		"ownerID": ownerID,
+		"name":    name,
+	}
+
+	var res GetStatusNamespaceByName
+	if err := c.Client.Post(ctx, "GetStatusNamespaceByName", GetStatusNamespaceByNameDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetStatusNamespaceStatusesDocument = `query GetStatusNamespaceStatuses ($statusNamespaceId: ID!, $first: Int, $after: Cursor, $orderBy: StatusOrder, $where: StatusWhereInput) {
+	statusNamespace(id: $statusNamespaceId) {
+		id
+		name
+		statuses(first: $first, after: $after, orderBy: $orderBy, where: $where) {
+			totalCount
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			edges {
+				node {
+					id
+					source
+					data
+					metadata {
+						nodeID
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetStatusNamespaceStatuses(ctx context.Context, statusNamespaceID gidx.PrefixedID, first *int64, after *string, orderBy *StatusOrder, where *StatusWhereInput, httpRequestOptions ...client.HTTPRequestOption) (*GetStatusNamespaceStatuses, error) {
+	vars := map[string]interface{}{
+		"statusNamespaceId": statusNamespaceID,
+		"first":             first,
+		"after":             after,
+		"orderBy":           orderBy,
+		"where":             where,
+	}
+
+	var res GetStatusNamespaceStatuses
+	if err := c.Client.Post(ctx, "GetStatusNamespaceStatuses", GetStatusNamespaceStatusesDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const StatusDeleteDocument = `mutation StatusDelete ($input: StatusDeleteInput!) {
 	statusDelete(input: $input) {
 		deletedID
@@ -535,6 +1195,7 @@ const StatusNamespaceCreateDocument = `mutation StatusNamespaceCreate ($input: C
 			id
 			name
 			private
+			jsonSchema
 			createdAt
 			updatedAt
 			owner {
@@ -562,6 +1223,13 @@ const StatusNamespaceDeleteDocument = `mutation StatusNamespaceDelete ($id: ID!,
 	statusNamespaceDelete(id: $id, force: $force) {
 		deletedID
 		statusDeletedCount
+		namespaceDeletion {
+			id
+			namespaceID
+			state
+			totalCount
+			deletedCount
+		}
 	}
 }
 `
@@ -580,18 +1248,44 @@ func (c *Client) StatusNamespaceDelete(ctx context.Context, id gidx.PrefixedID,
 	return &res, nil
 }
 
+const StatusNamespaceRestoreDocument = `mutation StatusNamespaceRestore ($id: ID!) {
+	statusNamespaceRestore(id: $id) {
+		statusNamespace {
+			id
+			name
+			deletedAt
+		}
+	}
+}
+`
+
+func (c *Client) StatusNamespaceRestore(ctx context.Context, id gidx.PrefixedID, httpRequestOptions ...client.HTTPRequestOption) (*StatusNamespaceRestore, error) {
+	vars := map[string]interface{}{
+		"id": id,
+	}
+
+	var res StatusNamespaceRestore
+	if err := c.Client.Post(ctx, "StatusNamespaceRestore", StatusNamespaceRestoreDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 const StatusNamespaceUpdateDocument = `mutation StatusNamespaceUpdate ($id: ID!, $input: UpdateStatusNamespaceInput!) {
 	statusNamespaceUpdate(id: $id, input: $input) {
 		statusNamespace {
 			id
 			name
 			private
+			jsonSchema
 			createdAt
 			updatedAt
 			owner {
 				id
 			}
 		}
+		invalidStatusCount
 	}
 }
 `
@@ -627,6 +1321,8 @@ const StatusUpdateDocument = `mutation StatusUpdate ($input: StatusUpdateInput!)
 			}
 			source
 			data
+			version
+			expiresAt
 			createdAt
 			updatedAt
 		}
@@ -646,3 +1342,36 @@ func (c *Client) StatusUpdate(ctx context.Context, input StatusUpdateInput, http
 
 	return &res, nil
 }
+
+const StatusUpdateBatchDocument = `mutation StatusUpdateBatch ($input: StatusUpdateBatchInput!) {
+	statusUpdateBatch(input: $input) {
+		results {
+			status {
+				id
+				metadata {
+					nodeID
+				}
+				namespace {
+					id
+				}
+				source
+				data
+			}
+			error
+		}
+	}
+}
+`
+
+func (c *Client) StatusUpdateBatch(ctx context.Context, input StatusUpdateBatchInput, httpRequestOptions ...client.HTTPRequestOption) (*StatusUpdateBatch, error) {
+	vars := map[string]interface{}{
+		"input": input,
+	}
+
+	var res StatusUpdateBatch
+	if err := c.Client.Post(ctx, "StatusUpdateBatch", StatusUpdateBatchDocument, &res, vars, httpRequestOptions...); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
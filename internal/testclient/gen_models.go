@@ -32,9 +32,13 @@ type Annotation struct {
 	// ID of the metadata of this annotation
 	MetadataID gidx.PrefixedID `json:"metadataID"`
 	// JSON formatted data of this annotation.
-	Data      json.RawMessage     `json:"data"`
+	Data json.RawMessage `json:"data"`
+	// Version of the annotation, incremented each time its data is set.
+	Version   int64               `json:"version"`
 	Namespace AnnotationNamespace `json:"namespace"`
 	Metadata  Metadata            `json:"metadata"`
+	// Changes made to this annotation, each with the data from before the change.
+	History AnnotationHistoryConnection `json:"history"`
 }
 
 func (Annotation) IsNode() {}
@@ -44,6 +48,16 @@ func (this Annotation) GetID() gidx.PrefixedID { return this.ID }
 
 func (Annotation) IsEntity() {}
 
+// A change made to a annotation.
+type AnnotationChange struct {
+	// The kind of change made.
+	Operation ChangeOperation `json:"operation"`
+	// The ID of the changed annotation.
+	AnnotationID gidx.PrefixedID `json:"annotationID"`
+	// The annotation as it is now, empty when it was deleted.
+	Annotation *Annotation `json:"annotation,omitempty"`
+}
+
 // A connection to a list of items.
 type AnnotationConnection struct {
 	// A list of edges.
@@ -58,8 +72,14 @@ type AnnotationConnection struct {
 type AnnotationDeleteInput struct {
 	// The node ID for this annotation.
 	NodeID gidx.PrefixedID `json:"nodeID"`
-	// The namespace ID for this annotation.
-	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	// The namespace ID for this annotation. Either namespaceID, or ownerID and namespaceName, must be set.
+	NamespaceID *gidx.PrefixedID `json:"namespaceID,omitempty"`
+	// The ID of the owner of the namespace, to name the namespace by namespaceName instead of namespaceID.
+	OwnerID *gidx.PrefixedID `json:"ownerID,omitempty"`
+	// The name of the namespace, used with ownerID instead of namespaceID.
+	NamespaceName *string `json:"namespaceName,omitempty"`
+	// The version the annotation must be at for it to be deleted.
+	ExpectedVersion *int64 `json:"expectedVersion,omitempty"`
 }
 
 // Return response from annotationDelete
@@ -76,16 +96,118 @@ type AnnotationEdge struct {
 	Cursor string `json:"cursor"`
 }
 
+type AnnotationHistory struct {
+	ID gidx.PrefixedID `json:"id"`
+	// Time the change to the annotation was made.
+	CreatedAt time.Time `json:"createdAt"`
+	// ID of the annotation that was changed.
+	AnnotationID gidx.PrefixedID `json:"annotationID"`
+	// ID of the metadata of the annotation.
+	MetadataID gidx.PrefixedID `json:"metadataID"`
+	// ID of the annotation namespace of the annotation.
+	AnnotationNamespaceID gidx.PrefixedID `json:"annotationNamespaceID"`
+	// The kind of change made to the annotation.
+	Operation AnnotationHistoryOperation `json:"operation"`
+	// JSON formatted data of the annotation before the change. Empty when the annotation was created.
+	Data json.RawMessage `json:"data,omitempty"`
+	// The subject that made the change, if known.
+	Actor *string `json:"actor,omitempty"`
+}
+
+func (AnnotationHistory) IsNode() {}
+
+// The id of the object.
+func (this AnnotationHistory) GetID() gidx.PrefixedID { return this.ID }
+
+// A connection to a list of items.
+type AnnotationHistoryConnection struct {
+	// A list of edges.
+	Edges []*AnnotationHistoryEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// An edge in a connection.
+type AnnotationHistoryEdge struct {
+	// The item at the end of the edge.
+	Node *AnnotationHistory `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for AnnotationHistory connections
+type AnnotationHistoryOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order AnnotationHistories.
+	Field AnnotationHistoryOrderField `json:"field"`
+}
+
+// AnnotationHistoryWhereInput is used for filtering AnnotationHistory objects.
+// Input was generated by ent.
+type AnnotationHistoryWhereInput struct {
+	Not *AnnotationHistoryWhereInput   `json:"not,omitempty"`
+	And []*AnnotationHistoryWhereInput `json:"and,omitempty"`
+	Or  []*AnnotationHistoryWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID      *gidx.PrefixedID  `json:"id,omitempty"`
+	IDNeq   *gidx.PrefixedID  `json:"idNEQ,omitempty"`
+	IDIn    []gidx.PrefixedID `json:"idIn,omitempty"`
+	IDNotIn []gidx.PrefixedID `json:"idNotIn,omitempty"`
+	IDGt    *gidx.PrefixedID  `json:"idGT,omitempty"`
+	IDGte   *gidx.PrefixedID  `json:"idGTE,omitempty"`
+	IDLt    *gidx.PrefixedID  `json:"idLT,omitempty"`
+	IDLte   *gidx.PrefixedID  `json:"idLTE,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// operation field predicates
+	Operation      *AnnotationHistoryOperation  `json:"operation,omitempty"`
+	OperationNeq   *AnnotationHistoryOperation  `json:"operationNEQ,omitempty"`
+	OperationIn    []AnnotationHistoryOperation `json:"operationIn,omitempty"`
+	OperationNotIn []AnnotationHistoryOperation `json:"operationNotIn,omitempty"`
+	// actor field predicates
+	Actor             *string  `json:"actor,omitempty"`
+	ActorNeq          *string  `json:"actorNEQ,omitempty"`
+	ActorIn           []string `json:"actorIn,omitempty"`
+	ActorNotIn        []string `json:"actorNotIn,omitempty"`
+	ActorGt           *string  `json:"actorGT,omitempty"`
+	ActorGte          *string  `json:"actorGTE,omitempty"`
+	ActorLt           *string  `json:"actorLT,omitempty"`
+	ActorLte          *string  `json:"actorLTE,omitempty"`
+	ActorContains     *string  `json:"actorContains,omitempty"`
+	ActorHasPrefix    *string  `json:"actorHasPrefix,omitempty"`
+	ActorHasSuffix    *string  `json:"actorHasSuffix,omitempty"`
+	ActorIsNil        *bool    `json:"actorIsNil,omitempty"`
+	ActorNotNil       *bool    `json:"actorNotNil,omitempty"`
+	ActorEqualFold    *string  `json:"actorEqualFold,omitempty"`
+	ActorContainsFold *string  `json:"actorContainsFold,omitempty"`
+}
+
 type AnnotationNamespace struct {
 	// The ID for the annotation namespace.
 	ID        gidx.PrefixedID `json:"id"`
 	CreatedAt time.Time       `json:"createdAt"`
 	UpdatedAt time.Time       `json:"updatedAt"`
+	// Time the record was deleted, it's purged once the purge delay has passed.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 	// The name of the annotation namespace.
 	Name string `json:"name"`
 	// Flag for if this namespace is private.
-	Private     bool          `json:"private"`
-	Annotations []*Annotation `json:"annotations,omitempty"`
+	Private bool `json:"private"`
+	// Flag for if this namespace is being deleted with its annotations. No annotations can be written to it.
+	Deleting bool `json:"deleting"`
+	// JSON Schema that annotation data in this namespace must validate against.
+	JSONSchema  json.RawMessage      `json:"jsonSchema,omitempty"`
+	Annotations AnnotationConnection `json:"annotations"`
 	// The owner of the annotation namespace.
 	Owner ResourceOwner `json:"owner"`
 }
@@ -119,6 +241,8 @@ type AnnotationNamespaceDeletePayload struct {
 	DeletedID gidx.PrefixedID `json:"deletedID"`
 	// The count of annotations deleted
 	AnnotationDeletedCount int64 `json:"annotationDeletedCount"`
+	// The deletion of the namespace, which purges it with its annotations in the background.
+	NamespaceDeletion *NamespaceDeletion `json:"namespaceDeletion,omitempty"`
 }
 
 // An edge in a connection.
@@ -137,10 +261,18 @@ type AnnotationNamespaceOrder struct {
 	Field AnnotationNamespaceOrderField `json:"field"`
 }
 
+// Return response from annotationNamespaceRestore
+type AnnotationNamespaceRestorePayload struct {
+	// The restored annotation namespace.
+	AnnotationNamespace AnnotationNamespace `json:"annotationNamespace"`
+}
+
 // Return response from annotationNamespaceUpdate
 type AnnotationNamespaceUpdatePayload struct {
 	// The updated annotation namespace.
 	AnnotationNamespace AnnotationNamespace `json:"annotationNamespace"`
+	// The count of existing annotations that don't validate against the updated JSON schema
+	InvalidAnnotationCount int64 `json:"invalidAnnotationCount"`
 }
 
 // AnnotationNamespaceWhereInput is used for filtering AnnotationNamespace objects.
@@ -203,14 +335,44 @@ type AnnotationOrder struct {
 	Field AnnotationOrderField `json:"field"`
 }
 
+// Input information to update many annotations.
+type AnnotationUpdateBatchInput struct {
+	// The annotations to update.
+	Items []*AnnotationUpdateInput `json:"items"`
+	// How items that fail are handled, defaults to applying all the items or none of them.
+	Mode *BatchMode `json:"mode,omitempty"`
+}
+
+// Return response from annotationUpdateBatch
+type AnnotationUpdateBatchResponse struct {
+	// The result of each item, in the order of the input items.
+	Results []*AnnotationUpdateBatchResult `json:"results"`
+}
+
+// The result of an item of annotationUpdateBatch.
+type AnnotationUpdateBatchResult struct {
+	// The set annotation, when the item succeeded.
+	Annotation *Annotation `json:"annotation,omitempty"`
+	// The reason the item failed.
+	Error *string `json:"error,omitempty"`
+}
+
 // Input information to update an annotation.
 type AnnotationUpdateInput struct {
 	// The node ID for this annotation.
 	NodeID gidx.PrefixedID `json:"nodeID"`
-	// The namespace ID for this annotation.
-	NamespaceID gidx.PrefixedID `json:"namespaceID"`
-	// The data to save in this annotation.
+	// The namespace ID for this annotation. Either namespaceID, or ownerID and namespaceName, must be set.
+	NamespaceID *gidx.PrefixedID `json:"namespaceID,omitempty"`
+	// The ID of the owner of the namespace, to name the namespace by namespaceName instead of namespaceID.
+	OwnerID *gidx.PrefixedID `json:"ownerID,omitempty"`
+	// The name of the namespace, used with ownerID instead of namespaceID.
+	NamespaceName *string `json:"namespaceName,omitempty"`
+	// The data to save in this annotation. When a patch mode is used, this is the patch to apply to the stored data.
 	Data json.RawMessage `json:"data"`
+	// How the data is applied to the stored data, defaults to replacing it.
+	Mode *DataUpdateMode `json:"mode,omitempty"`
+	// The version the annotation must be at for the update to be applied, 0 when it must not exist yet.
+	ExpectedVersion *int64 `json:"expectedVersion,omitempty"`
 }
 
 // Return response from annotationUpdate
@@ -258,6 +420,10 @@ type AnnotationWhereInput struct {
 	// metadata edge predicates
 	HasMetadata     *bool                 `json:"hasMetadata,omitempty"`
 	HasMetadataWith []*MetadataWhereInput `json:"hasMetadataWith,omitempty"`
+	// Annotations whose data contains the given JSON document.
+	DataContains json.RawMessage `json:"dataContains,omitempty"`
+	// Annotations with the given value at a path in their data.
+	DataPath *DataPathPredicate `json:"dataPath,omitempty"`
 }
 
 // Input information to create an annotation namespace.
@@ -268,6 +434,8 @@ type CreateAnnotationNamespaceInput struct {
 	OwnerID gidx.PrefixedID `json:"ownerID"`
 	// Flag for if this namespace is private.
 	Private *bool `json:"private,omitempty"`
+	// JSON Schema that annotation data in this namespace must validate against.
+	JSONSchema json.RawMessage `json:"jsonSchema,omitempty"`
 }
 
 // Input information to create a status namespace.
@@ -287,6 +455,18 @@ type CreateStatusNamespaceInput struct {
 	ResourceProviderID gidx.PrefixedID `json:"resourceProviderID"`
 	// Flag for if this namespace is private.
 	Private *bool `json:"private,omitempty"`
+	// JSON Schema that status data in this namespace must validate against.
+	JSONSchema json.RawMessage `json:"jsonSchema,omitempty"`
+	// Number of seconds statuses in this namespace are kept after they were last updated, unless the update sets its own expiry.
+	DefaultTTL *int64 `json:"defaultTTL,omitempty"`
+}
+
+// DataPathPredicate compares the value at a path in the JSON data to the given value.
+type DataPathPredicate struct {
+	// Path to the value, each element is an object key or an array index, e.g. ["conditions", "0", "state"].
+	Path []string `json:"path"`
+	// The value to compare the value at the path with.
+	Value json.RawMessage `json:"value"`
 }
 
 type Metadata struct {
@@ -385,6 +565,135 @@ type MetadataWhereInput struct {
 	HasStatusesWith []*StatusWhereInput `json:"hasStatusesWith,omitempty"`
 }
 
+type NamespaceDeletion struct {
+	// The ID for the namespace deletion.
+	ID        gidx.PrefixedID `json:"id"`
+	CreatedAt time.Time       `json:"createdAt"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+	// ID of the annotation or status namespace being deleted.
+	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	// ID of the owner or resource provider of the namespace.
+	OwnerID gidx.PrefixedID `json:"ownerID"`
+	// The state of the deletion. Pending deletions are canceled when the namespace is restored.
+	State NamespaceDeletionState `json:"state"`
+	// Number of statuses or annotations in the namespace when the deletion was started.
+	TotalCount int64 `json:"totalCount"`
+	// Number of statuses or annotations deleted so far.
+	DeletedCount int64 `json:"deletedCount"`
+	// Time the namespace was deleted.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+}
+
+func (NamespaceDeletion) IsNode() {}
+
+// The id of the object.
+func (this NamespaceDeletion) GetID() gidx.PrefixedID { return this.ID }
+
+// Ordering options for NamespaceDeletion connections
+type NamespaceDeletionOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order NamespaceDeletions.
+	Field NamespaceDeletionOrderField `json:"field"`
+}
+
+// NamespaceDeletionWhereInput is used for filtering NamespaceDeletion objects.
+// Input was generated by ent.
+type NamespaceDeletionWhereInput struct {
+	Not *NamespaceDeletionWhereInput   `json:"not,omitempty"`
+	And []*NamespaceDeletionWhereInput `json:"and,omitempty"`
+	Or  []*NamespaceDeletionWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID      *gidx.PrefixedID  `json:"id,omitempty"`
+	IDNeq   *gidx.PrefixedID  `json:"idNEQ,omitempty"`
+	IDIn    []gidx.PrefixedID `json:"idIn,omitempty"`
+	IDNotIn []gidx.PrefixedID `json:"idNotIn,omitempty"`
+	IDGt    *gidx.PrefixedID  `json:"idGT,omitempty"`
+	IDGte   *gidx.PrefixedID  `json:"idGTE,omitempty"`
+	IDLt    *gidx.PrefixedID  `json:"idLT,omitempty"`
+	IDLte   *gidx.PrefixedID  `json:"idLTE,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// updated_at field predicates
+	UpdatedAt      *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq   *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn    []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt    *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte   *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt    *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte   *time.Time   `json:"updatedAtLTE,omitempty"`
+	// namespace_id field predicates
+	NamespaceID             *gidx.PrefixedID  `json:"namespaceID,omitempty"`
+	NamespaceIDNeq          *gidx.PrefixedID  `json:"namespaceIDNEQ,omitempty"`
+	NamespaceIDIn           []gidx.PrefixedID `json:"namespaceIDIn,omitempty"`
+	NamespaceIDNotIn        []gidx.PrefixedID `json:"namespaceIDNotIn,omitempty"`
+	NamespaceIDGt           *gidx.PrefixedID  `json:"namespaceIDGT,omitempty"`
+	NamespaceIDGte          *gidx.PrefixedID  `json:"namespaceIDGTE,omitempty"`
+	NamespaceIDLt           *gidx.PrefixedID  `json:"namespaceIDLT,omitempty"`
+	NamespaceIDLte          *gidx.PrefixedID  `json:"namespaceIDLTE,omitempty"`
+	NamespaceIDContains     *gidx.PrefixedID  `json:"namespaceIDContains,omitempty"`
+	NamespaceIDHasPrefix    *gidx.PrefixedID  `json:"namespaceIDHasPrefix,omitempty"`
+	NamespaceIDHasSuffix    *gidx.PrefixedID  `json:"namespaceIDHasSuffix,omitempty"`
+	NamespaceIDEqualFold    *gidx.PrefixedID  `json:"namespaceIDEqualFold,omitempty"`
+	NamespaceIDContainsFold *gidx.PrefixedID  `json:"namespaceIDContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *gidx.PrefixedID  `json:"ownerID,omitempty"`
+	OwnerIDNeq          *gidx.PrefixedID  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []gidx.PrefixedID `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []gidx.PrefixedID `json:"ownerIDNotIn,omitempty"`
+	OwnerIDGt           *gidx.PrefixedID  `json:"ownerIDGT,omitempty"`
+	OwnerIDGte          *gidx.PrefixedID  `json:"ownerIDGTE,omitempty"`
+	OwnerIDLt           *gidx.PrefixedID  `json:"ownerIDLT,omitempty"`
+	OwnerIDLte          *gidx.PrefixedID  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *gidx.PrefixedID  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *gidx.PrefixedID  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *gidx.PrefixedID  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDEqualFold    *gidx.PrefixedID  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *gidx.PrefixedID  `json:"ownerIDContainsFold,omitempty"`
+	// state field predicates
+	State      *NamespaceDeletionState  `json:"state,omitempty"`
+	StateNeq   *NamespaceDeletionState  `json:"stateNEQ,omitempty"`
+	StateIn    []NamespaceDeletionState `json:"stateIn,omitempty"`
+	StateNotIn []NamespaceDeletionState `json:"stateNotIn,omitempty"`
+	// total_count field predicates
+	TotalCount      *int64  `json:"totalCount,omitempty"`
+	TotalCountNeq   *int64  `json:"totalCountNEQ,omitempty"`
+	TotalCountIn    []int64 `json:"totalCountIn,omitempty"`
+	TotalCountNotIn []int64 `json:"totalCountNotIn,omitempty"`
+	TotalCountGt    *int64  `json:"totalCountGT,omitempty"`
+	TotalCountGte   *int64  `json:"totalCountGTE,omitempty"`
+	TotalCountLt    *int64  `json:"totalCountLT,omitempty"`
+	TotalCountLte   *int64  `json:"totalCountLTE,omitempty"`
+	// deleted_count field predicates
+	DeletedCount      *int64  `json:"deletedCount,omitempty"`
+	DeletedCountNeq   *int64  `json:"deletedCountNEQ,omitempty"`
+	DeletedCountIn    []int64 `json:"deletedCountIn,omitempty"`
+	DeletedCountNotIn []int64 `json:"deletedCountNotIn,omitempty"`
+	DeletedCountGt    *int64  `json:"deletedCountGT,omitempty"`
+	DeletedCountGte   *int64  `json:"deletedCountGTE,omitempty"`
+	DeletedCountLt    *int64  `json:"deletedCountLT,omitempty"`
+	DeletedCountLte   *int64  `json:"deletedCountLTE,omitempty"`
+	// completed_at field predicates
+	CompletedAt       *time.Time   `json:"completedAt,omitempty"`
+	CompletedAtNeq    *time.Time   `json:"completedAtNEQ,omitempty"`
+	CompletedAtIn     []*time.Time `json:"completedAtIn,omitempty"`
+	CompletedAtNotIn  []*time.Time `json:"completedAtNotIn,omitempty"`
+	CompletedAtGt     *time.Time   `json:"completedAtGT,omitempty"`
+	CompletedAtGte    *time.Time   `json:"completedAtGTE,omitempty"`
+	CompletedAtLt     *time.Time   `json:"completedAtLT,omitempty"`
+	CompletedAtLte    *time.Time   `json:"completedAtLTE,omitempty"`
+	CompletedAtIsNil  *bool        `json:"completedAtIsNil,omitempty"`
+	CompletedAtNotNil *bool        `json:"completedAtNotNil,omitempty"`
+}
+
 // Information about pagination in a connection.
 // https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
 type PageInfo struct {
@@ -416,9 +725,15 @@ type Status struct {
 	StatusNamespaceID gidx.PrefixedID `json:"statusNamespaceID"`
 	Source            string          `json:"source"`
 	// JSON formatted data of this annotation.
-	Data      json.RawMessage `json:"data"`
+	Data json.RawMessage `json:"data"`
+	// Version of the status, incremented each time its data is set.
+	Version int64 `json:"version"`
+	// Time the status expires. Expired statuses are hidden and eventually deleted.
+	ExpiresAt *time.Time      `json:"expiresAt,omitempty"`
 	Namespace StatusNamespace `json:"namespace"`
 	Metadata  Metadata        `json:"metadata"`
+	// Changes made to this status, each with the data from before the change.
+	History StatusHistoryConnection `json:"history"`
 }
 
 func (Status) IsNode() {}
@@ -428,6 +743,16 @@ func (this Status) GetID() gidx.PrefixedID { return this.ID }
 
 func (Status) IsEntity() {}
 
+// A change made to a status.
+type StatusChange struct {
+	// The kind of change made.
+	Operation ChangeOperation `json:"operation"`
+	// The ID of the changed status.
+	StatusID gidx.PrefixedID `json:"statusID"`
+	// The status as it is now, empty when it was deleted.
+	Status *Status `json:"status,omitempty"`
+}
+
 // A connection to a list of items.
 type StatusConnection struct {
 	// A list of edges.
@@ -442,10 +767,16 @@ type StatusConnection struct {
 type StatusDeleteInput struct {
 	// The node ID for this status.
 	NodeID gidx.PrefixedID `json:"nodeID"`
-	// The namespace ID for this status.
-	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	// The namespace ID for this status. Either namespaceID, or ownerID and namespaceName, must be set.
+	NamespaceID *gidx.PrefixedID `json:"namespaceID,omitempty"`
+	// The ID of the resource provider of the namespace, to name the namespace by namespaceName instead of namespaceID.
+	OwnerID *gidx.PrefixedID `json:"ownerID,omitempty"`
+	// The name of the namespace, used with ownerID instead of namespaceID.
+	NamespaceName *string `json:"namespaceName,omitempty"`
 	// The source for this status.
 	Source string `json:"source"`
+	// The version the status must be at for it to be deleted.
+	ExpectedVersion *int64 `json:"expectedVersion,omitempty"`
 }
 
 // Return response from statusDelete
@@ -462,15 +793,136 @@ type StatusEdge struct {
 	Cursor string `json:"cursor"`
 }
 
+type StatusHistory struct {
+	ID gidx.PrefixedID `json:"id"`
+	// Time the change to the status was made.
+	CreatedAt time.Time `json:"createdAt"`
+	// ID of the status that was changed.
+	StatusID gidx.PrefixedID `json:"statusID"`
+	// ID of the metadata of the status.
+	MetadataID gidx.PrefixedID `json:"metadataID"`
+	// ID of the status namespace of the status.
+	StatusNamespaceID gidx.PrefixedID `json:"statusNamespaceID"`
+	// Source of the status.
+	Source string `json:"source"`
+	// The kind of change made to the status.
+	Operation StatusHistoryOperation `json:"operation"`
+	// JSON formatted data of the status before the change. Empty when the status was created.
+	Data json.RawMessage `json:"data,omitempty"`
+	// The subject that made the change, if known.
+	Actor *string `json:"actor,omitempty"`
+}
+
+func (StatusHistory) IsNode() {}
+
+// The id of the object.
+func (this StatusHistory) GetID() gidx.PrefixedID { return this.ID }
+
+// A connection to a list of items.
+type StatusHistoryConnection struct {
+	// A list of edges.
+	Edges []*StatusHistoryEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// An edge in a connection.
+type StatusHistoryEdge struct {
+	// The item at the end of the edge.
+	Node *StatusHistory `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for StatusHistory connections
+type StatusHistoryOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order StatusHistories.
+	Field StatusHistoryOrderField `json:"field"`
+}
+
+// StatusHistoryWhereInput is used for filtering StatusHistory objects.
+// Input was generated by ent.
+type StatusHistoryWhereInput struct {
+	Not *StatusHistoryWhereInput   `json:"not,omitempty"`
+	And []*StatusHistoryWhereInput `json:"and,omitempty"`
+	Or  []*StatusHistoryWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID      *gidx.PrefixedID  `json:"id,omitempty"`
+	IDNeq   *gidx.PrefixedID  `json:"idNEQ,omitempty"`
+	IDIn    []gidx.PrefixedID `json:"idIn,omitempty"`
+	IDNotIn []gidx.PrefixedID `json:"idNotIn,omitempty"`
+	IDGt    *gidx.PrefixedID  `json:"idGT,omitempty"`
+	IDGte   *gidx.PrefixedID  `json:"idGTE,omitempty"`
+	IDLt    *gidx.PrefixedID  `json:"idLT,omitempty"`
+	IDLte   *gidx.PrefixedID  `json:"idLTE,omitempty"`
+	// created_at field predicates
+	CreatedAt      *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq   *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn    []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt    *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte   *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt    *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte   *time.Time   `json:"createdAtLTE,omitempty"`
+	// source field predicates
+	Source             *string  `json:"source,omitempty"`
+	SourceNeq          *string  `json:"sourceNEQ,omitempty"`
+	SourceIn           []string `json:"sourceIn,omitempty"`
+	SourceNotIn        []string `json:"sourceNotIn,omitempty"`
+	SourceGt           *string  `json:"sourceGT,omitempty"`
+	SourceGte          *string  `json:"sourceGTE,omitempty"`
+	SourceLt           *string  `json:"sourceLT,omitempty"`
+	SourceLte          *string  `json:"sourceLTE,omitempty"`
+	SourceContains     *string  `json:"sourceContains,omitempty"`
+	SourceHasPrefix    *string  `json:"sourceHasPrefix,omitempty"`
+	SourceHasSuffix    *string  `json:"sourceHasSuffix,omitempty"`
+	SourceEqualFold    *string  `json:"sourceEqualFold,omitempty"`
+	SourceContainsFold *string  `json:"sourceContainsFold,omitempty"`
+	// operation field predicates
+	Operation      *StatusHistoryOperation  `json:"operation,omitempty"`
+	OperationNeq   *StatusHistoryOperation  `json:"operationNEQ,omitempty"`
+	OperationIn    []StatusHistoryOperation `json:"operationIn,omitempty"`
+	OperationNotIn []StatusHistoryOperation `json:"operationNotIn,omitempty"`
+	// actor field predicates
+	Actor             *string  `json:"actor,omitempty"`
+	ActorNeq          *string  `json:"actorNEQ,omitempty"`
+	ActorIn           []string `json:"actorIn,omitempty"`
+	ActorNotIn        []string `json:"actorNotIn,omitempty"`
+	ActorGt           *string  `json:"actorGT,omitempty"`
+	ActorGte          *string  `json:"actorGTE,omitempty"`
+	ActorLt           *string  `json:"actorLT,omitempty"`
+	ActorLte          *string  `json:"actorLTE,omitempty"`
+	ActorContains     *string  `json:"actorContains,omitempty"`
+	ActorHasPrefix    *string  `json:"actorHasPrefix,omitempty"`
+	ActorHasSuffix    *string  `json:"actorHasSuffix,omitempty"`
+	ActorIsNil        *bool    `json:"actorIsNil,omitempty"`
+	ActorNotNil       *bool    `json:"actorNotNil,omitempty"`
+	ActorEqualFold    *string  `json:"actorEqualFold,omitempty"`
+	ActorContainsFold *string  `json:"actorContainsFold,omitempty"`
+}
+
 type StatusNamespace struct {
 	// The ID for the status namespace.
 	ID        gidx.PrefixedID `json:"id"`
 	CreatedAt time.Time       `json:"createdAt"`
 	UpdatedAt time.Time       `json:"updatedAt"`
+	// Time the record was deleted, it's purged once the purge delay has passed.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 	// The name of the status namespace.
 	Name string `json:"name"`
 	// Flag for if this namespace is private.
 	Private bool `json:"private"`
+	// Flag for if this namespace is being deleted with its statuses. No statuses can be written to it.
+	Deleting bool `json:"deleting"`
+	// JSON Schema that status data in this namespace must validate against.
+	JSONSchema json.RawMessage `json:"jsonSchema,omitempty"`
+	// Number of seconds statuses in this namespace are kept after they were last updated, unless the update sets its own expiry.
+	DefaultTTL *int64           `json:"defaultTTL,omitempty"`
+	Statuses   StatusConnection `json:"statuses"`
 	// The owner of the status namespace.
 	Owner StatusOwner `json:"owner"`
 }
@@ -504,6 +956,8 @@ type StatusNamespaceDeletePayload struct {
 	DeletedID gidx.PrefixedID `json:"deletedID"`
 	// The count of statuss deleted
 	StatusDeletedCount int64 `json:"statusDeletedCount"`
+	// The deletion of the namespace, which purges it with its statuses in the background.
+	NamespaceDeletion *NamespaceDeletion `json:"namespaceDeletion,omitempty"`
 }
 
 // An edge in a connection.
@@ -522,10 +976,18 @@ type StatusNamespaceOrder struct {
 	Field StatusNamespaceOrderField `json:"field"`
 }
 
+// Return response from statusNamespaceRestore
+type StatusNamespaceRestorePayload struct {
+	// The restored status namespace.
+	StatusNamespace StatusNamespace `json:"statusNamespace"`
+}
+
 // Return response from statusNamespaceUpdate
 type StatusNamespaceUpdatePayload struct {
 	// The updated status namespace.
 	StatusNamespace StatusNamespace `json:"statusNamespace"`
+	// The count of existing statuses that don't validate against the updated JSON schema
+	InvalidStatusCount int64 `json:"invalidStatusCount"`
 }
 
 // StatusNamespaceWhereInput is used for filtering StatusNamespace objects.
@@ -575,6 +1037,9 @@ type StatusNamespaceWhereInput struct {
 	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
 	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
 	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// statuses edge predicates
+	HasStatuses     *bool               `json:"hasStatuses,omitempty"`
+	HasStatusesWith []*StatusWhereInput `json:"hasStatusesWith,omitempty"`
 }
 
 // Ordering options for Status connections
@@ -594,16 +1059,50 @@ type StatusOwner struct {
 
 func (StatusOwner) IsEntity() {}
 
+// Input information to update many statuses.
+type StatusUpdateBatchInput struct {
+	// The statuses to update.
+	Items []*StatusUpdateInput `json:"items"`
+	// How items that fail are handled, defaults to applying all the items or none of them.
+	Mode *BatchMode `json:"mode,omitempty"`
+}
+
+// Return response from statusUpdateBatch
+type StatusUpdateBatchResponse struct {
+	// The result of each item, in the order of the input items.
+	Results []*StatusUpdateBatchResult `json:"results"`
+}
+
+// The result of an item of statusUpdateBatch.
+type StatusUpdateBatchResult struct {
+	// The set status, when the item succeeded.
+	Status *Status `json:"status,omitempty"`
+	// The reason the item failed.
+	Error *string `json:"error,omitempty"`
+}
+
 // Input information to update an status.
 type StatusUpdateInput struct {
 	// The node ID for this status.
 	NodeID gidx.PrefixedID `json:"nodeID"`
-	// The namespace ID for this status.
-	NamespaceID gidx.PrefixedID `json:"namespaceID"`
+	// The namespace ID for this status. Either namespaceID, or ownerID and namespaceName, must be set.
+	NamespaceID *gidx.PrefixedID `json:"namespaceID,omitempty"`
+	// The ID of the resource provider of the namespace, to name the namespace by namespaceName instead of namespaceID.
+	OwnerID *gidx.PrefixedID `json:"ownerID,omitempty"`
+	// The name of the namespace, used with ownerID instead of namespaceID.
+	NamespaceName *string `json:"namespaceName,omitempty"`
 	// The source for this status.
 	Source string `json:"source"`
-	// The data to save in this status.
+	// The data to save in this status. When a patch mode is used, this is the patch to apply to the stored data.
 	Data json.RawMessage `json:"data"`
+	// How the data is applied to the stored data, defaults to replacing it.
+	Mode *DataUpdateMode `json:"mode,omitempty"`
+	// The time the status expires. Can't be set together with ttl.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// The number of seconds until the status expires. Can't be set together with expiresAt. When neither is set, the default TTL of the namespace is used.
+	TTL *int64 `json:"ttl,omitempty"`
+	// The version the status must be at for the update to be applied, 0 when it must not exist yet.
+	ExpectedVersion *int64 `json:"expectedVersion,omitempty"`
 }
 
 // Return response from statusUpdate
@@ -659,12 +1158,27 @@ type StatusWhereInput struct {
 	SourceHasSuffix    *string  `json:"sourceHasSuffix,omitempty"`
 	SourceEqualFold    *string  `json:"sourceEqualFold,omitempty"`
 	SourceContainsFold *string  `json:"sourceContainsFold,omitempty"`
+	// expires_at field predicates
+	ExpiresAt       *time.Time   `json:"expiresAt,omitempty"`
+	ExpiresAtNeq    *time.Time   `json:"expiresAtNEQ,omitempty"`
+	ExpiresAtIn     []*time.Time `json:"expiresAtIn,omitempty"`
+	ExpiresAtNotIn  []*time.Time `json:"expiresAtNotIn,omitempty"`
+	ExpiresAtGt     *time.Time   `json:"expiresAtGT,omitempty"`
+	ExpiresAtGte    *time.Time   `json:"expiresAtGTE,omitempty"`
+	ExpiresAtLt     *time.Time   `json:"expiresAtLT,omitempty"`
+	ExpiresAtLte    *time.Time   `json:"expiresAtLTE,omitempty"`
+	ExpiresAtIsNil  *bool        `json:"expiresAtIsNil,omitempty"`
+	ExpiresAtNotNil *bool        `json:"expiresAtNotNil,omitempty"`
 	// namespace edge predicates
 	HasNamespace     *bool                        `json:"hasNamespace,omitempty"`
 	HasNamespaceWith []*StatusNamespaceWhereInput `json:"hasNamespaceWith,omitempty"`
 	// metadata edge predicates
 	HasMetadata     *bool                 `json:"hasMetadata,omitempty"`
 	HasMetadataWith []*MetadataWhereInput `json:"hasMetadataWith,omitempty"`
+	// Statuses whose data contains the given JSON document.
+	DataContains json.RawMessage `json:"dataContains,omitempty"`
+	// Statuses with the given value at a path in their data.
+	DataPath *DataPathPredicate `json:"dataPath,omitempty"`
 }
 
 // Input information to update an annotation namespace.
@@ -673,6 +1187,10 @@ type UpdateAnnotationNamespaceInput struct {
 	Name *string `json:"name,omitempty"`
 	// Flag for if this namespace is private.
 	Private *bool `json:"private,omitempty"`
+	// JSON Schema that annotation data in this namespace must validate against.
+	JSONSchema       json.RawMessage `json:"jsonSchema,omitempty"`
+	AppendJSONSchema json.RawMessage `json:"appendJSONSchema,omitempty"`
+	ClearJSONSchema  *bool           `json:"clearJSONSchema,omitempty"`
 }
 
 // Input information to update a status namespace.
@@ -688,12 +1206,103 @@ type UpdateStatusNamespaceInput struct {
 	Name *string `json:"name,omitempty"`
 	// Flag for if this namespace is private.
 	Private *bool `json:"private,omitempty"`
+	// JSON Schema that status data in this namespace must validate against.
+	JSONSchema       json.RawMessage `json:"jsonSchema,omitempty"`
+	AppendJSONSchema json.RawMessage `json:"appendJSONSchema,omitempty"`
+	ClearJSONSchema  *bool           `json:"clearJSONSchema,omitempty"`
+	// Number of seconds statuses in this namespace are kept after they were last updated, unless the update sets its own expiry.
+	DefaultTTL      *int64 `json:"defaultTTL,omitempty"`
+	ClearDefaultTTL *bool  `json:"clearDefaultTTL,omitempty"`
 }
 
 type Service struct {
 	Sdl *string `json:"sdl,omitempty"`
 }
 
+// AnnotationHistoryOperation is enum for the field operation
+type AnnotationHistoryOperation string
+
+const (
+	AnnotationHistoryOperationCreate AnnotationHistoryOperation = "CREATE"
+	AnnotationHistoryOperationUpdate AnnotationHistoryOperation = "UPDATE"
+	AnnotationHistoryOperationDelete AnnotationHistoryOperation = "DELETE"
+)
+
+var AllAnnotationHistoryOperation = []AnnotationHistoryOperation{
+	AnnotationHistoryOperationCreate,
+	AnnotationHistoryOperationUpdate,
+	AnnotationHistoryOperationDelete,
+}
+
+func (e AnnotationHistoryOperation) IsValid() bool {
+	switch e {
+	case AnnotationHistoryOperationCreate, AnnotationHistoryOperationUpdate, AnnotationHistoryOperationDelete:
+		return true
+	}
+	return false
+}
+
+func (e AnnotationHistoryOperation) String() string {
+	return string(e)
+}
+
+func (e *AnnotationHistoryOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AnnotationHistoryOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AnnotationHistoryOperation", str)
+	}
+	return nil
+}
+
+func (e AnnotationHistoryOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Properties by which AnnotationHistory connections can be ordered.
+type AnnotationHistoryOrderField string
+
+const (
+	AnnotationHistoryOrderFieldCreatedAt AnnotationHistoryOrderField = "CREATED_AT"
+)
+
+var AllAnnotationHistoryOrderField = []AnnotationHistoryOrderField{
+	AnnotationHistoryOrderFieldCreatedAt,
+}
+
+func (e AnnotationHistoryOrderField) IsValid() bool {
+	switch e {
+	case AnnotationHistoryOrderFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e AnnotationHistoryOrderField) String() string {
+	return string(e)
+}
+
+func (e *AnnotationHistoryOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AnnotationHistoryOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AnnotationHistoryOrderField", str)
+	}
+	return nil
+}
+
+func (e AnnotationHistoryOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Properties by which AnnotationNamespace connections can be ordered.
 type AnnotationNamespaceOrderField string
 
@@ -786,6 +1395,145 @@ func (e AnnotationOrderField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// BatchMode defines how a batch mutation handles items that fail.
+type BatchMode string
+
+const (
+	// Apply all the items or none of them. The batch fails with the error of the first item that fails.
+	BatchModeAllOrNothing BatchMode = "ALL_OR_NOTHING"
+	// Apply every item that succeeds and return the error of each item that fails. The batch still fails
+	// when the database returns an error, since the state of the transaction is unknown then.
+	BatchModeBestEffort BatchMode = "BEST_EFFORT"
+)
+
+var AllBatchMode = []BatchMode{
+	BatchModeAllOrNothing,
+	BatchModeBestEffort,
+}
+
+func (e BatchMode) IsValid() bool {
+	switch e {
+	case BatchModeAllOrNothing, BatchModeBestEffort:
+		return true
+	}
+	return false
+}
+
+func (e BatchMode) String() string {
+	return string(e)
+}
+
+func (e *BatchMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BatchMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BatchMode", str)
+	}
+	return nil
+}
+
+func (e BatchMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// ChangeOperation is the kind of change made to a status or annotation.
+type ChangeOperation string
+
+const (
+	// The record was created.
+	ChangeOperationCreate ChangeOperation = "CREATE"
+	// The record was updated.
+	ChangeOperationUpdate ChangeOperation = "UPDATE"
+	// The record was deleted.
+	ChangeOperationDelete ChangeOperation = "DELETE"
+)
+
+var AllChangeOperation = []ChangeOperation{
+	ChangeOperationCreate,
+	ChangeOperationUpdate,
+	ChangeOperationDelete,
+}
+
+func (e ChangeOperation) IsValid() bool {
+	switch e {
+	case ChangeOperationCreate, ChangeOperationUpdate, ChangeOperationDelete:
+		return true
+	}
+	return false
+}
+
+func (e ChangeOperation) String() string {
+	return string(e)
+}
+
+func (e *ChangeOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeOperation", str)
+	}
+	return nil
+}
+
+func (e ChangeOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// DataUpdateMode defines how the data of an update is applied to the stored data.
+type DataUpdateMode string
+
+const (
+	// Replace the stored data with the given data.
+	DataUpdateModeReplace DataUpdateMode = "REPLACE"
+	// Apply the given data to the stored data as an RFC 7396 JSON merge patch.
+	DataUpdateModeMergePatch DataUpdateMode = "MERGE_PATCH"
+	// Apply the given data to the stored data as an RFC 6902 JSON Patch document.
+	DataUpdateModeJSONPatch DataUpdateMode = "JSON_PATCH"
+)
+
+var AllDataUpdateMode = []DataUpdateMode{
+	DataUpdateModeReplace,
+	DataUpdateModeMergePatch,
+	DataUpdateModeJSONPatch,
+}
+
+func (e DataUpdateMode) IsValid() bool {
+	switch e {
+	case DataUpdateModeReplace, DataUpdateModeMergePatch, DataUpdateModeJSONPatch:
+		return true
+	}
+	return false
+}
+
+func (e DataUpdateMode) String() string {
+	return string(e)
+}
+
+func (e *DataUpdateMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DataUpdateMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DataUpdateMode", str)
+	}
+	return nil
+}
+
+func (e DataUpdateMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Properties by which Metadata connections can be ordered.
 type MetadataOrderField string
 
@@ -828,6 +1576,94 @@ func (e MetadataOrderField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Properties by which NamespaceDeletion connections can be ordered.
+type NamespaceDeletionOrderField string
+
+const (
+	NamespaceDeletionOrderFieldCreatedAt NamespaceDeletionOrderField = "CREATED_AT"
+	NamespaceDeletionOrderFieldUpdatedAt NamespaceDeletionOrderField = "UPDATED_AT"
+)
+
+var AllNamespaceDeletionOrderField = []NamespaceDeletionOrderField{
+	NamespaceDeletionOrderFieldCreatedAt,
+	NamespaceDeletionOrderFieldUpdatedAt,
+}
+
+func (e NamespaceDeletionOrderField) IsValid() bool {
+	switch e {
+	case NamespaceDeletionOrderFieldCreatedAt, NamespaceDeletionOrderFieldUpdatedAt:
+		return true
+	}
+	return false
+}
+
+func (e NamespaceDeletionOrderField) String() string {
+	return string(e)
+}
+
+func (e *NamespaceDeletionOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NamespaceDeletionOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NamespaceDeletionOrderField", str)
+	}
+	return nil
+}
+
+func (e NamespaceDeletionOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// NamespaceDeletionState is enum for the field state
+type NamespaceDeletionState string
+
+const (
+	NamespaceDeletionStatePending   NamespaceDeletionState = "PENDING"
+	NamespaceDeletionStateRunning   NamespaceDeletionState = "RUNNING"
+	NamespaceDeletionStateCompleted NamespaceDeletionState = "COMPLETED"
+	NamespaceDeletionStateCanceled  NamespaceDeletionState = "CANCELED"
+)
+
+var AllNamespaceDeletionState = []NamespaceDeletionState{
+	NamespaceDeletionStatePending,
+	NamespaceDeletionStateRunning,
+	NamespaceDeletionStateCompleted,
+	NamespaceDeletionStateCanceled,
+}
+
+func (e NamespaceDeletionState) IsValid() bool {
+	switch e {
+	case NamespaceDeletionStatePending, NamespaceDeletionStateRunning, NamespaceDeletionStateCompleted, NamespaceDeletionStateCanceled:
+		return true
+	}
+	return false
+}
+
+func (e NamespaceDeletionState) String() string {
+	return string(e)
+}
+
+func (e *NamespaceDeletionState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NamespaceDeletionState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NamespaceDeletionState", str)
+	}
+	return nil
+}
+
+func (e NamespaceDeletionState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Possible directions in which to order a list of items when provided an `orderBy` argument.
 type OrderDirection string
 
@@ -872,6 +1708,90 @@ func (e OrderDirection) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// StatusHistoryOperation is enum for the field operation
+type StatusHistoryOperation string
+
+const (
+	StatusHistoryOperationCreate StatusHistoryOperation = "CREATE"
+	StatusHistoryOperationUpdate StatusHistoryOperation = "UPDATE"
+	StatusHistoryOperationDelete StatusHistoryOperation = "DELETE"
+)
+
+var AllStatusHistoryOperation = []StatusHistoryOperation{
+	StatusHistoryOperationCreate,
+	StatusHistoryOperationUpdate,
+	StatusHistoryOperationDelete,
+}
+
+func (e StatusHistoryOperation) IsValid() bool {
+	switch e {
+	case StatusHistoryOperationCreate, StatusHistoryOperationUpdate, StatusHistoryOperationDelete:
+		return true
+	}
+	return false
+}
+
+func (e StatusHistoryOperation) String() string {
+	return string(e)
+}
+
+func (e *StatusHistoryOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatusHistoryOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatusHistoryOperation", str)
+	}
+	return nil
+}
+
+func (e StatusHistoryOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Properties by which StatusHistory connections can be ordered.
+type StatusHistoryOrderField string
+
+const (
+	StatusHistoryOrderFieldCreatedAt StatusHistoryOrderField = "CREATED_AT"
+)
+
+var AllStatusHistoryOrderField = []StatusHistoryOrderField{
+	StatusHistoryOrderFieldCreatedAt,
+}
+
+func (e StatusHistoryOrderField) IsValid() bool {
+	switch e {
+	case StatusHistoryOrderFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e StatusHistoryOrderField) String() string {
+	return string(e)
+}
+
+func (e *StatusHistoryOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatusHistoryOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatusHistoryOrderField", str)
+	}
+	return nil
+}
+
+func (e StatusHistoryOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Properties by which StatusNamespace connections can be ordered.
 type StatusNamespaceOrderField string
 
@@ -928,16 +1848,18 @@ type StatusOrderField string
 const (
 	StatusOrderFieldCreatedAt StatusOrderField = "CREATED_AT"
 	StatusOrderFieldUpdatedAt StatusOrderField = "UPDATED_AT"
+	StatusOrderFieldExpiresAt StatusOrderField = "EXPIRES_AT"
 )
 
 var AllStatusOrderField = []StatusOrderField{
 	StatusOrderFieldCreatedAt,
 	StatusOrderFieldUpdatedAt,
+	StatusOrderFieldExpiresAt,
 }
 
 func (e StatusOrderField) IsValid() bool {
 	switch e {
-	case StatusOrderFieldCreatedAt, StatusOrderFieldUpdatedAt:
+	case StatusOrderFieldCreatedAt, StatusOrderFieldUpdatedAt, StatusOrderFieldExpiresAt:
 		return true
 	}
 	return false
@@ -3,7 +3,6 @@ package client
 import (
 	"context"
 	"net/http"
-	"strings"
 
 	graphql "github.com/hasura/go-graphql-client"
 )
@@ -14,10 +13,47 @@ type GQLClient interface {
 	Mutate(ctx context.Context, m interface{}, variables map[string]interface{}, options ...graphql.Option) error
 }
 
+// MetadataClient is the interface of the metadata api client, applications can
+// depend on it to swap the client for mockmetadata.MockMetadata in tests
+type MetadataClient interface {
+	StatusUpdate(ctx context.Context, input *StatusUpdateInput) (*StatusUpdate, error)
+	StatusDelete(ctx context.Context, input *StatusDeleteInput) (*StatusDelete, error)
+	AnnotationUpdate(ctx context.Context, input *AnnotationUpdateInput) (*AnnotationUpdate, error)
+	AnnotationDelete(ctx context.Context, input *AnnotationDeleteInput) (*AnnotationDelete, error)
+
+	AnnotationNamespace(ctx context.Context, id string) (*AnnotationNamespace, error)
+	AnnotationNamespaceByName(ctx context.Context, ownerID, name string) (*AnnotationNamespace, error)
+	AnnotationNamespaces(ctx context.Context, ownerID string) *Iterator[AnnotationNamespace]
+	AnnotationNamespaceCreate(ctx context.Context, input *CreateAnnotationNamespaceInput) (*AnnotationNamespaceCreate, error)
+	AnnotationNamespaceUpdate(ctx context.Context, id string, input *UpdateAnnotationNamespaceInput) (*AnnotationNamespaceUpdate, error)
+	AnnotationNamespaceDelete(ctx context.Context, id string, force bool) (*AnnotationNamespaceDelete, error)
+	AnnotationNamespaceRestore(ctx context.Context, id string) (*AnnotationNamespaceRestore, error)
+
+	StatusNamespace(ctx context.Context, id string) (*StatusNamespace, error)
+	StatusNamespaceByName(ctx context.Context, resourceProviderID, name string) (*StatusNamespace, error)
+	StatusNamespaces(ctx context.Context, resourceProviderID string) *Iterator[StatusNamespace]
+	StatusNamespaceCreate(ctx context.Context, input *CreateStatusNamespaceInput) (*StatusNamespaceCreate, error)
+	StatusNamespaceUpdate(ctx context.Context, id string, input *UpdateStatusNamespaceInput) (*StatusNamespaceUpdate, error)
+	StatusNamespaceDelete(ctx context.Context, id string, force bool) (*StatusNamespaceDelete, error)
+	StatusNamespaceRestore(ctx context.Context, id string) (*StatusNamespaceRestore, error)
+
+	NamespaceDeletion(ctx context.Context, id string) (*NamespaceDeletion, error)
+
+	Metadata(ctx context.Context, nodeID string) (*Metadata, error)
+	Statuses(ctx context.Context, nodeID string) *Iterator[Status]
+	Annotations(ctx context.Context, nodeID string) *Iterator[Annotation]
+}
+
+var _ MetadataClient = (*Client)(nil)
+
+// defaultPageSize is the number of nodes fetched in each page of a connection
+const defaultPageSize = 100
+
 // Client is a client for the metadata api
 type Client struct {
 	gqlCli     GQLClient
 	httpClient *http.Client
+	pageSize   int
 }
 
 // Option is a function that modifies a client
@@ -27,6 +63,7 @@ type Option func(*Client)
 func New(url string, opts ...Option) *Client {
 	c := &Client{
 		httpClient: http.DefaultClient,
+		pageSize:   defaultPageSize,
 	}
 
 	for _, opt := range opts {
@@ -45,6 +82,14 @@ func WithHTTPClient(cli *http.Client) Option {
 	}
 }
 
+// WithPageSize functional option to set the number of nodes fetched in each
+// page by iterators
+func WithPageSize(size int) Option {
+	return func(c *Client) {
+		c.pageSize = size
+	}
+}
+
 // StatusUpdate mutates the requested nodeID with json status
 func (c *Client) StatusUpdate(ctx context.Context, input *StatusUpdateInput) (*StatusUpdate, error) {
 	vars := map[string]interface{}{
@@ -59,13 +104,386 @@ func (c *Client) StatusUpdate(ctx context.Context, input *StatusUpdateInput) (*S
 	return r, nil
 }
 
-func translateGQLErr(err error) error {
-	switch {
-	case strings.Contains(err.Error(), "invalid or expired jwt"):
-		return ErrUnauthorized
-	case strings.Contains(err.Error(), "subject doesn't have access"):
-		return ErrPermissionDenied
+// StatusDelete deletes the status of the requested nodeID
+func (c *Client) StatusDelete(ctx context.Context, input *StatusDeleteInput) (*StatusDelete, error) {
+	vars := map[string]interface{}{
+		"input": *input,
+	}
+
+	r := new(StatusDelete)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// AnnotationUpdate mutates the requested nodeID with json annotation
+func (c *Client) AnnotationUpdate(ctx context.Context, input *AnnotationUpdateInput) (*AnnotationUpdate, error) {
+	vars := map[string]interface{}{
+		"input": *input,
+	}
+
+	r := new(AnnotationUpdate)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// AnnotationDelete deletes the annotation of the requested nodeID
+func (c *Client) AnnotationDelete(ctx context.Context, input *AnnotationDeleteInput) (*AnnotationDelete, error) {
+	vars := map[string]interface{}{
+		"input": *input,
+	}
+
+	r := new(AnnotationDelete)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// AnnotationNamespace returns the annotation namespace with the requested id
+func (c *Client) AnnotationNamespace(ctx context.Context, id string) (*AnnotationNamespace, error) {
+	vars := map[string]interface{}{
+		"id": graphql.ID(id),
+	}
+
+	q := new(annotationNamespaceQuery)
+	if err := c.gqlCli.Query(ctx, q, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &q.AnnotationNamespace, nil
+}
+
+// AnnotationNamespaceByName returns the annotation namespace with the requested name of
+// the requested owner
+func (c *Client) AnnotationNamespaceByName(ctx context.Context, ownerID, name string) (*AnnotationNamespace, error) {
+	vars := map[string]interface{}{
+		"ownerID": graphql.ID(ownerID),
+		"name":    graphql.String(name),
 	}
 
-	return err
+	q := new(annotationNamespaceByNameQuery)
+	if err := c.gqlCli.Query(ctx, q, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &q.AnnotationNamespace, nil
+}
+
+// AnnotationNamespaces returns an iterator over the annotation namespaces of the
+// requested owner
+func (c *Client) AnnotationNamespaces(ctx context.Context, ownerID string) *Iterator[AnnotationNamespace] {
+	return NewIterator(ctx, func(ctx context.Context, after *Cursor) ([]AnnotationNamespace, PageInfo, error) {
+		vars := c.pageVars(ownerID, "ResourceOwner", after)
+
+		q := new(annotationNamespacesQuery)
+		if err := c.gqlCli.Query(ctx, q, vars); err != nil {
+			return nil, PageInfo{}, translateGQLErr(err)
+		}
+
+		if len(q.Entities) == 0 {
+			return nil, PageInfo{}, nil
+		}
+
+		conn := q.Entities[0].ResourceOwner.AnnotationNamespaces
+
+		return conn.nodes(), conn.PageInfo, nil
+	})
+}
+
+// AnnotationNamespaceCreate creates an annotation namespace
+func (c *Client) AnnotationNamespaceCreate(ctx context.Context, input *CreateAnnotationNamespaceInput) (*AnnotationNamespaceCreate, error) {
+	vars := map[string]interface{}{
+		"input": *input,
+	}
+
+	r := new(AnnotationNamespaceCreate)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// AnnotationNamespaceUpdate updates the annotation namespace with the requested id
+func (c *Client) AnnotationNamespaceUpdate(ctx context.Context, id string, input *UpdateAnnotationNamespaceInput) (*AnnotationNamespaceUpdate, error) {
+	vars := map[string]interface{}{
+		"id":    graphql.ID(id),
+		"input": *input,
+	}
+
+	r := new(AnnotationNamespaceUpdate)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// AnnotationNamespaceDelete deletes the annotation namespace with the requested
+// id, force deletes the annotations using it as well
+func (c *Client) AnnotationNamespaceDelete(ctx context.Context, id string, force bool) (*AnnotationNamespaceDelete, error) {
+	vars := map[string]interface{}{
+		"id":    graphql.ID(id),
+		"force": force,
+	}
+
+	r := new(AnnotationNamespaceDelete)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// AnnotationNamespaceRestore restores the deleted annotation namespace with the requested
+// id, along with its annotations, before it's purged
+func (c *Client) AnnotationNamespaceRestore(ctx context.Context, id string) (*AnnotationNamespaceRestore, error) {
+	vars := map[string]interface{}{
+		"id": graphql.ID(id),
+	}
+
+	r := new(AnnotationNamespaceRestore)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// StatusNamespace returns the status namespace with the requested id
+func (c *Client) StatusNamespace(ctx context.Context, id string) (*StatusNamespace, error) {
+	vars := map[string]interface{}{
+		"id": graphql.ID(id),
+	}
+
+	q := new(statusNamespaceQuery)
+	if err := c.gqlCli.Query(ctx, q, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &q.StatusNamespace, nil
+}
+
+// StatusNamespaceByName returns the status namespace with the requested name of
+// the requested resource provider
+func (c *Client) StatusNamespaceByName(ctx context.Context, resourceProviderID, name string) (*StatusNamespace, error) {
+	vars := map[string]interface{}{
+		"ownerID": graphql.ID(resourceProviderID),
+		"name":    graphql.String(name),
+	}
+
+	q := new(statusNamespaceByNameQuery)
+	if err := c.gqlCli.Query(ctx, q, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &q.StatusNamespace, nil
+}
+
+// StatusNamespaces returns an iterator over the status namespaces of the
+// requested resource provider
+func (c *Client) StatusNamespaces(ctx context.Context, resourceProviderID string) *Iterator[StatusNamespace] {
+	return NewIterator(ctx, func(ctx context.Context, after *Cursor) ([]StatusNamespace, PageInfo, error) {
+		vars := c.pageVars(resourceProviderID, "StatusOwner", after)
+
+		q := new(statusNamespacesQuery)
+		if err := c.gqlCli.Query(ctx, q, vars); err != nil {
+			return nil, PageInfo{}, translateGQLErr(err)
+		}
+
+		if len(q.Entities) == 0 {
+			return nil, PageInfo{}, nil
+		}
+
+		conn := q.Entities[0].StatusOwner.StatusNamespaces
+
+		return conn.nodes(), conn.PageInfo, nil
+	})
+}
+
+// StatusNamespaceCreate creates a status namespace
+func (c *Client) StatusNamespaceCreate(ctx context.Context, input *CreateStatusNamespaceInput) (*StatusNamespaceCreate, error) {
+	vars := map[string]interface{}{
+		"input": *input,
+	}
+
+	r := new(StatusNamespaceCreate)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// StatusNamespaceUpdate updates the status namespace with the requested id
+func (c *Client) StatusNamespaceUpdate(ctx context.Context, id string, input *UpdateStatusNamespaceInput) (*StatusNamespaceUpdate, error) {
+	vars := map[string]interface{}{
+		"id":    graphql.ID(id),
+		"input": *input,
+	}
+
+	r := new(StatusNamespaceUpdate)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// StatusNamespaceDelete deletes the status namespace with the requested id,
+// force deletes the statuses using it as well
+func (c *Client) StatusNamespaceDelete(ctx context.Context, id string, force bool) (*StatusNamespaceDelete, error) {
+	vars := map[string]interface{}{
+		"id":    graphql.ID(id),
+		"force": force,
+	}
+
+	r := new(StatusNamespaceDelete)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// StatusNamespaceRestore restores the deleted status namespace with the requested
+// id, along with its statuses, before it's purged
+func (c *Client) StatusNamespaceRestore(ctx context.Context, id string) (*StatusNamespaceRestore, error) {
+	vars := map[string]interface{}{
+		"id": graphql.ID(id),
+	}
+
+	r := new(StatusNamespaceRestore)
+	if err := c.gqlCli.Mutate(ctx, r, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return r, nil
+}
+
+// NamespaceDeletion returns the namespace deletion with the requested id, which
+// reports the progress of a namespace delete until it's purged
+func (c *Client) NamespaceDeletion(ctx context.Context, id string) (*NamespaceDeletion, error) {
+	vars := map[string]interface{}{
+		"id": graphql.ID(id),
+	}
+
+	q := new(namespaceDeletionQuery)
+	if err := c.gqlCli.Query(ctx, q, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	return &q.NamespaceDeletion, nil
+}
+
+// Metadata returns the metadata of the requested nodeID with all of its statuses
+// and annotations. ErrMetadataNotFound is returned when the node has no metadata.
+func (c *Client) Metadata(ctx context.Context, nodeID string) (*Metadata, error) {
+	vars := map[string]interface{}{
+		"representations": []representation{{"__typename": "MetadataNode", "id": nodeID}},
+		"first":           c.pageSize,
+	}
+
+	q := new(metadataQuery)
+	if err := c.gqlCli.Query(ctx, q, vars); err != nil {
+		return nil, translateGQLErr(err)
+	}
+
+	if len(q.Entities) == 0 || q.Entities[0].MetadataNode.Metadata == nil {
+		return nil, ErrMetadataNotFound
+	}
+
+	md := q.Entities[0].MetadataNode.Metadata
+
+	m := &Metadata{
+		ID:          md.ID,
+		NodeID:      md.NodeID,
+		Statuses:    md.Statuses.nodes(),
+		Annotations: md.Annotations.nodes(),
+	}
+
+	// the first page of each connection is fetched with the metadata, the
+	// remaining pages are fetched with iterators continuing from its end
+	if md.Statuses.PageInfo.HasNextPage {
+		it := c.Statuses(ctx, nodeID)
+		it.after = md.Statuses.PageInfo.EndCursor
+
+		statuses, err := it.All()
+		if err != nil {
+			return nil, err
+		}
+
+		m.Statuses = append(m.Statuses, statuses...)
+	}
+
+	if md.Annotations.PageInfo.HasNextPage {
+		it := c.Annotations(ctx, nodeID)
+		it.after = md.Annotations.PageInfo.EndCursor
+
+		annotations, err := it.All()
+		if err != nil {
+			return nil, err
+		}
+
+		m.Annotations = append(m.Annotations, annotations...)
+	}
+
+	return m, nil
+}
+
+// Statuses returns an iterator over the statuses of the requested nodeID
+func (c *Client) Statuses(ctx context.Context, nodeID string) *Iterator[Status] {
+	return NewIterator(ctx, func(ctx context.Context, after *Cursor) ([]Status, PageInfo, error) {
+		vars := c.pageVars(nodeID, "MetadataNode", after)
+
+		q := new(statusesQuery)
+		if err := c.gqlCli.Query(ctx, q, vars); err != nil {
+			return nil, PageInfo{}, translateGQLErr(err)
+		}
+
+		if len(q.Entities) == 0 || q.Entities[0].MetadataNode.Metadata == nil {
+			return nil, PageInfo{}, nil
+		}
+
+		conn := q.Entities[0].MetadataNode.Metadata.Statuses
+
+		return conn.nodes(), conn.PageInfo, nil
+	})
+}
+
+// Annotations returns an iterator over the annotations of the requested nodeID
+func (c *Client) Annotations(ctx context.Context, nodeID string) *Iterator[Annotation] {
+	return NewIterator(ctx, func(ctx context.Context, after *Cursor) ([]Annotation, PageInfo, error) {
+		vars := c.pageVars(nodeID, "MetadataNode", after)
+
+		q := new(annotationsQuery)
+		if err := c.gqlCli.Query(ctx, q, vars); err != nil {
+			return nil, PageInfo{}, translateGQLErr(err)
+		}
+
+		if len(q.Entities) == 0 || q.Entities[0].MetadataNode.Metadata == nil {
+			return nil, PageInfo{}, nil
+		}
+
+		conn := q.Entities[0].MetadataNode.Metadata.Annotations
+
+		return conn.nodes(), conn.PageInfo, nil
+	})
+}
+
+// pageVars returns the variables to fetch a page of a connection of the entity
+// with the given id and type
+func (c *Client) pageVars(id, typename string, after *Cursor) map[string]interface{} {
+	return map[string]interface{}{
+		"representations": []representation{{"__typename": typename, "id": id}},
+		"first":           c.pageSize,
+		"after":           after,
+	}
 }
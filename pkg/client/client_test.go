@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -89,6 +90,98 @@ func TestUpdateStatus(t *testing.T) {
 		assert.ErrorContains(t, err, "invalid id")
 	})
 
+	t.Run("returns invalid ids as ErrInvalidID", func(t *testing.T) {
+		respJSON := `{
+			"errors": [
+				{
+					"message": "nodeID: invalid id: expected id format is prefix-id, but received invalid",
+					"path": [
+						"statusUpdate"
+					],
+					"extensions": {
+						"code": "INVALID_FIELD",
+						"field": "nodeID",
+						"reason": "INVALID_ID"
+					}
+				}
+			],
+			"data": null
+			}`
+
+		cli.gqlCli = mustNewGQLTestClient(respJSON, http.StatusOK)
+
+		_, err := cli.StatusUpdate(ctx, &StatusUpdateInput{NodeID: "invalid"})
+		require.Error(t, err)
+
+		var idErr *ErrInvalidID
+
+		require.ErrorAs(t, err, &idErr)
+		assert.Equal(t, "nodeID", idErr.field)
+		assert.ErrorIs(t, err, ErrInvalidField)
+
+		var apiErr *Error
+
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, CodeInvalidField, apiErr.Code)
+		assert.Equal(t, ReasonInvalidID, apiErr.Reason)
+	})
+
+	t.Run("invalid id messages without the reason don't match ErrInvalidID", func(t *testing.T) {
+		respJSON := `{
+			"errors": [
+				{
+					"message": "nodeID: invalid id: expected id format is prefix-id, but received invalid",
+					"path": [
+						"statusUpdate"
+					],
+					"extensions": {
+						"code": "INVALID_FIELD",
+						"field": "nodeID"
+					}
+				}
+			],
+			"data": null
+			}`
+
+		cli.gqlCli = mustNewGQLTestClient(respJSON, http.StatusOK)
+
+		_, err := cli.StatusUpdate(ctx, &StatusUpdateInput{NodeID: "invalid"})
+		require.Error(t, err)
+
+		var idErr *ErrInvalidID
+
+		assert.False(t, errors.As(err, &idErr))
+		assert.ErrorIs(t, err, ErrInvalidField)
+	})
+
+	t.Run("other errors don't match ErrInvalidID", func(t *testing.T) {
+		respJSON := `{
+			"errors": [
+				{
+					"message": "source: must not be empty",
+					"path": [
+						"statusUpdate"
+					],
+					"extensions": {
+						"code": "INVALID_FIELD",
+						"field": "source"
+					}
+				}
+			],
+			"data": null
+			}`
+
+		cli.gqlCli = mustNewGQLTestClient(respJSON, http.StatusOK)
+
+		_, err := cli.StatusUpdate(ctx, &StatusUpdateInput{NodeID: "loadbal-testing"})
+		require.Error(t, err)
+
+		var idErr *ErrInvalidID
+
+		assert.False(t, errors.As(err, &idErr))
+		assert.ErrorIs(t, err, ErrInvalidField)
+	})
+
 	t.Run("fails to update with unknown NamespaceID", func(t *testing.T) {
 		respJSON := `{
 			"errors": [
@@ -151,6 +244,101 @@ func TestUpdateStatus(t *testing.T) {
 	})
 }
 
+func TestMetadata(t *testing.T) {
+	cli := Client{pageSize: defaultPageSize}
+	ctx := context.Background()
+
+	t.Run("permission denied", func(t *testing.T) {
+		respJSON := `{"message":"subject doesn't have access"}`
+
+		cli.gqlCli = mustNewGQLTestClient(respJSON, http.StatusForbidden)
+
+		md, err := cli.Metadata(ctx, "loadbal-testing")
+		require.Nil(t, md)
+		assert.ErrorIs(t, err, ErrPermissionDenied)
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		respJSON := `{
+			"errors": [
+				{
+					"message": "id: invalid id: expected prefix length is 7, 'bad' is 3",
+					"path": ["_entities"],
+					"extensions": {"code": "INVALID_FIELD", "field": "id"}
+				}
+			],
+			"data": null
+		}`
+
+		cli.gqlCli = mustNewGQLTestClient(respJSON, http.StatusOK)
+
+		md, err := cli.Metadata(ctx, "bad-testing")
+		require.Nil(t, md)
+		assert.ErrorIs(t, err, ErrInvalidField)
+		assert.NotErrorIs(t, err, ErrNotFound)
+
+		var apiErr *Error
+
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, CodeInvalidField, apiErr.Code)
+		assert.Equal(t, "id", apiErr.Field)
+		assert.ErrorContains(t, err, "invalid id")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		respJSON := `{"data":{"_entities":[{"metadata":null}]}}`
+
+		cli.gqlCli = mustNewGQLTestClient(respJSON, http.StatusOK)
+
+		md, err := cli.Metadata(ctx, "loadbal-testing")
+		require.Nil(t, md)
+		assert.ErrorIs(t, err, ErrMetadataNotFound)
+	})
+
+	t.Run("successfully gets metadata", func(t *testing.T) {
+		respJSON := `{
+			"data": {
+				"_entities": [
+					{
+						"metadata": {
+							"id": "metadat-testing",
+							"nodeID": "loadbal-testing",
+							"statuses": {
+								"edges": [
+									{
+										"node": {
+											"id": "metasts-testing",
+											"data": {"state":"ACTIVE"},
+											"source": "unit-test",
+											"statusNamespaceID": "metasns-testing"
+										}
+									}
+								],
+								"pageInfo": {"hasNextPage": false, "endCursor": "cursor"}
+							},
+							"annotations": {
+								"edges": [],
+								"pageInfo": {"hasNextPage": false, "endCursor": null}
+							}
+						}
+					}
+				]
+			}
+		}`
+
+		cli.gqlCli = mustNewGQLTestClient(respJSON, http.StatusOK)
+
+		md, err := cli.Metadata(ctx, "loadbal-testing")
+		require.NoError(t, err)
+		assert.Equal(t, "metadat-testing", md.ID)
+		assert.Equal(t, "loadbal-testing", md.NodeID)
+		require.Len(t, md.Statuses, 1)
+		assert.Equal(t, "metasts-testing", md.Statuses[0].ID)
+		assert.JSONEq(t, `{"state":"ACTIVE"}`, string(md.Statuses[0].Data))
+		assert.Empty(t, md.Annotations)
+	})
+}
+
 func mustNewGQLTestClient(respJSON string, respCode int) *graphql.Client {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/query", func(w http.ResponseWriter, req *http.Request) {
@@ -1,2 +1,7 @@
 // Package client provides a client for interacting with the metadata-api service
+//
+// Paginated connections are returned as an Iterator, which fetches the next page
+// of the connection as its nodes are used up. Applications should depend on the
+// MetadataClient interface so the client can be replaced with
+// mockmetadata.MockMetadata in tests.
 package client
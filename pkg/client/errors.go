@@ -3,9 +3,92 @@ package client
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	graphql "github.com/hasura/go-graphql-client"
+)
+
+// Error codes returned by the metadata api in the code extension of errors
+const (
+	CodeNotFound          = "NOT_FOUND"
+	CodeInvalidField      = "INVALID_FIELD"
+	CodeConflict          = "CONFLICT"
+	CodeNamespaceInUse    = "NAMESPACE_IN_USE"
+	CodeNamespaceDeleting = "NAMESPACE_DELETING"
+	CodeForbidden         = "FORBIDDEN"
+	CodeUnauthenticated   = "UNAUTHENTICATED"
 )
 
-// ErrInvaliID returned when an invalid id is provided
+// ReasonInvalidID is set by the metadata api in the reason extension of
+// INVALID_FIELD errors when the field isn't a valid id
+const ReasonInvalidID = "INVALID_ID"
+
+var (
+	// ErrUnauthorized returned when the request is not authorized
+	ErrUnauthorized = errors.New("client is unauthorized")
+
+	// ErrPermissionDenied returned when the request is not authorized
+	ErrPermissionDenied = errors.New("client does not have permission to perform this action")
+
+	// ErrMetadataNotFound returned when the node has no metadata
+	ErrMetadataNotFound = errors.New("metadata not found")
+
+	// ErrNotFound returned when the requested record doesn't exist
+	ErrNotFound = errors.New("not found")
+
+	// ErrInvalidField returned when an input field is invalid
+	ErrInvalidField = errors.New("invalid field")
+
+	// ErrConflict returned when a change conflicts with a stored record
+	ErrConflict = errors.New("conflict")
+
+	// ErrNamespaceInUse returned when a namespace is in use and can't be deleted
+	ErrNamespaceInUse = errors.New("namespace is in use")
+
+	// ErrNamespaceDeleting returned when a namespace is being deleted and can't be written to
+	ErrNamespaceDeleting = errors.New("namespace is being deleted")
+)
+
+// codeErrors maps the error codes to the errors matched by Error.Is
+var codeErrors = map[string]error{
+	CodeNotFound:          ErrNotFound,
+	CodeInvalidField:      ErrInvalidField,
+	CodeConflict:          ErrConflict,
+	CodeNamespaceInUse:    ErrNamespaceInUse,
+	CodeNamespaceDeleting: ErrNamespaceDeleting,
+	CodeForbidden:         ErrPermissionDenied,
+	CodeUnauthenticated:   ErrUnauthorized,
+}
+
+// Error is an error returned by the metadata api. It matches the error of its
+// code with errors.Is, e.g. an error with the NOT_FOUND code matches ErrNotFound.
+type Error struct {
+	// Code is the code of the error, empty when the api didn't set one.
+	Code string
+	// Field is the input field which is invalid, for INVALID_FIELD and CONFLICT errors.
+	Field string
+	// Reason tells apart errors of the same code, e.g. INVALID_ID for invalid ids.
+	Reason string
+	// Message is the message of the error.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is reports whether target is the error of the code of e.
+func (e *Error) Is(target error) bool {
+	codeErr, ok := codeErrors[e.Code]
+
+	return ok && codeErr == target
+}
+
+// ErrInvalidID returned when an invalid id is provided. It wraps the *Error
+// returned by the api, so it also matches ErrInvalidField.
 type ErrInvalidID struct {
 	field string
 	err   error
@@ -16,10 +99,64 @@ func (e *ErrInvalidID) Error() string {
 	return fmt.Sprintf("%v, field: %s", e.err, e.field)
 }
 
-var (
-	// ErrUnauthorized returned when the request is not authorized
-	ErrUnauthorized = errors.New("client is unauthorized")
+// Unwrap returns the error returned by the api.
+func (e *ErrInvalidID) Unwrap() error {
+	return e.err
+}
 
-	// ErrPermissionDenied returned when the request is not authorized
-	ErrPermissionDenied = errors.New("client does not have permission to perform this action")
-)
+func translateGQLErr(err error) error {
+	var gqlErrs graphql.Errors
+
+	if !errors.As(err, &gqlErrs) || len(gqlErrs) == 0 {
+		return err
+	}
+
+	gqlErr := gqlErrs[0]
+
+	code, _ := gqlErr.Extensions["code"].(string)
+
+	// requests rejected before reaching the graph, e.g. by the auth middleware,
+	// are returned as request errors prefixed by the http status
+	if code == graphql.ErrRequestError {
+		switch httpStatus(gqlErr.Message) {
+		case http.StatusUnauthorized:
+			return ErrUnauthorized
+		case http.StatusForbidden:
+			return ErrPermissionDenied
+		}
+
+		return err
+	}
+
+	if _, ok := codeErrors[code]; !ok {
+		return err
+	}
+
+	field, _ := gqlErr.Extensions["field"].(string)
+	reason, _ := gqlErr.Extensions["reason"].(string)
+
+	apiErr := &Error{
+		Code:    code,
+		Field:   field,
+		Reason:  reason,
+		Message: gqlErr.Message,
+	}
+
+	if code == CodeInvalidField && reason == ReasonInvalidID {
+		return &ErrInvalidID{field: field, err: apiErr}
+	}
+
+	return apiErr
+}
+
+// httpStatus returns the http status at the start of a request error message
+func httpStatus(msg string) int {
+	status, _, _ := strings.Cut(msg, " ")
+
+	code, err := strconv.Atoi(status)
+	if err != nil {
+		return 0
+	}
+
+	return code
+}
@@ -0,0 +1,83 @@
+package client
+
+import "context"
+
+// PageFunc fetches the page of a connection following the after cursor, which
+// is nil for the first page.
+type PageFunc[T any] func(ctx context.Context, after *Cursor) ([]T, PageInfo, error)
+
+// Iterator iterates over the nodes of a paginated connection, fetching the next
+// page once the nodes of the current one are used up.
+//
+//	it := cli.Statuses(ctx, nodeID)
+//	for it.Next() {
+//		status := it.Node()
+//	}
+//
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type Iterator[T any] struct {
+	ctx   context.Context
+	fetch PageFunc[T]
+
+	after *Cursor
+	last  bool
+	page  []T
+	node  T
+	err   error
+}
+
+// NewIterator returns an iterator over the pages returned by fetch
+func NewIterator[T any](ctx context.Context, fetch PageFunc[T]) *Iterator[T] {
+	return &Iterator[T]{
+		ctx:   ctx,
+		fetch: fetch,
+	}
+}
+
+// Next advances the iterator to the next node, it returns false when there are
+// no more nodes or fetching a page failed.
+func (it *Iterator[T]) Next() bool {
+	for len(it.page) == 0 {
+		if it.last || it.err != nil {
+			return false
+		}
+
+		page, info, err := it.fetch(it.ctx, it.after)
+		if err != nil {
+			it.err = err
+
+			return false
+		}
+
+		it.page = page
+		it.after = info.EndCursor
+		it.last = !info.HasNextPage || info.EndCursor == nil
+	}
+
+	it.node, it.page = it.page[0], it.page[1:]
+
+	return true
+}
+
+// Node returns the current node
+func (it *Iterator[T]) Node() T {
+	return it.node
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All returns the remaining nodes
+func (it *Iterator[T]) All() ([]T, error) {
+	var nodes []T
+
+	for it.Next() {
+		nodes = append(nodes, it.Node())
+	}
+
+	return nodes, it.Err()
+}
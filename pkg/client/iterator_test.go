@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterator(t *testing.T) {
+	ctx := context.Background()
+
+	pages := map[Cursor][]int{
+		"":       {1, 2},
+		"page-2": {},
+		"page-3": {3},
+	}
+	next := map[Cursor]Cursor{
+		"":       "page-2",
+		"page-2": "page-3",
+	}
+
+	fetch := func(_ context.Context, after *Cursor) ([]int, PageInfo, error) {
+		var cursor Cursor
+		if after != nil {
+			cursor = *after
+		}
+
+		end, ok := next[cursor]
+
+		return pages[cursor], PageInfo{HasNextPage: ok, EndCursor: &end}, nil
+	}
+
+	t.Run("iterates over every page", func(t *testing.T) {
+		nodes, err := NewIterator(ctx, fetch).All()
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, nodes)
+	})
+
+	t.Run("stops on errors", func(t *testing.T) {
+		errFetch := errors.New("fetch failed")
+
+		it := NewIterator(ctx, func(ctx context.Context, after *Cursor) ([]int, PageInfo, error) {
+			if after != nil {
+				return nil, PageInfo{}, errFetch
+			}
+
+			return fetch(ctx, after)
+		})
+
+		nodes, err := it.All()
+		assert.ErrorIs(t, err, errFetch)
+		assert.Equal(t, []int{1, 2}, nodes)
+		assert.False(t, it.Next())
+	})
+}
@@ -10,12 +10,14 @@ import (
 	metadata "go.infratographer.com/metadata-api/pkg/client"
 )
 
-// MockMetadata implements permissions.AuthRelationshipRequestHandler.
+var _ metadata.MetadataClient = (*MockMetadata)(nil)
+
+// MockMetadata implements metadata.MetadataClient.
 type MockMetadata struct {
 	mock.Mock
 }
 
-// CreateAuthRelationships implements permissions.AuthRelationshipRequestHandler.
+// StatusUpdate implements metadata.MetadataClient.
 func (m *MockMetadata) StatusUpdate(ctx context.Context, input *metadata.StatusUpdateInput) (*metadata.StatusUpdate, error) {
 	calledArgs := []interface{}{ctx, input}
 
@@ -23,3 +25,157 @@ func (m *MockMetadata) StatusUpdate(ctx context.Context, input *metadata.StatusU
 
 	return args.Get(0).(*metadata.StatusUpdate), args.Error(1)
 }
+
+// StatusDelete implements metadata.MetadataClient.
+func (m *MockMetadata) StatusDelete(ctx context.Context, input *metadata.StatusDeleteInput) (*metadata.StatusDelete, error) {
+	args := m.Called(ctx, input)
+
+	return args.Get(0).(*metadata.StatusDelete), args.Error(1)
+}
+
+// AnnotationUpdate implements metadata.MetadataClient.
+func (m *MockMetadata) AnnotationUpdate(ctx context.Context, input *metadata.AnnotationUpdateInput) (*metadata.AnnotationUpdate, error) {
+	args := m.Called(ctx, input)
+
+	return args.Get(0).(*metadata.AnnotationUpdate), args.Error(1)
+}
+
+// AnnotationDelete implements metadata.MetadataClient.
+func (m *MockMetadata) AnnotationDelete(ctx context.Context, input *metadata.AnnotationDeleteInput) (*metadata.AnnotationDelete, error) {
+	args := m.Called(ctx, input)
+
+	return args.Get(0).(*metadata.AnnotationDelete), args.Error(1)
+}
+
+// AnnotationNamespace implements metadata.MetadataClient.
+func (m *MockMetadata) AnnotationNamespace(ctx context.Context, id string) (*metadata.AnnotationNamespace, error) {
+	args := m.Called(ctx, id)
+
+	return args.Get(0).(*metadata.AnnotationNamespace), args.Error(1)
+}
+
+// AnnotationNamespaceByName implements metadata.MetadataClient.
+func (m *MockMetadata) AnnotationNamespaceByName(ctx context.Context, ownerID, name string) (*metadata.AnnotationNamespace, error) {
+	args := m.Called(ctx, ownerID, name)
+
+	return args.Get(0).(*metadata.AnnotationNamespace), args.Error(1)
+}
+
+// AnnotationNamespaces implements metadata.MetadataClient.
+func (m *MockMetadata) AnnotationNamespaces(ctx context.Context, ownerID string) *metadata.Iterator[metadata.AnnotationNamespace] {
+	args := m.Called(ctx, ownerID)
+
+	return args.Get(0).(*metadata.Iterator[metadata.AnnotationNamespace])
+}
+
+// AnnotationNamespaceCreate implements metadata.MetadataClient.
+func (m *MockMetadata) AnnotationNamespaceCreate(ctx context.Context, input *metadata.CreateAnnotationNamespaceInput) (*metadata.AnnotationNamespaceCreate, error) {
+	args := m.Called(ctx, input)
+
+	return args.Get(0).(*metadata.AnnotationNamespaceCreate), args.Error(1)
+}
+
+// AnnotationNamespaceUpdate implements metadata.MetadataClient.
+func (m *MockMetadata) AnnotationNamespaceUpdate(ctx context.Context, id string, input *metadata.UpdateAnnotationNamespaceInput) (*metadata.AnnotationNamespaceUpdate, error) {
+	args := m.Called(ctx, id, input)
+
+	return args.Get(0).(*metadata.AnnotationNamespaceUpdate), args.Error(1)
+}
+
+// AnnotationNamespaceDelete implements metadata.MetadataClient.
+func (m *MockMetadata) AnnotationNamespaceDelete(ctx context.Context, id string, force bool) (*metadata.AnnotationNamespaceDelete, error) {
+	args := m.Called(ctx, id, force)
+
+	return args.Get(0).(*metadata.AnnotationNamespaceDelete), args.Error(1)
+}
+
+// AnnotationNamespaceRestore implements metadata.MetadataClient.
+func (m *MockMetadata) AnnotationNamespaceRestore(ctx context.Context, id string) (*metadata.AnnotationNamespaceRestore, error) {
+	args := m.Called(ctx, id)
+
+	return args.Get(0).(*metadata.AnnotationNamespaceRestore), args.Error(1)
+}
+
+// StatusNamespace implements metadata.MetadataClient.
+func (m *MockMetadata) StatusNamespace(ctx context.Context, id string) (*metadata.StatusNamespace, error) {
+	args := m.Called(ctx, id)
+
+	return args.Get(0).(*metadata.StatusNamespace), args.Error(1)
+}
+
+// StatusNamespaceByName implements metadata.MetadataClient.
+func (m *MockMetadata) StatusNamespaceByName(ctx context.Context, resourceProviderID, name string) (*metadata.StatusNamespace, error) {
+	args := m.Called(ctx, resourceProviderID, name)
+
+	return args.Get(0).(*metadata.StatusNamespace), args.Error(1)
+}
+
+// StatusNamespaces implements metadata.MetadataClient.
+func (m *MockMetadata) StatusNamespaces(ctx context.Context, resourceProviderID string) *metadata.Iterator[metadata.StatusNamespace] {
+	args := m.Called(ctx, resourceProviderID)
+
+	return args.Get(0).(*metadata.Iterator[metadata.StatusNamespace])
+}
+
+// StatusNamespaceCreate implements metadata.MetadataClient.
+func (m *MockMetadata) StatusNamespaceCreate(ctx context.Context, input *metadata.CreateStatusNamespaceInput) (*metadata.StatusNamespaceCreate, error) {
+	args := m.Called(ctx, input)
+
+	return args.Get(0).(*metadata.StatusNamespaceCreate), args.Error(1)
+}
+
+// StatusNamespaceUpdate implements metadata.MetadataClient.
+func (m *MockMetadata) StatusNamespaceUpdate(ctx context.Context, id string, input *metadata.UpdateStatusNamespaceInput) (*metadata.StatusNamespaceUpdate, error) {
+	args := m.Called(ctx, id, input)
+
+	return args.Get(0).(*metadata.StatusNamespaceUpdate), args.Error(1)
+}
+
+// StatusNamespaceDelete implements metadata.MetadataClient.
+func (m *MockMetadata) StatusNamespaceDelete(ctx context.Context, id string, force bool) (*metadata.StatusNamespaceDelete, error) {
+	args := m.Called(ctx, id, force)
+
+	return args.Get(0).(*metadata.StatusNamespaceDelete), args.Error(1)
+}
+
+// StatusNamespaceRestore implements metadata.MetadataClient.
+func (m *MockMetadata) StatusNamespaceRestore(ctx context.Context, id string) (*metadata.StatusNamespaceRestore, error) {
+	args := m.Called(ctx, id)
+
+	return args.Get(0).(*metadata.StatusNamespaceRestore), args.Error(1)
+}
+
+// NamespaceDeletion implements metadata.MetadataClient.
+func (m *MockMetadata) NamespaceDeletion(ctx context.Context, id string) (*metadata.NamespaceDeletion, error) {
+	args := m.Called(ctx, id)
+
+	return args.Get(0).(*metadata.NamespaceDeletion), args.Error(1)
+}
+
+// Metadata implements metadata.MetadataClient.
+func (m *MockMetadata) Metadata(ctx context.Context, nodeID string) (*metadata.Metadata, error) {
+	args := m.Called(ctx, nodeID)
+
+	return args.Get(0).(*metadata.Metadata), args.Error(1)
+}
+
+// Statuses implements metadata.MetadataClient.
+func (m *MockMetadata) Statuses(ctx context.Context, nodeID string) *metadata.Iterator[metadata.Status] {
+	args := m.Called(ctx, nodeID)
+
+	return args.Get(0).(*metadata.Iterator[metadata.Status])
+}
+
+// Annotations implements metadata.MetadataClient.
+func (m *MockMetadata) Annotations(ctx context.Context, nodeID string) *metadata.Iterator[metadata.Annotation] {
+	args := m.Called(ctx, nodeID)
+
+	return args.Get(0).(*metadata.Iterator[metadata.Annotation])
+}
+
+// Iterator returns an iterator over nodes, for mocking methods returning iterators.
+func Iterator[T any](ctx context.Context, nodes ...T) *metadata.Iterator[T] {
+	return metadata.NewIterator(ctx, func(context.Context, *metadata.Cursor) ([]T, metadata.PageInfo, error) {
+		return nodes, metadata.PageInfo{}, nil
+	})
+}
@@ -21,6 +21,31 @@ func TestMetadata(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, resp)
 
+		mockMeta.AssertExpectations(t)
+	})
+	t.Run("get metadata", func(t *testing.T) {
+		mockMeta := new(mockmetadata.MockMetadata)
+
+		mockMeta.On("Metadata", context.Background(), "loadbal-testing").Return(&metadata.Metadata{NodeID: "loadbal-testing"}, nil)
+
+		resp, err := mockMeta.Metadata(context.Background(), "loadbal-testing")
+		require.NoError(t, err)
+		require.Equal(t, "loadbal-testing", resp.NodeID)
+
+		mockMeta.AssertExpectations(t)
+	})
+
+	t.Run("list statuses", func(t *testing.T) {
+		mockMeta := new(mockmetadata.MockMetadata)
+
+		statuses := []metadata.Status{{ID: "metasts-one"}, {ID: "metasts-two"}}
+
+		mockMeta.On("Statuses", context.Background(), "loadbal-testing").Return(mockmetadata.Iterator(context.Background(), statuses...))
+
+		resp, err := mockMeta.Statuses(context.Background(), "loadbal-testing").All()
+		require.NoError(t, err)
+		require.Equal(t, statuses, resp)
+
 		mockMeta.AssertExpectations(t)
 	})
 }
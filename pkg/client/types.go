@@ -2,8 +2,111 @@ package client
 
 import (
 	"encoding/json"
+	"time"
 )
 
+// Cursor is a Relay cursor pointing to an edge of a connection
+type Cursor string
+
+// PageInfo is the pagination information of a connection
+type PageInfo struct {
+	HasNextPage bool    `graphql:"hasNextPage"`
+	EndCursor   *Cursor `graphql:"endCursor"`
+}
+
+// DataUpdateMode defines how the data of an update is applied to the stored data
+type DataUpdateMode string
+
+const (
+	// DataUpdateModeReplace replaces the stored data with the given data
+	DataUpdateModeReplace DataUpdateMode = "REPLACE"
+	// DataUpdateModeMergePatch applies the given data as an RFC 7396 JSON merge patch
+	DataUpdateModeMergePatch DataUpdateMode = "MERGE_PATCH"
+	// DataUpdateModeJSONPatch applies the given data as an RFC 6902 JSON Patch document
+	DataUpdateModeJSONPatch DataUpdateMode = "JSON_PATCH"
+)
+
+// representation is an entity representation used to look up federated entities
+type representation map[string]string
+
+// GetGraphQLType returns the graphql type of entity representations
+func (representation) GetGraphQLType() string {
+	return "_Any"
+}
+
+// MetadataRef identifies the metadata a status or annotation belongs to
+type MetadataRef struct {
+	ID     string `graphql:"id"`
+	NodeID string `graphql:"nodeID"`
+}
+
+// Metadata is the metadata of a node with all of its statuses and annotations
+type Metadata struct {
+	ID          string
+	NodeID      string
+	Statuses    []Status
+	Annotations []Annotation
+}
+
+// Status is a status of a node
+type Status struct {
+	ID                string          `graphql:"id"`
+	CreatedAt         time.Time       `graphql:"createdAt"`
+	UpdatedAt         time.Time       `graphql:"updatedAt"`
+	Data              json.RawMessage `graphql:"data"`
+	Source            string          `graphql:"source"`
+	Version           int64           `graphql:"version"`
+	ExpiresAt         *time.Time      `graphql:"expiresAt"`
+	StatusNamespaceID string          `graphql:"statusNamespaceID"`
+
+	Metadata MetadataRef `graphql:"metadata"`
+}
+
+// Annotation is an annotation of a node
+type Annotation struct {
+	ID        string          `graphql:"id"`
+	CreatedAt time.Time       `graphql:"createdAt"`
+	UpdatedAt time.Time       `graphql:"updatedAt"`
+	Data      json.RawMessage `graphql:"data"`
+	Version   int64           `graphql:"version"`
+
+	Namespace struct {
+		ID   string `graphql:"id"`
+		Name string `graphql:"name"`
+	} `graphql:"namespace"`
+
+	Metadata MetadataRef `graphql:"metadata"`
+}
+
+// AnnotationNamespace is an annotation namespace
+type AnnotationNamespace struct {
+	ID         string          `graphql:"id"`
+	CreatedAt  time.Time       `graphql:"createdAt"`
+	UpdatedAt  time.Time       `graphql:"updatedAt"`
+	Name       string          `graphql:"name"`
+	Private    bool            `graphql:"private"`
+	JSONSchema json.RawMessage `graphql:"jsonSchema"`
+
+	Owner struct {
+		ID string `graphql:"id"`
+	} `graphql:"owner"`
+}
+
+// StatusNamespace is a status namespace
+type StatusNamespace struct {
+	ID         string          `graphql:"id"`
+	CreatedAt  time.Time       `graphql:"createdAt"`
+	UpdatedAt  time.Time       `graphql:"updatedAt"`
+	Name       string          `graphql:"name"`
+	Private    bool            `graphql:"private"`
+	JSONSchema json.RawMessage `graphql:"jsonSchema"`
+	DefaultTTL *int64          `graphql:"defaultTTL"`
+
+	Owner struct {
+		ID string `graphql:"id"`
+	} `graphql:"owner"`
+}
+
 // StatusUpdate is the statusUpdate mutation
 type StatusUpdate struct {
 	StatusUpdate StatusUpdateResponse `graphql:"statusUpdate(input: $input)"`
@@ -13,25 +116,365 @@ type StatusUpdate struct {
 type StatusUpdateInput struct {
 	// The node ID for this status.
 	NodeID string `graphql:"nodeID" json:"nodeID"`
-	// The namespace ID for this status.
-	NamespaceID string `graphql:"namespaceID" json:"namespaceID"`
+	// The namespace ID for this status. Either NamespaceID, or OwnerID and NamespaceName, must be set.
+	NamespaceID string `graphql:"namespaceID" json:"namespaceID,omitempty"`
+	// The ID of the resource provider of the namespace, to name it by NamespaceName instead of NamespaceID.
+	OwnerID string `graphql:"ownerID" json:"ownerID,omitempty"`
+	// The name of the namespace, used with OwnerID instead of NamespaceID.
+	NamespaceName string `graphql:"namespaceName" json:"namespaceName,omitempty"`
 	// The source for this status.
 	Source string `graphql:"source" json:"source"`
 	// The data to save in this status.
 	Data json.RawMessage `graphql:"data" json:"data"`
+	// How the data is applied to the stored data, defaults to replacing it.
+	Mode *DataUpdateMode `graphql:"mode" json:"mode,omitempty"`
+	// The time the status expires. Can't be set together with TTL.
+	ExpiresAt *time.Time `graphql:"expiresAt" json:"expiresAt,omitempty"`
+	// The number of seconds until the status expires. Can't be set together with ExpiresAt.
+	TTL *int64 `graphql:"ttl" json:"ttl,omitempty"`
+	// The version the status must be at for the update to be applied, 0 when it must not exist yet.
+	ExpectedVersion *int64 `graphql:"expectedVersion" json:"expectedVersion,omitempty"`
 }
 
 // StatusUpdateResponse is the response for the statusUpdate mutation
 type StatusUpdateResponse struct {
-	Status struct {
-		ID                string          `graphql:"id"`
-		Data              json.RawMessage `graphql:"data"`
-		Source            string          `graphql:"source"`
-		StatusNamespaceID string          `graphql:"statusNamespaceID"`
-
-		Metadata struct {
-			ID     string `graphql:"id"`
-			NodeID string `graphql:"nodeID"`
-		} `graphql:"metadata"`
-	} `graphql:"status"`
+	Status Status `graphql:"status"`
+}
+
+// StatusDelete is the statusDelete mutation
+type StatusDelete struct {
+	StatusDelete StatusDeleteResponse `graphql:"statusDelete(input: $input)"`
+}
+
+// StatusDeleteInput is the input for the statusDelete mutation
+type StatusDeleteInput struct {
+	// The node ID for this status.
+	NodeID string `graphql:"nodeID" json:"nodeID"`
+	// The namespace ID for this status. Either NamespaceID, or OwnerID and NamespaceName, must be set.
+	NamespaceID string `graphql:"namespaceID" json:"namespaceID,omitempty"`
+	// The ID of the resource provider of the namespace, to name it by NamespaceName instead of NamespaceID.
+	OwnerID string `graphql:"ownerID" json:"ownerID,omitempty"`
+	// The name of the namespace, used with OwnerID instead of NamespaceID.
+	NamespaceName string `graphql:"namespaceName" json:"namespaceName,omitempty"`
+	// The source for this status.
+	Source string `graphql:"source" json:"source"`
+	// The version the status must be at for it to be deleted.
+	ExpectedVersion *int64 `graphql:"expectedVersion" json:"expectedVersion,omitempty"`
+}
+
+// StatusDeleteResponse is the response for the statusDelete mutation
+type StatusDeleteResponse struct {
+	DeletedID string `graphql:"deletedID"`
+}
+
+// AnnotationUpdate is the annotationUpdate mutation
+type AnnotationUpdate struct {
+	AnnotationUpdate AnnotationUpdateResponse `graphql:"annotationUpdate(input: $input)"`
+}
+
+// AnnotationUpdateInput is the input for the annotationUpdate mutation
+type AnnotationUpdateInput struct {
+	// The node ID for this annotation.
+	NodeID string `graphql:"nodeID" json:"nodeID"`
+	// The namespace ID for this annotation. Either NamespaceID, or OwnerID and NamespaceName, must be set.
+	NamespaceID string `graphql:"namespaceID" json:"namespaceID,omitempty"`
+	// The ID of the owner of the namespace, to name it by NamespaceName instead of NamespaceID.
+	OwnerID string `graphql:"ownerID" json:"ownerID,omitempty"`
+	// The name of the namespace, used with OwnerID instead of NamespaceID.
+	NamespaceName string `graphql:"namespaceName" json:"namespaceName,omitempty"`
+	// The data to save in this annotation.
+	Data json.RawMessage `graphql:"data" json:"data"`
+	// How the data is applied to the stored data, defaults to replacing it.
+	Mode *DataUpdateMode `graphql:"mode" json:"mode,omitempty"`
+	// The version the annotation must be at for the update to be applied, 0 when it must not exist yet.
+	ExpectedVersion *int64 `graphql:"expectedVersion" json:"expectedVersion,omitempty"`
+}
+
+// AnnotationUpdateResponse is the response for the annotationUpdate mutation
+type AnnotationUpdateResponse struct {
+	Annotation Annotation `graphql:"annotation"`
+}
+
+// AnnotationDelete is the annotationDelete mutation
+type AnnotationDelete struct {
+	AnnotationDelete AnnotationDeleteResponse `graphql:"annotationDelete(input: $input)"`
+}
+
+// AnnotationDeleteInput is the input for the annotationDelete mutation
+type AnnotationDeleteInput struct {
+	// The node ID for this annotation.
+	NodeID string `graphql:"nodeID" json:"nodeID"`
+	// The namespace ID for this annotation. Either NamespaceID, or OwnerID and NamespaceName, must be set.
+	NamespaceID string `graphql:"namespaceID" json:"namespaceID,omitempty"`
+	// The ID of the owner of the namespace, to name it by NamespaceName instead of NamespaceID.
+	OwnerID string `graphql:"ownerID" json:"ownerID,omitempty"`
+	// The name of the namespace, used with OwnerID instead of NamespaceID.
+	NamespaceName string `graphql:"namespaceName" json:"namespaceName,omitempty"`
+	// The version the annotation must be at for it to be deleted.
+	ExpectedVersion *int64 `graphql:"expectedVersion" json:"expectedVersion,omitempty"`
+}
+
+// AnnotationDeleteResponse is the response for the annotationDelete mutation
+type AnnotationDeleteResponse struct {
+	DeletedID string `graphql:"deletedID"`
+}
+
+// AnnotationNamespaceCreate is the annotationNamespaceCreate mutation
+type AnnotationNamespaceCreate struct {
+	AnnotationNamespaceCreate struct {
+		AnnotationNamespace AnnotationNamespace `graphql:"annotationNamespace"`
+	} `graphql:"annotationNamespaceCreate(input: $input)"`
+}
+
+// CreateAnnotationNamespaceInput is the input for the annotationNamespaceCreate mutation
+type CreateAnnotationNamespaceInput struct {
+	// The name of the annotation namespace.
+	Name string `json:"name"`
+	// The ID for the owner for this annotation namespace.
+	OwnerID string `json:"ownerID"`
+	// Whether the annotation namespace is private.
+	Private *bool `json:"private,omitempty"`
+	// JSON schema the data of the annotations must validate against.
+	JSONSchema json.RawMessage `json:"jsonSchema,omitempty"`
+}
+
+// AnnotationNamespaceUpdate is the annotationNamespaceUpdate mutation
+type AnnotationNamespaceUpdate struct {
+	AnnotationNamespaceUpdate struct {
+		AnnotationNamespace AnnotationNamespace `graphql:"annotationNamespace"`
+	} `graphql:"annotationNamespaceUpdate(id: $id, input: $input)"`
+}
+
+// UpdateAnnotationNamespaceInput is the input for the annotationNamespaceUpdate mutation
+type UpdateAnnotationNamespaceInput struct {
+	// The name of the annotation namespace.
+	Name *string `json:"name,omitempty"`
+	// Whether the annotation namespace is private.
+	Private *bool `json:"private,omitempty"`
+	// JSON schema the data of the annotations must validate against.
+	JSONSchema json.RawMessage `json:"jsonSchema,omitempty"`
+	// Removes the JSON schema of the annotation namespace.
+	ClearJSONSchema *bool `json:"clearJSONSchema,omitempty"`
+}
+
+// AnnotationNamespaceDelete is the annotationNamespaceDelete mutation
+type AnnotationNamespaceDelete struct {
+	AnnotationNamespaceDelete struct {
+		DeletedID              string             `graphql:"deletedID"`
+		AnnotationDeletedCount int                `graphql:"annotationDeletedCount"`
+		NamespaceDeletion      *NamespaceDeletion `graphql:"namespaceDeletion"`
+	} `graphql:"annotationNamespaceDelete(id: $id, force: $force)"`
+}
+
+// AnnotationNamespaceRestore is the annotationNamespaceRestore mutation
+type AnnotationNamespaceRestore struct {
+	AnnotationNamespaceRestore struct {
+		AnnotationNamespace AnnotationNamespace `graphql:"annotationNamespace"`
+	} `graphql:"annotationNamespaceRestore(id: $id)"`
+}
+
+// StatusNamespaceCreate is the statusNamespaceCreate mutation
+type StatusNamespaceCreate struct {
+	StatusNamespaceCreate struct {
+		StatusNamespace StatusNamespace `graphql:"statusNamespace"`
+	} `graphql:"statusNamespaceCreate(input: $input)"`
+}
+
+// CreateStatusNamespaceInput is the input for the statusNamespaceCreate mutation
+type CreateStatusNamespaceInput struct {
+	// The name of the status namespace.
+	Name string `json:"name"`
+	// The ID for the resource provider for this status namespace.
+	ResourceProviderID string `json:"resourceProviderID"`
+	// Whether the status namespace is private.
+	Private *bool `json:"private,omitempty"`
+	// JSON schema the data of the statuses must validate against.
+	JSONSchema json.RawMessage `json:"jsonSchema,omitempty"`
+	// The number of seconds until statuses in the namespace expire when they're updated without an expiry.
+	DefaultTTL *int64 `json:"defaultTTL,omitempty"`
+}
+
+// StatusNamespaceUpdate is the statusNamespaceUpdate mutation
+type StatusNamespaceUpdate struct {
+	StatusNamespaceUpdate struct {
+		StatusNamespace StatusNamespace `graphql:"statusNamespace"`
+	} `graphql:"statusNamespaceUpdate(id: $id, input: $input)"`
+}
+
+// UpdateStatusNamespaceInput is the input for the statusNamespaceUpdate mutation
+type UpdateStatusNamespaceInput struct {
+	// The name of the status namespace.
+	Name *string `json:"name,omitempty"`
+	// Whether the status namespace is private.
+	Private *bool `json:"private,omitempty"`
+	// JSON schema the data of the statuses must validate against.
+	JSONSchema json.RawMessage `json:"jsonSchema,omitempty"`
+	// Removes the JSON schema of the status namespace.
+	ClearJSONSchema *bool `json:"clearJSONSchema,omitempty"`
+	// The number of seconds until statuses in the namespace expire when they're updated without an expiry.
+	DefaultTTL *int64 `json:"defaultTTL,omitempty"`
+	// Removes the default TTL of the status namespace.
+	ClearDefaultTTL *bool `json:"clearDefaultTTL,omitempty"`
+}
+
+// StatusNamespaceDelete is the statusNamespaceDelete mutation
+type StatusNamespaceDelete struct {
+	StatusNamespaceDelete struct {
+		DeletedID          string             `graphql:"deletedID"`
+		StatusDeletedCount int                `graphql:"statusDeletedCount"`
+		NamespaceDeletion  *NamespaceDeletion `graphql:"namespaceDeletion"`
+	} `graphql:"statusNamespaceDelete(id: $id, force: $force)"`
+}
+
+// StatusNamespaceRestore is the statusNamespaceRestore mutation
+type StatusNamespaceRestore struct {
+	StatusNamespaceRestore struct {
+		StatusNamespace StatusNamespace `graphql:"statusNamespace"`
+	} `graphql:"statusNamespaceRestore(id: $id)"`
+}
+
+// NamespaceDeletion is the deletion of a namespace with its statuses or
+// annotations, which is run in the background
+type NamespaceDeletion struct {
+	ID           string     `graphql:"id"`
+	CreatedAt    time.Time  `graphql:"createdAt"`
+	UpdatedAt    time.Time  `graphql:"updatedAt"`
+	NamespaceID  string     `graphql:"namespaceID"`
+	State        string     `graphql:"state"`
+	TotalCount   int        `graphql:"totalCount"`
+	DeletedCount int        `graphql:"deletedCount"`
+	CompletedAt  *time.Time `graphql:"completedAt"`
+}
+
+type statusConnection struct {
+	Edges []struct {
+		Node Status `graphql:"node"`
+	} `graphql:"edges"`
+	PageInfo PageInfo `graphql:"pageInfo"`
+}
+
+func (c statusConnection) nodes() []Status {
+	nodes := make([]Status, len(c.Edges))
+	for i, edge := range c.Edges {
+		nodes[i] = edge.Node
+	}
+
+	return nodes
+}
+
+type annotationConnection struct {
+	Edges []struct {
+		Node Annotation `graphql:"node"`
+	} `graphql:"edges"`
+	PageInfo PageInfo `graphql:"pageInfo"`
+}
+
+func (c annotationConnection) nodes() []Annotation {
+	nodes := make([]Annotation, len(c.Edges))
+	for i, edge := range c.Edges {
+		nodes[i] = edge.Node
+	}
+
+	return nodes
+}
+
+type annotationNamespaceConnection struct {
+	Edges []struct {
+		Node AnnotationNamespace `graphql:"node"`
+	} `graphql:"edges"`
+	PageInfo PageInfo `graphql:"pageInfo"`
+}
+
+func (c annotationNamespaceConnection) nodes() []AnnotationNamespace {
+	nodes := make([]AnnotationNamespace, len(c.Edges))
+	for i, edge := range c.Edges {
+		nodes[i] = edge.Node
+	}
+
+	return nodes
+}
+
+type statusNamespaceConnection struct {
+	Edges []struct {
+		Node StatusNamespace `graphql:"node"`
+	} `graphql:"edges"`
+	PageInfo PageInfo `graphql:"pageInfo"`
+}
+
+func (c statusNamespaceConnection) nodes() []StatusNamespace {
+	nodes := make([]StatusNamespace, len(c.Edges))
+	for i, edge := range c.Edges {
+		nodes[i] = edge.Node
+	}
+
+	return nodes
+}
+
+type metadataQuery struct {
+	Entities []struct {
+		MetadataNode struct {
+			Metadata *struct {
+				ID          string               `graphql:"id"`
+				NodeID      string               `graphql:"nodeID"`
+				Statuses    statusConnection     `graphql:"statuses(first: $first)"`
+				Annotations annotationConnection `graphql:"annotations(first: $first)"`
+			} `graphql:"metadata"`
+		} `graphql:"... on MetadataNode"`
+	} `graphql:"_entities(representations: $representations)"`
+}
+
+type statusesQuery struct {
+	Entities []struct {
+		MetadataNode struct {
+			Metadata *struct {
+				Statuses statusConnection `graphql:"statuses(first: $first, after: $after)"`
+			} `graphql:"metadata"`
+		} `graphql:"... on MetadataNode"`
+	} `graphql:"_entities(representations: $representations)"`
+}
+
+type annotationsQuery struct {
+	Entities []struct {
+		MetadataNode struct {
+			Metadata *struct {
+				Annotations annotationConnection `graphql:"annotations(first: $first, after: $after)"`
+			} `graphql:"metadata"`
+		} `graphql:"... on MetadataNode"`
+	} `graphql:"_entities(representations: $representations)"`
+}
+
+type namespaceDeletionQuery struct {
+	NamespaceDeletion NamespaceDeletion `graphql:"namespaceDeletion(id: $id)"`
+}
+
+type annotationNamespaceQuery struct {
+	AnnotationNamespace AnnotationNamespace `graphql:"annotationNamespace(id: $id)"`
+}
+
+type annotationNamespaceByNameQuery struct {
+	AnnotationNamespace AnnotationNamespace `graphql:"annotationNamespaceByName(ownerID: $ownerID, name: $name)"`
+}
+
+type annotationNamespacesQuery struct {
+	Entities []struct {
+		ResourceOwner struct {
+			AnnotationNamespaces annotationNamespaceConnection `graphql:"annotationNamespaces(first: $first, after: $after)"`
+		} `graphql:"... on ResourceOwner"`
+	} `graphql:"_entities(representations: $representations)"`
+}
+
+type statusNamespaceQuery struct {
+	StatusNamespace StatusNamespace `graphql:"statusNamespace(id: $id)"`
+}
+
+type statusNamespaceByNameQuery struct {
+	StatusNamespace StatusNamespace `graphql:"statusNamespaceByName(ownerID: $ownerID, name: $name)"`
+}
+
+type statusNamespacesQuery struct {
+	Entities []struct {
+		StatusOwner struct {
+			StatusNamespaces statusNamespaceConnection `graphql:"statusNamespaces(first: $first, after: $after)"`
+		} `graphql:"... on StatusOwner"`
+	} `graphql:"_entities(representations: $representations)"`
 }